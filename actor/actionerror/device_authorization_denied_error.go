@@ -0,0 +1,9 @@
+package actionerror
+
+// DeviceAuthorizationDeniedError is returned when the user denies a device
+// login.
+type DeviceAuthorizationDeniedError struct{}
+
+func (DeviceAuthorizationDeniedError) Error() string {
+	return "The login request was denied."
+}
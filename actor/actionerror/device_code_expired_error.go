@@ -0,0 +1,9 @@
+package actionerror
+
+// DeviceCodeExpiredError is returned when a device login is not approved
+// before its device code expires.
+type DeviceCodeExpiredError struct{}
+
+func (DeviceCodeExpiredError) Error() string {
+	return "The device code expired before the login was approved."
+}
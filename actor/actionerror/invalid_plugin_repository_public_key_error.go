@@ -0,0 +1,9 @@
+package actionerror
+
+// InvalidPluginRepositoryPublicKeyError is returned when a plugin repository
+// public key is not a base64-encoded ed25519 public key.
+type InvalidPluginRepositoryPublicKeyError struct{}
+
+func (InvalidPluginRepositoryPublicKeyError) Error() string {
+	return "plugin repository public key must be a base64-encoded ed25519 public key"
+}
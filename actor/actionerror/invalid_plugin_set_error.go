@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// InvalidPluginSetError is returned when a plugin set file cannot be parsed or
+// is missing required fields.
+type InvalidPluginSetError struct {
+	Path    string
+	Message string
+}
+
+func (e InvalidPluginSetError) Error() string {
+	return fmt.Sprintf("Plugin set file %s is invalid: %s", e.Path, e.Message)
+}
@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// PluginNameMismatchError is returned when a plugin binary meant to update an
+// installed plugin reports a different plugin name.
+type PluginNameMismatchError struct {
+	ExpectedName string
+	ActualName   string
+}
+
+func (e PluginNameMismatchError) Error() string {
+	return fmt.Sprintf("Plugin binary is for plugin %s, not %s.", e.ActualName, e.ExpectedName)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// PluginSignatureInvalidError is returned when a plugin binary's signature
+// does not verify against its repository's public key.
+type PluginSignatureInvalidError struct {
+	PluginName string
+}
+
+func (e PluginSignatureInvalidError) Error() string {
+	return fmt.Sprintf("Plugin %s has an invalid signature", e.PluginName)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// PluginSignatureMissingError is returned when a plugin comes from a
+// repository with a public key configured but has no signature.
+type PluginSignatureMissingError struct {
+	PluginName string
+}
+
+func (e PluginSignatureMissingError) Error() string {
+	return fmt.Sprintf("Plugin %s is not signed", e.PluginName)
+}
@@ -0,0 +1,16 @@
+package actionerror
+
+import "fmt"
+
+// PluginVersionNotAvailableError is returned when a repository does not offer
+// the version of a plugin that was asked for.
+type PluginVersionNotAvailableError struct {
+	PluginName       string
+	RepositoryName   string
+	Version          string
+	AvailableVersion string
+}
+
+func (e PluginVersionNotAvailableError) Error() string {
+	return fmt.Sprintf("Plugin %s %s is not available in repository %s; it offers %s", e.PluginName, e.Version, e.RepositoryName, e.AvailableVersion)
+}
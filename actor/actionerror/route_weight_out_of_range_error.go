@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// RouteWeightOutOfRangeError is returned when a route destination weight is
+// not a percentage between 1 and 100.
+type RouteWeightOutOfRangeError struct {
+	Weight int
+}
+
+func (e RouteWeightOutOfRangeError) Error() string {
+	return fmt.Sprintf("Route weight %d must be between 1 and 100", e.Weight)
+}
@@ -0,0 +1,18 @@
+package actionerror
+
+import "fmt"
+
+// RouteWeightsUnbalancedError is returned when the requested route
+// destination weights cannot be combined with the route's other destinations
+// into weights that add up to 100.
+type RouteWeightsUnbalancedError struct {
+	RouteURL          string
+	OtherDestinations int
+}
+
+func (e RouteWeightsUnbalancedError) Error() string {
+	if e.OtherDestinations == 0 {
+		return fmt.Sprintf("Weights for route %s must add up to 100", e.RouteURL)
+	}
+	return fmt.Sprintf("Weights for route %s must leave at least 1 for each of its %d other destinations", e.RouteURL, e.OtherDestinations)
+}
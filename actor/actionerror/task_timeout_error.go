@@ -0,0 +1,17 @@
+package actionerror
+
+import (
+	"fmt"
+	"time"
+)
+
+// TaskTimeoutError is returned when a task does not finish within the
+// allotted time.
+type TaskTimeoutError struct {
+	SequenceID int
+	Timeout    time.Duration
+}
+
+func (e TaskTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out after %s waiting for task %d to complete", e.Timeout, e.SequenceID)
+}
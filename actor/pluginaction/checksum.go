@@ -1,8 +1,57 @@
 package pluginaction
 
-import "code.cloudfoundry.org/cli/util/configv3"
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"io/ioutil"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+// ValidateFileChecksum compares the file at path with checksum, which is a
+// hex-encoded SHA-256 or, for repositories that only publish SHA-1, SHA-1
+// digest.
 func (actor Actor) ValidateFileChecksum(path string, checksum string) bool {
 	plugin := configv3.Plugin{Location: path}
+	if len(checksum) == hex.EncodedLen(sha256.Size) {
+		return plugin.CalculateSHA256() == checksum
+	}
 	return plugin.CalculateSHA1() == checksum
 }
+
+// VerifyPluginSignature checks the plugin binary at path against the
+// signature published for it, using the public key of the repository it was
+// found in. Plugins from repositories without a public key are not checked.
+func (actor Actor) VerifyPluginSignature(path string, pluginInfo PluginInfo) error {
+	if pluginInfo.PublicKey == "" {
+		return nil
+	}
+
+	if pluginInfo.Signature == "" {
+		return actionerror.PluginSignatureMissingError{PluginName: pluginInfo.Name}
+	}
+
+	publicKey, err := base64.StdEncoding.DecodeString(pluginInfo.PublicKey)
+	if err != nil || len(publicKey) != ed25519.PublicKeySize {
+		return actionerror.InvalidPluginRepositoryPublicKeyError{}
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(pluginInfo.Signature)
+	if err != nil {
+		return actionerror.PluginSignatureInvalidError{PluginName: pluginInfo.Name}
+	}
+
+	binary, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if !ed25519.Verify(publicKey, binary, signature) {
+		return actionerror.PluginSignatureInvalidError{PluginName: pluginInfo.Name}
+	}
+
+	return nil
+}
@@ -1,9 +1,13 @@
 package pluginaction_test
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
 	"io/ioutil"
 	"os"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/pluginaction"
 	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
 	. "github.com/onsi/ginkgo"
@@ -49,5 +53,102 @@ var _ = Describe("Checksums", func() {
 				Expect(actor.ValidateFileChecksum(file.Name(), "blah")).To(BeFalse())
 			})
 		})
+
+		When("the checksum is a SHA-256 digest", func() {
+			It("compares the SHA-256 digest of the file", func() {
+				Expect(actor.ValidateFileChecksum(file.Name(), "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae")).To(BeTrue())
+				Expect(actor.ValidateFileChecksum(file.Name(), "0000000000000000000000000000000000000000000000000000000000000000")).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("VerifyPluginSignature", func() {
+		var (
+			file       *os.File
+			publicKey  ed25519.PublicKey
+			privateKey ed25519.PrivateKey
+			pluginInfo PluginInfo
+		)
+
+		BeforeEach(func() {
+			var err error
+			file, err = ioutil.TempFile("", "")
+			Expect(err).NotTo(HaveOccurred())
+			defer file.Close()
+
+			err = ioutil.WriteFile(file.Name(), []byte("foo"), 0600)
+			Expect(err).NotTo(HaveOccurred())
+
+			publicKey, privateKey, err = ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			pluginInfo = PluginInfo{
+				Name:      "some-plugin",
+				Signature: base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, []byte("foo"))),
+				PublicKey: base64.StdEncoding.EncodeToString(publicKey),
+			}
+		})
+
+		AfterEach(func() {
+			err := os.Remove(file.Name())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the signature matches", func() {
+			It("returns no error", func() {
+				Expect(actor.VerifyPluginSignature(file.Name(), pluginInfo)).To(Succeed())
+			})
+		})
+
+		When("the repository has no public key", func() {
+			BeforeEach(func() {
+				pluginInfo.PublicKey = ""
+				pluginInfo.Signature = ""
+			})
+
+			It("does not check the signature", func() {
+				Expect(actor.VerifyPluginSignature(file.Name(), pluginInfo)).To(Succeed())
+			})
+		})
+
+		When("the plugin is not signed", func() {
+			BeforeEach(func() {
+				pluginInfo.Signature = ""
+			})
+
+			It("returns a PluginSignatureMissingError", func() {
+				Expect(actor.VerifyPluginSignature(file.Name(), pluginInfo)).To(MatchError(actionerror.PluginSignatureMissingError{PluginName: "some-plugin"}))
+			})
+		})
+
+		When("the signature is for different contents", func() {
+			BeforeEach(func() {
+				pluginInfo.Signature = base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, []byte("bar")))
+			})
+
+			It("returns a PluginSignatureInvalidError", func() {
+				Expect(actor.VerifyPluginSignature(file.Name(), pluginInfo)).To(MatchError(actionerror.PluginSignatureInvalidError{PluginName: "some-plugin"}))
+			})
+		})
+
+		When("the signature is not base64", func() {
+			BeforeEach(func() {
+				pluginInfo.Signature = "not base64!"
+			})
+
+			It("returns a PluginSignatureInvalidError", func() {
+				Expect(actor.VerifyPluginSignature(file.Name(), pluginInfo)).To(MatchError(actionerror.PluginSignatureInvalidError{PluginName: "some-plugin"}))
+			})
+		})
+
+		When("the public key is malformed", func() {
+			BeforeEach(func() {
+				pluginInfo.PublicKey = base64.StdEncoding.EncodeToString([]byte("short"))
+			})
+
+			It("returns an InvalidPluginRepositoryPublicKeyError", func() {
+				Expect(actor.VerifyPluginSignature(file.Name(), pluginInfo)).To(MatchError(actionerror.InvalidPluginRepositoryPublicKeyError{}))
+			})
+		})
 	})
 })
@@ -2,6 +2,7 @@ package pluginaction
 
 import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"github.com/blang/semver"
 )
 
@@ -12,10 +13,16 @@ type OutdatedPlugin struct {
 }
 
 func (actor Actor) GetOutdatedPlugins() ([]OutdatedPlugin, error) {
+	return actor.GetOutdatedPluginsFromRepositories(actor.config.PluginRepositories())
+}
+
+// GetOutdatedPluginsFromRepositories returns the installed plugins that have a
+// newer version in one of the given repositories.
+func (actor Actor) GetOutdatedPluginsFromRepositories(pluginRepos []configv3.PluginRepository) ([]OutdatedPlugin, error) {
 	var outdatedPlugins []OutdatedPlugin
 
 	repoPlugins := map[string]string{}
-	for _, repo := range actor.config.PluginRepositories() {
+	for _, repo := range pluginRepos {
 		repository, err := actor.client.GetPluginRepository(repo.URL)
 		if err != nil {
 			return nil, actionerror.GettingPluginRepositoryError{Name: repo.Name, Message: err.Error()}
@@ -108,5 +108,29 @@ var _ = Describe("Plugin actions", func() {
 				})
 			})
 		})
+
+		When("repositories are given", func() {
+			BeforeEach(func() {
+				fakeConfig.PluginsReturns([]configv3.Plugin{
+					{Name: "plugin-1", Version: configv3.PluginVersion{Major: 1, Minor: 0, Build: 0}},
+				})
+				fakePluginClient.GetPluginRepositoryReturns(plugin.PluginRepository{
+					Plugins: []plugin.Plugin{{Name: "plugin-1", Version: "1.5.0"}},
+				}, nil)
+			})
+
+			It("only searches those repositories", func() {
+				outdatedPlugins, err := actor.GetOutdatedPluginsFromRepositories([]configv3.PluginRepository{
+					{Name: "Coo Plugins", URL: "https://reallycooplugins.org"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakePluginClient.GetPluginRepositoryCallCount()).To(Equal(1))
+				Expect(fakePluginClient.GetPluginRepositoryArgsForCall(0)).To(Equal("https://reallycooplugins.org"))
+				Expect(outdatedPlugins).To(Equal([]OutdatedPlugin{
+					{Name: "plugin-1", CurrentVersion: "1.0.0", LatestVersion: "1.5.0"},
+				}))
+			})
+		})
 	})
 })
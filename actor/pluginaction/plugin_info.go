@@ -9,10 +9,16 @@ import (
 )
 
 type PluginInfo struct {
-	Name     string
-	Version  string
-	URL      string
-	Checksum string
+	Name    string
+	Version string
+	URL     string
+	// Checksum is the SHA-256 digest of the binary when the repository
+	// publishes one, and its SHA-1 digest otherwise.
+	Checksum  string
+	Signature string
+	// PublicKey is the key configured for the repository the plugin was
+	// found in.
+	PublicKey string
 }
 
 // GetPluginInfoFromRepositoriesForPlatform returns the newest version of the specified plugin
@@ -73,11 +79,18 @@ func (actor Actor) getPluginInfoFromRepositoryForPlatform(pluginName string, plu
 		if plugin.Name == pluginName {
 			for _, pluginBinary := range plugin.Binaries {
 				if pluginBinary.Platform == platform {
+					checksum := pluginBinary.Checksum
+					if pluginBinary.SHA256 != "" {
+						checksum = pluginBinary.SHA256
+					}
+
 					return PluginInfo{
-						Name:     plugin.Name,
-						Version:  plugin.Version,
-						URL:      pluginBinary.URL,
-						Checksum: pluginBinary.Checksum,
+						Name:      plugin.Name,
+						Version:   plugin.Version,
+						URL:       pluginBinary.URL,
+						Checksum:  checksum,
+						Signature: pluginBinary.Signature,
+						PublicKey: pluginRepo.PublicKey,
 					}, nil
 				}
 			}
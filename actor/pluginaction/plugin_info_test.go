@@ -100,6 +100,35 @@ var _ = Describe("plugin info actions", func() {
 						Expect(repos).To(ConsistOf("some-repo"))
 					})
 				})
+
+				When("the repository publishes a SHA-256 digest and signature", func() {
+					BeforeEach(func() {
+						fakeClient.GetPluginRepositoryReturns(plugin.PluginRepository{
+							Plugins: []plugin.Plugin{
+								{
+									Name:    "some-plugin",
+									Version: "1.2.3",
+									Binaries: []plugin.PluginBinary{
+										{Platform: "osx", URL: "http://some-darwin-url", Checksum: "somechecksum", SHA256: "somesha256", Signature: "somesignature"},
+									},
+								},
+							},
+						}, nil)
+					})
+
+					It("prefers the SHA-256 digest and returns the signature with the repository's public key", func() {
+						pluginInfo, _, err := actor.GetPluginInfoFromRepositoriesForPlatform("some-plugin", []configv3.PluginRepository{{Name: "some-repo", URL: "some-url", PublicKey: "some-key"}}, "osx")
+						Expect(err).ToNot(HaveOccurred())
+						Expect(pluginInfo).To(Equal(PluginInfo{
+							Name:      "some-plugin",
+							Version:   "1.2.3",
+							URL:       "http://some-darwin-url",
+							Checksum:  "somesha256",
+							Signature: "somesignature",
+							PublicKey: "some-key",
+						}))
+					})
+				})
 			})
 		})
 
@@ -1,6 +1,8 @@
 package pluginaction
 
 import (
+	"crypto/ed25519"
+	"encoding/base64"
 	"fmt"
 	"strings"
 
@@ -55,6 +57,33 @@ func (actor Actor) GetPluginRepository(repositoryName string) (configv3.PluginRe
 	return configv3.PluginRepository{}, actionerror.RepositoryNotRegisteredError{Name: repositoryName}
 }
 
+// SetPluginRepositoryPublicKey sets the base64-encoded ed25519 key that the
+// plugin binaries of the named repository must be signed with.
+func (actor Actor) SetPluginRepositoryPublicKey(repositoryName string, publicKey string) error {
+	err := actor.ValidatePluginRepositoryPublicKey(publicKey)
+	if err != nil {
+		return err
+	}
+
+	repository, err := actor.GetPluginRepository(repositoryName)
+	if err != nil {
+		return err
+	}
+
+	actor.config.SetPluginRepositoryPublicKey(repository.Name, publicKey)
+	return nil
+}
+
+// ValidatePluginRepositoryPublicKey checks that publicKey is a base64-encoded
+// ed25519 public key.
+func (Actor) ValidatePluginRepositoryPublicKey(publicKey string) error {
+	decodedKey, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil || len(decodedKey) != ed25519.PublicKeySize {
+		return actionerror.InvalidPluginRepositoryPublicKeyError{}
+	}
+	return nil
+}
+
 func (actor Actor) IsPluginRepositoryRegistered(repositoryName string) bool {
 	for _, repository := range actor.config.PluginRepositories() {
 		if repositoryName == repository.Name {
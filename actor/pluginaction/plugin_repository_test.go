@@ -206,6 +206,37 @@ var _ = Describe("Plugin Repository Actions", func() {
 		})
 	})
 
+	Describe("SetPluginRepositoryPublicKey", func() {
+		const publicKey = "11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo="
+
+		BeforeEach(func() {
+			fakeConfig.PluginRepositoriesReturns([]configv3.PluginRepository{
+				{Name: "some-REPO", URL: "some-url"},
+			})
+		})
+
+		It("sets the key on the registered repository", func() {
+			Expect(actor.SetPluginRepositoryPublicKey("sOmE-rEpO", publicKey)).To(Succeed())
+			Expect(fakeConfig.SetPluginRepositoryPublicKeyCallCount()).To(Equal(1))
+			repoName, key := fakeConfig.SetPluginRepositoryPublicKeyArgsForCall(0)
+			Expect(repoName).To(Equal("some-REPO"))
+			Expect(key).To(Equal(publicKey))
+		})
+
+		When("the key is not an ed25519 public key", func() {
+			It("returns an InvalidPluginRepositoryPublicKeyError", func() {
+				Expect(actor.SetPluginRepositoryPublicKey("some-REPO", "c2hvcnQ=")).To(MatchError(actionerror.InvalidPluginRepositoryPublicKeyError{}))
+				Expect(fakeConfig.SetPluginRepositoryPublicKeyCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the repository is not registered", func() {
+			It("returns a RepositoryNotRegisteredError", func() {
+				Expect(actor.SetPluginRepositoryPublicKey("other-repo", publicKey)).To(MatchError(actionerror.RepositoryNotRegisteredError{Name: "other-repo"}))
+			})
+		})
+	})
+
 	Describe("IsPluginRepositoryRegistered", func() {
 		When("the repository is registered", func() {
 			BeforeEach(func() {
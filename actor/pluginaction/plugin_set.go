@@ -0,0 +1,170 @@
+package pluginaction
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/util"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"github.com/blang/semver"
+	"gopkg.in/yaml.v2"
+)
+
+// PluginSet is the declarative list of plugins read from a plugin set file.
+type PluginSet struct {
+	Plugins []PluginSetEntry `yaml:"plugins"`
+}
+
+// PluginSetEntry pins a plugin to a version from a repository. Repository is
+// either the name of a registered plugin repository or the URL of one.
+type PluginSetEntry struct {
+	Name       string `yaml:"name"`
+	Repository string `yaml:"repository"`
+	Version    string `yaml:"version"`
+	Checksum   string `yaml:"checksum,omitempty"`
+}
+
+type PluginDriftType string
+
+const (
+	// PluginDriftMissing means the plugin is in the set but not installed.
+	PluginDriftMissing PluginDriftType = "missing"
+	// PluginDriftVersion means the installed version differs from the set.
+	PluginDriftVersion PluginDriftType = "version"
+	// PluginDriftExtra means the plugin is installed but not in the set.
+	PluginDriftExtra PluginDriftType = "extra"
+)
+
+// PluginDrift describes one difference between the installed plugins and a
+// plugin set.
+type PluginDrift struct {
+	Type             PluginDriftType
+	Name             string
+	InstalledVersion string
+	Entry            PluginSetEntry
+}
+
+// ReadPluginSet reads and validates the plugin set file at path.
+func (actor Actor) ReadPluginSet(path string) (PluginSet, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return PluginSet{}, err
+	}
+
+	var pluginSet PluginSet
+	err = yaml.UnmarshalStrict(raw, &pluginSet)
+	if err != nil {
+		return PluginSet{}, actionerror.InvalidPluginSetError{Path: path, Message: err.Error()}
+	}
+
+	seen := map[string]bool{}
+	for i, entry := range pluginSet.Plugins {
+		switch {
+		case entry.Name == "":
+			return PluginSet{}, actionerror.InvalidPluginSetError{Path: path, Message: fmt.Sprintf("plugin %d has no name", i+1)}
+		case entry.Repository == "":
+			return PluginSet{}, actionerror.InvalidPluginSetError{Path: path, Message: fmt.Sprintf("plugin %s has no repository", entry.Name)}
+		case entry.Version == "":
+			return PluginSet{}, actionerror.InvalidPluginSetError{Path: path, Message: fmt.Sprintf("plugin %s has no version", entry.Name)}
+		case seen[entry.Name]:
+			return PluginSet{}, actionerror.InvalidPluginSetError{Path: path, Message: fmt.Sprintf("plugin %s is listed more than once", entry.Name)}
+		}
+		seen[entry.Name] = true
+	}
+
+	return pluginSet, nil
+}
+
+// GetPluginSetDrift compares the installed plugins with pluginSet. Installed
+// plugins that are not in the set come first, sorted by name, so that they are
+// uninstalled before a plugin from the set that reuses their commands is
+// installed. They are followed by the plugins from the set, in the order they
+// are listed, with updates before installs.
+func (actor Actor) GetPluginSetDrift(pluginSet PluginSet) []PluginDrift {
+	wanted := map[string]bool{}
+	for _, entry := range pluginSet.Plugins {
+		wanted[entry.Name] = true
+	}
+
+	var extra []PluginDrift
+	for _, installedPlugin := range actor.config.Plugins() {
+		if !wanted[installedPlugin.Name] {
+			extra = append(extra, PluginDrift{
+				Type:             PluginDriftExtra,
+				Name:             installedPlugin.Name,
+				InstalledVersion: installedPlugin.Version.String(),
+			})
+		}
+	}
+	sort.Slice(extra, func(i, j int) bool { return extra[i].Name < extra[j].Name })
+
+	var version, missing []PluginDrift
+	for _, entry := range pluginSet.Plugins {
+		installedPlugin, installed := actor.config.GetPlugin(entry.Name)
+		switch {
+		case !installed:
+			missing = append(missing, PluginDrift{Type: PluginDriftMissing, Name: entry.Name, Entry: entry})
+		case !sameVersion(installedPlugin.Version.String(), entry.Version):
+			version = append(version, PluginDrift{
+				Type:             PluginDriftVersion,
+				Name:             entry.Name,
+				InstalledVersion: installedPlugin.Version.String(),
+				Entry:            entry,
+			})
+		}
+	}
+
+	drift := append(extra, version...)
+	return append(drift, missing...)
+}
+
+// GetPluginInfoForPluginSetEntry looks up the plugin pinned by entry in its
+// repository for the given platform. The repository must offer exactly the
+// pinned version. When the entry pins a checksum, it replaces the one from the
+// repository metadata.
+func (actor Actor) GetPluginInfoForPluginSetEntry(entry PluginSetEntry, platform string) (PluginInfo, error) {
+	repo := configv3.PluginRepository{Name: entry.Repository, URL: entry.Repository}
+	if !util.IsHTTPScheme(entry.Repository) {
+		var err error
+		repo, err = actor.GetPluginRepository(entry.Repository)
+		if err != nil {
+			return PluginInfo{}, err
+		}
+	}
+
+	pluginInfo, _, err := actor.GetPluginInfoFromRepositoriesForPlatform(entry.Name, []configv3.PluginRepository{repo}, platform)
+	if err != nil {
+		return PluginInfo{}, err
+	}
+
+	if !sameVersion(pluginInfo.Version, entry.Version) {
+		return PluginInfo{}, actionerror.PluginVersionNotAvailableError{
+			PluginName:       entry.Name,
+			RepositoryName:   repo.Name,
+			Version:          entry.Version,
+			AvailableVersion: pluginInfo.Version,
+		}
+	}
+
+	if entry.Checksum != "" {
+		pluginInfo.Checksum = entry.Checksum
+	}
+
+	return pluginInfo, nil
+}
+
+func sameVersion(version1 string, version2 string) bool {
+	v1, err := semver.Make(version1)
+	if err != nil {
+		return version1 == version2
+	}
+
+	v2, err := semver.Make(version2)
+	if err != nil {
+		return version1 == version2
+	}
+
+	return v1.EQ(v2)
+}
@@ -0,0 +1,240 @@
+package pluginaction_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("plugin set actions", func() {
+	var (
+		actor      *Actor
+		fakeConfig *pluginactionfakes.FakeConfig
+		fakeClient *pluginactionfakes.FakePluginClient
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(pluginactionfakes.FakeConfig)
+		fakeClient = new(pluginactionfakes.FakePluginClient)
+		actor = NewActor(fakeConfig, fakeClient)
+	})
+
+	Describe("ReadPluginSet", func() {
+		var (
+			dir        string
+			path       string
+			contents   string
+			pluginSet  PluginSet
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "plugin-set")
+			Expect(err).ToNot(HaveOccurred())
+			path = filepath.Join(dir, "cf-plugins.yml")
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		JustBeforeEach(func() {
+			Expect(ioutil.WriteFile(path, []byte(contents), 0600)).To(Succeed())
+			pluginSet, executeErr = actor.ReadPluginSet(path)
+		})
+
+		When("the file is valid", func() {
+			BeforeEach(func() {
+				contents = `---
+plugins:
+- name: some-plugin
+  repository: CF-Community
+  version: 1.2.3
+  checksum: some-checksum
+- name: other-plugin
+  repository: https://plugins.example.com
+  version: 0.1.0
+`
+			})
+
+			It("returns the plugins in order", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(pluginSet.Plugins).To(Equal([]PluginSetEntry{
+					{Name: "some-plugin", Repository: "CF-Community", Version: "1.2.3", Checksum: "some-checksum"},
+					{Name: "other-plugin", Repository: "https://plugins.example.com", Version: "0.1.0"},
+				}))
+			})
+		})
+
+		When("the file has unknown fields", func() {
+			BeforeEach(func() {
+				contents = "plugins:\n- name: some-plugin\n  repo: CF-Community\n  version: 1.2.3\n"
+			})
+
+			It("returns an InvalidPluginSetError", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(actionerror.InvalidPluginSetError{}))
+			})
+		})
+
+		When("a plugin has no version", func() {
+			BeforeEach(func() {
+				contents = "plugins:\n- name: some-plugin\n  repository: CF-Community\n"
+			})
+
+			It("returns an InvalidPluginSetError", func() {
+				Expect(executeErr).To(MatchError(actionerror.InvalidPluginSetError{Path: path, Message: "plugin some-plugin has no version"}))
+			})
+		})
+
+		When("a plugin is listed twice", func() {
+			BeforeEach(func() {
+				contents = "plugins:\n- {name: some-plugin, repository: r, version: 1.0.0}\n- {name: some-plugin, repository: r, version: 2.0.0}\n"
+			})
+
+			It("returns an InvalidPluginSetError", func() {
+				Expect(executeErr).To(MatchError(actionerror.InvalidPluginSetError{Path: path, Message: "plugin some-plugin is listed more than once"}))
+			})
+		})
+	})
+
+	Describe("GetPluginSetDrift", func() {
+		BeforeEach(func() {
+			installed := []configv3.Plugin{
+				{Name: "matching-plugin", Version: configv3.PluginVersion{Major: 1, Minor: 2, Build: 3}},
+				{Name: "old-plugin", Version: configv3.PluginVersion{Major: 1}},
+				{Name: "z-extra-plugin", Version: configv3.PluginVersion{Major: 3}},
+				{Name: "a-extra-plugin", Version: configv3.PluginVersion{Major: 4}},
+			}
+			fakeConfig.PluginsReturns(installed)
+			fakeConfig.GetPluginStub = func(name string) (configv3.Plugin, bool) {
+				for _, p := range installed {
+					if p.Name == name {
+						return p, true
+					}
+				}
+				return configv3.Plugin{}, false
+			}
+		})
+
+		It("reports missing, mismatched and extra plugins", func() {
+			drift := actor.GetPluginSetDrift(PluginSet{Plugins: []PluginSetEntry{
+				{Name: "matching-plugin", Repository: "r", Version: "1.2.3"},
+				{Name: "old-plugin", Repository: "r", Version: "2.0.0"},
+				{Name: "new-plugin", Repository: "r", Version: "1.0.0"},
+			}})
+
+			Expect(drift).To(Equal([]PluginDrift{
+				{Type: PluginDriftExtra, Name: "a-extra-plugin", InstalledVersion: "4.0.0"},
+				{Type: PluginDriftExtra, Name: "z-extra-plugin", InstalledVersion: "3.0.0"},
+				{Type: PluginDriftVersion, Name: "old-plugin", InstalledVersion: "1.0.0", Entry: PluginSetEntry{Name: "old-plugin", Repository: "r", Version: "2.0.0"}},
+				{Type: PluginDriftMissing, Name: "new-plugin", Entry: PluginSetEntry{Name: "new-plugin", Repository: "r", Version: "1.0.0"}},
+			}))
+		})
+
+		When("an installed plugin is replaced by another plugin", func() {
+			It("uninstalls the old plugin before installing the new one", func() {
+				drift := actor.GetPluginSetDrift(PluginSet{Plugins: []PluginSetEntry{
+					{Name: "matching-plugin", Repository: "r", Version: "1.2.3"},
+					{Name: "old-plugin", Repository: "r", Version: "1.0.0"},
+					{Name: "a-extra-plugin", Repository: "r", Version: "4.0.0"},
+					{Name: "b-replacement-plugin", Repository: "r", Version: "1.0.0"},
+				}})
+
+				Expect(drift).To(Equal([]PluginDrift{
+					{Type: PluginDriftExtra, Name: "z-extra-plugin", InstalledVersion: "3.0.0"},
+					{Type: PluginDriftMissing, Name: "b-replacement-plugin", Entry: PluginSetEntry{Name: "b-replacement-plugin", Repository: "r", Version: "1.0.0"}},
+				}))
+			})
+		})
+	})
+
+	Describe("GetPluginInfoForPluginSetEntry", func() {
+		var (
+			entry      PluginSetEntry
+			pluginInfo PluginInfo
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			entry = PluginSetEntry{Name: "some-plugin", Repository: "some-repo", Version: "1.2.3"}
+			fakeConfig.PluginRepositoriesReturns([]configv3.PluginRepository{{Name: "some-repo", URL: "https://some-repo.example.com"}})
+			fakeClient.GetPluginRepositoryReturns(plugin.PluginRepository{
+				Plugins: []plugin.Plugin{
+					{
+						Name:    "some-plugin",
+						Version: "1.2.3",
+						Binaries: []plugin.PluginBinary{
+							{Platform: "linux64", URL: "http://some-linux-url", Checksum: "repo-checksum"},
+						},
+					},
+				},
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			pluginInfo, executeErr = actor.GetPluginInfoForPluginSetEntry(entry, "linux64")
+		})
+
+		It("returns the plugin info from the registered repository", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(pluginInfo).To(Equal(PluginInfo{Name: "some-plugin", Version: "1.2.3", URL: "http://some-linux-url", Checksum: "repo-checksum"}))
+			Expect(fakeClient.GetPluginRepositoryArgsForCall(0)).To(Equal("https://some-repo.example.com"))
+		})
+
+		When("the entry pins a checksum", func() {
+			BeforeEach(func() {
+				entry.Checksum = "pinned-checksum"
+			})
+
+			It("uses the pinned checksum", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(pluginInfo.Checksum).To(Equal("pinned-checksum"))
+			})
+		})
+
+		When("the repository is a URL", func() {
+			BeforeEach(func() {
+				entry.Repository = "https://other-repo.example.com"
+			})
+
+			It("uses the URL without requiring a registered repository", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeClient.GetPluginRepositoryArgsForCall(0)).To(Equal("https://other-repo.example.com"))
+			})
+		})
+
+		When("the repository is not registered", func() {
+			BeforeEach(func() {
+				entry.Repository = "unknown-repo"
+			})
+
+			It("returns a RepositoryNotRegisteredError", func() {
+				Expect(executeErr).To(MatchError(actionerror.RepositoryNotRegisteredError{Name: "unknown-repo"}))
+			})
+		})
+
+		When("the repository offers a different version", func() {
+			BeforeEach(func() {
+				entry.Version = "1.0.0"
+			})
+
+			It("returns a PluginVersionNotAvailableError", func() {
+				Expect(executeErr).To(MatchError(actionerror.PluginVersionNotAvailableError{
+					PluginName:       "some-plugin",
+					RepositoryName:   "some-repo",
+					Version:          "1.0.0",
+					AvailableVersion: "1.2.3",
+				}))
+			})
+		})
+	})
+})
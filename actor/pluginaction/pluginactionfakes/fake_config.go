@@ -78,6 +78,12 @@ type FakeConfig struct {
 	removePluginArgsForCall []struct {
 		arg1 string
 	}
+	SetPluginRepositoryPublicKeyStub        func(string, string)
+	setPluginRepositoryPublicKeyMutex       sync.RWMutex
+	setPluginRepositoryPublicKeyArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
 	WritePluginConfigStub        func() error
 	writePluginConfigMutex       sync.RWMutex
 	writePluginConfigArgsForCall []struct {
@@ -457,6 +463,38 @@ func (fake *FakeConfig) RemovePluginArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
+func (fake *FakeConfig) SetPluginRepositoryPublicKey(arg1 string, arg2 string) {
+	fake.setPluginRepositoryPublicKeyMutex.Lock()
+	fake.setPluginRepositoryPublicKeyArgsForCall = append(fake.setPluginRepositoryPublicKeyArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("SetPluginRepositoryPublicKey", []interface{}{arg1, arg2})
+	fake.setPluginRepositoryPublicKeyMutex.Unlock()
+	if fake.SetPluginRepositoryPublicKeyStub != nil {
+		fake.SetPluginRepositoryPublicKeyStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeConfig) SetPluginRepositoryPublicKeyCallCount() int {
+	fake.setPluginRepositoryPublicKeyMutex.RLock()
+	defer fake.setPluginRepositoryPublicKeyMutex.RUnlock()
+	return len(fake.setPluginRepositoryPublicKeyArgsForCall)
+}
+
+func (fake *FakeConfig) SetPluginRepositoryPublicKeyCalls(stub func(string, string)) {
+	fake.setPluginRepositoryPublicKeyMutex.Lock()
+	defer fake.setPluginRepositoryPublicKeyMutex.Unlock()
+	fake.SetPluginRepositoryPublicKeyStub = stub
+}
+
+func (fake *FakeConfig) SetPluginRepositoryPublicKeyArgsForCall(i int) (string, string) {
+	fake.setPluginRepositoryPublicKeyMutex.RLock()
+	defer fake.setPluginRepositoryPublicKeyMutex.RUnlock()
+	argsForCall := fake.setPluginRepositoryPublicKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeConfig) WritePluginConfig() error {
 	fake.writePluginConfigMutex.Lock()
 	ret, specificReturn := fake.writePluginConfigReturnsOnCall[len(fake.writePluginConfigArgsForCall)]
@@ -528,6 +566,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.pluginsMutex.RUnlock()
 	fake.removePluginMutex.RLock()
 	defer fake.removePluginMutex.RUnlock()
+	fake.setPluginRepositoryPublicKeyMutex.RLock()
+	defer fake.setPluginRepositoryPublicKeyMutex.RUnlock()
 	fake.writePluginConfigMutex.RLock()
 	defer fake.writePluginConfigMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,88 @@
+package pluginaction
+
+import (
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/generic"
+	"code.cloudfoundry.org/gofileutils/fileutils"
+)
+
+// UpdatePlugin replaces the binary of an installed plugin with the one at
+// path. The new binary is staged next to the installed one and validated
+// before anything is changed; the installed binary is then moved aside and
+// replaced with a rename, and restored if the swap or saving the plugin
+// config fails.
+func (actor Actor) UpdatePlugin(pluginMetadata PluginMetadata, commandList CommandList, pluginName string, path string) (configv3.Plugin, error) {
+	installedPlugin, exist := actor.config.GetPlugin(pluginName)
+	if !exist {
+		return configv3.Plugin{}, actionerror.PluginNotFoundError{PluginName: pluginName}
+	}
+
+	installPath := generic.ExecutableFilename(filepath.Join(actor.config.PluginHome(), pluginName))
+	stagedPath := generic.ExecutableFilename(filepath.Join(actor.config.PluginHome(), "."+pluginName+".update"))
+	backupPath := installedPlugin.Location + ".backup"
+
+	err := fileutils.CopyPathToPath(path, stagedPath)
+	if err != nil {
+		return configv3.Plugin{}, err
+	}
+	defer os.Remove(stagedPath)
+
+	// rwxr-xr-x so that multiple users can share the same $CF_PLUGIN_HOME
+	err = os.Chmod(stagedPath, 0755)
+	if err != nil {
+		return configv3.Plugin{}, err
+	}
+
+	plugin, err := actor.GetAndValidatePlugin(pluginMetadata, commandList, stagedPath)
+	if err != nil {
+		return configv3.Plugin{}, err
+	}
+	if plugin.Name != pluginName {
+		return configv3.Plugin{}, actionerror.PluginNameMismatchError{
+			ExpectedName: pluginName,
+			ActualName:   plugin.Name,
+		}
+	}
+
+	hasBackup := false
+	if actor.FileExists(installedPlugin.Location) {
+		err = os.Rename(installedPlugin.Location, backupPath)
+		if err != nil {
+			return configv3.Plugin{}, err
+		}
+		hasBackup = true
+	}
+
+	rollback := func() {
+		if hasBackup {
+			_ = os.Remove(installPath)
+			_ = os.Rename(backupPath, installedPlugin.Location)
+		}
+		actor.config.AddPlugin(installedPlugin)
+	}
+
+	err = os.Rename(stagedPath, installPath)
+	if err != nil {
+		rollback()
+		return configv3.Plugin{}, err
+	}
+
+	plugin.Location = installPath
+	actor.config.AddPlugin(plugin)
+
+	err = actor.config.WritePluginConfig()
+	if err != nil {
+		rollback()
+		return configv3.Plugin{}, err
+	}
+
+	if hasBackup {
+		_ = os.Remove(backupPath)
+	}
+
+	return plugin, nil
+}
@@ -0,0 +1,150 @@
+package pluginaction_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/generic"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("update plugin actions", func() {
+	var (
+		actor              *Actor
+		fakeConfig         *pluginactionfakes.FakeConfig
+		fakePluginMetadata *pluginactionfakes.FakePluginMetadata
+		fakeCommandList    *pluginactionfakes.FakeCommandList
+		pluginHome         string
+		installPath        string
+		newBinaryPath      string
+		installedPlugin    configv3.Plugin
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(pluginactionfakes.FakeConfig)
+		fakePluginMetadata = new(pluginactionfakes.FakePluginMetadata)
+		fakeCommandList = new(pluginactionfakes.FakeCommandList)
+		actor = NewActor(fakeConfig, nil)
+
+		var err error
+		pluginHome, err = ioutil.TempDir("", "")
+		Expect(err).ToNot(HaveOccurred())
+
+		installPath = generic.ExecutableFilename(filepath.Join(pluginHome, "some-plugin"))
+		Expect(ioutil.WriteFile(installPath, []byte("old binary"), 0755)).To(Succeed())
+
+		newBinaryPath = filepath.Join(pluginHome, "downloaded")
+		Expect(ioutil.WriteFile(newBinaryPath, []byte("new binary"), 0700)).To(Succeed())
+
+		installedPlugin = configv3.Plugin{
+			Name:     "some-plugin",
+			Version:  configv3.PluginVersion{Major: 1},
+			Location: installPath,
+		}
+
+		fakeConfig.PluginHomeReturns(pluginHome)
+		fakeConfig.BinaryVersionReturns("6.0.0")
+		fakeConfig.GetPluginReturns(installedPlugin, true)
+		fakePluginMetadata.GetMetadataReturns(configv3.Plugin{
+			Name:     "some-plugin",
+			Version:  configv3.PluginVersion{Major: 2},
+			Commands: []configv3.PluginCommand{{Name: "some-command"}},
+		}, nil)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(pluginHome)
+	})
+
+	Describe("UpdatePlugin", func() {
+		var (
+			plugin configv3.Plugin
+			err    error
+		)
+
+		JustBeforeEach(func() {
+			plugin, err = actor.UpdatePlugin(fakePluginMetadata, fakeCommandList, "some-plugin", newBinaryPath)
+		})
+
+		It("replaces the installed binary and records the new version", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(plugin.Version).To(Equal(configv3.PluginVersion{Major: 2}))
+			Expect(plugin.Location).To(Equal(installPath))
+
+			Expect(ioutil.ReadFile(installPath)).To(Equal([]byte("new binary")))
+
+			Expect(fakeConfig.AddPluginCallCount()).To(Equal(1))
+			Expect(fakeConfig.AddPluginArgsForCall(0)).To(Equal(plugin))
+			Expect(fakeConfig.WritePluginConfigCallCount()).To(Equal(1))
+
+			files, readErr := ioutil.ReadDir(pluginHome)
+			Expect(readErr).ToNot(HaveOccurred())
+			Expect(files).To(HaveLen(2), "expected no staged or backup binaries to be left behind")
+		})
+
+		It("validates the staged binary rather than the download", func() {
+			Expect(fakePluginMetadata.GetMetadataCallCount()).To(Equal(1))
+			Expect(filepath.Dir(fakePluginMetadata.GetMetadataArgsForCall(0))).To(Equal(pluginHome))
+			Expect(fakePluginMetadata.GetMetadataArgsForCall(0)).ToNot(Equal(newBinaryPath))
+		})
+
+		When("the plugin is not installed", func() {
+			BeforeEach(func() {
+				fakeConfig.GetPluginReturns(configv3.Plugin{}, false)
+			})
+
+			It("returns a PluginNotFoundError", func() {
+				Expect(err).To(MatchError(actionerror.PluginNotFoundError{PluginName: "some-plugin"}))
+			})
+		})
+
+		When("the new binary is not a valid plugin", func() {
+			BeforeEach(func() {
+				fakePluginMetadata.GetMetadataReturns(configv3.Plugin{}, errors.New("not a plugin"))
+			})
+
+			It("leaves the installed plugin untouched", func() {
+				Expect(err).To(MatchError(actionerror.PluginInvalidError{Err: errors.New("not a plugin")}))
+				Expect(ioutil.ReadFile(installPath)).To(Equal([]byte("old binary")))
+				Expect(fakeConfig.AddPluginCallCount()).To(Equal(0))
+				Expect(fakeConfig.WritePluginConfigCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the new binary is for a different plugin", func() {
+			BeforeEach(func() {
+				fakePluginMetadata.GetMetadataReturns(configv3.Plugin{
+					Name:     "other-plugin",
+					Commands: []configv3.PluginCommand{{Name: "other-command"}},
+				}, nil)
+			})
+
+			It("leaves the installed plugin untouched", func() {
+				Expect(err).To(MatchError(actionerror.PluginNameMismatchError{ExpectedName: "some-plugin", ActualName: "other-plugin"}))
+				Expect(ioutil.ReadFile(installPath)).To(Equal([]byte("old binary")))
+				Expect(fakeConfig.AddPluginCallCount()).To(Equal(0))
+			})
+		})
+
+		When("saving the plugin config fails", func() {
+			BeforeEach(func() {
+				fakeConfig.WritePluginConfigReturns(errors.New("write-error"))
+			})
+
+			It("restores the previous binary and config entry", func() {
+				Expect(err).To(MatchError("write-error"))
+				Expect(ioutil.ReadFile(installPath)).To(Equal([]byte("old binary")))
+
+				Expect(fakeConfig.AddPluginCallCount()).To(Equal(2))
+				Expect(fakeConfig.AddPluginArgsForCall(1)).To(Equal(installedPlugin))
+			})
+		})
+	})
+})
@@ -0,0 +1,91 @@
+package sharedaction
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// LogFilter narrows down the log messages returned by GetRecentLogsWithFilter
+// and GetStreamingLogsWithFilter. The zero value matches every message.
+//
+// Since and Until are passed to Log Cache as the start and end time of the
+// query; the remaining fields are applied to the messages as they are read.
+type LogFilter struct {
+	// Since is the inclusive lower bound on the message timestamp.
+	Since time.Time
+	// Until is the exclusive upper bound on the message timestamp.
+	Until time.Time
+
+	// ProcessType only matches app logs from instances of the given process
+	// type, e.g. "web".
+	ProcessType string
+	// Instance only matches app logs from the instance with the given index.
+	Instance string
+
+	// SourceTypes only matches logs from one of the given sources, e.g. "APP"
+	// or "RTR". "APP" also matches "APP/PROC/WEB" and "APP/TASK/migrate".
+	SourceTypes []string
+	// Pattern only matches messages whose payload matches the expression.
+	Pattern *regexp.Regexp
+
+	// MaxLines limits the number of messages returned. Recent logs keep the
+	// newest messages; streaming stops once the limit has been reached.
+	MaxLines int
+}
+
+// Matches returns true if the message satisfies every criterion of the
+// filter.
+func (filter LogFilter) Matches(message LogMessage) bool {
+	if !filter.Since.IsZero() && message.timestamp.Before(filter.Since) {
+		return false
+	}
+
+	if !filter.Until.IsZero() && !message.timestamp.Before(filter.Until) {
+		return false
+	}
+
+	if filter.ProcessType != "" && !strings.EqualFold(message.sourceType, "APP/PROC/"+filter.ProcessType) {
+		return false
+	}
+
+	if filter.Instance != "" && (!isAppSourceType(message.sourceType) || message.sourceInstance != filter.Instance) {
+		return false
+	}
+
+	if len(filter.SourceTypes) > 0 && !filter.matchesSourceType(message.sourceType) {
+		return false
+	}
+
+	if filter.Pattern != nil && !filter.Pattern.MatchString(message.message) {
+		return false
+	}
+
+	return true
+}
+
+// filtersMessages returns true if the filter has criteria that Log Cache
+// cannot apply itself.
+func (filter LogFilter) filtersMessages() bool {
+	return filter.ProcessType != "" ||
+		filter.Instance != "" ||
+		len(filter.SourceTypes) > 0 ||
+		filter.Pattern != nil
+}
+
+func (filter LogFilter) matchesSourceType(sourceType string) bool {
+	for _, wanted := range filter.SourceTypes {
+		if strings.EqualFold(sourceType, wanted) || hasPrefixFold(sourceType, wanted+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func isAppSourceType(sourceType string) bool {
+	return strings.EqualFold(sourceType, "APP") || hasPrefixFold(sourceType, "APP/")
+}
+
+func hasPrefixFold(s string, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
@@ -0,0 +1,60 @@
+package sharedaction_test
+
+import (
+	"regexp"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogFilter", func() {
+	Describe("Matches", func() {
+		var message LogMessage
+
+		BeforeEach(func() {
+			message = *NewLogMessage(
+				"GET /health 200",
+				"OUT",
+				time.Unix(100, 0),
+				"APP/PROC/WEB",
+				"1",
+			)
+		})
+
+		DescribeTable("filtering a message",
+			func(filter LogFilter, expected bool) {
+				Expect(filter.Matches(message)).To(Equal(expected))
+			},
+			Entry("matches with the zero filter", LogFilter{}, true),
+
+			Entry("matches at the start time", LogFilter{Since: time.Unix(100, 0)}, true),
+			Entry("does not match before the start time", LogFilter{Since: time.Unix(101, 0)}, false),
+			Entry("matches before the end time", LogFilter{Until: time.Unix(101, 0)}, true),
+			Entry("does not match at the end time", LogFilter{Until: time.Unix(100, 0)}, false),
+
+			Entry("matches the process type case-insensitively", LogFilter{ProcessType: "web"}, true),
+			Entry("does not match another process type", LogFilter{ProcessType: "worker"}, false),
+
+			Entry("matches the instance index", LogFilter{Instance: "1"}, true),
+			Entry("does not match another instance index", LogFilter{Instance: "0"}, false),
+
+			Entry("matches the source type by prefix", LogFilter{SourceTypes: []string{"rtr", "app"}}, true),
+			Entry("matches the full source type", LogFilter{SourceTypes: []string{"APP/PROC/WEB"}}, true),
+			Entry("does not match a partial source type", LogFilter{SourceTypes: []string{"AP"}}, false),
+			Entry("does not match other source types", LogFilter{SourceTypes: []string{"RTR", "STG"}}, false),
+
+			Entry("matches the pattern", LogFilter{Pattern: regexp.MustCompile(`/health\b`)}, true),
+			Entry("does not match another pattern", LogFilter{Pattern: regexp.MustCompile(`^POST`)}, false),
+		)
+
+		When("filtering by instance", func() {
+			It("does not match logs from other sources with the same index", func() {
+				routerMessage := *NewLogMessage("some-message", "OUT", time.Unix(100, 0), "RTR", "1")
+				Expect(LogFilter{Instance: "1"}.Matches(routerMessage)).To(BeFalse())
+			})
+		})
+	})
+})
@@ -20,6 +20,7 @@ const (
 
 	retryCount    = 5
 	retryInterval = time.Millisecond * 250
+	walkDelay     = 2 * time.Second
 )
 
 type LogMessage struct {
@@ -87,12 +88,13 @@ func (c channelWriter) Write(bytes []byte) (n int, err error) {
 }
 
 // cliRetryBackoff returns true for OnErr after sleeping the given interval for a limited number of times,
-// and returns true for OnEmpty always.
-// Basically: retry x times on connection failures, and wait forever for logs to show up.
+// and returns true for OnEmpty until the optional end time has passed.
+// Basically: retry x times on connection failures, and wait for logs to show up.
 type cliRetryBackoff struct {
 	interval time.Duration
 	maxCount int
 	count    int
+	until    time.Time
 }
 
 func newCliRetryBackoff(interval time.Duration, maxCount int) *cliRetryBackoff {
@@ -113,6 +115,12 @@ func (b *cliRetryBackoff) OnErr(error) bool {
 }
 
 func (b *cliRetryBackoff) OnEmpty() bool {
+	// Walk does not read envelopes younger than the walk delay, so only give
+	// up once those have been read too.
+	if !b.until.IsZero() && time.Now().Add(-walkDelay).After(b.until) {
+		return false
+	}
+
 	time.Sleep(b.interval)
 	return true
 }
@@ -122,6 +130,13 @@ func (b *cliRetryBackoff) Reset() {
 }
 
 func GetStreamingLogs(appGUID string, client LogCacheClient) (<-chan LogMessage, <-chan error, context.CancelFunc) {
+	return GetStreamingLogsWithFilter(appGUID, client, LogFilter{})
+}
+
+// GetStreamingLogsWithFilter tails the logs of the given source, only passing
+// through the messages that match the filter. When the filter has an end time
+// or a line limit, the streams are closed once it has been reached.
+func GetStreamingLogsWithFilter(appGUID string, client LogCacheClient, filter LogFilter) (<-chan LogMessage, <-chan error, context.CancelFunc) {
 
 	logrus.Info("Start Tailing Logs")
 
@@ -132,39 +147,62 @@ func GetStreamingLogs(appGUID string, client LogCacheClient) (<-chan LogMessage,
 		defer close(outgoingLogStream)
 		defer close(outgoingErrStream)
 
-		ts := latestEnvelopeTimestamp(client, outgoingErrStream, ctx, appGUID)
+		walkStartTime := filter.Since
+		if walkStartTime.IsZero() {
+			ts := latestEnvelopeTimestamp(client, outgoingErrStream, ctx, appGUID)
+
+			// if the context was cancelled we may not have seen an envelope
+			if ts.IsZero() {
+				return
+			}
 
-		// if the context was cancelled we may not have seen an envelope
-		if ts.IsZero() {
-			return
+			const offset = 1 * time.Second
+			walkStartTime = ts.Add(-offset)
 		}
 
-		const offset = 1 * time.Second
-		walkStartTime := ts.Add(-offset)
+		backoff := newCliRetryBackoff(retryInterval, retryCount)
+		backoff.until = filter.Until
 
+		walkOptions := []logcache.WalkOption{
+			logcache.WithWalkDelay(walkDelay),
+			logcache.WithWalkStartTime(walkStartTime),
+			logcache.WithWalkEnvelopeTypes(logcache_v1.EnvelopeType_LOG),
+			logcache.WithWalkBackoff(backoff),
+			logcache.WithWalkLogger(log.New(channelWriter{
+				errChannel: outgoingErrStream,
+			}, "", 0)),
+		}
+		if !filter.Until.IsZero() {
+			walkOptions = append(walkOptions, logcache.WithWalkEndTime(filter.Until))
+		}
+
+		var sentCount int
 		logcache.Walk(
 			ctx,
 			appGUID,
 			logcache.Visitor(func(envelopes []*loggregator_v2.Envelope) bool {
 				logMessages := convertEnvelopesToLogMessages(envelopes)
 				for _, logMessage := range logMessages {
+					if !filter.Matches(*logMessage) {
+						continue
+					}
+
 					select {
 					case <-ctx.Done():
 						return false
 					default:
 						outgoingLogStream <- *logMessage
 					}
+
+					sentCount++
+					if filter.MaxLines > 0 && sentCount >= filter.MaxLines {
+						return false
+					}
 				}
 				return true
 			}),
 			client.Read,
-			logcache.WithWalkDelay(2*time.Second),
-			logcache.WithWalkStartTime(walkStartTime),
-			logcache.WithWalkEnvelopeTypes(logcache_v1.EnvelopeType_LOG),
-			logcache.WithWalkBackoff(newCliRetryBackoff(retryInterval, retryCount)),
-			logcache.WithWalkLogger(log.New(channelWriter{
-				errChannel: outgoingErrStream,
-			}, "", 0)),
+			walkOptions...,
 		)
 	}()
 
@@ -209,35 +247,94 @@ func latestEnvelopeTimestamp(client LogCacheClient, errs chan error, ctx context
 }
 
 func GetRecentLogs(appGUID string, client LogCacheClient) ([]LogMessage, error) {
-	logLineRequestCount := RecentLogsLines
+	return GetRecentLogsWithFilter(appGUID, client, LogFilter{})
+}
+
+// GetRecentLogsWithFilter returns the most recent logs of the given source
+// that match the filter, oldest first: up to the filter's line limit, or
+// RecentLogsLines when it has none. The time window, and the line limit when
+// no other criteria are set, are applied by Log Cache; otherwise Log Cache is
+// read backwards one batch at a time until enough messages match or the
+// window is exhausted.
+func GetRecentLogsWithFilter(appGUID string, client LogCacheClient, filter LogFilter) ([]LogMessage, error) {
+	maxLines := RecentLogsLines
+	if filter.MaxLines > 0 {
+		maxLines = filter.MaxLines
+	}
+
+	var matchingLogMessages []*LogMessage
+	endTime := filter.Until
+	for len(matchingLogMessages) < maxLines {
+		logLineRequestCount := RecentLogsLines
+		if remaining := maxLines - len(matchingLogMessages); remaining < logLineRequestCount && !filter.filtersMessages() {
+			logLineRequestCount = remaining
+		}
+
+		envelopes, readCount, err := readRecentEnvelopes(appGUID, client, filter.Since, endTime, logLineRequestCount)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to retrieve logs from Log Cache: %s", err)
+		}
+
+		for _, logMessage := range convertEnvelopesToLogMessages(envelopes) {
+			if len(matchingLogMessages) >= maxLines {
+				break
+			}
+			if filter.Matches(*logMessage) {
+				matchingLogMessages = append(matchingLogMessages, logMessage)
+			}
+		}
+
+		if len(envelopes) < readCount {
+			break
+		}
+
+		// Log Cache's end time is exclusive, so the next batch starts right
+		// before the oldest envelope of this one.
+		oldest := time.Unix(0, envelopes[len(envelopes)-1].GetTimestamp())
+		if !endTime.IsZero() && !oldest.Before(endTime) {
+			break
+		}
+		endTime = oldest
+	}
+
+	var reorderedLogMessages []LogMessage
+	for i := len(matchingLogMessages) - 1; i >= 0; i-- {
+		reorderedLogMessages = append(reorderedLogMessages, *matchingLogMessages[i])
+	}
+
+	return reorderedLogMessages, nil
+}
+
+// readRecentEnvelopes reads up to count log envelopes before endTime, newest
+// first. Log Cache rejects large reads when it is busy, so the count is halved
+// until it accepts one; the count that was read with is returned.
+func readRecentEnvelopes(appGUID string, client LogCacheClient, startTime time.Time, endTime time.Time, count int) ([]*loggregator_v2.Envelope, int, error) {
 	var envelopes []*loggregator_v2.Envelope
 	var err error
 
-	for logLineRequestCount >= 1 {
+	for count >= 1 {
+		readOptions := []logcache.ReadOption{
+			logcache.WithEnvelopeTypes(logcache_v1.EnvelopeType_LOG),
+			logcache.WithLimit(count),
+			logcache.WithDescending(),
+		}
+		if !endTime.IsZero() {
+			readOptions = append(readOptions, logcache.WithEndTime(endTime))
+		}
+
 		envelopes, err = client.Read(
 			context.Background(),
 			appGUID,
-			time.Time{},
-			logcache.WithEnvelopeTypes(logcache_v1.EnvelopeType_LOG),
-			logcache.WithLimit(logLineRequestCount),
-			logcache.WithDescending(),
+			startTime,
+			readOptions...,
 		)
 		if err == nil || err.Error() != "unexpected status code 429" {
 			break
 		}
-		logLineRequestCount /= 2
-	}
-	if err != nil {
-		return nil, fmt.Errorf("Failed to retrieve logs from Log Cache: %s", err)
+		count /= 2
 	}
 
-	logMessages := convertEnvelopesToLogMessages(envelopes)
-	var reorderedLogMessages []LogMessage
-	for i := len(logMessages) - 1; i >= 0; i-- {
-		reorderedLogMessages = append(reorderedLogMessages, *logMessages[i])
-	}
-
-	return reorderedLogMessages, nil
+	return envelopes, count, err
 }
 
 func convertEnvelopesToLogMessages(envelopes []*loggregator_v2.Envelope) []*LogMessage {
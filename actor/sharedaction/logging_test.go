@@ -366,4 +366,264 @@ var _ = Describe("Logging Actions", func() {
 		})
 	})
 
+	Describe("GetRecentLogsWithFilter", func() {
+		var (
+			filter      sharedaction.LogFilter
+			messages    []sharedaction.LogMessage
+			executeErr  error
+			newEnvelope func(timestamp int64, sourceType string, payload string) *loggregator_v2.Envelope
+		)
+
+		BeforeEach(func() {
+			filter = sharedaction.LogFilter{}
+
+			newEnvelope = func(timestamp int64, sourceType string, payload string) *loggregator_v2.Envelope {
+				return &loggregator_v2.Envelope{
+					Timestamp:  timestamp,
+					SourceId:   "some-app-guid",
+					InstanceId: "0",
+					Message: &loggregator_v2.Envelope_Log{
+						Log: &loggregator_v2.Log{
+							Payload: []byte(payload),
+							Type:    loggregator_v2.Log_OUT,
+						},
+					},
+					Tags: map[string]string{
+						"source_type": sourceType,
+					},
+				}
+			}
+
+			fakeLogCacheClient.ReadReturns([]*loggregator_v2.Envelope{
+				newEnvelope(40, "APP/PROC/WEB", "message-4"),
+				newEnvelope(30, "RTR", "message-3"),
+				newEnvelope(20, "APP/PROC/WEB", "message-2"),
+				newEnvelope(10, "APP/PROC/WEB", "message-1"),
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			messages, executeErr = sharedaction.GetRecentLogsWithFilter("some-app-guid", fakeLogCacheClient, filter)
+		})
+
+		When("the filter has a time window", func() {
+			BeforeEach(func() {
+				filter.Since = time.Unix(0, 10)
+				filter.Until = time.Unix(0, 50)
+			})
+
+			It("passes it to Log Cache", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				_, sourceID, start, readOptions := fakeLogCacheClient.ReadArgsForCall(0)
+				Expect(sourceID).To(Equal("some-app-guid"))
+				Expect(start).To(Equal(time.Unix(0, 10)))
+
+				u := new(url.URL)
+				v := make(url.Values)
+				for _, readOption := range readOptions {
+					readOption(u, v)
+				}
+				Expect(v.Get("end_time")).To(Equal("50"))
+			})
+		})
+
+		When("the filter only limits the number of lines", func() {
+			BeforeEach(func() {
+				filter.MaxLines = 2
+			})
+
+			It("asks Log Cache for that many lines and returns the newest ones", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				_, _, _, readOptions := fakeLogCacheClient.ReadArgsForCall(0)
+				u := new(url.URL)
+				v := make(url.Values)
+				readOptions[1](u, v)
+				Expect(v.Get("limit")).To(Equal("2"))
+
+				Expect(messages).To(HaveLen(2))
+				Expect(messages[0].Message()).To(Equal("message-3"))
+				Expect(messages[1].Message()).To(Equal("message-4"))
+			})
+		})
+
+		When("the filter matches on the messages", func() {
+			BeforeEach(func() {
+				filter.SourceTypes = []string{"APP"}
+				filter.MaxLines = 2
+			})
+
+			It("reads the default number of lines and filters them", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				_, _, _, readOptions := fakeLogCacheClient.ReadArgsForCall(0)
+				u := new(url.URL)
+				v := make(url.Values)
+				readOptions[1](u, v)
+				Expect(v.Get("limit")).To(Equal("1000"))
+
+				Expect(messages).To(HaveLen(2))
+				Expect(messages[0].Message()).To(Equal("message-2"))
+				Expect(messages[1].Message()).To(Equal("message-4"))
+			})
+		})
+
+		When("too few messages of the newest batch match", func() {
+			var olderBatch []*loggregator_v2.Envelope
+
+			BeforeEach(func() {
+				filter.SourceTypes = []string{"APP"}
+				filter.MaxLines = 2
+
+				var newestBatch []*loggregator_v2.Envelope
+				for i := int64(0); i < sharedaction.RecentLogsLines; i++ {
+					newestBatch = append(newestBatch, newEnvelope(2000-i, "RTR", "router-message"))
+				}
+				newestBatch[500] = newEnvelope(1500, "APP/PROC/WEB", "message-3")
+
+				olderBatch = []*loggregator_v2.Envelope{
+					newEnvelope(900, "APP/PROC/WEB", "message-2"),
+					newEnvelope(800, "APP/PROC/WEB", "message-1"),
+				}
+
+				fakeLogCacheClient.ReadStub = func(_ context.Context, _ string, _ time.Time, readOptions ...logcache.ReadOption) ([]*loggregator_v2.Envelope, error) {
+					u := new(url.URL)
+					v := make(url.Values)
+					for _, readOption := range readOptions {
+						readOption(u, v)
+					}
+					if v.Get("end_time") == "" {
+						return newestBatch, nil
+					}
+					return olderBatch, nil
+				}
+			})
+
+			It("reads older batches, ending before the oldest envelope read so far, until enough match", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(2))
+				_, _, _, readOptions := fakeLogCacheClient.ReadArgsForCall(1)
+				u := new(url.URL)
+				v := make(url.Values)
+				for _, readOption := range readOptions {
+					readOption(u, v)
+				}
+				Expect(v.Get("end_time")).To(Equal("1001"))
+				Expect(v.Get("limit")).To(Equal("1000"))
+
+				Expect(messages).To(HaveLen(2))
+				Expect(messages[0].Message()).To(Equal("message-2"))
+				Expect(messages[1].Message()).To(Equal("message-3"))
+			})
+
+			When("the window is exhausted first", func() {
+				BeforeEach(func() {
+					filter.MaxLines = 5
+				})
+
+				It("returns the messages that matched", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(2))
+					Expect(messages).To(HaveLen(3))
+					Expect(messages[0].Message()).To(Equal("message-1"))
+					Expect(messages[1].Message()).To(Equal("message-2"))
+					Expect(messages[2].Message()).To(Equal("message-3"))
+				})
+			})
+		})
+	})
+
+	Describe("GetStreamingLogsWithFilter", func() {
+		var (
+			filter        sharedaction.LogFilter
+			messages      <-chan sharedaction.LogMessage
+			errs          <-chan error
+			stopStreaming context.CancelFunc
+			startTime     time.Time
+		)
+
+		BeforeEach(func() {
+			startTime = time.Now().Add(-time.Minute)
+			filter = sharedaction.LogFilter{
+				Since:       startTime,
+				SourceTypes: []string{"APP"},
+				MaxLines:    2,
+			}
+
+			fakeLogCacheClient.ReadStub = func(
+				ctx context.Context,
+				sourceID string,
+				start time.Time,
+				opts ...logcache.ReadOption,
+			) ([]*loggregator_v2.Envelope, error) {
+				var envelopes []*loggregator_v2.Envelope
+				for i, sourceType := range []string{"APP/PROC/WEB", "RTR", "APP/PROC/WEB", "APP/PROC/WEB"} {
+					timestamp := startTime.Add(time.Duration(i) * time.Second)
+					if timestamp.Before(start) {
+						continue
+					}
+
+					envelopes = append(envelopes, &loggregator_v2.Envelope{
+						Timestamp: timestamp.UnixNano(),
+						Message: &loggregator_v2.Envelope_Log{
+							Log: &loggregator_v2.Log{
+								Payload: []byte(fmt.Sprintf("message-%d", i+1)),
+							},
+						},
+						Tags: map[string]string{
+							"source_type": sourceType,
+						},
+					})
+				}
+				return envelopes, ctx.Err()
+			}
+		})
+
+		JustBeforeEach(func() {
+			messages, errs, stopStreaming = sharedaction.GetStreamingLogsWithFilter("some-app-guid", fakeLogCacheClient, filter)
+		})
+
+		AfterEach(func() {
+			stopStreaming()
+			Eventually(messages).Should(BeClosed())
+			Eventually(errs).Should(BeClosed())
+		})
+
+		It("starts walking at the start time of the filter", func() {
+			Eventually(fakeLogCacheClient.ReadCallCount).Should(BeNumerically(">=", 1))
+
+			_, _, start, _ := fakeLogCacheClient.ReadArgsForCall(0)
+			Expect(start).To(BeTemporally("==", startTime))
+		})
+
+		It("passes through matching messages until the line limit is reached", func() {
+			var message sharedaction.LogMessage
+			Eventually(messages).Should(Receive(&message))
+			Expect(message.Message()).To(Equal("message-1"))
+			Eventually(messages).Should(Receive(&message))
+			Expect(message.Message()).To(Equal("message-3"))
+
+			Eventually(messages).Should(BeClosed())
+			Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(1))
+		})
+
+		When("the filter has an end time in the past", func() {
+			BeforeEach(func() {
+				filter.MaxLines = 0
+				filter.Until = startTime.Add(1500 * time.Millisecond)
+			})
+
+			It("stops streaming once the end time is reached", func() {
+				var message sharedaction.LogMessage
+				Eventually(messages).Should(Receive(&message))
+				Expect(message.Message()).To(Equal("message-1"))
+
+				Eventually(messages).Should(BeClosed())
+			})
+		})
+	})
+
 })
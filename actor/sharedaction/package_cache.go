@@ -0,0 +1,314 @@
+package sharedaction
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultPackageCacheEntries is the number of archives kept in the
+	// package cache.
+	DefaultPackageCacheEntries = 5
+
+	// PackageCacheChunkSize is the size of the chunks whose checksums are
+	// verified before a cached archive is reused.
+	PackageCacheChunkSize = 4 * 1024 * 1024
+
+	// packageCachePruneLock is held while the cache is pruned, so that pushes
+	// running at the same time do not prune it at once. A lock older than
+	// stalePruneLockAge was left behind by a push that did not finish.
+	packageCachePruneLock = "prune.lock"
+	stalePruneLockAge     = time.Minute
+)
+
+// PackageCache keeps the archives built for app pushes on disk, so that
+// pushing the same files again reuses the archive instead of rebuilding it.
+// Archives are keyed by the resources they contain and are verified chunk by
+// chunk before they are reused.
+type PackageCache struct {
+	Dir        string
+	MaxEntries int
+}
+
+type packageCacheIndex struct {
+	Size      int64    `json:"size"`
+	ChunkSize int64    `json:"chunk_size"`
+	Chunks    []string `json:"chunks"`
+}
+
+// NewPackageCache returns a PackageCache that stores archives in dir.
+func NewPackageCache(dir string) PackageCache {
+	return PackageCache{
+		Dir:        dir,
+		MaxEntries: DefaultPackageCacheEntries,
+	}
+}
+
+// PackageCacheKey returns the key of the archive containing the given
+// resources. The key does not depend on the order of the resources.
+func PackageCacheKey(resources []Resource) string {
+	lines := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		lines = append(lines, fmt.Sprintf("%s\x00%s\x00%o", resource.Filename, resource.SHA1, resource.Mode))
+	}
+	sort.Strings(lines)
+
+	sum := sha256.Sum256([]byte(strings.Join(lines, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// Get returns the path of the cached archive containing the given resources.
+// Archives that fail verification are removed from the cache.
+func (cache PackageCache) Get(resources []Resource) (string, bool) {
+	archivePath, indexPath := cache.paths(PackageCacheKey(resources))
+
+	rawIndex, err := ioutil.ReadFile(indexPath)
+	if err != nil {
+		return "", false
+	}
+
+	var index packageCacheIndex
+	err = json.Unmarshal(rawIndex, &index)
+	if err != nil || index.ChunkSize <= 0 {
+		cache.remove(archivePath, indexPath)
+		return "", false
+	}
+
+	actual, err := chunkChecksums(archivePath, index.ChunkSize)
+	if err != nil || actual.Size != index.Size || !equalStrings(actual.Chunks, index.Chunks) {
+		log.WithField("archivePath", archivePath).Warn("cached archive failed verification")
+		cache.remove(archivePath, indexPath)
+		return "", false
+	}
+
+	_ = touch(indexPath)
+	log.WithField("archivePath", archivePath).Info("reusing cached archive")
+	return archivePath, true
+}
+
+// Put moves the archive at archivePath into the cache and returns its new
+// location. When caching fails, the archive is left at archivePath. The oldest
+// archives are removed once the cache holds more than MaxEntries archives.
+func (cache PackageCache) Put(resources []Resource, archivePath string) (string, error) {
+	err := os.MkdirAll(cache.Dir, 0700)
+	if err != nil {
+		return "", err
+	}
+
+	cachedPath, indexPath := cache.paths(PackageCacheKey(resources))
+
+	index, err := chunkChecksums(archivePath, PackageCacheChunkSize)
+	if err != nil {
+		return "", err
+	}
+
+	err = moveFile(archivePath, cachedPath)
+	if err != nil {
+		return "", err
+	}
+
+	rawIndex, err := json.Marshal(index)
+	if err != nil {
+		return "", err
+	}
+
+	err = writeFileAtomically(indexPath, rawIndex)
+	if err != nil {
+		if moveErr := moveFile(cachedPath, archivePath); moveErr != nil {
+			log.WithField("archivePath", archivePath).Warnln("restoring archive:", moveErr)
+		}
+		_ = os.Remove(indexPath)
+		return "", err
+	}
+
+	cache.prune()
+
+	return cachedPath, nil
+}
+
+// Remove deletes the cached archive containing the given resources.
+func (cache PackageCache) Remove(resources []Resource) {
+	cache.remove(cache.paths(PackageCacheKey(resources)))
+}
+
+func (cache PackageCache) paths(key string) (string, string) {
+	return filepath.Join(cache.Dir, key+".zip"), filepath.Join(cache.Dir, key+".json")
+}
+
+func (PackageCache) remove(archivePath string, indexPath string) {
+	_ = os.Remove(archivePath)
+	_ = os.Remove(indexPath)
+}
+
+// prune removes the least recently used archives. Entries may disappear while
+// it runs, since other pushes remove the archives they have uploaded.
+func (cache PackageCache) prune() {
+	if cache.MaxEntries <= 0 {
+		return
+	}
+
+	unlock, locked := cache.lockPrune()
+	if !locked {
+		log.Debug("package cache is being pruned by another push")
+		return
+	}
+	defer unlock()
+
+	indexPaths, err := filepath.Glob(filepath.Join(cache.Dir, "*.json"))
+	if err != nil || len(indexPaths) <= cache.MaxEntries {
+		return
+	}
+
+	type entry struct {
+		indexPath string
+		modTime   int64
+	}
+	var entries []entry
+	for _, indexPath := range indexPaths {
+		info, err := os.Stat(indexPath)
+		if err != nil {
+			continue
+		}
+		entries = append(entries, entry{indexPath: indexPath, modTime: info.ModTime().UnixNano()})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].modTime > entries[j].modTime
+	})
+
+	for i := cache.MaxEntries; i < len(entries); i++ {
+		indexPath := entries[i].indexPath
+		cache.remove(strings.TrimSuffix(indexPath, ".json")+".zip", indexPath)
+	}
+}
+
+// lockPrune takes the prune lock. It returns false when another push holds it.
+func (cache PackageCache) lockPrune() (func(), bool) {
+	lockPath := filepath.Join(cache.Dir, packageCachePruneLock)
+
+	lockFile, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if os.IsExist(err) {
+		info, statErr := os.Stat(lockPath)
+		if statErr != nil || time.Since(info.ModTime()) < stalePruneLockAge {
+			return nil, false
+		}
+
+		log.WithField("lockPath", lockPath).Warn("removing stale package cache lock")
+		_ = os.Remove(lockPath)
+		lockFile, err = os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	}
+	if err != nil {
+		return nil, false
+	}
+	lockFile.Close()
+
+	return func() { _ = os.Remove(lockPath) }, true
+}
+
+func chunkChecksums(path string, chunkSize int64) (packageCacheIndex, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return packageCacheIndex{}, err
+	}
+	defer file.Close()
+
+	index := packageCacheIndex{ChunkSize: chunkSize, Chunks: []string{}}
+	for {
+		hash := sha256.New()
+		written, err := io.CopyN(hash, file, chunkSize)
+		if written > 0 {
+			index.Size += written
+			index.Chunks = append(index.Chunks, hex.EncodeToString(hash.Sum(nil)))
+		}
+		if err == io.EOF {
+			return index, nil
+		}
+		if err != nil {
+			return packageCacheIndex{}, err
+		}
+	}
+}
+
+func moveFile(source string, destination string) error {
+	err := os.Rename(source, destination)
+	if err == nil {
+		return nil
+	}
+
+	// Renaming fails when the temporary directory is on another device.
+	sourceFile, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer sourceFile.Close()
+
+	destinationFile, err := os.OpenFile(destination, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(destinationFile, sourceFile)
+	closeErr := destinationFile.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(destination)
+		return err
+	}
+
+	sourceFile.Close()
+	return os.Remove(source)
+}
+
+// writeFileAtomically writes data to a temporary file next to path and renames
+// it, so that readers never see a partially written file.
+func writeFileAtomically(path string, data []byte) error {
+	file, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(data)
+	closeErr := file.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), path)
+	}
+	if err != nil {
+		_ = os.Remove(file.Name())
+		return err
+	}
+
+	return nil
+}
+
+func touch(path string) error {
+	now := time.Now()
+	return os.Chtimes(path, now, now)
+}
+
+func equalStrings(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
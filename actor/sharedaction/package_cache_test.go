@@ -0,0 +1,225 @@
+package sharedaction_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PackageCache", func() {
+	var (
+		cache     PackageCache
+		cacheDir  string
+		tmpDir    string
+		resources []Resource
+	)
+
+	writeArchive := func(name string, contents string) string {
+		path := filepath.Join(tmpDir, name)
+		Expect(ioutil.WriteFile(path, []byte(contents), 0600)).To(Succeed())
+		return path
+	}
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "package-cache-test")
+		Expect(err).ToNot(HaveOccurred())
+		cacheDir = filepath.Join(tmpDir, "cache")
+
+		cache = NewPackageCache(cacheDir)
+		resources = []Resource{
+			{Filename: "a.txt", SHA1: "sha-a", Mode: 0644, Size: 1},
+			{Filename: "b.txt", SHA1: "sha-b", Mode: 0644, Size: 1},
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	Describe("PackageCacheKey", func() {
+		It("does not depend on the order of the resources", func() {
+			reversed := []Resource{resources[1], resources[0]}
+			Expect(PackageCacheKey(reversed)).To(Equal(PackageCacheKey(resources)))
+		})
+
+		It("changes when the contents of a resource change", func() {
+			changed := []Resource{resources[0], {Filename: "b.txt", SHA1: "sha-b2", Mode: 0644, Size: 1}}
+			Expect(PackageCacheKey(changed)).ToNot(Equal(PackageCacheKey(resources)))
+		})
+	})
+
+	When("nothing has been cached", func() {
+		It("does not find an archive", func() {
+			_, ok := cache.Get(resources)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	When("the index cannot be written", func() {
+		var archivePath string
+
+		BeforeEach(func() {
+			indexPath := filepath.Join(cacheDir, PackageCacheKey(resources)+".json")
+			Expect(os.MkdirAll(indexPath, 0700)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(indexPath, "some-file"), nil, 0600)).To(Succeed())
+
+			archivePath = writeArchive("archive.zip", "some-zip-contents")
+		})
+
+		It("returns the error and leaves the archive where it was", func() {
+			_, err := cache.Put(resources, archivePath)
+			Expect(err).To(HaveOccurred())
+
+			Expect(ioutil.ReadFile(archivePath)).To(Equal([]byte("some-zip-contents")))
+			Expect(filepath.Join(cacheDir, PackageCacheKey(resources)+".zip")).ToNot(BeAnExistingFile())
+		})
+	})
+
+	When("an archive has been cached", func() {
+		var cachedPath string
+
+		BeforeEach(func() {
+			var err error
+			archivePath := writeArchive("archive.zip", "some-zip-contents")
+			cachedPath, err = cache.Put(resources, archivePath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(archivePath).ToNot(BeAnExistingFile())
+		})
+
+		It("moves the archive into the cache directory", func() {
+			Expect(filepath.Dir(cachedPath)).To(Equal(cacheDir))
+			Expect(ioutil.ReadFile(cachedPath)).To(Equal([]byte("some-zip-contents")))
+		})
+
+		It("returns the cached archive for the same resources", func() {
+			path, ok := cache.Get(resources)
+			Expect(ok).To(BeTrue())
+			Expect(path).To(Equal(cachedPath))
+		})
+
+		It("does not return the archive for other resources", func() {
+			_, ok := cache.Get(resources[:1])
+			Expect(ok).To(BeFalse())
+		})
+
+		When("the cached archive has been modified", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(cachedPath, []byte("some-zip-cont3nts"), 0600)).To(Succeed())
+			})
+
+			It("discards the archive", func() {
+				_, ok := cache.Get(resources)
+				Expect(ok).To(BeFalse())
+				Expect(cachedPath).ToNot(BeAnExistingFile())
+			})
+		})
+
+		When("the cached archive has been truncated", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(cachedPath, []byte("some-zip"), 0600)).To(Succeed())
+			})
+
+			It("discards the archive", func() {
+				_, ok := cache.Get(resources)
+				Expect(ok).To(BeFalse())
+			})
+		})
+
+		When("the archive is removed", func() {
+			BeforeEach(func() {
+				cache.Remove(resources)
+			})
+
+			It("is no longer cached", func() {
+				_, ok := cache.Get(resources)
+				Expect(ok).To(BeFalse())
+				Expect(cachedPath).ToNot(BeAnExistingFile())
+			})
+		})
+	})
+
+	When("more than MaxEntries archives are cached", func() {
+		var (
+			allResources [][]Resource
+			lockPath     string
+			putErr       error
+		)
+
+		BeforeEach(func() {
+			allResources = nil
+			for i := 0; i < 3; i++ {
+				entryResources := []Resource{{Filename: fmt.Sprintf("file-%d", i), SHA1: "sha"}}
+				allResources = append(allResources, entryResources)
+
+				_, err := cache.Put(entryResources, writeArchive(fmt.Sprintf("archive-%d.zip", i), "contents"))
+				Expect(err).ToNot(HaveOccurred())
+
+				// make sure every entry has a distinct modification time
+				entryTime := time.Now().Add(time.Duration(i-10) * time.Minute)
+				indexPath := filepath.Join(cacheDir, PackageCacheKey(entryResources)+".json")
+				Expect(os.Chtimes(indexPath, entryTime, entryTime)).To(Succeed())
+			}
+
+			cache.MaxEntries = 2
+			lockPath = filepath.Join(cacheDir, "prune.lock")
+		})
+
+		JustBeforeEach(func() {
+			_, putErr = cache.Put([]Resource{{Filename: "file-3", SHA1: "sha"}}, writeArchive("archive-3.zip", "contents"))
+		})
+
+		It("removes the least recently used archives", func() {
+			Expect(putErr).ToNot(HaveOccurred())
+
+			_, ok := cache.Get(allResources[0])
+			Expect(ok).To(BeFalse())
+			_, ok = cache.Get(allResources[1])
+			Expect(ok).To(BeFalse())
+			_, ok = cache.Get(allResources[2])
+			Expect(ok).To(BeTrue())
+
+			Expect(lockPath).ToNot(BeAnExistingFile())
+		})
+
+		When("another push is pruning the cache", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(lockPath, nil, 0600)).To(Succeed())
+			})
+
+			It("caches the archive without pruning", func() {
+				Expect(putErr).ToNot(HaveOccurred())
+
+				for _, entryResources := range allResources {
+					_, ok := cache.Get(entryResources)
+					Expect(ok).To(BeTrue())
+				}
+
+				Expect(lockPath).To(BeAnExistingFile())
+			})
+		})
+
+		When("a push that was pruning the cache did not finish", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(lockPath, nil, 0600)).To(Succeed())
+				lockTime := time.Now().Add(-time.Hour)
+				Expect(os.Chtimes(lockPath, lockTime, lockTime)).To(Succeed())
+			})
+
+			It("removes its lock and prunes the cache", func() {
+				Expect(putErr).ToNot(HaveOccurred())
+
+				_, ok := cache.Get(allResources[0])
+				Expect(ok).To(BeFalse())
+
+				Expect(lockPath).ToNot(BeAnExistingFile())
+			})
+		})
+	})
+})
@@ -1,13 +1,22 @@
 package sharedaction
 
-import "code.cloudfoundry.org/cli/util/clissh"
+import (
+	"io"
+
+	"code.cloudfoundry.org/cli/util/clissh"
+)
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SecureShellClient
 
 type SecureShellClient interface {
 	Connect(username string, passcode string, sshEndpoint string, sshHostKeyFingerprint string, skipHostValidation bool) error
 	Close() error
+	Download(remotePath string, localPath string, recursive bool, progressBar clissh.ProgressBar) error
+	ExecuteCommand(commands []string, stdout io.Writer, stderr io.Writer) error
 	InteractiveSession(commands []string, terminalRequest clissh.TTYRequest) error
 	LocalPortForward(localPortForwardSpecs []clissh.LocalPortForward) error
+	RemotePortForward(remotePortForwardSpecs []clissh.RemotePortForward) error
+	DynamicPortForward(dynamicPortForwardSpecs []clissh.DynamicPortForward) error
+	Upload(localPath string, remotePath string, recursive bool, progressBar clissh.ProgressBar) error
 	Wait() error
 }
@@ -1,6 +1,14 @@
 package sharedaction
 
-import "code.cloudfoundry.org/cli/util/clissh"
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"code.cloudfoundry.org/cli/util/clissh"
+	"golang.org/x/crypto/ssh"
+)
 
 type TTYOption clissh.TTYRequest
 
@@ -13,16 +21,66 @@ const (
 
 type LocalPortForward clissh.LocalPortForward
 
+type RemotePortForward clissh.RemotePortForward
+
+type DynamicPortForward clissh.DynamicPortForward
+
 type SSHOptions struct {
-	Commands              []string
-	Username              string
-	Passcode              string
-	Endpoint              string
-	HostKeyFingerprint    string
-	SkipHostValidation    bool
-	SkipRemoteExecution   bool
-	TTYOption             TTYOption
-	LocalPortForwardSpecs []LocalPortForward
+	Commands                []string
+	Username                string
+	Passcode                string
+	Endpoint                string
+	HostKeyFingerprint      string
+	SkipHostValidation      bool
+	SkipRemoteExecution     bool
+	TTYOption               TTYOption
+	LocalPortForwardSpecs   []LocalPortForward
+	RemotePortForwardSpecs  []RemotePortForward
+	DynamicPortForwardSpecs []DynamicPortForward
+}
+
+// SecureCopyOptions describes a file transfer to or from an app instance.
+type SecureCopyOptions struct {
+	Username           string
+	Passcode           string
+	Endpoint           string
+	HostKeyFingerprint string
+	SkipHostValidation bool
+	Download           bool
+	LocalPath          string
+	RemotePath         string
+	Recursive          bool
+}
+
+// InstanceSSHOptions describes how to connect to one app instance.
+// GetPasscode is called right before connecting, since a passcode can only be
+// used once and may expire while waiting for other instances.
+type InstanceSSHOptions struct {
+	Index              uint
+	Username           string
+	GetPasscode        func() (string, error)
+	Endpoint           string
+	HostKeyFingerprint string
+}
+
+// MultiInstanceSSHOptions describes a non-interactive command to run on
+// several app instances.
+type MultiInstanceSSHOptions struct {
+	Commands           []string
+	Instances          []InstanceSSHOptions
+	MaxInFlight        int
+	SkipHostValidation bool
+	Stdout             io.Writer
+	Stderr             io.Writer
+}
+
+// InstanceCommandResult is the outcome of running a command on one app
+// instance. Err is set when the command could not be run or was terminated
+// by a signal; ExitStatus is then 255, like ssh reports connection failures.
+type InstanceCommandResult struct {
+	Index      uint
+	ExitStatus int
+	Err        error
 }
 
 func (actor Actor) ExecuteSecureShell(sshClient SecureShellClient, sshOptions SSHOptions) error {
@@ -37,6 +95,16 @@ func (actor Actor) ExecuteSecureShell(sshClient SecureShellClient, sshOptions SS
 		return err
 	}
 
+	err = sshClient.RemotePortForward(convertActorToSSHPackageRemoteForwardingSpecs(sshOptions.RemotePortForwardSpecs))
+	if err != nil {
+		return err
+	}
+
+	err = sshClient.DynamicPortForward(convertActorToSSHPackageDynamicForwardingSpecs(sshOptions.DynamicPortForwardSpecs))
+	if err != nil {
+		return err
+	}
+
 	if sshOptions.SkipRemoteExecution {
 		err = sshClient.Wait()
 	} else {
@@ -45,6 +113,98 @@ func (actor Actor) ExecuteSecureShell(sshClient SecureShellClient, sshOptions SS
 	return err
 }
 
+// ExecuteSecureCopy copies files to or from an app instance over SSH.
+func (actor Actor) ExecuteSecureCopy(sshClient SecureShellClient, copyOptions SecureCopyOptions, progressBar clissh.ProgressBar) error {
+	err := sshClient.Connect(copyOptions.Username, copyOptions.Passcode, copyOptions.Endpoint, copyOptions.HostKeyFingerprint, copyOptions.SkipHostValidation)
+	if err != nil {
+		return err
+	}
+	defer sshClient.Close()
+
+	if copyOptions.Download {
+		return sshClient.Download(copyOptions.RemotePath, copyOptions.LocalPath, copyOptions.Recursive, progressBar)
+	}
+	return sshClient.Upload(copyOptions.LocalPath, copyOptions.RemotePath, copyOptions.Recursive, progressBar)
+}
+
+// ExecuteSecureShellOnInstances runs a command on every instance in
+// sshOptions, up to MaxInFlight of them at the same time, each over a client
+// returned by newSSHClient. Every line of output is prefixed with the index of
+// the instance that wrote it. The results are sorted by instance index.
+func (actor Actor) ExecuteSecureShellOnInstances(newSSHClient func() SecureShellClient, sshOptions MultiInstanceSSHOptions) []InstanceCommandResult {
+	maxInFlight := sshOptions.MaxInFlight
+	if maxInFlight < 1 {
+		maxInFlight = 1
+	}
+
+	var (
+		outputLock   sync.Mutex
+		passcodeLock sync.Mutex
+		wg           sync.WaitGroup
+	)
+	results := make([]InstanceCommandResult, len(sshOptions.Instances))
+	inFlight := make(chan struct{}, maxInFlight)
+
+	for i, instance := range sshOptions.Instances {
+		wg.Add(1)
+		inFlight <- struct{}{}
+		go func(i int, instance InstanceSSHOptions) {
+			defer wg.Done()
+			defer func() { <-inFlight }()
+
+			// Passcodes share the user's access token, so fetch them one at
+			// a time to refresh it only once when it has expired.
+			getPasscode := instance.GetPasscode
+			instance.GetPasscode = func() (string, error) {
+				passcodeLock.Lock()
+				defer passcodeLock.Unlock()
+				return getPasscode()
+			}
+
+			prefix := fmt.Sprintf("[%d] ", instance.Index)
+			stdout := clissh.NewPrefixWriter(sshOptions.Stdout, prefix, &outputLock)
+			stderr := clissh.NewPrefixWriter(sshOptions.Stderr, prefix, &outputLock)
+
+			err := executeCommandOnInstance(newSSHClient(), instance, sshOptions, stdout, stderr)
+			_ = stdout.Flush()
+			_ = stderr.Flush()
+
+			results[i] = newInstanceCommandResult(instance.Index, err)
+		}(i, instance)
+	}
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Index < results[j].Index })
+	return results
+}
+
+func executeCommandOnInstance(sshClient SecureShellClient, instance InstanceSSHOptions, sshOptions MultiInstanceSSHOptions, stdout io.Writer, stderr io.Writer) error {
+	passcode, err := instance.GetPasscode()
+	if err != nil {
+		return err
+	}
+
+	err = sshClient.Connect(instance.Username, passcode, instance.Endpoint, instance.HostKeyFingerprint, sshOptions.SkipHostValidation)
+	if err != nil {
+		return err
+	}
+	defer sshClient.Close()
+
+	return sshClient.ExecuteCommand(sshOptions.Commands, stdout, stderr)
+}
+
+func newInstanceCommandResult(index uint, err error) InstanceCommandResult {
+	if err == nil {
+		return InstanceCommandResult{Index: index}
+	}
+
+	if exitErr, ok := err.(*ssh.ExitError); ok && exitErr.Signal() == "" {
+		return InstanceCommandResult{Index: index, ExitStatus: exitErr.ExitStatus()}
+	}
+
+	return InstanceCommandResult{Index: index, ExitStatus: 255, Err: err}
+}
+
 func convertActorToSSHPackageForwardingSpecs(actorSpecs []LocalPortForward) []clissh.LocalPortForward {
 	sshPackageSpecs := []clissh.LocalPortForward{}
 
@@ -54,3 +214,23 @@ func convertActorToSSHPackageForwardingSpecs(actorSpecs []LocalPortForward) []cl
 
 	return sshPackageSpecs
 }
+
+func convertActorToSSHPackageRemoteForwardingSpecs(actorSpecs []RemotePortForward) []clissh.RemotePortForward {
+	sshPackageSpecs := []clissh.RemotePortForward{}
+
+	for _, spec := range actorSpecs {
+		sshPackageSpecs = append(sshPackageSpecs, clissh.RemotePortForward(spec))
+	}
+
+	return sshPackageSpecs
+}
+
+func convertActorToSSHPackageDynamicForwardingSpecs(actorSpecs []DynamicPortForward) []clissh.DynamicPortForward {
+	sshPackageSpecs := []clissh.DynamicPortForward{}
+
+	for _, spec := range actorSpecs {
+		sshPackageSpecs = append(sshPackageSpecs, clissh.DynamicPortForward(spec))
+	}
+
+	return sshPackageSpecs
+}
@@ -1,11 +1,18 @@
 package sharedaction_test
 
 import (
+	"bytes"
 	"errors"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	. "code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
 	"code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/clissh/clisshfakes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -73,6 +80,12 @@ var _ = Describe("SSH Actions", func() {
 					{LocalAddress: "local-address-1", RemoteAddress: "remote-address-1"},
 					{LocalAddress: "local-address-2", RemoteAddress: "remote-address-2"},
 				}
+				sshOptions.RemotePortForwardSpecs = []RemotePortForward{
+					{RemoteAddress: "remote-address-3", LocalAddress: "local-address-3"},
+				}
+				sshOptions.DynamicPortForwardSpecs = []DynamicPortForward{
+					{LocalAddress: "local-address-4"},
+				}
 			})
 
 			AfterEach(func() {
@@ -89,6 +102,24 @@ var _ = Describe("SSH Actions", func() {
 				))
 			})
 
+			It("forwards the remote ports", func() {
+				Expect(fakeSecureShellClient.RemotePortForwardCallCount()).To(Equal(1))
+				Expect(fakeSecureShellClient.RemotePortForwardArgsForCall(0)).To(Equal(
+					[]clissh.RemotePortForward{
+						{RemoteAddress: "remote-address-3", LocalAddress: "local-address-3"},
+					},
+				))
+			})
+
+			It("starts the dynamic port forwards", func() {
+				Expect(fakeSecureShellClient.DynamicPortForwardCallCount()).To(Equal(1))
+				Expect(fakeSecureShellClient.DynamicPortForwardArgsForCall(0)).To(Equal(
+					[]clissh.DynamicPortForward{
+						{LocalAddress: "local-address-4"},
+					},
+				))
+			})
+
 			When("local port forwarding fails", func() {
 				BeforeEach(func() {
 					fakeSecureShellClient.LocalPortForwardReturns(errors.New("some-forwarding-error"))
@@ -99,6 +130,27 @@ var _ = Describe("SSH Actions", func() {
 				})
 			})
 
+			When("remote port forwarding fails", func() {
+				BeforeEach(func() {
+					fakeSecureShellClient.RemotePortForwardReturns(errors.New("some-remote-forwarding-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("some-remote-forwarding-error"))
+					Expect(fakeSecureShellClient.DynamicPortForwardCallCount()).To(Equal(0))
+				})
+			})
+
+			When("dynamic port forwarding fails", func() {
+				BeforeEach(func() {
+					fakeSecureShellClient.DynamicPortForwardReturns(errors.New("some-dynamic-forwarding-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("some-dynamic-forwarding-error"))
+				})
+			})
+
 			When("local port forwarding succeeds", func() {
 				When("skipping remote execution", func() {
 					BeforeEach(func() {
@@ -164,3 +216,320 @@ var _ = Describe("SSH Actions", func() {
 		})
 	})
 })
+
+var _ = Describe("SecureCopy Actions", func() {
+	var (
+		fakeConfig            *sharedactionfakes.FakeConfig
+		actor                 *Actor
+		fakeSecureShellClient *sharedactionfakes.FakeSecureShellClient
+		fakeProgressBar       *clisshfakes.FakeProgressBar
+	)
+
+	BeforeEach(func() {
+		fakeSecureShellClient = new(sharedactionfakes.FakeSecureShellClient)
+		fakeConfig = new(sharedactionfakes.FakeConfig)
+		fakeProgressBar = new(clisshfakes.FakeProgressBar)
+		actor = NewActor(fakeConfig)
+	})
+
+	Describe("ExecuteSecureCopy", func() {
+		var (
+			copyOptions SecureCopyOptions
+			executeErr  error
+		)
+
+		BeforeEach(func() {
+			copyOptions = SecureCopyOptions{
+				Username:           "some-user",
+				Passcode:           "some-passcode",
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				LocalPath:          "some-local-path",
+				RemotePath:         "some-remote-path",
+				Recursive:          true,
+			}
+		})
+
+		JustBeforeEach(func() {
+			executeErr = actor.ExecuteSecureCopy(fakeSecureShellClient, copyOptions, fakeProgressBar)
+		})
+
+		It("connects with the provided authorization info", func() {
+			Expect(fakeSecureShellClient.ConnectCallCount()).To(Equal(1))
+			usernameArg, passcodeArg, endpointArg, fingerprintArg, skipHostValidationArg := fakeSecureShellClient.ConnectArgsForCall(0)
+			Expect(usernameArg).To(Equal("some-user"))
+			Expect(passcodeArg).To(Equal("some-passcode"))
+			Expect(endpointArg).To(Equal("some-endpoint"))
+			Expect(fingerprintArg).To(Equal("some-fingerprint"))
+			Expect(skipHostValidationArg).To(BeFalse())
+		})
+
+		When("connecting fails", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.ConnectReturns(errors.New("some-connect-error"))
+			})
+
+			It("returns the error without copying", func() {
+				Expect(executeErr).To(MatchError("some-connect-error"))
+				Expect(fakeSecureShellClient.UploadCallCount()).To(Equal(0))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(0))
+			})
+		})
+
+		When("uploading", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.UploadReturns(errors.New("some-upload-error"))
+			})
+
+			It("uploads the local path and closes the connection", func() {
+				Expect(executeErr).To(MatchError("some-upload-error"))
+				Expect(fakeSecureShellClient.UploadCallCount()).To(Equal(1))
+				localPath, remotePath, recursive, progressBar := fakeSecureShellClient.UploadArgsForCall(0)
+				Expect(localPath).To(Equal("some-local-path"))
+				Expect(remotePath).To(Equal("some-remote-path"))
+				Expect(recursive).To(BeTrue())
+				Expect(progressBar).To(Equal(fakeProgressBar))
+				Expect(fakeSecureShellClient.DownloadCallCount()).To(Equal(0))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+			})
+		})
+
+		When("downloading", func() {
+			BeforeEach(func() {
+				copyOptions.Download = true
+			})
+
+			It("downloads the remote path and closes the connection", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeSecureShellClient.DownloadCallCount()).To(Equal(1))
+				remotePath, localPath, recursive, _ := fakeSecureShellClient.DownloadArgsForCall(0)
+				Expect(remotePath).To(Equal("some-remote-path"))
+				Expect(localPath).To(Equal("some-local-path"))
+				Expect(recursive).To(BeTrue())
+				Expect(fakeSecureShellClient.UploadCallCount()).To(Equal(0))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+			})
+		})
+	})
+})
+
+var _ = Describe("Multi-instance SSH Actions", func() {
+	var (
+		actor       *Actor
+		fakeClients []*sharedactionfakes.FakeSecureShellClient
+		clientsLock sync.Mutex
+		sshOptions  MultiInstanceSSHOptions
+		stdout      *bytes.Buffer
+		stderr      *bytes.Buffer
+		results     []InstanceCommandResult
+		events      []string
+		eventsLock  sync.Mutex
+		passcode    func(string) func() (string, error)
+	)
+
+	recordEvent := func(event string) {
+		eventsLock.Lock()
+		defer eventsLock.Unlock()
+		events = append(events, event)
+	}
+
+	BeforeEach(func() {
+		actor = NewActor(new(sharedactionfakes.FakeConfig))
+		events = nil
+		passcode = func(code string) func() (string, error) {
+			return func() (string, error) {
+				recordEvent("get " + code)
+				return code, nil
+			}
+		}
+		fakeClients = nil
+		stdout = new(bytes.Buffer)
+		stderr = new(bytes.Buffer)
+
+		sshOptions = MultiInstanceSSHOptions{
+			Commands: []string{"hostname"},
+			Instances: []InstanceSSHOptions{
+				{Index: 0, Username: "cf:some-guid/0", GetPasscode: passcode("passcode-0"), Endpoint: "some-endpoint", HostKeyFingerprint: "some-fingerprint"},
+				{Index: 1, Username: "cf:some-guid/1", GetPasscode: passcode("passcode-1"), Endpoint: "some-endpoint", HostKeyFingerprint: "some-fingerprint"},
+				{Index: 2, Username: "cf:some-guid/2", GetPasscode: passcode("passcode-2"), Endpoint: "some-endpoint", HostKeyFingerprint: "some-fingerprint"},
+			},
+			MaxInFlight:        2,
+			SkipHostValidation: true,
+			Stdout:             stdout,
+			Stderr:             stderr,
+		}
+	})
+
+	Describe("ExecuteSecureShellOnInstances", func() {
+		var (
+			inFlight    int32
+			maxInFlight int32
+		)
+
+		BeforeEach(func() {
+			inFlight = 0
+			maxInFlight = 0
+		})
+
+		JustBeforeEach(func() {
+			newClient := func() SecureShellClient {
+				client := new(sharedactionfakes.FakeSecureShellClient)
+				var username string
+				client.ConnectStub = func(user string, code string, _ string, _ string, _ bool) error {
+					username = user
+					recordEvent("connect with " + code)
+					if user == "cf:some-guid/2" {
+						return errors.New("unable to connect")
+					}
+					return nil
+				}
+				client.ExecuteCommandStub = func(commands []string, stdout io.Writer, stderr io.Writer) error {
+					current := atomic.AddInt32(&inFlight, 1)
+					defer atomic.AddInt32(&inFlight, -1)
+					for {
+						seen := atomic.LoadInt32(&maxInFlight)
+						if current <= seen || atomic.CompareAndSwapInt32(&maxInFlight, seen, current) {
+							break
+						}
+					}
+					time.Sleep(10 * time.Millisecond)
+
+					_, _ = io.WriteString(stdout, "host of "+username+"\n")
+					if username == "cf:some-guid/1" {
+						_, _ = io.WriteString(stderr, "partial error")
+						return errors.New("command failed")
+					}
+					return nil
+				}
+
+				clientsLock.Lock()
+				defer clientsLock.Unlock()
+				fakeClients = append(fakeClients, client)
+				return client
+			}
+
+			results = actor.ExecuteSecureShellOnInstances(newClient, sshOptions)
+		})
+
+		It("connects to every instance with its own credentials", func() {
+			Expect(fakeClients).To(HaveLen(3))
+
+			var usernames, passcodes []string
+			for _, client := range fakeClients {
+				Expect(client.ConnectCallCount()).To(Equal(1))
+				username, passcode, endpoint, fingerprint, skipHostValidation := client.ConnectArgsForCall(0)
+				usernames = append(usernames, username)
+				passcodes = append(passcodes, passcode)
+				Expect(endpoint).To(Equal("some-endpoint"))
+				Expect(fingerprint).To(Equal("some-fingerprint"))
+				Expect(skipHostValidation).To(BeTrue())
+			}
+			Expect(usernames).To(ConsistOf("cf:some-guid/0", "cf:some-guid/1", "cf:some-guid/2"))
+			Expect(passcodes).To(ConsistOf("passcode-0", "passcode-1", "passcode-2"))
+		})
+
+		It("runs the command on the instances it connected to and closes their clients", func() {
+			for _, client := range fakeClients {
+				username, _, _, _, _ := client.ConnectArgsForCall(0)
+				if username == "cf:some-guid/2" {
+					Expect(client.ExecuteCommandCallCount()).To(Equal(0))
+					Expect(client.CloseCallCount()).To(Equal(0))
+					continue
+				}
+
+				Expect(client.ExecuteCommandCallCount()).To(Equal(1))
+				commands, _, _ := client.ExecuteCommandArgsForCall(0)
+				Expect(commands).To(Equal([]string{"hostname"}))
+				Expect(client.CloseCallCount()).To(Equal(1))
+			}
+		})
+
+		When("the instances are handled one at a time", func() {
+			BeforeEach(func() {
+				sshOptions.MaxInFlight = 1
+			})
+
+			It("gets each passcode right before connecting to its instance", func() {
+				Expect(events).To(Equal([]string{
+					"get passcode-0", "connect with passcode-0",
+					"get passcode-1", "connect with passcode-1",
+					"get passcode-2", "connect with passcode-2",
+				}))
+			})
+		})
+
+		When("getting the passcode of an instance fails", func() {
+			BeforeEach(func() {
+				sshOptions.Instances[0].GetPasscode = func() (string, error) {
+					return "", errors.New("passcode-error")
+				}
+			})
+
+			It("does not connect to that instance and reports the error in its result", func() {
+				var notConnected int
+				for _, client := range fakeClients {
+					if client.ConnectCallCount() == 0 {
+						notConnected++
+						Expect(client.ExecuteCommandCallCount()).To(Equal(0))
+						Expect(client.CloseCallCount()).To(Equal(0))
+					}
+				}
+				Expect(notConnected).To(Equal(1))
+				Expect(results[0]).To(Equal(InstanceCommandResult{Index: 0, ExitStatus: 255, Err: errors.New("passcode-error")}))
+			})
+		})
+
+		When("the access token expires while several instances are handled at once", func() {
+			var refreshCount int
+
+			BeforeEach(func() {
+				sshOptions.MaxInFlight = 3
+
+				// Like the UAA client, the passcode fetcher shares
+				// unsynchronized token state between calls.
+				accessToken := "expired-token"
+				refreshCount = 0
+				getPasscode := func() (string, error) {
+					if accessToken == "expired-token" {
+						time.Sleep(5 * time.Millisecond)
+						refreshCount++
+						accessToken = "fresh-token"
+					}
+					return "passcode-for-" + accessToken, nil
+				}
+				for i := range sshOptions.Instances {
+					sshOptions.Instances[i].GetPasscode = getPasscode
+				}
+			})
+
+			It("fetches the passcodes one at a time, refreshing the token once", func() {
+				Expect(refreshCount).To(Equal(1))
+				for _, client := range fakeClients {
+					_, passcode, _, _, _ := client.ConnectArgsForCall(0)
+					Expect(passcode).To(Equal("passcode-for-fresh-token"))
+				}
+			})
+		})
+
+		It("runs no more than MaxInFlight commands at the same time", func() {
+			Expect(atomic.LoadInt32(&maxInFlight)).To(BeNumerically("<=", 2))
+		})
+
+		It("prefixes the output with the instance index", func() {
+			Expect(strings.Split(stdout.String(), "\n")).To(ConsistOf(
+				"[0] host of cf:some-guid/0",
+				"[1] host of cf:some-guid/1",
+				"",
+			))
+			Expect(stderr.String()).To(Equal("[1] partial error\n"))
+		})
+
+		It("returns the result of every instance sorted by index", func() {
+			Expect(results).To(Equal([]InstanceCommandResult{
+				{Index: 0, ExitStatus: 0},
+				{Index: 1, ExitStatus: 255, Err: errors.New("command failed")},
+				{Index: 2, ExitStatus: 255, Err: errors.New("unable to connect")},
+			}))
+		})
+	})
+})
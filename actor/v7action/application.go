@@ -319,7 +319,7 @@ func (actor Actor) PollStartForRolling(app resources.Application, deploymentGUID
 			}
 			return allWarnings, actionerror.StartupTimeoutError{Name: app.Name}
 		case <-timer.C():
-			if !isDeployed(deployment) {
+			if !isDeployed(deployment) && !isPaused(deployment) {
 				ccDeployment, warnings, err := actor.getDeployment(deploymentGUID)
 				allWarnings = append(allWarnings, warnings...)
 				if err != nil {
@@ -333,7 +333,7 @@ func (actor Actor) PollStartForRolling(app resources.Application, deploymentGUID
 				}
 			}
 
-			if noWait || isDeployed(deployment) {
+			if noWait || isDeployed(deployment) || isPaused(deployment) {
 				stopPolling, warnings, err := actor.PollProcesses(processes, handleInstanceDetails)
 				allWarnings = append(allWarnings, warnings...)
 				if stopPolling || err != nil {
@@ -350,6 +350,12 @@ func isDeployed(d resources.Deployment) bool {
 	return d.StatusValue == constant.DeploymentStatusValueFinalized && d.StatusReason == constant.DeploymentStatusReasonDeployed
 }
 
+// isPaused returns true when a canary deployment is waiting to be continued or
+// canceled.
+func isPaused(d resources.Deployment) bool {
+	return d.StatusValue == constant.DeploymentStatusValueActive && d.StatusReason == constant.DeploymentStatusReasonPaused
+}
+
 // PollProcesses - return true if there's no need to keep polling
 func (actor Actor) PollProcesses(processes []resources.Process, handleInstanceDetails func(string)) (bool, Warnings, error) {
 	numProcesses := len(processes)
@@ -419,7 +425,9 @@ func (actor Actor) getDeployment(deploymentGUID string) (resources.Deployment, W
 }
 
 func (actor Actor) getProcesses(deployment resources.Deployment, appGUID string, noWait bool) ([]resources.Process, Warnings, error) {
-	if noWait {
+	// a paused canary deployment has only started the new web process instances
+	// of its first step, so there is nothing else to wait for
+	if noWait || isPaused(deployment) {
 		// these are only web processes for now so we can just use these
 		return deployment.NewProcesses, nil, nil
 	}
@@ -1,8 +1,12 @@
 package v7action
 
 import (
+	"fmt"
+
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/resources"
+	"gopkg.in/yaml.v2"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ManifestParser
@@ -66,5 +70,70 @@ func (actor Actor) GetRawApplicationManifestByNameAndSpace(appName string, space
 	}
 
 	rawManifest, manifestWarnings, err := actor.CloudControllerClient.GetApplicationManifest(app.GUID)
-	return rawManifest, append(warnings, manifestWarnings...), err
+	warnings = append(warnings, manifestWarnings...)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	sidecars, sidecarWarnings, err := actor.CloudControllerClient.GetApplicationSidecars(app.GUID)
+	warnings = append(warnings, sidecarWarnings...)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	rawManifest, err = addSidecarsToManifest(rawManifest, sidecars)
+	return rawManifest, warnings, err
+}
+
+// addSidecarsToManifest adds the sidecars to the application in the manifest
+// when the manifest generated by the cloud controller leaves them out, so that
+// the manifest recreates the app as it is.
+func addSidecarsToManifest(rawManifest []byte, sidecars []resources.Sidecar) ([]byte, error) {
+	if len(sidecars) == 0 {
+		return rawManifest, nil
+	}
+
+	var manifest yaml.MapSlice
+	err := yaml.Unmarshal(rawManifest, &manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, item := range manifest {
+		if item.Key != "applications" {
+			continue
+		}
+		applications, ok := item.Value.([]interface{})
+		if !ok || len(applications) != 1 {
+			return rawManifest, nil
+		}
+		application, ok := applications[0].(yaml.MapSlice)
+		if !ok {
+			return rawManifest, nil
+		}
+		for _, field := range application {
+			if field.Key == "sidecars" {
+				return rawManifest, nil
+			}
+		}
+
+		var manifestSidecars []yaml.MapSlice
+		for _, sidecar := range sidecars {
+			manifestSidecar := yaml.MapSlice{
+				{Key: "name", Value: sidecar.Name},
+				{Key: "process_types", Value: sidecar.ProcessTypes},
+				{Key: "command", Value: sidecar.Command.Value},
+			}
+			if sidecar.MemoryInMB.IsSet {
+				manifestSidecar = append(manifestSidecar, yaml.MapItem{Key: "memory", Value: fmt.Sprintf("%dM", sidecar.MemoryInMB.Value)})
+			}
+			manifestSidecars = append(manifestSidecars, manifestSidecar)
+		}
+
+		applications[0] = append(application, yaml.MapItem{Key: "sidecars", Value: manifestSidecars})
+		manifest[i].Value = applications
+		return yaml.Marshal(manifest)
+	}
+
+	return rawManifest, nil
 }
@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/clock"
 
 	. "github.com/onsi/ginkgo"
@@ -267,6 +268,65 @@ var _ = Describe("Application Manifest Actions", func() {
 					Expect(fakeCloudControllerClient.GetApplicationManifestCallCount()).To(Equal(1))
 					Expect(fakeCloudControllerClient.GetApplicationManifestArgsForCall(0)).To(Equal("some-app-guid"))
 				})
+
+				When("the app has sidecars that the manifest leaves out", func() {
+					BeforeEach(func() {
+						rawManifest = []byte("applications:\n- name: some-app-name\n  memory: 1G\n")
+						fakeCloudControllerClient.GetApplicationManifestReturns(rawManifest, ccv3.Warnings{"get-manifest-warnings"}, nil)
+						fakeCloudControllerClient.GetApplicationSidecarsReturns(
+							[]resources.Sidecar{
+								{
+									Name:         "auth-sidecar",
+									Command:      types.FilteredString{IsSet: true, Value: "bundle exec rackup"},
+									ProcessTypes: []string{"web", "worker"},
+									MemoryInMB:   types.NullUint64{IsSet: true, Value: 64},
+								},
+							},
+							ccv3.Warnings{"get-sidecars-warning"},
+							nil,
+						)
+					})
+
+					It("adds the sidecars to the manifest", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(warnings).To(ConsistOf("get-application-warning", "get-manifest-warnings", "get-sidecars-warning"))
+						Expect(fakeCloudControllerClient.GetApplicationSidecarsArgsForCall(0)).To(Equal("some-app-guid"))
+						Expect(string(manifestBytes)).To(Equal(`applications:
+- name: some-app-name
+  memory: 1G
+  sidecars:
+  - name: auth-sidecar
+    process_types:
+    - web
+    - worker
+    command: bundle exec rackup
+    memory: 64M
+`))
+					})
+
+					When("the manifest already has the sidecars", func() {
+						BeforeEach(func() {
+							rawManifest = []byte("applications:\n- name: some-app-name\n  sidecars:\n  - name: auth-sidecar\n")
+							fakeCloudControllerClient.GetApplicationManifestReturns(rawManifest, nil, nil)
+						})
+
+						It("returns the manifest unchanged", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(manifestBytes).To(Equal(rawManifest))
+						})
+					})
+				})
+
+				When("getting the sidecars returns an error", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.GetApplicationSidecarsReturns(nil, ccv3.Warnings{"get-sidecars-warning"}, errors.New("sidecars-error"))
+					})
+
+					It("returns the error and warnings", func() {
+						Expect(executeErr).To(MatchError("sidecars-error"))
+						Expect(warnings).To(ConsistOf("get-application-warning", "get-manifest-warnings", "get-sidecars-warning"))
+					})
+				})
 			})
 
 			When("getting the manifest returns an error", func() {
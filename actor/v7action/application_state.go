@@ -0,0 +1,382 @@
+package v7action
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"gopkg.in/yaml.v2"
+)
+
+// ApplicationState is the configuration of an app that can be compared with
+// DiffApplicationStates. Nil fields, empty strings and process fields missing
+// from Processes were not specified by the source of the state.
+type ApplicationState struct {
+	Buildpacks []string
+	Stack      string
+	Droplet    string
+	Env        map[string]string
+	// Processes maps each process type to its manifest fields, such as
+	// "command", "instances" and "memory".
+	Processes map[string]map[string]string
+	Routes    []string
+	Services  []string
+
+	// Partial is set when the state only lists some of the app's environment
+	// variables, process types, routes and services, as a manifest does.
+	Partial bool
+}
+
+// GetApplicationStateByNameAndSpace returns the current state of the app.
+func (actor Actor) GetApplicationStateByNameAndSpace(appName string, spaceGUID string) (ApplicationState, Warnings, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return ApplicationState{}, warnings, err
+	}
+
+	rawManifest, manifestWarnings, err := actor.CloudControllerClient.GetApplicationManifest(app.GUID)
+	warnings = append(warnings, manifestWarnings...)
+	if err != nil {
+		return ApplicationState{}, warnings, err
+	}
+
+	var manifest manifestparser.Manifest
+	err = yaml.Unmarshal(rawManifest, &manifest)
+	if err != nil {
+		return ApplicationState{}, warnings, err
+	}
+	if len(manifest.Applications) == 0 {
+		return ApplicationState{}, warnings, actionerror.ApplicationNotFoundError{Name: appName}
+	}
+
+	state := ApplicationStateFromManifest(manifest.Applications[0])
+	state.Partial = false
+	if state.Buildpacks == nil {
+		state.Buildpacks = []string{}
+	}
+	if state.Env == nil {
+		state.Env = map[string]string{}
+	}
+	if state.Routes == nil {
+		state.Routes = []string{}
+	}
+	if state.Services == nil {
+		state.Services = []string{}
+	}
+	for _, fields := range state.Processes {
+		if _, ok := fields["command"]; !ok {
+			fields["command"] = ""
+		}
+	}
+
+	droplet, dropletWarnings, err := actor.GetCurrentDropletByApplication(app.GUID)
+	warnings = append(warnings, dropletWarnings...)
+	if _, ok := err.(actionerror.DropletNotFoundError); err != nil && !ok {
+		return ApplicationState{}, warnings, err
+	}
+	state.Droplet = droplet.GUID
+
+	return state, warnings, nil
+}
+
+// GetApplicationStateByRevision returns the state of the app recorded in the
+// given revision. Revisions only record the droplet, the environment variables
+// and the commands of the app's processes.
+func (actor Actor) GetApplicationStateByRevision(appName string, spaceGUID string, version int) (ApplicationState, Warnings, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return ApplicationState{}, warnings, err
+	}
+
+	revision, revisionWarnings, err := actor.GetRevisionByApplicationAndVersion(app.GUID, version)
+	warnings = append(warnings, revisionWarnings...)
+	if err != nil {
+		return ApplicationState{}, warnings, err
+	}
+
+	envVars, envWarnings, err := actor.CloudControllerClient.GetRevisionEnvironmentVariables(revision.GUID)
+	warnings = append(warnings, envWarnings...)
+	if err != nil {
+		return ApplicationState{}, warnings, err
+	}
+
+	state := ApplicationState{
+		Droplet:   revision.Droplet.GUID,
+		Env:       map[string]string{},
+		Processes: map[string]map[string]string{},
+	}
+	for name, value := range envVars {
+		state.Env[name] = value.Value
+	}
+	for processType, process := range revision.Processes {
+		state.Processes[processType] = map[string]string{"command": process.Command}
+	}
+
+	return state, warnings, nil
+}
+
+// ApplicationStateFromManifest returns the state described by an app in a
+// manifest. Settings on the app itself apply to its web process.
+func ApplicationStateFromManifest(app manifestparser.Application) ApplicationState {
+	fields := app.RemainingManifestFields
+	state := ApplicationState{
+		Stack:     app.Stack,
+		Processes: map[string]map[string]string{},
+		Partial:   true,
+	}
+
+	if buildpacks, ok := fields["buildpacks"].([]interface{}); ok {
+		state.Buildpacks = []string{}
+		for _, buildpack := range buildpacks {
+			state.Buildpacks = append(state.Buildpacks, fmt.Sprint(buildpack))
+		}
+	} else if buildpack, ok := fields["buildpack"].(string); ok {
+		state.Buildpacks = []string{buildpack}
+	}
+
+	if env, ok := fields["env"].(map[interface{}]interface{}); ok {
+		state.Env = map[string]string{}
+		for name, value := range env {
+			state.Env[fmt.Sprint(name)] = fmt.Sprint(value)
+		}
+	}
+
+	if routes, ok := fields["routes"].([]interface{}); ok {
+		state.Routes = []string{}
+		for _, route := range routes {
+			if route, ok := route.(map[interface{}]interface{}); ok {
+				state.Routes = append(state.Routes, fmt.Sprint(route["route"]))
+			}
+		}
+	}
+	if app.NoRoute {
+		state.Routes = []string{}
+	}
+
+	if services, ok := fields["services"].([]interface{}); ok {
+		state.Services = []string{}
+		for _, service := range services {
+			if service, ok := service.(map[interface{}]interface{}); ok {
+				state.Services = append(state.Services, fmt.Sprint(service["name"]))
+				continue
+			}
+			state.Services = append(state.Services, fmt.Sprint(service))
+		}
+	}
+
+	web := manifestProcessFields(app.Instances, app.Memory, app.DiskQuota, app.HealthCheckType, app.HealthCheckEndpoint, app.HealthCheckTimeout, fields)
+	if len(web) > 0 {
+		state.Processes[constant.ProcessTypeWeb] = web
+	}
+
+	for _, process := range app.Processes {
+		processFields := manifestProcessFields(process.Instances, process.Memory, process.DiskQuota, process.HealthCheckType, process.HealthCheckEndpoint, process.HealthCheckTimeout, process.RemainingManifestFields)
+		if existing, ok := state.Processes[process.Type]; ok {
+			for name, value := range processFields {
+				existing[name] = value
+			}
+			continue
+		}
+		state.Processes[process.Type] = processFields
+	}
+
+	return state
+}
+
+// DiffApplicationStates returns the changes that turn the from state into the
+// to state. Settings that the to state does not specify are not compared, and
+// nothing is reported as removed when the to state is partial.
+func DiffApplicationStates(from ApplicationState, to ApplicationState) []resources.Diff {
+	var diffs []resources.Diff
+
+	if to.Buildpacks != nil && !equalStringSlices(from.Buildpacks, to.Buildpacks) {
+		diffs = append(diffs, listDiff("/buildpacks", from.Buildpacks, to.Buildpacks))
+	}
+
+	if to.Stack != "" && from.Stack != to.Stack {
+		diffs = append(diffs, valueDiff("/stack", from.Stack, to.Stack))
+	}
+
+	if to.Droplet != "" && from.Droplet != to.Droplet {
+		diffs = append(diffs, valueDiff("/droplet", from.Droplet, to.Droplet))
+	}
+
+	if to.Env != nil {
+		for _, name := range sortedKeys(from.Env, to.Env) {
+			was, inFrom := from.Env[name]
+			value, inTo := to.Env[name]
+			switch {
+			case inTo && !inFrom:
+				diffs = append(diffs, resources.Diff{Op: resources.AddOperation, Path: "/env/" + name, Value: value})
+			case inTo && was != value:
+				diffs = append(diffs, resources.Diff{Op: resources.ReplaceOperation, Path: "/env/" + name, Was: was, Value: value})
+			case !inTo && !to.Partial:
+				diffs = append(diffs, resources.Diff{Op: resources.RemoveOperation, Path: "/env/" + name, Was: was})
+			}
+		}
+	}
+
+	if to.Processes != nil {
+		processTypes := map[string]bool{}
+		for processType := range from.Processes {
+			processTypes[processType] = true
+		}
+		for processType := range to.Processes {
+			processTypes[processType] = true
+		}
+
+		for _, processType := range sortedSet(processTypes) {
+			fromFields, inFrom := from.Processes[processType]
+			toFields, inTo := to.Processes[processType]
+			path := "/processes/" + processType
+			switch {
+			case inTo && !inFrom:
+				diffs = append(diffs, resources.Diff{Op: resources.AddOperation, Path: path, Value: toFields})
+			case inTo:
+				for _, name := range sortedKeys(nil, toFields) {
+					if fromFields[name] != toFields[name] {
+						diffs = append(diffs, valueDiff(path+"/"+name, fromFields[name], toFields[name]))
+					}
+				}
+			case !to.Partial:
+				diffs = append(diffs, resources.Diff{Op: resources.RemoveOperation, Path: path, Was: fromFields})
+			}
+		}
+	}
+
+	if to.Routes != nil {
+		diffs = append(diffs, setDiffs("/routes", from.Routes, to.Routes, to.Partial)...)
+	}
+
+	if to.Services != nil {
+		diffs = append(diffs, setDiffs("/services", from.Services, to.Services, to.Partial)...)
+	}
+
+	return diffs
+}
+
+func manifestProcessFields(instances *int, memory string, diskQuota string, healthCheckType constant.HealthCheckType, healthCheckEndpoint string, healthCheckTimeout int64, remainingFields map[string]interface{}) map[string]string {
+	fields := map[string]string{}
+
+	if command, ok := remainingFields["command"]; ok {
+		fields["command"] = ""
+		if command != nil {
+			fields["command"] = fmt.Sprint(command)
+		}
+	}
+	if instances != nil {
+		fields["instances"] = strconv.Itoa(*instances)
+	}
+	if memory != "" {
+		fields["memory"] = normalizeMegabytes(memory)
+	}
+	if diskQuota != "" {
+		fields["disk_quota"] = normalizeMegabytes(diskQuota)
+	}
+	if healthCheckType != "" {
+		fields["health-check-type"] = string(healthCheckType)
+	}
+	if healthCheckEndpoint != "" {
+		fields["health-check-http-endpoint"] = healthCheckEndpoint
+	}
+	if healthCheckTimeout != 0 {
+		fields["timeout"] = strconv.FormatInt(healthCheckTimeout, 10)
+	}
+
+	return fields
+}
+
+// normalizeMegabytes converts sizes such as "1G" to megabytes, the unit the
+// API uses in app manifests.
+func normalizeMegabytes(size string) string {
+	megabytes, err := bytefmt.ToMegabytes(size)
+	if err != nil {
+		return size
+	}
+	return fmt.Sprintf("%dM", megabytes)
+}
+
+func valueDiff(path string, was string, value string) resources.Diff {
+	switch {
+	case was == "":
+		return resources.Diff{Op: resources.AddOperation, Path: path, Value: value}
+	case value == "":
+		return resources.Diff{Op: resources.RemoveOperation, Path: path, Was: was}
+	default:
+		return resources.Diff{Op: resources.ReplaceOperation, Path: path, Was: was, Value: value}
+	}
+}
+
+func listDiff(path string, was []string, value []string) resources.Diff {
+	switch {
+	case len(was) == 0:
+		return resources.Diff{Op: resources.AddOperation, Path: path, Value: value}
+	case len(value) == 0:
+		return resources.Diff{Op: resources.RemoveOperation, Path: path, Was: was}
+	default:
+		return resources.Diff{Op: resources.ReplaceOperation, Path: path, Was: was, Value: value}
+	}
+}
+
+func setDiffs(path string, from []string, to []string, partial bool) []resources.Diff {
+	inFrom := map[string]bool{}
+	for _, item := range from {
+		inFrom[item] = true
+	}
+	inTo := map[string]bool{}
+	for _, item := range to {
+		inTo[item] = true
+	}
+
+	var diffs []resources.Diff
+	if !partial {
+		for _, item := range sortedSet(inFrom) {
+			if !inTo[item] {
+				diffs = append(diffs, resources.Diff{Op: resources.RemoveOperation, Path: path, Was: item})
+			}
+		}
+	}
+	for _, item := range sortedSet(inTo) {
+		if !inFrom[item] {
+			diffs = append(diffs, resources.Diff{Op: resources.AddOperation, Path: path, Value: item})
+		}
+	}
+	return diffs
+}
+
+func sortedKeys(a map[string]string, b map[string]string) []string {
+	keys := map[string]bool{}
+	for key := range a {
+		keys[key] = true
+	}
+	for key := range b {
+		keys[key] = true
+	}
+	return sortedSet(keys)
+}
+
+func sortedSet(set map[string]bool) []string {
+	items := make([]string, 0, len(set))
+	for item := range set {
+		items = append(items, item)
+	}
+	sort.Strings(items)
+	return items
+}
+
+func equalStringSlices(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,347 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v2"
+)
+
+var _ = Describe("Application State Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeConfig                *v7actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v7actionfakes.FakeConfig)
+		fakeConfig.APIVersionReturns("3.86.0")
+		actor = NewActor(fakeCloudControllerClient, fakeConfig, nil, nil, nil, nil)
+
+		fakeCloudControllerClient.GetApplicationsReturns(
+			[]resources.Application{{Name: "some-app", GUID: "some-app-guid"}},
+			ccv3.Warnings{"get-app-warning"},
+			nil,
+		)
+	})
+
+	Describe("GetApplicationStateByNameAndSpace", func() {
+		var (
+			state      ApplicationState
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			state, warnings, executeErr = actor.GetApplicationStateByNameAndSpace("some-app", "some-space-guid")
+		})
+
+		When("the app has a manifest and a droplet", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationManifestReturns(
+					[]byte(`---
+applications:
+- name: some-app
+  stack: cflinuxfs3
+  buildpacks:
+  - ruby_buildpack
+  env:
+    SOME_VAR: some-value
+  routes:
+  - route: some-app.example.com
+  processes:
+  - type: web
+    instances: 2
+    memory: 1G
+    disk_quota: 1024M
+    health-check-type: port
+  - type: worker
+    command: bundle exec work
+    instances: 1
+`),
+					ccv3.Warnings{"get-manifest-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(
+					resources.Droplet{GUID: "some-droplet-guid"},
+					ccv3.Warnings{"get-droplet-warning"},
+					nil,
+				)
+			})
+
+			It("returns the complete state of the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-manifest-warning", "get-droplet-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationManifestArgsForCall(0)).To(Equal("some-app-guid"))
+				Expect(fakeCloudControllerClient.GetApplicationDropletCurrentArgsForCall(0)).To(Equal("some-app-guid"))
+
+				Expect(state).To(Equal(ApplicationState{
+					Buildpacks: []string{"ruby_buildpack"},
+					Stack:      "cflinuxfs3",
+					Droplet:    "some-droplet-guid",
+					Env:        map[string]string{"SOME_VAR": "some-value"},
+					Processes: map[string]map[string]string{
+						"web": {
+							"command":           "",
+							"instances":         "2",
+							"memory":            "1024M",
+							"disk_quota":        "1024M",
+							"health-check-type": "port",
+						},
+						"worker": {
+							"command":   "bundle exec work",
+							"instances": "1",
+						},
+					},
+					Routes:   []string{"some-app.example.com"},
+					Services: []string{},
+				}))
+			})
+		})
+
+		When("the app does not have a droplet", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationManifestReturns([]byte("applications:\n- name: some-app\n"), nil, nil)
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(resources.Droplet{}, nil, ccerror.DropletNotFoundError{})
+			})
+
+			It("returns a state without a droplet", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(state.Droplet).To(BeEmpty())
+				Expect(state.Env).To(BeEmpty())
+				Expect(state.Env).ToNot(BeNil())
+			})
+		})
+
+		When("getting the manifest fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationManifestReturns(nil, ccv3.Warnings{"get-manifest-warning"}, errors.New("manifest-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("manifest-error"))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-manifest-warning"))
+			})
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+			})
+		})
+	})
+
+	Describe("GetApplicationStateByRevision", func() {
+		var (
+			state      ApplicationState
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			state, warnings, executeErr = actor.GetApplicationStateByRevision("some-app", "some-space-guid", 3)
+		})
+
+		When("the revision exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationRevisionsReturns(
+					[]resources.Revision{{
+						GUID:      "some-revision-guid",
+						Version:   3,
+						Droplet:   resources.Droplet{GUID: "some-droplet-guid"},
+						Processes: map[string]resources.RevisionProcess{"web": {Command: "bundle exec rackup"}},
+					}},
+					ccv3.Warnings{"get-revisions-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetRevisionEnvironmentVariablesReturns(
+					resources.EnvironmentVariables{"SOME_VAR": types.FilteredString{Value: "some-value", IsSet: true}},
+					ccv3.Warnings{"get-env-warning"},
+					nil,
+				)
+			})
+
+			It("returns the state recorded in the revision", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-revisions-warning", "get-env-warning"))
+
+				appGUID, query := fakeCloudControllerClient.GetApplicationRevisionsArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(query).To(ConsistOf(ccv3.Query{Key: ccv3.VersionsFilter, Values: []string{"3"}}))
+				Expect(fakeCloudControllerClient.GetRevisionEnvironmentVariablesArgsForCall(0)).To(Equal("some-revision-guid"))
+
+				Expect(state).To(Equal(ApplicationState{
+					Droplet:   "some-droplet-guid",
+					Env:       map[string]string{"SOME_VAR": "some-value"},
+					Processes: map[string]map[string]string{"web": {"command": "bundle exec rackup"}},
+				}))
+			})
+		})
+
+		When("the revision does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationRevisionsReturns(nil, ccv3.Warnings{"get-revisions-warning"}, nil)
+			})
+
+			It("returns a RevisionNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.RevisionNotFoundError{Version: 3}))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-revisions-warning"))
+				Expect(fakeCloudControllerClient.GetRevisionEnvironmentVariablesCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("ApplicationStateFromManifest", func() {
+		parse := func(rawManifest string) manifestparser.Application {
+			var manifest manifestparser.Manifest
+			Expect(yaml.Unmarshal([]byte(rawManifest), &manifest)).To(Succeed())
+			return manifest.Applications[0]
+		}
+
+		It("only specifies the settings in the manifest", func() {
+			state := ApplicationStateFromManifest(parse(`
+applications:
+- name: some-app
+  memory: 512M
+`))
+			Expect(state).To(Equal(ApplicationState{
+				Processes: map[string]map[string]string{"web": {"memory": "512M"}},
+				Partial:   true,
+			}))
+		})
+
+		It("applies app-level settings to the web process", func() {
+			state := ApplicationStateFromManifest(parse(`
+applications:
+- name: some-app
+  command: start-web
+  instances: 3
+  disk-quota: 2G
+  processes:
+  - type: web
+    memory: 256M
+  - type: worker
+    command: null
+`))
+			Expect(state.Processes).To(Equal(map[string]map[string]string{
+				"web":    {"command": "start-web", "instances": "3", "disk_quota": "2048M", "memory": "256M"},
+				"worker": {"command": ""},
+			}))
+		})
+
+		It("reads buildpacks, env, routes and services", func() {
+			state := ApplicationStateFromManifest(parse(`
+applications:
+- name: some-app
+  buildpack: go_buildpack
+  env:
+    PORT_OFFSET: 2
+  routes:
+  - route: a.example.com
+  services:
+  - some-db
+  - name: some-queue
+    parameters: {durable: true}
+`))
+			Expect(state.Buildpacks).To(Equal([]string{"go_buildpack"}))
+			Expect(state.Env).To(Equal(map[string]string{"PORT_OFFSET": "2"}))
+			Expect(state.Routes).To(Equal([]string{"a.example.com"}))
+			Expect(state.Services).To(Equal([]string{"some-db", "some-queue"}))
+		})
+
+		It("specifies no routes when no-route is set", func() {
+			state := ApplicationStateFromManifest(parse("applications:\n- name: some-app\n  no-route: true\n"))
+			Expect(state.Routes).To(Equal([]string{}))
+		})
+	})
+
+	Describe("DiffApplicationStates", func() {
+		var live ApplicationState
+
+		BeforeEach(func() {
+			live = ApplicationState{
+				Buildpacks: []string{"ruby_buildpack"},
+				Stack:      "cflinuxfs3",
+				Droplet:    "droplet-1",
+				Env:        map[string]string{"KEEP": "same", "CHANGE": "old", "EXTRA": "value"},
+				Processes: map[string]map[string]string{
+					"web":    {"command": "", "instances": "2", "memory": "1024M"},
+					"worker": {"command": "work", "instances": "1"},
+				},
+				Routes:   []string{"a.example.com", "b.example.com"},
+				Services: []string{"some-db"},
+			}
+		})
+
+		It("returns nothing when the states are the same", func() {
+			Expect(DiffApplicationStates(live, live)).To(BeEmpty())
+		})
+
+		When("the other state is complete", func() {
+			It("reports additions, changes and removals", func() {
+				other := ApplicationState{
+					Buildpacks: []string{"go_buildpack"},
+					Stack:      "cflinuxfs3",
+					Droplet:    "droplet-2",
+					Env:        map[string]string{"KEEP": "same", "CHANGE": "new", "ADDED": "value"},
+					Processes: map[string]map[string]string{
+						"web": {"command": "", "instances": "3", "memory": "1024M"},
+					},
+					Routes:   []string{"b.example.com", "c.example.com"},
+					Services: []string{},
+				}
+
+				Expect(DiffApplicationStates(live, other)).To(Equal([]resources.Diff{
+					{Op: resources.ReplaceOperation, Path: "/buildpacks", Was: []string{"ruby_buildpack"}, Value: []string{"go_buildpack"}},
+					{Op: resources.ReplaceOperation, Path: "/droplet", Was: "droplet-1", Value: "droplet-2"},
+					{Op: resources.AddOperation, Path: "/env/ADDED", Value: "value"},
+					{Op: resources.ReplaceOperation, Path: "/env/CHANGE", Was: "old", Value: "new"},
+					{Op: resources.RemoveOperation, Path: "/env/EXTRA", Was: "value"},
+					{Op: resources.ReplaceOperation, Path: "/processes/web/instances", Was: "2", Value: "3"},
+					{Op: resources.RemoveOperation, Path: "/processes/worker", Was: map[string]string{"command": "work", "instances": "1"}},
+					{Op: resources.RemoveOperation, Path: "/routes", Was: "a.example.com"},
+					{Op: resources.AddOperation, Path: "/routes", Value: "c.example.com"},
+					{Op: resources.RemoveOperation, Path: "/services", Was: "some-db"},
+				}))
+			})
+		})
+
+		When("the other state is partial", func() {
+			It("only reports settings the other state specifies and does not report removals", func() {
+				manifest := ApplicationState{
+					Env: map[string]string{"CHANGE": "new"},
+					Processes: map[string]map[string]string{
+						"web":   {"memory": "512M"},
+						"clock": {"command": "tick"},
+					},
+					Routes:  []string{"c.example.com"},
+					Partial: true,
+				}
+
+				Expect(DiffApplicationStates(live, manifest)).To(Equal([]resources.Diff{
+					{Op: resources.ReplaceOperation, Path: "/env/CHANGE", Was: "old", Value: "new"},
+					{Op: resources.AddOperation, Path: "/processes/clock", Value: map[string]string{"command": "tick"}},
+					{Op: resources.ReplaceOperation, Path: "/processes/web/memory", Was: "1024M", Value: "512M"},
+					{Op: resources.AddOperation, Path: "/routes", Value: "c.example.com"},
+				}))
+			})
+		})
+	})
+})
@@ -1451,6 +1451,57 @@ var _ = Describe("Application Actions", func() {
 
 			})
 
+			When("a canary deployment is paused", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetDeploymentReturnsOnCall(0,
+						resources.Deployment{StatusValue: constant.DeploymentStatusValueActive},
+						ccv3.Warnings{"get-deployment-warning-1"},
+						nil,
+					)
+
+					fakeCloudControllerClient.GetDeploymentReturnsOnCall(1,
+						resources.Deployment{
+							StatusValue:  constant.DeploymentStatusValueActive,
+							StatusReason: constant.DeploymentStatusReasonPaused,
+							NewProcesses: []resources.Process{{GUID: "canary-process-guid"}},
+						},
+						ccv3.Warnings{"get-deployment-warning-2"},
+						nil,
+					)
+
+					fakeCloudControllerClient.GetProcessInstancesReturns(
+						[]ccv3.ProcessInstance{{State: constant.ProcessInstanceRunning}},
+						ccv3.Warnings{"poll-processes-warning"},
+						nil,
+					)
+				})
+
+				It("stops polling once the canary instances are running", func() {
+					// Initial tick
+					fakeClock.WaitForNWatchersAndIncrement(1*time.Millisecond, 2)
+
+					Eventually(fakeCloudControllerClient.GetDeploymentCallCount).Should(Equal(1))
+					Eventually(fakeConfig.PollingIntervalCallCount).Should(Equal(1))
+
+					// the deployment is now paused so only the canary process is polled
+					fakeClock.Increment(1 * time.Second)
+
+					Eventually(done).Should(Receive(BeTrue()))
+
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(warnings).To(ConsistOf(
+						"get-deployment-warning-1",
+						"get-deployment-warning-2",
+						"poll-processes-warning",
+					))
+
+					Expect(fakeCloudControllerClient.GetDeploymentCallCount()).To(Equal(2))
+					Expect(fakeCloudControllerClient.GetApplicationProcessesCallCount()).To(Equal(0))
+					Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(0)).To(Equal("canary-process-guid"))
+				})
+			})
+
 		})
 	})
 
@@ -39,7 +39,9 @@ func (actor defaultAuthActor) Authenticate(credentials map[string]string, origin
 	accessToken = fmt.Sprintf("bearer %s", accessToken)
 	actor.config.SetTokenInformation(accessToken, refreshToken, "")
 
-	if grantType == constant.GrantTypePassword {
+	// Tokens obtained with an authorization code or a device code are refreshed
+	// the same way as password tokens.
+	if grantType == constant.GrantTypePassword || grantType == constant.GrantTypeAuthorizationCode || grantType == constant.GrantTypeDeviceCode {
 		actor.config.SetUAAGrantType("")
 	} else {
 		actor.config.SetUAAGrantType(string(grantType))
@@ -73,6 +75,12 @@ func (actor defaultAuthActor) GetCurrentUser() (configv3.User, error) {
 	return actor.config.CurrentUser()
 }
 
+// GetAuthorizationCodeURL returns the URL a user visits in a web browser to
+// log in and be redirected to redirectURI with an authorization code.
+func (actor Actor) GetAuthorizationCodeURL(redirectURI string, state string, codeChallenge string) (string, error) {
+	return actor.UAAClient.AuthorizationCodeURL(redirectURI, state, codeChallenge)
+}
+
 // TODO: error check this in future stories
 func (actor Actor) RevokeAccessAndRefreshTokens() error {
 	accessToken := actor.Config.AccessToken()
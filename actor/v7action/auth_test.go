@@ -94,6 +94,25 @@ var _ = Describe("Default Auth Actions", func() {
 				})
 			})
 
+			When("the grant type is an authorization code grant", func() {
+				BeforeEach(func() {
+					grantType = constant.GrantTypeAuthorizationCode
+				})
+
+				It("stores the tokens so that they are refreshed like password tokens", func() {
+					Expect(actualErr).NotTo(HaveOccurred())
+
+					Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
+					accessToken, refreshToken, _ := fakeConfig.SetTokenInformationArgsForCall(0)
+					Expect(accessToken).To(Equal("bearer some-access-token"))
+					Expect(refreshToken).To(Equal("some-refresh-token"))
+
+					Expect(fakeConfig.SetUAAGrantTypeCallCount()).To(Equal(1))
+					Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(Equal(""))
+					Expect(fakeConfig.SetUAAClientCredentialsCallCount()).To(Equal(0))
+				})
+			})
+
 			When("the grant type is not password", func() {
 				BeforeEach(func() {
 					grantType = constant.GrantTypeClientCredentials
@@ -155,6 +174,44 @@ var _ = Describe("Default Auth Actions", func() {
 		})
 	})
 
+	Describe("GetAuthorizationCodeURL", func() {
+		var (
+			authorizeURL string
+			actualErr    error
+		)
+
+		JustBeforeEach(func() {
+			authorizeURL, actualErr = actor.GetAuthorizationCodeURL("http://127.0.0.1:4242/callback", "some-state", "some-challenge")
+		})
+
+		When("the UAA client returns the URL", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthorizationCodeURLReturns("https://login.example.com/oauth/authorize?state=some-state", nil)
+			})
+
+			It("returns the URL", func() {
+				Expect(actualErr).NotTo(HaveOccurred())
+				Expect(authorizeURL).To(Equal("https://login.example.com/oauth/authorize?state=some-state"))
+
+				Expect(fakeUAAClient.AuthorizationCodeURLCallCount()).To(Equal(1))
+				redirectURI, state, codeChallenge := fakeUAAClient.AuthorizationCodeURLArgsForCall(0)
+				Expect(redirectURI).To(Equal("http://127.0.0.1:4242/callback"))
+				Expect(state).To(Equal("some-state"))
+				Expect(codeChallenge).To(Equal("some-challenge"))
+			})
+		})
+
+		When("the UAA client returns an error", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthorizationCodeURLReturns("", errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(actualErr).To(MatchError("some-error"))
+			})
+		})
+	})
+
 	Describe("RevokeAccessAndRefreshTokens", func() {
 		var (
 			expectedAccessToken  string
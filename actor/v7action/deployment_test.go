@@ -25,22 +25,42 @@ var _ = Describe("Deployment Actions", func() {
 
 	BeforeEach(func() {
 		actor, fakeCloudControllerClient, _, _, _, _, _ = NewTestActor()
-		fakeCloudControllerClient.CreateApplicationDeploymentByRevisionReturns(
-			"some-deployment-guid",
-			ccv3.Warnings{"create-warning-1", "create-warning-2"},
-			errors.New("create-error"),
-		)
 	})
 
-	Describe("CreateDeploymentByApplicationAndRevision", func() {
+	Describe("CreateDeployment", func() {
+		var deployment resources.Deployment
+
+		BeforeEach(func() {
+			deployment = resources.Deployment{
+				DropletGUID:   "some-droplet-guid",
+				Strategy:      constant.DeploymentStrategyCanary,
+				CanarySteps:   []resources.CanaryStep{{InstanceWeight: 10}},
+				Relationships: resources.Relationships{constant.RelationshipTypeApplication: resources.Relationship{GUID: "some-app-guid"}},
+			}
+			fakeCloudControllerClient.CreateApplicationDeploymentReturns(
+				"some-deployment-guid",
+				ccv3.Warnings{"create-warning-1", "create-warning-2"},
+				nil,
+			)
+		})
+
 		JustBeforeEach(func() {
-			returnedDeploymentGUID, warnings, executeErr = actor.CreateDeploymentByApplicationAndRevision("some-app-guid", "some-revision-guid")
+			returnedDeploymentGUID, warnings, executeErr = actor.CreateDeployment(deployment)
+		})
+
+		It("delegates to the cloud controller client", func() {
+			Expect(fakeCloudControllerClient.CreateApplicationDeploymentCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.CreateApplicationDeploymentArgsForCall(0)).To(Equal(deployment))
+
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(returnedDeploymentGUID).To(Equal("some-deployment-guid"))
+			Expect(warnings).To(Equal(Warnings{"create-warning-1", "create-warning-2"}))
 		})
 
 		When("the client fails", func() {
 			BeforeEach(func() {
-				fakeCloudControllerClient.CreateApplicationDeploymentByRevisionReturns(
-					"some-deployment-guid",
+				fakeCloudControllerClient.CreateApplicationDeploymentReturns(
+					"",
 					ccv3.Warnings{"create-warning-1", "create-warning-2"},
 					errors.New("create-deployment-error"),
 				)
@@ -51,36 +71,6 @@ var _ = Describe("Deployment Actions", func() {
 				Expect(warnings).To(ConsistOf("create-warning-1", "create-warning-2"))
 			})
 		})
-
-		It("delegates to the cloud controller client", func() {
-
-			Expect(fakeCloudControllerClient.CreateApplicationDeploymentByRevisionCallCount()).To(Equal(1), "CreateApplicationDeploymentByRevision call count")
-			givenAppGUID, givenRevisionGUID := fakeCloudControllerClient.CreateApplicationDeploymentByRevisionArgsForCall(0)
-
-			Expect(givenAppGUID).To(Equal("some-app-guid"))
-			Expect(givenRevisionGUID).To(Equal("some-revision-guid"))
-
-			Expect(returnedDeploymentGUID).To(Equal("some-deployment-guid"))
-			Expect(warnings).To(Equal(Warnings{"create-warning-1", "create-warning-2"}))
-		})
-	})
-
-	Describe("CreateDeploymentByApplicationAndDroplet", func() {
-		It("delegates to the cloud controller client", func() {
-			fakeCloudControllerClient.CreateApplicationDeploymentReturns("some-deployment-guid", ccv3.Warnings{"create-warning-1", "create-warning-2"}, errors.New("create-error"))
-
-			returnedDeploymentGUID, warnings, executeErr := actor.CreateDeploymentByApplicationAndDroplet("some-app-guid", "some-droplet-guid")
-
-			Expect(fakeCloudControllerClient.CreateApplicationDeploymentCallCount()).To(Equal(1))
-			givenAppGUID, givenDropletGUID := fakeCloudControllerClient.CreateApplicationDeploymentArgsForCall(0)
-
-			Expect(givenAppGUID).To(Equal("some-app-guid"))
-			Expect(givenDropletGUID).To(Equal("some-droplet-guid"))
-
-			Expect(returnedDeploymentGUID).To(Equal("some-deployment-guid"))
-			Expect(warnings).To(Equal(Warnings{"create-warning-1", "create-warning-2"}))
-			Expect(executeErr).To(MatchError("create-error"))
-		})
 	})
 
 	Describe("GetLatestActiveDeploymentForApp", func() {
@@ -205,4 +195,37 @@ var _ = Describe("Deployment Actions", func() {
 			})
 		})
 	})
+
+	Describe("ContinueDeployment", func() {
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ContinueDeployment("dep-guid")
+		})
+
+		It("delegates to the cc client", func() {
+			Expect(fakeCloudControllerClient.ContinueDeploymentCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.ContinueDeploymentArgsForCall(0)).To(Equal("dep-guid"))
+		})
+
+		When("the client fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.ContinueDeploymentReturns(ccv3.Warnings{"continue-deployment-warning"}, errors.New("continue-deployment-error"))
+			})
+
+			It("returns the warnings and error", func() {
+				Expect(executeErr).To(MatchError("continue-deployment-error"))
+				Expect(warnings).To(ConsistOf("continue-deployment-warning"))
+			})
+		})
+
+		When("the client succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.ContinueDeploymentReturns(ccv3.Warnings{"continue-deployment-warning"}, nil)
+			})
+
+			It("returns the warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("continue-deployment-warning"))
+			})
+		})
+	})
 })
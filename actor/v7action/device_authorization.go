@@ -0,0 +1,71 @@
+package v7action
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+)
+
+const (
+	// defaultDeviceCodeInterval is the polling interval used when the UAA does
+	// not provide one (RFC 8628, section 3.2).
+	defaultDeviceCodeInterval = 5 * time.Second
+	// deviceCodeSlowDownIncrement is added to the polling interval every time
+	// the UAA asks the CLI to slow down (RFC 8628, section 3.5).
+	deviceCodeSlowDownIncrement = 5 * time.Second
+)
+
+// DeviceAuthorization contains the codes a user needs to approve a login from
+// another device.
+type DeviceAuthorization uaa.DeviceAuthorization
+
+// GetDeviceAuthorization requests a device code and a user code from the UAA.
+func (actor Actor) GetDeviceAuthorization() (DeviceAuthorization, error) {
+	authorization, err := actor.UAAClient.RequestDeviceAuthorization()
+	return DeviceAuthorization(authorization), err
+}
+
+// AuthenticateWithDeviceCode polls the UAA until the user approves the device
+// login, then stores the resulting tokens. It waits at least the interval
+// given by the UAA between polls, and longer every time it is asked to slow
+// down.
+func (actor Actor) AuthenticateWithDeviceCode(authorization DeviceAuthorization) error {
+	interval := time.Duration(authorization.Interval) * time.Second
+	if interval <= 0 {
+		interval = defaultDeviceCodeInterval
+	}
+
+	var expired <-chan time.Time
+	if authorization.ExpiresIn > 0 {
+		expired = actor.Clock.After(time.Duration(authorization.ExpiresIn) * time.Second)
+	}
+
+	credentials := map[string]string{
+		"device_code": authorization.DeviceCode,
+	}
+
+	for {
+		select {
+		case <-expired:
+			return actionerror.DeviceCodeExpiredError{}
+		case <-actor.Clock.After(interval):
+		}
+
+		err := actor.Authenticate(credentials, "", constant.GrantTypeDeviceCode)
+		switch err.(type) {
+		case nil:
+			return nil
+		case uaa.AuthorizationPendingError:
+		case uaa.SlowDownError:
+			interval += deviceCodeSlowDownIncrement
+		case uaa.ExpiredTokenError:
+			return actionerror.DeviceCodeExpiredError{}
+		case uaa.AccessDeniedError:
+			return actionerror.DeviceAuthorizationDeniedError{}
+		default:
+			return err
+		}
+	}
+}
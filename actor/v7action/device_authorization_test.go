@@ -0,0 +1,171 @@
+package v7action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Device Authorization Actions", func() {
+	var (
+		actor         *Actor
+		fakeUAAClient *v7actionfakes.FakeUAAClient
+		fakeConfig    *v7actionfakes.FakeConfig
+		fakeClock     *fakeclock.FakeClock
+	)
+
+	BeforeEach(func() {
+		actor, _, fakeConfig, _, fakeUAAClient, _, fakeClock = NewTestActor()
+	})
+
+	Describe("GetDeviceAuthorization", func() {
+		It("returns the device authorization from the UAA", func() {
+			fakeUAAClient.RequestDeviceAuthorizationReturns(uaa.DeviceAuthorization{
+				DeviceCode: "some-device-code",
+				UserCode:   "WDJB-MJHT",
+			}, nil)
+
+			authorization, err := actor.GetDeviceAuthorization()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(authorization).To(Equal(DeviceAuthorization{
+				DeviceCode: "some-device-code",
+				UserCode:   "WDJB-MJHT",
+			}))
+		})
+
+		It("returns errors from the UAA", func() {
+			fakeUAAClient.RequestDeviceAuthorizationReturns(uaa.DeviceAuthorization{}, errors.New("some-error"))
+
+			_, err := actor.GetDeviceAuthorization()
+			Expect(err).To(MatchError("some-error"))
+		})
+	})
+
+	Describe("AuthenticateWithDeviceCode", func() {
+		var (
+			authorization DeviceAuthorization
+			errs          chan error
+		)
+
+		BeforeEach(func() {
+			authorization = DeviceAuthorization{
+				DeviceCode: "some-device-code",
+				ExpiresIn:  60,
+				Interval:   2,
+			}
+			errs = make(chan error, 1)
+		})
+
+		JustBeforeEach(func() {
+			go func() {
+				errs <- actor.AuthenticateWithDeviceCode(authorization)
+			}()
+		})
+
+		// advance waits for the actor to start waiting on both the expiry and
+		// the polling interval, then moves the clock forward.
+		advance := func(duration time.Duration) {
+			Eventually(fakeClock.WatcherCount).Should(Equal(2))
+			fakeClock.Increment(duration)
+		}
+
+		When("the user approves the login", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateReturnsOnCall(0, "", "", uaa.AuthorizationPendingError{})
+				fakeUAAClient.AuthenticateReturnsOnCall(1, "some-access-token", "some-refresh-token", nil)
+			})
+
+			It("polls at the given interval until it gets a token", func() {
+				advance(time.Second)
+				Consistently(fakeUAAClient.AuthenticateCallCount).Should(Equal(0))
+				fakeClock.Increment(time.Second)
+				Eventually(fakeUAAClient.AuthenticateCallCount).Should(Equal(1))
+
+				advance(2 * time.Second)
+				Eventually(errs).Should(Receive(BeNil()))
+
+				credentials, origin, grantType := fakeUAAClient.AuthenticateArgsForCall(1)
+				Expect(credentials).To(Equal(map[string]string{"device_code": "some-device-code"}))
+				Expect(origin).To(BeEmpty())
+				Expect(grantType).To(Equal(constant.GrantTypeDeviceCode))
+
+				accessToken, refreshToken, _ := fakeConfig.SetTokenInformationArgsForCall(fakeConfig.SetTokenInformationCallCount() - 1)
+				Expect(accessToken).To(Equal("bearer some-access-token"))
+				Expect(refreshToken).To(Equal("some-refresh-token"))
+			})
+		})
+
+		When("the UAA asks the CLI to slow down", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateReturnsOnCall(0, "", "", uaa.SlowDownError{})
+				fakeUAAClient.AuthenticateReturnsOnCall(1, "some-access-token", "some-refresh-token", nil)
+			})
+
+			It("increases the polling interval", func() {
+				advance(2 * time.Second)
+				Eventually(fakeUAAClient.AuthenticateCallCount).Should(Equal(1))
+
+				advance(2 * time.Second)
+				Consistently(fakeUAAClient.AuthenticateCallCount).Should(Equal(1))
+				fakeClock.Increment(5 * time.Second)
+				Eventually(errs).Should(Receive(BeNil()))
+				Expect(fakeUAAClient.AuthenticateCallCount()).To(Equal(2))
+			})
+		})
+
+		When("the device code expires", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateReturns("", "", uaa.AuthorizationPendingError{})
+				authorization.Interval = 30
+			})
+
+			It("returns a DeviceCodeExpiredError", func() {
+				advance(30 * time.Second)
+				Eventually(fakeUAAClient.AuthenticateCallCount).Should(Equal(1))
+				advance(30 * time.Second)
+				Eventually(errs).Should(Receive(MatchError(actionerror.DeviceCodeExpiredError{})))
+			})
+		})
+
+		When("the UAA reports the device code expired", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateReturns("", "", uaa.ExpiredTokenError{})
+			})
+
+			It("returns a DeviceCodeExpiredError", func() {
+				advance(2 * time.Second)
+				Eventually(errs).Should(Receive(MatchError(actionerror.DeviceCodeExpiredError{})))
+			})
+		})
+
+		When("the user denies the login", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateReturns("", "", uaa.AccessDeniedError{})
+			})
+
+			It("returns a DeviceAuthorizationDeniedError", func() {
+				advance(2 * time.Second)
+				Eventually(errs).Should(Receive(MatchError(actionerror.DeviceAuthorizationDeniedError{})))
+			})
+		})
+
+		When("any other error occurs", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateReturns("", "", errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				advance(2 * time.Second)
+				Eventually(errs).Should(Receive(MatchError("some-error")))
+			})
+		})
+	})
+})
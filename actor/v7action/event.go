@@ -10,46 +10,155 @@ import (
 	"code.cloudfoundry.org/cli/util/generic"
 )
 
+// recentEventsLimit is the number of events returned when not fetching the
+// complete history. It matches the Cloud Controller's default page size.
+const recentEventsLimit = 50
+
 type Event struct {
-	GUID        string
-	Time        time.Time
-	Type        string
-	ActorName   string
-	Description string
+	GUID             string
+	Time             time.Time
+	Type             string
+	ActorGUID        string
+	ActorType        string
+	ActorName        string
+	TargetGUID       string
+	TargetType       string
+	TargetName       string
+	SpaceGUID        string
+	OrganizationGUID string
+	Description      string
+	Data             map[string]interface{}
+}
+
+// EventQuery selects the audit events returned by GetEvents. Empty fields do
+// not restrict the results.
+type EventQuery struct {
+	TargetGUIDs       []string
+	SpaceGUIDs        []string
+	OrganizationGUIDs []string
+	Types             []string
+
+	// Actor only matches events caused by the user or client with this name
+	// or GUID. The Cloud Controller cannot filter on it, so it is applied to
+	// the events that were fetched, paging until enough events match.
+	Actor string
+
+	// Since is the inclusive lower bound on the creation time of the events.
+	Since time.Time
+	// Until is the exclusive upper bound on the creation time of the events.
+	Until time.Time
+
+	// AllPages fetches the complete history instead of only the first page of
+	// the most recent events.
+	AllPages bool
 }
 
-func (actor Actor) GetRecentEventsByApplicationNameAndSpace(appName string, spaceGUID string) ([]Event, Warnings, error) {
-	var allWarnings Warnings
+// GetEvents returns the audit events matching the query, most recent first.
+func (actor Actor) GetEvents(query EventQuery) ([]Event, Warnings, error) {
+	queries := []ccv3.Query{
+		{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+	}
+	if len(query.TargetGUIDs) > 0 {
+		queries = append(queries, ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: query.TargetGUIDs})
+	}
+	if len(query.SpaceGUIDs) > 0 {
+		queries = append(queries, ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: query.SpaceGUIDs})
+	}
+	if len(query.OrganizationGUIDs) > 0 {
+		queries = append(queries, ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: query.OrganizationGUIDs})
+	}
+	if len(query.Types) > 0 {
+		queries = append(queries, ccv3.Query{Key: ccv3.EventTypesFilter, Values: query.Types})
+	}
+	if !query.Since.IsZero() {
+		queries = append(queries, ccv3.Query{Key: ccv3.CreatedAtsGreaterThanOrEqualFilter, Values: []string{query.Since.UTC().Format(time.RFC3339)}})
+	}
+	if !query.Until.IsZero() {
+		queries = append(queries, ccv3.Query{Key: ccv3.CreatedAtsLessThanFilter, Values: []string{query.Until.UTC().Format(time.RFC3339)}})
+	}
 
-	app, appWarnings, appErr := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
-	allWarnings = append(allWarnings, appWarnings...)
-	if appErr != nil {
-		return nil, allWarnings, appErr
+	if query.Actor != "" && !query.AllPages {
+		return actor.getRecentEventsByActor(queries, query.Actor)
 	}
 
-	ccEvents, warnings, err := actor.CloudControllerClient.GetEvents(
-		ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: []string{app.GUID}},
-		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
-	)
-	allWarnings = append(allWarnings, warnings...)
+	getEvents := actor.CloudControllerClient.GetEvents
+	if query.AllPages {
+		getEvents = actor.CloudControllerClient.GetAllEvents
+	}
 
+	ccEvents, warnings, err := getEvents(queries...)
 	if err != nil {
-		return nil, allWarnings, err
+		return nil, Warnings(warnings), err
 	}
 
 	var events []Event
 	for _, ccEvent := range ccEvents {
-		events = append(events, Event{
-			GUID:        ccEvent.GUID,
-			Time:        ccEvent.CreatedAt,
-			Type:        ccEvent.Type,
-			ActorName:   ccEvent.ActorName,
-			Description: generateDescription(ccEvent.Data),
-		})
+		if query.Actor != "" && !causedBy(ccEvent, query.Actor) {
+			continue
+		}
+		events = append(events, newEvent(ccEvent))
+	}
+
+	return events, Warnings(warnings), nil
+}
+
+// getRecentEventsByActor fetches pages of the events matching queries until
+// recentEventsLimit of them were caused by actorName or there are no more
+// events.
+func (actor Actor) getRecentEventsByActor(queries []ccv3.Query, actorName string) ([]Event, Warnings, error) {
+	var (
+		allWarnings Warnings
+		events      []Event
+	)
+
+	for page := 1; ; page++ {
+		pageQueries := append([]ccv3.Query{
+			{Key: ccv3.PerPage, Values: []string{strconv.Itoa(recentEventsLimit)}},
+			{Key: ccv3.Page, Values: []string{strconv.Itoa(page)}},
+		}, queries...)
+
+		ccEvents, warnings, err := actor.CloudControllerClient.GetEvents(pageQueries...)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
 
+		for _, ccEvent := range ccEvents {
+			if !causedBy(ccEvent, actorName) {
+				continue
+			}
+			events = append(events, newEvent(ccEvent))
+			if len(events) == recentEventsLimit {
+				return events, allWarnings, nil
+			}
+		}
+
+		if len(ccEvents) < recentEventsLimit {
+			return events, allWarnings, nil
+		}
 	}
+}
 
-	return events, allWarnings, nil
+func causedBy(ccEvent ccv3.Event, actorName string) bool {
+	return ccEvent.ActorName == actorName || ccEvent.ActorGUID == actorName
+}
+
+func newEvent(ccEvent ccv3.Event) Event {
+	return Event{
+		GUID:             ccEvent.GUID,
+		Time:             ccEvent.CreatedAt,
+		Type:             ccEvent.Type,
+		ActorGUID:        ccEvent.ActorGUID,
+		ActorType:        ccEvent.ActorType,
+		ActorName:        ccEvent.ActorName,
+		TargetGUID:       ccEvent.TargetGUID,
+		TargetType:       ccEvent.TargetType,
+		TargetName:       ccEvent.TargetName,
+		SpaceGUID:        ccEvent.SpaceGUID,
+		OrganizationGUID: ccEvent.OrganizationGUID,
+		Description:      generateDescription(ccEvent.Data),
+		Data:             ccEvent.Data,
+	}
 }
 
 var knownMetadataKeys = []string{
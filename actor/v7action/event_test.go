@@ -2,11 +2,12 @@ package v7action_test
 
 import (
 	"errors"
+	"strconv"
+	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
-	"code.cloudfoundry.org/cli/resources"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -21,105 +22,156 @@ var _ = Describe("Event Actions", func() {
 		actor, fakeCloudControllerClient, _, _, _, _, _ = NewTestActor()
 	})
 
-	Describe("GetRecentEventsForApp", func() {
+	Describe("GetEvents", func() {
 		var (
-			appName   string
-			spaceGUID string
-			events    []Event
-			warnings  Warnings
-			err       error
+			query    EventQuery
+			events   []Event
+			warnings Warnings
+			err      error
 		)
 
 		BeforeEach(func() {
-			appName = "some-app-name"
-			spaceGUID = "some-space-guid"
+			query = EventQuery{}
+
+			ccEvents := []ccv3.Event{
+				{GUID: "event-1", Type: "audit.app.update", ActorGUID: "user-guid-1", ActorName: "alice", TargetGUID: "app-guid"},
+				{GUID: "event-2", Type: "audit.app.update", ActorGUID: "user-guid-2", ActorName: "bob", TargetGUID: "app-guid"},
+			}
+			fakeCloudControllerClient.GetEventsReturns(ccEvents, ccv3.Warnings{"first-page-warning"}, nil)
+			fakeCloudControllerClient.GetAllEventsReturns(ccEvents, ccv3.Warnings{"all-pages-warning"}, nil)
 		})
 
 		JustBeforeEach(func() {
-			events, warnings, err = actor.GetRecentEventsByApplicationNameAndSpace(appName, spaceGUID)
+			events, warnings, err = actor.GetEvents(query)
 		})
 
-		When("getting the app succeeds", func() {
+		It("fetches the most recent page of events", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("first-page-warning"))
+			Expect(events).To(HaveLen(2))
+			Expect(events[0]).To(Equal(Event{GUID: "event-1", Type: "audit.app.update", ActorGUID: "user-guid-1", ActorName: "alice", TargetGUID: "app-guid"}))
+
+			Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+			))
+			Expect(fakeCloudControllerClient.GetAllEventsCallCount()).To(Equal(0))
+		})
+
+		When("every filter is set", func() {
 			BeforeEach(func() {
-				apps := []resources.Application{
-					{GUID: "some-app-guid"},
+				query = EventQuery{
+					TargetGUIDs:       []string{"app-guid"},
+					SpaceGUIDs:        []string{"space-guid"},
+					OrganizationGUIDs: []string{"org-guid"},
+					Types:             []string{"audit.app.update", "audit.app.restage"},
+					Since:             time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC),
+					Until:             time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC),
 				}
+			})
 
-				ccWarnings := ccv3.Warnings{
-					"some-app-warnings",
-				}
+			It("passes them to the cloud controller", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+					ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: []string{"app-guid"}},
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-guid"}},
+					ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"org-guid"}},
+					ccv3.Query{Key: ccv3.EventTypesFilter, Values: []string{"audit.app.update", "audit.app.restage"}},
+					ccv3.Query{Key: ccv3.CreatedAtsGreaterThanOrEqualFilter, Values: []string{"2021-01-02T03:04:05Z"}},
+					ccv3.Query{Key: ccv3.CreatedAtsLessThanFilter, Values: []string{"2021-02-03T04:05:06Z"}},
+				))
+			})
+		})
+
+		When("filtering by actor", func() {
+			BeforeEach(func() {
+				query.Actor = "user-guid-2"
+			})
 
-				fakeCloudControllerClient.GetApplicationsReturns(
-					apps,
-					ccWarnings,
-					nil,
-				)
+			It("only returns events caused by that actor", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(events).To(HaveLen(1))
+				Expect(events[0].ActorName).To(Equal("bob"))
 			})
 
-			When("the cc client returns the list of events", func() {
+			When("the first page has fewer matching events than the limit", func() {
 				BeforeEach(func() {
-					ccEvents := []ccv3.Event{
-						{GUID: "event-1", Type: "audit.app.wow", Data: map[string]interface{}{"index": "17"}},
-						{GUID: "event-2", Type: "audit.app.cool", Data: map[string]interface{}{"unimportant_key": "23"}},
+					fullPage := make([]ccv3.Event, 50)
+					for i := range fullPage {
+						actorName := "alice"
+						if i%10 == 0 {
+							actorName = "bob"
+						}
+						fullPage[i] = ccv3.Event{GUID: "event-guid", ActorName: actorName}
 					}
+					lastPage := []ccv3.Event{{GUID: "last-event-guid", ActorName: "bob"}}
 
-					ccWarnings := ccv3.Warnings{
-						"some-event-warnings",
-					}
+					fakeCloudControllerClient.GetEventsReturnsOnCall(0, fullPage, ccv3.Warnings{"page-1-warning"}, nil)
+					fakeCloudControllerClient.GetEventsReturnsOnCall(1, fullPage, ccv3.Warnings{"page-2-warning"}, nil)
+					fakeCloudControllerClient.GetEventsReturnsOnCall(2, lastPage, ccv3.Warnings{"page-3-warning"}, nil)
 
-					fakeCloudControllerClient.GetEventsReturns(
-						ccEvents,
-						ccWarnings,
-						nil,
-					)
+					query.Actor = "bob"
 				})
 
-				It("returns the events and warnings", func() {
-					Expect(events).To(Equal([]Event{
-						{GUID: "event-1", Type: "audit.app.wow", Description: "index: 17"},
-						{GUID: "event-2", Type: "audit.app.cool", Description: ""},
-					}))
-					Expect(warnings).To(ConsistOf("some-app-warnings", "some-event-warnings"))
+				It("keeps fetching pages until there are no more events", func() {
 					Expect(err).NotTo(HaveOccurred())
+					Expect(warnings).To(Equal(Warnings{"page-1-warning", "page-2-warning", "page-3-warning"}))
+					Expect(events).To(HaveLen(11))
+					Expect(events[10].GUID).To(Equal("last-event-guid"))
+
+					Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(3))
+					for i := 0; i < 3; i++ {
+						Expect(fakeCloudControllerClient.GetEventsArgsForCall(i)).To(ConsistOf(
+							ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+							ccv3.Query{Key: ccv3.PerPage, Values: []string{"50"}},
+							ccv3.Query{Key: ccv3.Page, Values: []string{strconv.Itoa(i + 1)}},
+						))
+					}
 				})
 			})
 
-			When("the cc client returns an error", func() {
+			When("the matching events fill the limit", func() {
 				BeforeEach(func() {
-					ccWarnings := ccv3.Warnings{
-						"some-event-warnings",
+					fullPage := make([]ccv3.Event, 50)
+					for i := range fullPage {
+						fullPage[i] = ccv3.Event{ActorName: "bob"}
 					}
+					fakeCloudControllerClient.GetEventsReturns(fullPage, nil, nil)
 
-					fakeCloudControllerClient.GetEventsReturns(
-						nil,
-						ccWarnings,
-						errors.New("failed to get events"),
-					)
+					query.Actor = "bob"
 				})
 
-				It("returns the err and warnings", func() {
-					Expect(warnings).To(ConsistOf("some-app-warnings", "some-event-warnings"))
-					Expect(err).To(MatchError("failed to get events"))
+				It("stops after the page that filled it", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(events).To(HaveLen(50))
+					Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(1))
 				})
 			})
 		})
 
-		When("getting the app fails", func() {
+		When("fetching all pages", func() {
 			BeforeEach(func() {
-				ccWarnings := ccv3.Warnings{
-					"some-app-warnings",
-				}
+				query.AllPages = true
+			})
 
-				fakeCloudControllerClient.GetApplicationsReturns(
-					nil,
-					ccWarnings,
-					errors.New("failed to get app"),
-				)
+			It("follows pagination", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("all-pages-warning"))
+				Expect(events).To(HaveLen(2))
+				Expect(fakeCloudControllerClient.GetAllEventsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEventsReturns(nil, ccv3.Warnings{"first-page-warning"}, errors.New("failed to get events"))
 			})
 
-			It("returns the err and warnings", func() {
-				Expect(warnings).To(ConsistOf("some-app-warnings"))
-				Expect(err).To(MatchError("failed to get app"))
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("failed to get events"))
+				Expect(warnings).To(ConsistOf("first-page-warning"))
 			})
 		})
 	})
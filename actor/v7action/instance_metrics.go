@@ -0,0 +1,87 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// InstanceMetrics is a snapshot of the resource usage of a single process
+// instance of an app.
+type InstanceMetrics struct {
+	AppName string
+	ProcessInstance
+}
+
+// MemoryUsageRatio returns the fraction of its memory quota the instance is
+// using, or 0 when the quota is unknown.
+func (metrics InstanceMetrics) MemoryUsageRatio() float64 {
+	if metrics.MemoryQuota == 0 {
+		return 0
+	}
+	return float64(metrics.MemoryUsage) / float64(metrics.MemoryQuota)
+}
+
+// DiskUsageRatio returns the fraction of its disk quota the instance is
+// using, or 0 when the quota is unknown.
+func (metrics InstanceMetrics) DiskUsageRatio() float64 {
+	if metrics.DiskQuota == 0 {
+		return 0
+	}
+	return float64(metrics.DiskUsage) / float64(metrics.DiskQuota)
+}
+
+// GetInstanceMetrics returns the current usage of every process instance of
+// the named app. When appName is empty, it returns the instances of every
+// started app in the space instead.
+func (actor Actor) GetInstanceMetrics(appName string, spaceGUID string) ([]InstanceMetrics, Warnings, error) {
+	var (
+		apps        []resources.Application
+		allWarnings Warnings
+	)
+
+	if appName == "" {
+		spaceApps, warnings, err := actor.GetApplicationsBySpace(spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		for _, app := range spaceApps {
+			if app.State == constant.ApplicationStarted {
+				apps = append(apps, app)
+			}
+		}
+	} else {
+		app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		apps = append(apps, app)
+	}
+
+	var allMetrics []InstanceMetrics
+	for _, app := range apps {
+		processes, warnings, err := actor.CloudControllerClient.GetApplicationProcesses(app.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		for _, process := range processes {
+			instances, warnings, err := actor.CloudControllerClient.GetProcessInstances(process.GUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+
+			for _, instance := range instances {
+				allMetrics = append(allMetrics, InstanceMetrics{
+					AppName:         app.Name,
+					ProcessInstance: ProcessInstance(instance),
+				})
+			}
+		}
+	}
+
+	return allMetrics, allWarnings, nil
+}
@@ -0,0 +1,139 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("instance metrics actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("InstanceMetrics", func() {
+		It("computes usage ratios against the quotas", func() {
+			metrics := InstanceMetrics{ProcessInstance: ProcessInstance{
+				MemoryUsage: 96, MemoryQuota: 128,
+				DiskUsage: 10, DiskQuota: 40,
+			}}
+			Expect(metrics.MemoryUsageRatio()).To(Equal(0.75))
+			Expect(metrics.DiskUsageRatio()).To(Equal(0.25))
+		})
+
+		It("returns zero when the quotas are unknown", func() {
+			metrics := InstanceMetrics{ProcessInstance: ProcessInstance{MemoryUsage: 96, DiskUsage: 10}}
+			Expect(metrics.MemoryUsageRatio()).To(BeZero())
+			Expect(metrics.DiskUsageRatio()).To(BeZero())
+		})
+	})
+
+	Describe("GetInstanceMetrics", func() {
+		var (
+			appName  string
+			metrics  []InstanceMetrics
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			appName = "some-app"
+
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{
+					{Name: "some-app", GUID: "app-guid-1", State: constant.ApplicationStarted},
+					{Name: "stopped-app", GUID: "app-guid-2", State: constant.ApplicationStopped},
+				},
+				ccv3.Warnings{"get-apps-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationProcessesReturns(
+				[]resources.Process{{GUID: "process-guid-web", Type: "web"}, {GUID: "process-guid-worker", Type: "worker"}},
+				ccv3.Warnings{"get-processes-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetProcessInstancesStub = func(processGUID string) ([]ccv3.ProcessInstance, ccv3.Warnings, error) {
+				if processGUID == "process-guid-web" {
+					return []ccv3.ProcessInstance{
+						{Index: 0, Type: "web", CPU: 0.5},
+						{Index: 1, Type: "web", CPU: 0.1},
+					}, ccv3.Warnings{"get-instances-warning"}, nil
+				}
+				return []ccv3.ProcessInstance{{Index: 0, Type: "worker"}}, nil, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			metrics, warnings, err = actor.GetInstanceMetrics(appName, "some-space-guid")
+		})
+
+		When("an app name is given", func() {
+			It("returns the instances of every process of the app", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-apps-warning", "get-processes-warning", "get-instances-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-app"}},
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+				))
+				Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(0)).To(Equal("app-guid-1"))
+
+				Expect(metrics).To(Equal([]InstanceMetrics{
+					{AppName: "some-app", ProcessInstance: ProcessInstance{Index: 0, Type: "web", CPU: 0.5}},
+					{AppName: "some-app", ProcessInstance: ProcessInstance{Index: 1, Type: "web", CPU: 0.1}},
+					{AppName: "some-app", ProcessInstance: ProcessInstance{Index: 0, Type: "worker"}},
+				}))
+			})
+
+			When("the app does not exist", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-apps-warning"}, nil)
+				})
+
+				It("returns an ApplicationNotFoundError", func() {
+					Expect(err).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+					Expect(warnings).To(ConsistOf("get-apps-warning"))
+				})
+			})
+		})
+
+		When("no app name is given", func() {
+			BeforeEach(func() {
+				appName = ""
+			})
+
+			It("returns the instances of the started apps in the space", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetApplicationProcessesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(0)).To(Equal("app-guid-1"))
+				Expect(metrics).To(HaveLen(3))
+			})
+		})
+
+		When("getting the process instances fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesStub = nil
+				fakeCloudControllerClient.GetProcessInstancesReturns(nil, ccv3.Warnings{"get-instances-warning"}, errors.New("stats-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError("stats-error"))
+				Expect(warnings).To(ConsistOf("get-apps-warning", "get-processes-warning", "get-instances-warning"))
+			})
+		})
+	})
+})
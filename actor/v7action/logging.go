@@ -11,23 +11,35 @@ import (
 )
 
 func (actor Actor) GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, Warnings, error) {
+	return actor.GetFilteredStreamingLogsForApplicationByNameAndSpace(appName, spaceGUID, client, sharedaction.LogFilter{})
+}
+
+// GetFilteredStreamingLogsForApplicationByNameAndSpace tails the logs of the
+// application that match the filter.
+func (actor Actor) GetFilteredStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient, filter sharedaction.LogFilter) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, Warnings, error) {
 	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
 	if err != nil {
 		return nil, nil, nil, allWarnings, err
 	}
 
-	messages, logErrs, cancelFunc := sharedaction.GetStreamingLogs(app.GUID, client)
+	messages, logErrs, cancelFunc := sharedaction.GetStreamingLogsWithFilter(app.GUID, client, filter)
 
 	return messages, logErrs, cancelFunc, allWarnings, err
 }
 
 func (actor Actor) GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient) ([]sharedaction.LogMessage, Warnings, error) {
+	return actor.GetFilteredRecentLogsForApplicationByNameAndSpace(appName, spaceGUID, client, sharedaction.LogFilter{})
+}
+
+// GetFilteredRecentLogsForApplicationByNameAndSpace returns the recent logs
+// of the application that match the filter.
+func (actor Actor) GetFilteredRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient, filter sharedaction.LogFilter) ([]sharedaction.LogMessage, Warnings, error) {
 	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
 	if err != nil {
 		return nil, allWarnings, err
 	}
 
-	logCacheMessages, err := sharedaction.GetRecentLogs(app.GUID, client)
+	logCacheMessages, err := sharedaction.GetRecentLogsWithFilter(app.GUID, client, filter)
 	if err != nil {
 		return nil, allWarnings, err
 	}
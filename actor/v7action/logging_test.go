@@ -137,6 +137,53 @@ var _ = Describe("Logging Actions", func() {
 		})
 	})
 
+	Describe("GetFilteredRecentLogsForApplicationByNameAndSpace", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{{Name: "some-app", GUID: "some-app-guid"}},
+				ccv3.Warnings{"some-app-warnings"},
+				nil,
+			)
+
+			fakeLogCacheClient.ReadReturns([]*loggregator_v2.Envelope{
+				{
+					Timestamp:  int64(20),
+					InstanceId: "0",
+					Message: &loggregator_v2.Envelope_Log{
+						Log: &loggregator_v2.Log{Payload: []byte("router-message")},
+					},
+					Tags: map[string]string{"source_type": "RTR"},
+				},
+				{
+					Timestamp:  int64(10),
+					InstanceId: "0",
+					Message: &loggregator_v2.Envelope_Log{
+						Log: &loggregator_v2.Log{Payload: []byte("app-message")},
+					},
+					Tags: map[string]string{"source_type": "APP/PROC/WEB"},
+				},
+			}, nil)
+		})
+
+		It("reads the logs of the application from the start of the filter and filters them", func() {
+			messages, warnings, err := actor.GetFilteredRecentLogsForApplicationByNameAndSpace(
+				"some-app",
+				"some-space-guid",
+				fakeLogCacheClient,
+				sharedaction.LogFilter{Since: time.Unix(0, 5), SourceTypes: []string{"APP"}},
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("some-app-warnings"))
+
+			Expect(messages).To(HaveLen(1))
+			Expect(messages[0].Message()).To(Equal("app-message"))
+
+			_, sourceID, start, _ := fakeLogCacheClient.ReadArgsForCall(0)
+			Expect(sourceID).To(Equal("some-app-guid"))
+			Expect(start).To(Equal(time.Unix(0, 5)))
+		})
+	})
+
 	Describe("GetStreamingLogsForApplicationByNameAndSpace", func() {
 		When("the application can be found", func() {
 			var (
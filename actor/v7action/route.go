@@ -59,8 +59,9 @@ func (actor Actor) GetRouteDestinations(routeGUID string) ([]resources.RouteDest
 	var actorDestinations []resources.RouteDestination
 	for _, dst := range destinations {
 		actorDestinations = append(actorDestinations, resources.RouteDestination{
-			GUID: dst.GUID,
-			App:  resources.RouteDestinationApp(dst.App),
+			GUID:   dst.GUID,
+			App:    resources.RouteDestinationApp(dst.App),
+			Weight: dst.Weight,
 		})
 	}
 
@@ -402,6 +403,139 @@ func (actor Actor) UpdateDestination(routeGUID string, destinationGUID string, p
 	warnings, err := actor.CloudControllerClient.UpdateDestination(routeGUID, destinationGUID, protocol)
 	return Warnings(warnings), err
 }
+
+// SetRouteDestinationWeights sets the percentage of the route's traffic sent
+// to each app in weights, which is keyed by app GUID. Apps that are not yet
+// destinations of the route are mapped to it with destinationProtocol. The
+// rest of the traffic is divided between the route's other destinations in
+// proportion to their current weights, or equally when they have none.
+func (actor Actor) SetRouteDestinationWeights(route resources.Route, weights map[string]int, destinationProtocol string) (Warnings, error) {
+	total := 0
+	for _, weight := range weights {
+		if weight < 1 || weight > 100 {
+			return nil, actionerror.RouteWeightOutOfRangeError{Weight: weight}
+		}
+		total += weight
+	}
+
+	var weighted, others []resources.RouteDestination
+	mapped := map[string]bool{}
+	for _, destination := range route.Destinations {
+		weight, ok := weights[destination.App.GUID]
+		if ok && destination.App.Process.Type == constant.ProcessTypeWeb {
+			destination.Weight = &weight
+			weighted = append(weighted, destination)
+			mapped[destination.App.GUID] = true
+		} else {
+			others = append(others, destination)
+		}
+	}
+
+	appGUIDs := make([]string, 0, len(weights))
+	for appGUID := range weights {
+		appGUIDs = append(appGUIDs, appGUID)
+	}
+	sort.Strings(appGUIDs)
+	for _, appGUID := range appGUIDs {
+		if mapped[appGUID] {
+			continue
+		}
+		weight := weights[appGUID]
+		destination := resources.RouteDestination{Protocol: destinationProtocol, Weight: &weight}
+		destination.App.GUID = appGUID
+		weighted = append(weighted, destination)
+	}
+
+	remaining := 100 - total
+	if remaining < len(others) || (len(others) == 0 && remaining != 0) {
+		return nil, actionerror.RouteWeightsUnbalancedError{RouteURL: route.URL, OtherDestinations: len(others)}
+	}
+
+	destinations := append(weighted, distributeRouteWeight(others, remaining)...)
+	warnings, err := actor.CloudControllerClient.ReplaceRouteDestinations(route.GUID, destinations)
+	return Warnings(warnings), err
+}
+
+// SetRouteDestinationWeightsByAppName looks up the route and the apps named
+// in weights in the space and sets their weights like
+// SetRouteDestinationWeights.
+func (actor Actor) SetRouteDestinationWeightsByAppName(routePath string, spaceGUID string, weights map[string]int) (Warnings, error) {
+	route, allWarnings, err := actor.GetRoute(routePath, spaceGUID)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	appNames := make([]string, 0, len(weights))
+	for appName := range weights {
+		appNames = append(appNames, appName)
+	}
+	sort.Strings(appNames)
+
+	apps, warnings, err := actor.GetApplicationsByNamesAndSpace(appNames, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	weightsByGUID := make(map[string]int, len(apps))
+	for _, app := range apps {
+		weightsByGUID[app.GUID] = weights[app.Name]
+	}
+
+	warnings, err = actor.SetRouteDestinationWeights(route, weightsByGUID, "")
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+// distributeRouteWeight divides total between the destinations in proportion
+// to their weights, treating destinations without a weight as equal. Every
+// destination gets at least 1; the caller ensures total allows for that.
+func distributeRouteWeight(destinations []resources.RouteDestination, total int) []resources.RouteDestination {
+	if len(destinations) == 0 {
+		return nil
+	}
+
+	shares := make([]int, len(destinations))
+	sum := 0
+	for i, destination := range destinations {
+		shares[i] = 1
+		if destination.Weight != nil {
+			shares[i] = *destination.Weight
+		}
+		sum += shares[i]
+	}
+
+	spare := total - len(destinations)
+	weights := make([]int, len(destinations))
+	remainders := make([]int, len(destinations))
+	assigned := 0
+	for i := range destinations {
+		weights[i] = 1 + spare*shares[i]/sum
+		remainders[i] = spare * shares[i] % sum
+		assigned += weights[i]
+	}
+
+	order := make([]int, len(destinations))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]] > remainders[order[b]]
+	})
+	for i := 0; assigned < total; i++ {
+		weights[order[i%len(order)]]++
+		assigned++
+	}
+
+	distributed := make([]resources.RouteDestination, len(destinations))
+	for i, destination := range destinations {
+		weight := weights[i]
+		destination.Weight = &weight
+		distributed[i] = destination
+	}
+	return distributed
+}
+
 func (actor Actor) UnmapRoute(routeGUID string, destinationGUID string) (Warnings, error) {
 	warnings, err := actor.CloudControllerClient.UnmapRoute(routeGUID, destinationGUID)
 	return Warnings(warnings), err
@@ -1674,6 +1674,202 @@ var _ = Describe("Route Actions", func() {
 		})
 	})
 
+	Describe("SetRouteDestinationWeights", func() {
+		var (
+			route      resources.Route
+			weights    map[string]int
+			executeErr error
+			warnings   Warnings
+		)
+
+		weightOf := func(weight int) *int { return &weight }
+		webApp := func(guid string) resources.RouteDestinationApp {
+			app := resources.RouteDestinationApp{GUID: guid}
+			app.Process.Type = constant.ProcessTypeWeb
+			return app
+		}
+
+		BeforeEach(func() {
+			route = resources.Route{
+				GUID: "route-guid",
+				URL:  "myhost.example.com",
+				Destinations: []resources.RouteDestination{
+					{GUID: "destination-1-guid", App: webApp("app-1-guid"), Protocol: "http1"},
+				},
+			}
+			fakeCloudControllerClient.ReplaceRouteDestinationsReturns(ccv3.Warnings{"replace-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.SetRouteDestinationWeights(route, weights, "http2")
+		})
+
+		When("a new app is given a weight", func() {
+			BeforeEach(func() {
+				weights = map[string]int{"app-2-guid": 10}
+			})
+
+			It("maps the app and gives the rest of the traffic to the other destinations", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("replace-warning"))
+
+				Expect(fakeCloudControllerClient.ReplaceRouteDestinationsCallCount()).To(Equal(1))
+				routeGUID, destinations := fakeCloudControllerClient.ReplaceRouteDestinationsArgsForCall(0)
+				Expect(routeGUID).To(Equal("route-guid"))
+				Expect(destinations).To(Equal([]resources.RouteDestination{
+					{App: resources.RouteDestinationApp{GUID: "app-2-guid"}, Protocol: "http2", Weight: weightOf(10)},
+					{GUID: "destination-1-guid", App: webApp("app-1-guid"), Protocol: "http1", Weight: weightOf(90)},
+				}))
+			})
+		})
+
+		When("an existing destination is rebalanced", func() {
+			BeforeEach(func() {
+				route.Destinations = []resources.RouteDestination{
+					{GUID: "destination-1-guid", App: webApp("app-1-guid"), Weight: weightOf(60)},
+					{GUID: "destination-2-guid", App: webApp("app-2-guid"), Weight: weightOf(30)},
+					{GUID: "destination-3-guid", App: webApp("app-3-guid"), Weight: weightOf(10)},
+				}
+				weights = map[string]int{"app-3-guid": 50}
+			})
+
+			It("divides the rest of the traffic in proportion to the current weights", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				_, destinations := fakeCloudControllerClient.ReplaceRouteDestinationsArgsForCall(0)
+				Expect(destinations).To(Equal([]resources.RouteDestination{
+					{GUID: "destination-3-guid", App: webApp("app-3-guid"), Weight: weightOf(50)},
+					{GUID: "destination-1-guid", App: webApp("app-1-guid"), Weight: weightOf(33)},
+					{GUID: "destination-2-guid", App: webApp("app-2-guid"), Weight: weightOf(17)},
+				}))
+			})
+		})
+
+		When("the other destinations have no weights", func() {
+			BeforeEach(func() {
+				route.Destinations = append(route.Destinations,
+					resources.RouteDestination{GUID: "destination-2-guid", App: webApp("app-2-guid")},
+					resources.RouteDestination{GUID: "destination-3-guid", App: webApp("app-3-guid")},
+				)
+				weights = map[string]int{"app-4-guid": 20}
+			})
+
+			It("divides the rest of the traffic equally so that the weights add up to 100", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				_, destinations := fakeCloudControllerClient.ReplaceRouteDestinationsArgsForCall(0)
+				Expect(destinations).To(HaveLen(4))
+				Expect(*destinations[0].Weight).To(Equal(20))
+				Expect(*destinations[1].Weight).To(Equal(27))
+				Expect(*destinations[2].Weight).To(Equal(27))
+				Expect(*destinations[3].Weight).To(Equal(26))
+			})
+		})
+
+		When("a weight is out of range", func() {
+			BeforeEach(func() {
+				weights = map[string]int{"app-2-guid": 101}
+			})
+
+			It("returns a RouteWeightOutOfRangeError", func() {
+				Expect(executeErr).To(MatchError(actionerror.RouteWeightOutOfRangeError{Weight: 101}))
+				Expect(fakeCloudControllerClient.ReplaceRouteDestinationsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the weights leave nothing for the other destinations", func() {
+			BeforeEach(func() {
+				weights = map[string]int{"app-2-guid": 100}
+			})
+
+			It("returns a RouteWeightsUnbalancedError", func() {
+				Expect(executeErr).To(MatchError(actionerror.RouteWeightsUnbalancedError{RouteURL: "myhost.example.com", OtherDestinations: 1}))
+				Expect(fakeCloudControllerClient.ReplaceRouteDestinationsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the weights cover every destination but do not add up to 100", func() {
+			BeforeEach(func() {
+				weights = map[string]int{"app-1-guid": 40, "app-2-guid": 40}
+			})
+
+			It("returns a RouteWeightsUnbalancedError", func() {
+				Expect(executeErr).To(MatchError(actionerror.RouteWeightsUnbalancedError{RouteURL: "myhost.example.com", OtherDestinations: 0}))
+			})
+		})
+
+		When("the cloud controller client errors", func() {
+			BeforeEach(func() {
+				weights = map[string]int{"app-2-guid": 10}
+				fakeCloudControllerClient.ReplaceRouteDestinationsReturns(ccv3.Warnings{"replace-warning"}, errors.New("replace-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("replace-error"))
+				Expect(warnings).To(ConsistOf("replace-warning"))
+			})
+		})
+	})
+
+	Describe("SetRouteDestinationWeightsByAppName", func() {
+		var (
+			executeErr error
+			warnings   Warnings
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetDomainsReturns(
+				[]resources.Domain{{Name: "example.com", GUID: "domain-guid"}},
+				ccv3.Warnings{"get-domains-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetRoutesReturns(
+				[]resources.Route{{GUID: "route-guid", URL: "example.com"}},
+				ccv3.Warnings{"get-routes-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{{Name: "app-1", GUID: "app-1-guid"}, {Name: "app-2", GUID: "app-2-guid"}},
+				ccv3.Warnings{"get-apps-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.ReplaceRouteDestinationsReturns(ccv3.Warnings{"replace-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.SetRouteDestinationWeightsByAppName("example.com", "space-guid", map[string]int{"app-1": 90, "app-2": 10})
+		})
+
+		It("sets the weights of the named apps on the route", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-domains-warning", "get-routes-warning", "get-apps-warning", "replace-warning"))
+
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.NameFilter, Values: []string{"app-1", "app-2"}},
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-guid"}},
+			))
+
+			routeGUID, destinations := fakeCloudControllerClient.ReplaceRouteDestinationsArgsForCall(0)
+			Expect(routeGUID).To(Equal("route-guid"))
+			Expect(destinations).To(HaveLen(2))
+			Expect(destinations[0].App.GUID).To(Equal("app-1-guid"))
+			Expect(*destinations[0].Weight).To(Equal(90))
+			Expect(destinations[1].App.GUID).To(Equal("app-2-guid"))
+			Expect(*destinations[1].Weight).To(Equal(10))
+		})
+
+		When("the route does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv3.Warnings{"get-routes-warning"}, nil)
+			})
+
+			It("returns a RouteNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.RouteNotFoundError{DomainName: "example.com"}))
+				Expect(fakeCloudControllerClient.ReplaceRouteDestinationsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("DeleteOrphanedRoutes", func() {
 		var (
 			spaceGUID string
@@ -102,6 +102,36 @@ func (actor Actor) DeleteServiceAppBinding(params DeleteServiceAppBindingParams)
 	}
 }
 
+func (actor Actor) GetServiceAppBindingsForApp(appName, spaceGUID string) ([]resources.ServiceCredentialBinding, Warnings, error) {
+	var (
+		app      resources.Application
+		bindings []resources.ServiceCredentialBinding
+	)
+
+	warnings, err := railway.Sequentially(
+		func() (warnings ccv3.Warnings, err error) {
+			app, warnings, err = actor.CloudControllerClient.GetApplicationByNameAndSpace(appName, spaceGUID)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			bindings, warnings, err = actor.CloudControllerClient.GetServiceCredentialBindings(
+				ccv3.Query{Key: ccv3.TypeFilter, Values: []string{"app"}},
+				ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{app.GUID}},
+			)
+			return
+		},
+	)
+
+	switch err.(type) {
+	case nil:
+		return bindings, Warnings(warnings), nil
+	case ccerror.ApplicationNotFoundError:
+		return nil, Warnings(warnings), actionerror.ApplicationNotFoundError{Name: appName}
+	default:
+		return nil, Warnings(warnings), err
+	}
+}
+
 func (actor Actor) createServiceAppBinding(serviceInstanceGUID, appGUID, bindingName string, parameters types.OptionalObject) (ccv3.JobURL, ccv3.Warnings, error) {
 	jobURL, warnings, err := actor.CloudControllerClient.CreateServiceCredentialBinding(resources.ServiceCredentialBinding{
 		Type:                resources.AppBinding,
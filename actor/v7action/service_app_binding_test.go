@@ -491,4 +491,92 @@ var _ = Describe("Service App Binding Action", func() {
 			})
 		})
 	})
+
+	Describe("GetServiceAppBindingsForApp", func() {
+		const (
+			appName   = "fake-app-name"
+			appGUID   = "fake-app-guid"
+			spaceGUID = "fake-space-guid"
+		)
+
+		var (
+			bindings       []resources.ServiceCredentialBinding
+			warnings       Warnings
+			executionError error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationByNameAndSpaceReturns(
+				resources.Application{GUID: appGUID, Name: appName},
+				ccv3.Warnings{"get app warning"},
+				nil,
+			)
+
+			fakeCloudControllerClient.GetServiceCredentialBindingsReturns(
+				[]resources.ServiceCredentialBinding{
+					{GUID: "binding-guid-1", AppGUID: appGUID, ServiceInstanceGUID: "instance-guid-1"},
+					{GUID: "binding-guid-2", AppGUID: appGUID, ServiceInstanceGUID: "instance-guid-2"},
+				},
+				ccv3.Warnings{"get bindings warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			bindings, warnings, executionError = actor.GetServiceAppBindingsForApp(appName, spaceGUID)
+		})
+
+		It("returns the app bindings and warnings", func() {
+			Expect(executionError).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get app warning", "get bindings warning"))
+			Expect(bindings).To(Equal([]resources.ServiceCredentialBinding{
+				{GUID: "binding-guid-1", AppGUID: appGUID, ServiceInstanceGUID: "instance-guid-1"},
+				{GUID: "binding-guid-2", AppGUID: appGUID, ServiceInstanceGUID: "instance-guid-2"},
+			}))
+		})
+
+		It("makes the correct calls", func() {
+			Expect(fakeCloudControllerClient.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+			actualAppName, actualSpaceGUID := fakeCloudControllerClient.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(actualAppName).To(Equal(appName))
+			Expect(actualSpaceGUID).To(Equal(spaceGUID))
+
+			Expect(fakeCloudControllerClient.GetServiceCredentialBindingsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServiceCredentialBindingsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.TypeFilter, Values: []string{"app"}},
+				ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{appGUID}},
+			))
+		})
+
+		When("the app is not found", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationByNameAndSpaceReturns(
+					resources.Application{},
+					ccv3.Warnings{"get app warning"},
+					ccerror.ApplicationNotFoundError{Name: appName},
+				)
+			})
+
+			It("returns an ApplicationNotFoundError and warnings", func() {
+				Expect(warnings).To(ConsistOf("get app warning"))
+				Expect(executionError).To(MatchError(actionerror.ApplicationNotFoundError{Name: appName}))
+				Expect(fakeCloudControllerClient.GetServiceCredentialBindingsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("listing the bindings fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceCredentialBindingsReturns(
+					nil,
+					ccv3.Warnings{"get bindings warning"},
+					errors.New("boom"),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(warnings).To(ConsistOf("get app warning", "get bindings warning"))
+				Expect(executionError).To(MatchError("boom"))
+			})
+		})
+	})
 })
@@ -0,0 +1,183 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sidecar Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+
+		warnings   Warnings
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+
+		fakeCloudControllerClient.GetApplicationsReturns(
+			[]resources.Application{{Name: "some-app", GUID: "some-app-guid"}},
+			ccv3.Warnings{"get-app-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetApplicationSidecarsReturns(
+			[]resources.Sidecar{
+				{GUID: "sidecar-2-guid", Name: "sidecar-2"},
+				{GUID: "sidecar-1-guid", Name: "sidecar-1"},
+			},
+			ccv3.Warnings{"get-sidecars-warning"},
+			nil,
+		)
+	})
+
+	Describe("GetApplicationSidecarsByNameAndSpace", func() {
+		var sidecars []resources.Sidecar
+
+		JustBeforeEach(func() {
+			sidecars, warnings, executeErr = actor.GetApplicationSidecarsByNameAndSpace("some-app", "some-space-guid")
+		})
+
+		It("returns the app's sidecars sorted by name", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-app-warning", "get-sidecars-warning"))
+			Expect(sidecars).To(Equal([]resources.Sidecar{
+				{GUID: "sidecar-1-guid", Name: "sidecar-1"},
+				{GUID: "sidecar-2-guid", Name: "sidecar-2"},
+			}))
+			Expect(fakeCloudControllerClient.GetApplicationSidecarsArgsForCall(0)).To(Equal("some-app-guid"))
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(fakeCloudControllerClient.GetApplicationSidecarsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the sidecars fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationSidecarsReturns(nil, ccv3.Warnings{"get-sidecars-warning"}, errors.New("sidecars-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("sidecars-error"))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-sidecars-warning"))
+			})
+		})
+	})
+
+	Describe("CreateApplicationSidecar", func() {
+		var sidecar resources.Sidecar
+
+		BeforeEach(func() {
+			sidecar = resources.Sidecar{
+				Name:         "sidecar-3",
+				Command:      types.FilteredString{IsSet: true, Value: "run-it"},
+				ProcessTypes: []string{"web"},
+			}
+			fakeCloudControllerClient.CreateApplicationSidecarReturns(resources.Sidecar{GUID: "sidecar-3-guid"}, ccv3.Warnings{"create-warning"}, nil)
+		})
+
+		It("creates the sidecar for the app", func() {
+			createdSidecar, warnings, err := actor.CreateApplicationSidecar("some-app", "some-space-guid", sidecar)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-app-warning", "create-warning"))
+			Expect(createdSidecar.GUID).To(Equal("sidecar-3-guid"))
+
+			appGUID, actualSidecar := fakeCloudControllerClient.CreateApplicationSidecarArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(actualSidecar).To(Equal(sidecar))
+		})
+	})
+
+	Describe("UpdateApplicationSidecar", func() {
+		var sidecarName string
+
+		BeforeEach(func() {
+			sidecarName = "sidecar-2"
+			fakeCloudControllerClient.UpdateSidecarReturns(resources.Sidecar{GUID: "sidecar-2-guid"}, ccv3.Warnings{"update-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			_, warnings, executeErr = actor.UpdateApplicationSidecar("some-app", "some-space-guid", sidecarName, resources.Sidecar{
+				MemoryInMB: types.NullUint64{IsSet: true, Value: 32},
+			})
+		})
+
+		It("updates the sidecar with the given name", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-app-warning", "get-sidecars-warning", "update-warning"))
+			Expect(fakeCloudControllerClient.UpdateSidecarArgsForCall(0)).To(Equal(resources.Sidecar{
+				GUID:       "sidecar-2-guid",
+				MemoryInMB: types.NullUint64{IsSet: true, Value: 32},
+			}))
+		})
+
+		When("the app has no sidecar with the name", func() {
+			BeforeEach(func() {
+				sidecarName = "no-such-sidecar"
+			})
+
+			It("returns a SidecarNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.SidecarNotFoundError{Name: "no-such-sidecar", AppName: "some-app"}))
+				Expect(fakeCloudControllerClient.UpdateSidecarCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("DeleteApplicationSidecar", func() {
+		var sidecarName string
+
+		BeforeEach(func() {
+			sidecarName = "sidecar-1"
+			fakeCloudControllerClient.DeleteSidecarReturns(ccv3.Warnings{"delete-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.DeleteApplicationSidecar("some-app", "some-space-guid", sidecarName)
+		})
+
+		It("deletes the sidecar with the given name", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-app-warning", "get-sidecars-warning", "delete-warning"))
+			Expect(fakeCloudControllerClient.DeleteSidecarArgsForCall(0)).To(Equal("sidecar-1-guid"))
+		})
+
+		When("the app has no sidecar with the name", func() {
+			BeforeEach(func() {
+				sidecarName = "no-such-sidecar"
+			})
+
+			It("returns a SidecarNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.SidecarNotFoundError{Name: "no-such-sidecar", AppName: "some-app"}))
+				Expect(fakeCloudControllerClient.DeleteSidecarCallCount()).To(Equal(0))
+			})
+		})
+
+		When("deleting the sidecar fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteSidecarReturns(ccv3.Warnings{"delete-warning"}, errors.New("delete-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("delete-error"))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-sidecars-warning", "delete-warning"))
+			})
+		})
+	})
+})
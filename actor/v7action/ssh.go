@@ -14,6 +14,17 @@ type SSHAuthentication struct {
 	Username           string
 }
 
+// InstanceSSHAuthentication is the SSH authentication information for one
+// instance of a process. It has no passcode: passcodes can only be used once
+// and expire quickly, so each one is fetched with GetSSHPasscode right before
+// connecting to the instance.
+type InstanceSSHAuthentication struct {
+	Endpoint           string
+	HostKeyFingerprint string
+	Username           string
+	Index              uint
+}
+
 func (actor Actor) GetSSHPasscode() (string, error) {
 	return actor.UAAClient.GetSSHPasscode(actor.Config.AccessToken(), actor.Config.SSHOAuthClient())
 }
@@ -25,22 +36,12 @@ func (actor Actor) GetSecureShellConfigurationByApplicationNameSpaceProcessTypeA
 ) (SSHAuthentication, Warnings, error) {
 	var allWarnings Warnings
 
-	rootInfo, warnings, err := actor.CloudControllerClient.GetInfo()
+	endpoint, fingerprint, warnings, err := actor.getSSHEndpointAndFingerprint()
 	allWarnings = append(allWarnings, warnings...)
 	if err != nil {
 		return SSHAuthentication{}, allWarnings, err
 	}
 
-	endpoint := rootInfo.AppSSHEndpoint()
-	if endpoint == "" {
-		return SSHAuthentication{}, nil, actionerror.SSHEndpointNotSetError{}
-	}
-
-	fingerprint := rootInfo.AppSSHHostKeyFingerprint()
-	if fingerprint == "" {
-		return SSHAuthentication{}, nil, actionerror.SSHHostKeyFingerprintNotSetError{}
-	}
-
 	passcode, err := actor.UAAClient.GetSSHPasscode(actor.Config.AccessToken(), actor.Config.SSHOAuthClient())
 	if err != nil {
 		return SSHAuthentication{}, Warnings{}, err
@@ -70,22 +71,94 @@ func (actor Actor) GetSecureShellConfigurationByApplicationNameSpaceProcessTypeA
 	}, allWarnings, err
 }
 
-func (actor Actor) getUsername(application resources.Application, processType string, processIndex uint) (string, Warnings, error) {
+// GetSecureShellConfigurationsForRunningInstances returns the SSH
+// authentication information for every running instance of the process.
+func (actor Actor) GetSecureShellConfigurationsForRunningInstances(
+	appName string, spaceGUID string, processType string,
+) ([]InstanceSSHAuthentication, Warnings, error) {
+	var allWarnings Warnings
+
+	endpoint, fingerprint, warnings, err := actor.getSSHEndpointAndFingerprint()
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	application, appWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	allWarnings = append(allWarnings, appWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	if !application.Started() {
+		return nil, allWarnings, actionerror.ApplicationNotStartedError{Name: appName}
+	}
+
+	processSummary, processWarnings, err := actor.getProcessSummaryByType(application, processType)
+	allWarnings = append(allWarnings, processWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var configurations []InstanceSSHAuthentication
+	for _, instance := range processSummary.InstanceDetails {
+		if !instance.Running() {
+			continue
+		}
+
+		configurations = append(configurations, InstanceSSHAuthentication{
+			Endpoint:           endpoint,
+			HostKeyFingerprint: fingerprint,
+			Username:           fmt.Sprintf("cf:%s/%d", processSummary.GUID, instance.Index),
+			Index:              uint(instance.Index),
+		})
+	}
+
+	if len(configurations) == 0 {
+		return nil, allWarnings, actionerror.NoRunningProcessInstancesError{ProcessType: processType}
+	}
+
+	return configurations, allWarnings, nil
+}
+
+func (actor Actor) getSSHEndpointAndFingerprint() (string, string, Warnings, error) {
+	rootInfo, warnings, err := actor.CloudControllerClient.GetInfo()
+	if err != nil {
+		return "", "", Warnings(warnings), err
+	}
+
+	endpoint := rootInfo.AppSSHEndpoint()
+	if endpoint == "" {
+		return "", "", nil, actionerror.SSHEndpointNotSetError{}
+	}
+
+	fingerprint := rootInfo.AppSSHHostKeyFingerprint()
+	if fingerprint == "" {
+		return "", "", nil, actionerror.SSHHostKeyFingerprintNotSetError{}
+	}
+
+	return endpoint, fingerprint, Warnings(warnings), nil
+}
+
+func (actor Actor) getProcessSummaryByType(application resources.Application, processType string) (ProcessSummary, Warnings, error) {
 	processSummaries, processWarnings, err := actor.getProcessSummariesForApp(application.GUID, false)
 	if err != nil {
-		return "", processWarnings, err
+		return ProcessSummary{}, processWarnings, err
 	}
 
-	var processSummary ProcessSummary
 	for _, appProcessSummary := range processSummaries {
 		if appProcessSummary.Type == processType {
-			processSummary = appProcessSummary
-			break
+			return appProcessSummary, processWarnings, nil
 		}
 	}
 
-	if processSummary.GUID == "" {
-		return "", processWarnings, actionerror.ProcessNotFoundError{ProcessType: processType}
+	return ProcessSummary{}, processWarnings, actionerror.ProcessNotFoundError{ProcessType: processType}
+}
+
+func (actor Actor) getUsername(application resources.Application, processType string, processIndex uint) (string, Warnings, error) {
+	processSummary, processWarnings, err := actor.getProcessSummaryByType(application, processType)
+	if err != nil {
+		return "", processWarnings, err
 	}
 
 	var processInstance ProcessInstance
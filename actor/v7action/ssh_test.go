@@ -267,4 +267,105 @@ var _ = Describe("SSH Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetSecureShellConfigurationsForRunningInstances", func() {
+		var configurations []InstanceSSHAuthentication
+
+		BeforeEach(func() {
+			fakeConfig.AccessTokenReturns("some-access-token")
+			fakeConfig.SSHOAuthClientReturns("some-access-oauth-client")
+
+			fakeCloudControllerClient.GetInfoReturns(ccv3.Info{
+				Links: ccv3.InfoLinks{
+					AppSSH: resources.APILink{
+						HREF: "some-app-ssh-endpoint",
+						Meta: resources.APILinkMeta{HostKeyFingerprint: "some-app-ssh-fingerprint"},
+					},
+				},
+			}, ccv3.Warnings{"some-info-warnings"}, nil)
+			fakeCloudControllerClient.GetApplicationsReturns([]resources.Application{{Name: "some-app", State: constant.ApplicationStarted}}, ccv3.Warnings{"some-app-warnings"}, nil)
+			fakeCloudControllerClient.GetApplicationProcessesReturns([]resources.Process{{Type: "some-process-type", GUID: "some-process-guid"}}, ccv3.Warnings{"some-process-warnings"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			configurations, warnings, executeErr = actor.GetSecureShellConfigurationsForRunningInstances("some-app", "some-space-guid", "some-process-type")
+		})
+
+		When("some instances are running", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturns([]ccv3.ProcessInstance{
+					{State: constant.ProcessInstanceRunning, Index: 0},
+					{State: constant.ProcessInstanceCrashed, Index: 1},
+					{State: constant.ProcessInstanceRunning, Index: 2},
+				}, ccv3.Warnings{"some-instance-warnings"}, nil)
+			})
+
+			It("returns a configuration for each running instance without getting any passcodes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-info-warnings", "some-app-warnings", "some-process-warnings", "some-instance-warnings"))
+
+				Expect(configurations).To(Equal([]InstanceSSHAuthentication{
+					{
+						Endpoint:           "some-app-ssh-endpoint",
+						HostKeyFingerprint: "some-app-ssh-fingerprint",
+						Username:           "cf:some-process-guid/0",
+						Index:              0,
+					},
+					{
+						Endpoint:           "some-app-ssh-endpoint",
+						HostKeyFingerprint: "some-app-ssh-fingerprint",
+						Username:           "cf:some-process-guid/2",
+						Index:              2,
+					},
+				}))
+
+				Expect(fakeUAAClient.GetSSHPasscodeCallCount()).To(Equal(0))
+			})
+		})
+
+		When("no instances are running", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturns([]ccv3.ProcessInstance{
+					{State: constant.ProcessInstanceCrashed, Index: 0},
+				}, ccv3.Warnings{"some-instance-warnings"}, nil)
+			})
+
+			It("returns a NoRunningProcessInstancesError and all warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.NoRunningProcessInstancesError{ProcessType: "some-process-type"}))
+				Expect(warnings).To(ConsistOf("some-info-warnings", "some-app-warnings", "some-process-warnings", "some-instance-warnings"))
+				Expect(fakeUAAClient.GetSSHPasscodeCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the process does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessesReturns([]resources.Process{{Type: "web", GUID: "some-process-guid"}}, ccv3.Warnings{"some-process-warnings"}, nil)
+			})
+
+			It("returns a ProcessNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ProcessNotFoundError{ProcessType: "some-process-type"}))
+			})
+		})
+
+		When("the application is stopped", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]resources.Application{{Name: "some-app", State: constant.ApplicationStopped}}, ccv3.Warnings{"some-app-warnings"}, nil)
+			})
+
+			It("returns an ApplicationNotStartedError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotStartedError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("some-info-warnings", "some-app-warnings"))
+			})
+		})
+
+		When("the app ssh endpoint is empty", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetInfoReturns(ccv3.Info{}, nil, nil)
+			})
+
+			It("returns an SSHEndpointNotSetError", func() {
+				Expect(executeErr).To(MatchError(actionerror.SSHEndpointNotSetError{}))
+			})
+		})
+	})
 })
@@ -1,13 +1,14 @@
 package v7action
 
 import (
-	"strconv"
-
 	"sort"
+	"strconv"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/resources"
 )
 
@@ -63,6 +64,40 @@ func (actor Actor) GetTaskBySequenceIDAndApplication(sequenceID int, appGUID str
 	return resources.Task(tasks[0]), Warnings(warnings), nil
 }
 
+// PollTask polls the task with the given sequence ID until it has succeeded
+// or failed and returns it in its final state. A timeout of zero waits
+// indefinitely.
+func (actor Actor) PollTask(appGUID string, sequenceID int, timeout time.Duration) (resources.Task, Warnings, error) {
+	var allWarnings Warnings
+
+	timer := actor.Clock.NewTimer(time.Millisecond)
+	defer timer.Stop()
+
+	var timeoutChan <-chan time.Time
+	if timeout > 0 {
+		timeoutChan = actor.Clock.After(timeout)
+	}
+
+	for {
+		select {
+		case <-timeoutChan:
+			return resources.Task{}, allWarnings, actionerror.TaskTimeoutError{SequenceID: sequenceID, Timeout: timeout}
+		case <-timer.C():
+			task, warnings, err := actor.GetTaskBySequenceIDAndApplication(sequenceID, appGUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return resources.Task{}, allWarnings, err
+			}
+
+			if task.State == constant.TaskSucceeded || task.State == constant.TaskFailed {
+				return task, allWarnings, nil
+			}
+
+			timer.Reset(actor.Config.PollingInterval())
+		}
+	}
+}
+
 func (actor Actor) TerminateTask(taskGUID string) (resources.Task, Warnings, error) {
 	task, warnings, err := actor.CloudControllerClient.UpdateTaskCancel(taskGUID)
 	return resources.Task(task), Warnings(warnings), err
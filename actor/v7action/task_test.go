@@ -2,6 +2,7 @@ package v7action_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v7action"
@@ -10,6 +11,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/clock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -264,6 +266,78 @@ var _ = Describe("Task Actions", func() {
 		})
 	})
 
+	Describe("PollTask", func() {
+		var (
+			fakeConfig *v7actionfakes.FakeConfig
+			timeout    time.Duration
+			task       resources.Task
+			warnings   Warnings
+			err        error
+		)
+
+		BeforeEach(func() {
+			fakeConfig = new(v7actionfakes.FakeConfig)
+			fakeConfig.PollingIntervalReturns(time.Millisecond)
+			actor = NewActor(fakeCloudControllerClient, fakeConfig, nil, nil, nil, clock.NewClock())
+			timeout = 0
+		})
+
+		JustBeforeEach(func() {
+			task, warnings, err = actor.PollTask("some-app-guid", 3, timeout)
+		})
+
+		When("the task finishes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturnsOnCall(0,
+					[]resources.Task{{SequenceID: 3, State: constant.TaskPending}}, ccv3.Warnings{"warning-1"}, nil)
+				fakeCloudControllerClient.GetApplicationTasksReturnsOnCall(1,
+					[]resources.Task{{SequenceID: 3, State: constant.TaskRunning}}, ccv3.Warnings{"warning-2"}, nil)
+				fakeCloudControllerClient.GetApplicationTasksReturnsOnCall(2,
+					[]resources.Task{{
+						SequenceID: 3,
+						State:      constant.TaskFailed,
+						Result:     &resources.TaskResult{FailureReason: "Exited with status 2"},
+					}}, ccv3.Warnings{"warning-3"}, nil)
+			})
+
+			It("polls until the task is in a final state and returns it", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2", "warning-3"))
+				Expect(task.State).To(Equal(constant.TaskFailed))
+				Expect(task.Result.FailureReason).To(Equal("Exited with status 2"))
+
+				Expect(fakeCloudControllerClient.GetApplicationTasksCallCount()).To(Equal(3))
+				appGUID, queries := fakeCloudControllerClient.GetApplicationTasksArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(queries).To(ConsistOf(ccv3.Query{Key: ccv3.SequenceIDFilter, Values: []string{"3"}}))
+			})
+		})
+
+		When("the task does not finish in time", func() {
+			BeforeEach(func() {
+				timeout = 20 * time.Millisecond
+				fakeCloudControllerClient.GetApplicationTasksReturns(
+					[]resources.Task{{SequenceID: 3, State: constant.TaskRunning}}, ccv3.Warnings{"warning"}, nil)
+			})
+
+			It("returns a TaskTimeoutError", func() {
+				Expect(err).To(MatchError(actionerror.TaskTimeoutError{SequenceID: 3, Timeout: 20 * time.Millisecond}))
+				Expect(warnings).To(ContainElement("warning"))
+			})
+		})
+
+		When("getting the task fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturns(nil, ccv3.Warnings{"warning"}, errors.New("get-task-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("get-task-error"))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+	})
+
 	Describe("TerminateTask", func() {
 		When("the task exists", func() {
 			var returnedTask resources.Task
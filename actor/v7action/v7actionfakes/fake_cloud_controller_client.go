@@ -77,6 +77,19 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	ContinueDeploymentStub        func(string) (ccv3.Warnings, error)
+	continueDeploymentMutex       sync.RWMutex
+	continueDeploymentArgsForCall []struct {
+		arg1 string
+	}
+	continueDeploymentReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	continueDeploymentReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	CopyPackageStub        func(string, string) (resources.Package, ccv3.Warnings, error)
 	copyPackageMutex       sync.RWMutex
 	copyPackageArgsForCall []struct {
@@ -108,11 +121,10 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
-	CreateApplicationDeploymentStub        func(string, string) (string, ccv3.Warnings, error)
+	CreateApplicationDeploymentStub        func(resources.Deployment) (string, ccv3.Warnings, error)
 	createApplicationDeploymentMutex       sync.RWMutex
 	createApplicationDeploymentArgsForCall []struct {
-		arg1 string
-		arg2 string
+		arg1 resources.Deployment
 	}
 	createApplicationDeploymentReturns struct {
 		result1 string
@@ -124,22 +136,6 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
-	CreateApplicationDeploymentByRevisionStub        func(string, string) (string, ccv3.Warnings, error)
-	createApplicationDeploymentByRevisionMutex       sync.RWMutex
-	createApplicationDeploymentByRevisionArgsForCall []struct {
-		arg1 string
-		arg2 string
-	}
-	createApplicationDeploymentByRevisionReturns struct {
-		result1 string
-		result2 ccv3.Warnings
-		result3 error
-	}
-	createApplicationDeploymentByRevisionReturnsOnCall map[int]struct {
-		result1 string
-		result2 ccv3.Warnings
-		result3 error
-	}
 	CreateApplicationProcessScaleStub        func(string, resources.Process) (resources.Process, ccv3.Warnings, error)
 	createApplicationProcessScaleMutex       sync.RWMutex
 	createApplicationProcessScaleArgsForCall []struct {
@@ -156,6 +152,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	CreateApplicationSidecarStub        func(string, resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error)
+	createApplicationSidecarMutex       sync.RWMutex
+	createApplicationSidecarArgsForCall []struct {
+		arg1 string
+		arg2 resources.Sidecar
+	}
+	createApplicationSidecarReturns struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createApplicationSidecarReturnsOnCall map[int]struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
 	CreateApplicationTaskStub        func(string, resources.Task) (resources.Task, ccv3.Warnings, error)
 	createApplicationTaskMutex       sync.RWMutex
 	createApplicationTaskArgsForCall []struct {
@@ -694,6 +706,19 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	DeleteSidecarStub        func(string) (ccv3.Warnings, error)
+	deleteSidecarMutex       sync.RWMutex
+	deleteSidecarArgsForCall []struct {
+		arg1 string
+	}
+	deleteSidecarReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	deleteSidecarReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	DeleteSpaceStub        func(string) (ccv3.JobURL, ccv3.Warnings, error)
 	deleteSpaceMutex       sync.RWMutex
 	deleteSpaceArgsForCall []struct {
@@ -770,6 +795,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetAllEventsStub        func(...ccv3.Query) ([]ccv3.Event, ccv3.Warnings, error)
+	getAllEventsMutex       sync.RWMutex
+	getAllEventsArgsForCall []struct {
+		arg1 []ccv3.Query
+	}
+	getAllEventsReturns struct {
+		result1 []ccv3.Event
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getAllEventsReturnsOnCall map[int]struct {
+		result1 []ccv3.Event
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetAppFeatureStub        func(string, string) (resources.ApplicationFeature, ccv3.Warnings, error)
 	getAppFeatureMutex       sync.RWMutex
 	getAppFeatureArgsForCall []struct {
@@ -924,6 +964,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetApplicationSidecarsStub        func(string) ([]resources.Sidecar, ccv3.Warnings, error)
+	getApplicationSidecarsMutex       sync.RWMutex
+	getApplicationSidecarsArgsForCall []struct {
+		arg1 string
+	}
+	getApplicationSidecarsReturns struct {
+		result1 []resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getApplicationSidecarsReturnsOnCall map[int]struct {
+		result1 []resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetApplicationTasksStub        func(string, ...ccv3.Query) ([]resources.Task, ccv3.Warnings, error)
 	getApplicationTasksMutex       sync.RWMutex
 	getApplicationTasksArgsForCall []struct {
@@ -1421,6 +1476,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetRevisionEnvironmentVariablesStub        func(string) (resources.EnvironmentVariables, ccv3.Warnings, error)
+	getRevisionEnvironmentVariablesMutex       sync.RWMutex
+	getRevisionEnvironmentVariablesArgsForCall []struct {
+		arg1 string
+	}
+	getRevisionEnvironmentVariablesReturns struct {
+		result1 resources.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getRevisionEnvironmentVariablesReturnsOnCall map[int]struct {
+		result1 resources.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetRolesStub        func(...ccv3.Query) ([]resources.Role, ccv3.IncludedResources, ccv3.Warnings, error)
 	getRolesMutex       sync.RWMutex
 	getRolesArgsForCall []struct {
@@ -2017,6 +2087,20 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	ReplaceRouteDestinationsStub        func(string, []resources.RouteDestination) (ccv3.Warnings, error)
+	replaceRouteDestinationsMutex       sync.RWMutex
+	replaceRouteDestinationsArgsForCall []struct {
+		arg1 string
+		arg2 []resources.RouteDestination
+	}
+	replaceRouteDestinationsReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	replaceRouteDestinationsReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	ResourceMatchStub        func([]ccv3.Resource) ([]ccv3.Resource, ccv3.Warnings, error)
 	resourceMatchMutex       sync.RWMutex
 	resourceMatchArgsForCall []struct {
@@ -2532,6 +2616,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	UpdateSidecarStub        func(resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error)
+	updateSidecarMutex       sync.RWMutex
+	updateSidecarArgsForCall []struct {
+		arg1 resources.Sidecar
+	}
+	updateSidecarReturns struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateSidecarReturnsOnCall map[int]struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
 	UpdateSpaceStub        func(resources.Space) (resources.Space, ccv3.Warnings, error)
 	updateSpaceMutex       sync.RWMutex
 	updateSpaceArgsForCall []struct {
@@ -2978,6 +3077,69 @@ func (fake *FakeCloudControllerClient) CheckRouteReturnsOnCall(i int, result1 bo
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) ContinueDeployment(arg1 string) (ccv3.Warnings, error) {
+	fake.continueDeploymentMutex.Lock()
+	ret, specificReturn := fake.continueDeploymentReturnsOnCall[len(fake.continueDeploymentArgsForCall)]
+	fake.continueDeploymentArgsForCall = append(fake.continueDeploymentArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ContinueDeployment", []interface{}{arg1})
+	fake.continueDeploymentMutex.Unlock()
+	if fake.ContinueDeploymentStub != nil {
+		return fake.ContinueDeploymentStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.continueDeploymentReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) ContinueDeploymentCallCount() int {
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
+	return len(fake.continueDeploymentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) ContinueDeploymentCalls(stub func(string) (ccv3.Warnings, error)) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = stub
+}
+
+func (fake *FakeCloudControllerClient) ContinueDeploymentArgsForCall(i int) string {
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
+	argsForCall := fake.continueDeploymentArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) ContinueDeploymentReturns(result1 ccv3.Warnings, result2 error) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = nil
+	fake.continueDeploymentReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) ContinueDeploymentReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = nil
+	if fake.continueDeploymentReturnsOnCall == nil {
+		fake.continueDeploymentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.continueDeploymentReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) CopyPackage(arg1 string, arg2 string) (resources.Package, ccv3.Warnings, error) {
 	fake.copyPackageMutex.Lock()
 	ret, specificReturn := fake.copyPackageReturnsOnCall[len(fake.copyPackageArgsForCall)]
@@ -3111,17 +3273,16 @@ func (fake *FakeCloudControllerClient) CreateApplicationReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) CreateApplicationDeployment(arg1 string, arg2 string) (string, ccv3.Warnings, error) {
+func (fake *FakeCloudControllerClient) CreateApplicationDeployment(arg1 resources.Deployment) (string, ccv3.Warnings, error) {
 	fake.createApplicationDeploymentMutex.Lock()
 	ret, specificReturn := fake.createApplicationDeploymentReturnsOnCall[len(fake.createApplicationDeploymentArgsForCall)]
 	fake.createApplicationDeploymentArgsForCall = append(fake.createApplicationDeploymentArgsForCall, struct {
-		arg1 string
-		arg2 string
-	}{arg1, arg2})
-	fake.recordInvocation("CreateApplicationDeployment", []interface{}{arg1, arg2})
+		arg1 resources.Deployment
+	}{arg1})
+	fake.recordInvocation("CreateApplicationDeployment", []interface{}{arg1})
 	fake.createApplicationDeploymentMutex.Unlock()
 	if fake.CreateApplicationDeploymentStub != nil {
-		return fake.CreateApplicationDeploymentStub(arg1, arg2)
+		return fake.CreateApplicationDeploymentStub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -3136,17 +3297,17 @@ func (fake *FakeCloudControllerClient) CreateApplicationDeploymentCallCount() in
 	return len(fake.createApplicationDeploymentArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) CreateApplicationDeploymentCalls(stub func(string, string) (string, ccv3.Warnings, error)) {
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentCalls(stub func(resources.Deployment) (string, ccv3.Warnings, error)) {
 	fake.createApplicationDeploymentMutex.Lock()
 	defer fake.createApplicationDeploymentMutex.Unlock()
 	fake.CreateApplicationDeploymentStub = stub
 }
 
-func (fake *FakeCloudControllerClient) CreateApplicationDeploymentArgsForCall(i int) (string, string) {
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentArgsForCall(i int) resources.Deployment {
 	fake.createApplicationDeploymentMutex.RLock()
 	defer fake.createApplicationDeploymentMutex.RUnlock()
 	argsForCall := fake.createApplicationDeploymentArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1
 }
 
 func (fake *FakeCloudControllerClient) CreateApplicationDeploymentReturns(result1 string, result2 ccv3.Warnings, result3 error) {
@@ -3178,73 +3339,6 @@ func (fake *FakeCloudControllerClient) CreateApplicationDeploymentReturnsOnCall(
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) CreateApplicationDeploymentByRevision(arg1 string, arg2 string) (string, ccv3.Warnings, error) {
-	fake.createApplicationDeploymentByRevisionMutex.Lock()
-	ret, specificReturn := fake.createApplicationDeploymentByRevisionReturnsOnCall[len(fake.createApplicationDeploymentByRevisionArgsForCall)]
-	fake.createApplicationDeploymentByRevisionArgsForCall = append(fake.createApplicationDeploymentByRevisionArgsForCall, struct {
-		arg1 string
-		arg2 string
-	}{arg1, arg2})
-	fake.recordInvocation("CreateApplicationDeploymentByRevision", []interface{}{arg1, arg2})
-	fake.createApplicationDeploymentByRevisionMutex.Unlock()
-	if fake.CreateApplicationDeploymentByRevisionStub != nil {
-		return fake.CreateApplicationDeploymentByRevisionStub(arg1, arg2)
-	}
-	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
-	}
-	fakeReturns := fake.createApplicationDeploymentByRevisionReturns
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
-}
-
-func (fake *FakeCloudControllerClient) CreateApplicationDeploymentByRevisionCallCount() int {
-	fake.createApplicationDeploymentByRevisionMutex.RLock()
-	defer fake.createApplicationDeploymentByRevisionMutex.RUnlock()
-	return len(fake.createApplicationDeploymentByRevisionArgsForCall)
-}
-
-func (fake *FakeCloudControllerClient) CreateApplicationDeploymentByRevisionCalls(stub func(string, string) (string, ccv3.Warnings, error)) {
-	fake.createApplicationDeploymentByRevisionMutex.Lock()
-	defer fake.createApplicationDeploymentByRevisionMutex.Unlock()
-	fake.CreateApplicationDeploymentByRevisionStub = stub
-}
-
-func (fake *FakeCloudControllerClient) CreateApplicationDeploymentByRevisionArgsForCall(i int) (string, string) {
-	fake.createApplicationDeploymentByRevisionMutex.RLock()
-	defer fake.createApplicationDeploymentByRevisionMutex.RUnlock()
-	argsForCall := fake.createApplicationDeploymentByRevisionArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
-}
-
-func (fake *FakeCloudControllerClient) CreateApplicationDeploymentByRevisionReturns(result1 string, result2 ccv3.Warnings, result3 error) {
-	fake.createApplicationDeploymentByRevisionMutex.Lock()
-	defer fake.createApplicationDeploymentByRevisionMutex.Unlock()
-	fake.CreateApplicationDeploymentByRevisionStub = nil
-	fake.createApplicationDeploymentByRevisionReturns = struct {
-		result1 string
-		result2 ccv3.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
-func (fake *FakeCloudControllerClient) CreateApplicationDeploymentByRevisionReturnsOnCall(i int, result1 string, result2 ccv3.Warnings, result3 error) {
-	fake.createApplicationDeploymentByRevisionMutex.Lock()
-	defer fake.createApplicationDeploymentByRevisionMutex.Unlock()
-	fake.CreateApplicationDeploymentByRevisionStub = nil
-	if fake.createApplicationDeploymentByRevisionReturnsOnCall == nil {
-		fake.createApplicationDeploymentByRevisionReturnsOnCall = make(map[int]struct {
-			result1 string
-			result2 ccv3.Warnings
-			result3 error
-		})
-	}
-	fake.createApplicationDeploymentByRevisionReturnsOnCall[i] = struct {
-		result1 string
-		result2 ccv3.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
 func (fake *FakeCloudControllerClient) CreateApplicationProcessScale(arg1 string, arg2 resources.Process) (resources.Process, ccv3.Warnings, error) {
 	fake.createApplicationProcessScaleMutex.Lock()
 	ret, specificReturn := fake.createApplicationProcessScaleReturnsOnCall[len(fake.createApplicationProcessScaleArgsForCall)]
@@ -3312,6 +3406,73 @@ func (fake *FakeCloudControllerClient) CreateApplicationProcessScaleReturnsOnCal
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateApplicationSidecar(arg1 string, arg2 resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error) {
+	fake.createApplicationSidecarMutex.Lock()
+	ret, specificReturn := fake.createApplicationSidecarReturnsOnCall[len(fake.createApplicationSidecarArgsForCall)]
+	fake.createApplicationSidecarArgsForCall = append(fake.createApplicationSidecarArgsForCall, struct {
+		arg1 string
+		arg2 resources.Sidecar
+	}{arg1, arg2})
+	fake.recordInvocation("CreateApplicationSidecar", []interface{}{arg1, arg2})
+	fake.createApplicationSidecarMutex.Unlock()
+	if fake.CreateApplicationSidecarStub != nil {
+		return fake.CreateApplicationSidecarStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createApplicationSidecarReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationSidecarCallCount() int {
+	fake.createApplicationSidecarMutex.RLock()
+	defer fake.createApplicationSidecarMutex.RUnlock()
+	return len(fake.createApplicationSidecarArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationSidecarCalls(stub func(string, resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error)) {
+	fake.createApplicationSidecarMutex.Lock()
+	defer fake.createApplicationSidecarMutex.Unlock()
+	fake.CreateApplicationSidecarStub = stub
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationSidecarArgsForCall(i int) (string, resources.Sidecar) {
+	fake.createApplicationSidecarMutex.RLock()
+	defer fake.createApplicationSidecarMutex.RUnlock()
+	argsForCall := fake.createApplicationSidecarArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationSidecarReturns(result1 resources.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.createApplicationSidecarMutex.Lock()
+	defer fake.createApplicationSidecarMutex.Unlock()
+	fake.CreateApplicationSidecarStub = nil
+	fake.createApplicationSidecarReturns = struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationSidecarReturnsOnCall(i int, result1 resources.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.createApplicationSidecarMutex.Lock()
+	defer fake.createApplicationSidecarMutex.Unlock()
+	fake.CreateApplicationSidecarStub = nil
+	if fake.createApplicationSidecarReturnsOnCall == nil {
+		fake.createApplicationSidecarReturnsOnCall = make(map[int]struct {
+			result1 resources.Sidecar
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createApplicationSidecarReturnsOnCall[i] = struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateApplicationTask(arg1 string, arg2 resources.Task) (resources.Task, ccv3.Warnings, error) {
 	fake.createApplicationTaskMutex.Lock()
 	ret, specificReturn := fake.createApplicationTaskReturnsOnCall[len(fake.createApplicationTaskArgsForCall)]
@@ -5682,6 +5843,69 @@ func (fake *FakeCloudControllerClient) DeleteServicePlanVisibilityReturnsOnCall(
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) DeleteSidecar(arg1 string) (ccv3.Warnings, error) {
+	fake.deleteSidecarMutex.Lock()
+	ret, specificReturn := fake.deleteSidecarReturnsOnCall[len(fake.deleteSidecarArgsForCall)]
+	fake.deleteSidecarArgsForCall = append(fake.deleteSidecarArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DeleteSidecar", []interface{}{arg1})
+	fake.deleteSidecarMutex.Unlock()
+	if fake.DeleteSidecarStub != nil {
+		return fake.DeleteSidecarStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteSidecarReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteSidecarCallCount() int {
+	fake.deleteSidecarMutex.RLock()
+	defer fake.deleteSidecarMutex.RUnlock()
+	return len(fake.deleteSidecarArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteSidecarCalls(stub func(string) (ccv3.Warnings, error)) {
+	fake.deleteSidecarMutex.Lock()
+	defer fake.deleteSidecarMutex.Unlock()
+	fake.DeleteSidecarStub = stub
+}
+
+func (fake *FakeCloudControllerClient) DeleteSidecarArgsForCall(i int) string {
+	fake.deleteSidecarMutex.RLock()
+	defer fake.deleteSidecarMutex.RUnlock()
+	argsForCall := fake.deleteSidecarArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) DeleteSidecarReturns(result1 ccv3.Warnings, result2 error) {
+	fake.deleteSidecarMutex.Lock()
+	defer fake.deleteSidecarMutex.Unlock()
+	fake.DeleteSidecarStub = nil
+	fake.deleteSidecarReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteSidecarReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.deleteSidecarMutex.Lock()
+	defer fake.deleteSidecarMutex.Unlock()
+	fake.DeleteSidecarStub = nil
+	if fake.deleteSidecarReturnsOnCall == nil {
+		fake.deleteSidecarReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.deleteSidecarReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) DeleteSpace(arg1 string) (ccv3.JobURL, ccv3.Warnings, error) {
 	fake.deleteSpaceMutex.Lock()
 	ret, specificReturn := fake.deleteSpaceReturnsOnCall[len(fake.deleteSpaceArgsForCall)]
@@ -6018,6 +6242,72 @@ func (fake *FakeCloudControllerClient) EntitleIsolationSegmentToOrganizationsRet
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetAllEvents(arg1 ...ccv3.Query) ([]ccv3.Event, ccv3.Warnings, error) {
+	fake.getAllEventsMutex.Lock()
+	ret, specificReturn := fake.getAllEventsReturnsOnCall[len(fake.getAllEventsArgsForCall)]
+	fake.getAllEventsArgsForCall = append(fake.getAllEventsArgsForCall, struct {
+		arg1 []ccv3.Query
+	}{arg1})
+	fake.recordInvocation("GetAllEvents", []interface{}{arg1})
+	fake.getAllEventsMutex.Unlock()
+	if fake.GetAllEventsStub != nil {
+		return fake.GetAllEventsStub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getAllEventsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetAllEventsCallCount() int {
+	fake.getAllEventsMutex.RLock()
+	defer fake.getAllEventsMutex.RUnlock()
+	return len(fake.getAllEventsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetAllEventsCalls(stub func(...ccv3.Query) ([]ccv3.Event, ccv3.Warnings, error)) {
+	fake.getAllEventsMutex.Lock()
+	defer fake.getAllEventsMutex.Unlock()
+	fake.GetAllEventsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetAllEventsArgsForCall(i int) []ccv3.Query {
+	fake.getAllEventsMutex.RLock()
+	defer fake.getAllEventsMutex.RUnlock()
+	argsForCall := fake.getAllEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetAllEventsReturns(result1 []ccv3.Event, result2 ccv3.Warnings, result3 error) {
+	fake.getAllEventsMutex.Lock()
+	defer fake.getAllEventsMutex.Unlock()
+	fake.GetAllEventsStub = nil
+	fake.getAllEventsReturns = struct {
+		result1 []ccv3.Event
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetAllEventsReturnsOnCall(i int, result1 []ccv3.Event, result2 ccv3.Warnings, result3 error) {
+	fake.getAllEventsMutex.Lock()
+	defer fake.getAllEventsMutex.Unlock()
+	fake.GetAllEventsStub = nil
+	if fake.getAllEventsReturnsOnCall == nil {
+		fake.getAllEventsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Event
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getAllEventsReturnsOnCall[i] = struct {
+		result1 []ccv3.Event
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetAppFeature(arg1 string, arg2 string) (resources.ApplicationFeature, ccv3.Warnings, error) {
 	fake.getAppFeatureMutex.Lock()
 	ret, specificReturn := fake.getAppFeatureReturnsOnCall[len(fake.getAppFeatureArgsForCall)]
@@ -6682,6 +6972,72 @@ func (fake *FakeCloudControllerClient) GetApplicationRoutesReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetApplicationSidecars(arg1 string) ([]resources.Sidecar, ccv3.Warnings, error) {
+	fake.getApplicationSidecarsMutex.Lock()
+	ret, specificReturn := fake.getApplicationSidecarsReturnsOnCall[len(fake.getApplicationSidecarsArgsForCall)]
+	fake.getApplicationSidecarsArgsForCall = append(fake.getApplicationSidecarsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetApplicationSidecars", []interface{}{arg1})
+	fake.getApplicationSidecarsMutex.Unlock()
+	if fake.GetApplicationSidecarsStub != nil {
+		return fake.GetApplicationSidecarsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationSidecarsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecarsCallCount() int {
+	fake.getApplicationSidecarsMutex.RLock()
+	defer fake.getApplicationSidecarsMutex.RUnlock()
+	return len(fake.getApplicationSidecarsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecarsCalls(stub func(string) ([]resources.Sidecar, ccv3.Warnings, error)) {
+	fake.getApplicationSidecarsMutex.Lock()
+	defer fake.getApplicationSidecarsMutex.Unlock()
+	fake.GetApplicationSidecarsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecarsArgsForCall(i int) string {
+	fake.getApplicationSidecarsMutex.RLock()
+	defer fake.getApplicationSidecarsMutex.RUnlock()
+	argsForCall := fake.getApplicationSidecarsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecarsReturns(result1 []resources.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.getApplicationSidecarsMutex.Lock()
+	defer fake.getApplicationSidecarsMutex.Unlock()
+	fake.GetApplicationSidecarsStub = nil
+	fake.getApplicationSidecarsReturns = struct {
+		result1 []resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecarsReturnsOnCall(i int, result1 []resources.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.getApplicationSidecarsMutex.Lock()
+	defer fake.getApplicationSidecarsMutex.Unlock()
+	fake.GetApplicationSidecarsStub = nil
+	if fake.getApplicationSidecarsReturnsOnCall == nil {
+		fake.getApplicationSidecarsReturnsOnCall = make(map[int]struct {
+			result1 []resources.Sidecar
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationSidecarsReturnsOnCall[i] = struct {
+		result1 []resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetApplicationTasks(arg1 string, arg2 ...ccv3.Query) ([]resources.Task, ccv3.Warnings, error) {
 	fake.getApplicationTasksMutex.Lock()
 	ret, specificReturn := fake.getApplicationTasksReturnsOnCall[len(fake.getApplicationTasksArgsForCall)]
@@ -8848,6 +9204,72 @@ func (fake *FakeCloudControllerClient) GetProcessesReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetRevisionEnvironmentVariables(arg1 string) (resources.EnvironmentVariables, ccv3.Warnings, error) {
+	fake.getRevisionEnvironmentVariablesMutex.Lock()
+	ret, specificReturn := fake.getRevisionEnvironmentVariablesReturnsOnCall[len(fake.getRevisionEnvironmentVariablesArgsForCall)]
+	fake.getRevisionEnvironmentVariablesArgsForCall = append(fake.getRevisionEnvironmentVariablesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetRevisionEnvironmentVariables", []interface{}{arg1})
+	fake.getRevisionEnvironmentVariablesMutex.Unlock()
+	if fake.GetRevisionEnvironmentVariablesStub != nil {
+		return fake.GetRevisionEnvironmentVariablesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getRevisionEnvironmentVariablesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetRevisionEnvironmentVariablesCallCount() int {
+	fake.getRevisionEnvironmentVariablesMutex.RLock()
+	defer fake.getRevisionEnvironmentVariablesMutex.RUnlock()
+	return len(fake.getRevisionEnvironmentVariablesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetRevisionEnvironmentVariablesCalls(stub func(string) (resources.EnvironmentVariables, ccv3.Warnings, error)) {
+	fake.getRevisionEnvironmentVariablesMutex.Lock()
+	defer fake.getRevisionEnvironmentVariablesMutex.Unlock()
+	fake.GetRevisionEnvironmentVariablesStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetRevisionEnvironmentVariablesArgsForCall(i int) string {
+	fake.getRevisionEnvironmentVariablesMutex.RLock()
+	defer fake.getRevisionEnvironmentVariablesMutex.RUnlock()
+	argsForCall := fake.getRevisionEnvironmentVariablesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetRevisionEnvironmentVariablesReturns(result1 resources.EnvironmentVariables, result2 ccv3.Warnings, result3 error) {
+	fake.getRevisionEnvironmentVariablesMutex.Lock()
+	defer fake.getRevisionEnvironmentVariablesMutex.Unlock()
+	fake.GetRevisionEnvironmentVariablesStub = nil
+	fake.getRevisionEnvironmentVariablesReturns = struct {
+		result1 resources.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRevisionEnvironmentVariablesReturnsOnCall(i int, result1 resources.EnvironmentVariables, result2 ccv3.Warnings, result3 error) {
+	fake.getRevisionEnvironmentVariablesMutex.Lock()
+	defer fake.getRevisionEnvironmentVariablesMutex.Unlock()
+	fake.GetRevisionEnvironmentVariablesStub = nil
+	if fake.getRevisionEnvironmentVariablesReturnsOnCall == nil {
+		fake.getRevisionEnvironmentVariablesReturnsOnCall = make(map[int]struct {
+			result1 resources.EnvironmentVariables
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getRevisionEnvironmentVariablesReturnsOnCall[i] = struct {
+		result1 resources.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetRoles(arg1 ...ccv3.Query) ([]resources.Role, ccv3.IncludedResources, ccv3.Warnings, error) {
 	fake.getRolesMutex.Lock()
 	ret, specificReturn := fake.getRolesReturnsOnCall[len(fake.getRolesArgsForCall)]
@@ -11442,6 +11864,75 @@ func (fake *FakeCloudControllerClient) PurgeServiceOfferingReturnsOnCall(i int,
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) ReplaceRouteDestinations(arg1 string, arg2 []resources.RouteDestination) (ccv3.Warnings, error) {
+	var arg2Copy []resources.RouteDestination
+	if arg2 != nil {
+		arg2Copy = make([]resources.RouteDestination, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.replaceRouteDestinationsMutex.Lock()
+	ret, specificReturn := fake.replaceRouteDestinationsReturnsOnCall[len(fake.replaceRouteDestinationsArgsForCall)]
+	fake.replaceRouteDestinationsArgsForCall = append(fake.replaceRouteDestinationsArgsForCall, struct {
+		arg1 string
+		arg2 []resources.RouteDestination
+	}{arg1, arg2Copy})
+	fake.recordInvocation("ReplaceRouteDestinations", []interface{}{arg1, arg2Copy})
+	fake.replaceRouteDestinationsMutex.Unlock()
+	if fake.ReplaceRouteDestinationsStub != nil {
+		return fake.ReplaceRouteDestinationsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.replaceRouteDestinationsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) ReplaceRouteDestinationsCallCount() int {
+	fake.replaceRouteDestinationsMutex.RLock()
+	defer fake.replaceRouteDestinationsMutex.RUnlock()
+	return len(fake.replaceRouteDestinationsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) ReplaceRouteDestinationsCalls(stub func(string, []resources.RouteDestination) (ccv3.Warnings, error)) {
+	fake.replaceRouteDestinationsMutex.Lock()
+	defer fake.replaceRouteDestinationsMutex.Unlock()
+	fake.ReplaceRouteDestinationsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) ReplaceRouteDestinationsArgsForCall(i int) (string, []resources.RouteDestination) {
+	fake.replaceRouteDestinationsMutex.RLock()
+	defer fake.replaceRouteDestinationsMutex.RUnlock()
+	argsForCall := fake.replaceRouteDestinationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) ReplaceRouteDestinationsReturns(result1 ccv3.Warnings, result2 error) {
+	fake.replaceRouteDestinationsMutex.Lock()
+	defer fake.replaceRouteDestinationsMutex.Unlock()
+	fake.ReplaceRouteDestinationsStub = nil
+	fake.replaceRouteDestinationsReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) ReplaceRouteDestinationsReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.replaceRouteDestinationsMutex.Lock()
+	defer fake.replaceRouteDestinationsMutex.Unlock()
+	fake.ReplaceRouteDestinationsStub = nil
+	if fake.replaceRouteDestinationsReturnsOnCall == nil {
+		fake.replaceRouteDestinationsReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.replaceRouteDestinationsReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) ResourceMatch(arg1 []ccv3.Resource) ([]ccv3.Resource, ccv3.Warnings, error) {
 	var arg1Copy []ccv3.Resource
 	if arg1 != nil {
@@ -13723,6 +14214,72 @@ func (fake *FakeCloudControllerClient) UpdateServicePlanVisibilityReturnsOnCall(
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateSidecar(arg1 resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error) {
+	fake.updateSidecarMutex.Lock()
+	ret, specificReturn := fake.updateSidecarReturnsOnCall[len(fake.updateSidecarArgsForCall)]
+	fake.updateSidecarArgsForCall = append(fake.updateSidecarArgsForCall, struct {
+		arg1 resources.Sidecar
+	}{arg1})
+	fake.recordInvocation("UpdateSidecar", []interface{}{arg1})
+	fake.updateSidecarMutex.Unlock()
+	if fake.UpdateSidecarStub != nil {
+		return fake.UpdateSidecarStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateSidecarReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateSidecarCallCount() int {
+	fake.updateSidecarMutex.RLock()
+	defer fake.updateSidecarMutex.RUnlock()
+	return len(fake.updateSidecarArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSidecarCalls(stub func(resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error)) {
+	fake.updateSidecarMutex.Lock()
+	defer fake.updateSidecarMutex.Unlock()
+	fake.UpdateSidecarStub = stub
+}
+
+func (fake *FakeCloudControllerClient) UpdateSidecarArgsForCall(i int) resources.Sidecar {
+	fake.updateSidecarMutex.RLock()
+	defer fake.updateSidecarMutex.RUnlock()
+	argsForCall := fake.updateSidecarArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) UpdateSidecarReturns(result1 resources.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.updateSidecarMutex.Lock()
+	defer fake.updateSidecarMutex.Unlock()
+	fake.UpdateSidecarStub = nil
+	fake.updateSidecarReturns = struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSidecarReturnsOnCall(i int, result1 resources.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.updateSidecarMutex.Lock()
+	defer fake.updateSidecarMutex.Unlock()
+	fake.UpdateSidecarStub = nil
+	if fake.updateSidecarReturnsOnCall == nil {
+		fake.updateSidecarReturnsOnCall = make(map[int]struct {
+			result1 resources.Sidecar
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateSidecarReturnsOnCall[i] = struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) UpdateSpace(arg1 resources.Space) (resources.Space, ccv3.Warnings, error) {
 	fake.updateSpaceMutex.Lock()
 	ret, specificReturn := fake.updateSpaceReturnsOnCall[len(fake.updateSpaceArgsForCall)]
@@ -14473,16 +15030,18 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.cancelDeploymentMutex.RUnlock()
 	fake.checkRouteMutex.RLock()
 	defer fake.checkRouteMutex.RUnlock()
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
 	fake.copyPackageMutex.RLock()
 	defer fake.copyPackageMutex.RUnlock()
 	fake.createApplicationMutex.RLock()
 	defer fake.createApplicationMutex.RUnlock()
 	fake.createApplicationDeploymentMutex.RLock()
 	defer fake.createApplicationDeploymentMutex.RUnlock()
-	fake.createApplicationDeploymentByRevisionMutex.RLock()
-	defer fake.createApplicationDeploymentByRevisionMutex.RUnlock()
 	fake.createApplicationProcessScaleMutex.RLock()
 	defer fake.createApplicationProcessScaleMutex.RUnlock()
+	fake.createApplicationSidecarMutex.RLock()
+	defer fake.createApplicationSidecarMutex.RUnlock()
 	fake.createApplicationTaskMutex.RLock()
 	defer fake.createApplicationTaskMutex.RUnlock()
 	fake.createBuildMutex.RLock()
@@ -14555,6 +15114,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.deleteServiceInstanceMutex.RUnlock()
 	fake.deleteServicePlanVisibilityMutex.RLock()
 	defer fake.deleteServicePlanVisibilityMutex.RUnlock()
+	fake.deleteSidecarMutex.RLock()
+	defer fake.deleteSidecarMutex.RUnlock()
 	fake.deleteSpaceMutex.RLock()
 	defer fake.deleteSpaceMutex.RUnlock()
 	fake.deleteSpaceQuotaMutex.RLock()
@@ -14565,6 +15126,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.downloadDropletMutex.RUnlock()
 	fake.entitleIsolationSegmentToOrganizationsMutex.RLock()
 	defer fake.entitleIsolationSegmentToOrganizationsMutex.RUnlock()
+	fake.getAllEventsMutex.RLock()
+	defer fake.getAllEventsMutex.RUnlock()
 	fake.getAppFeatureMutex.RLock()
 	defer fake.getAppFeatureMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
@@ -14585,6 +15148,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getApplicationRevisionsDeployedMutex.RUnlock()
 	fake.getApplicationRoutesMutex.RLock()
 	defer fake.getApplicationRoutesMutex.RUnlock()
+	fake.getApplicationSidecarsMutex.RLock()
+	defer fake.getApplicationSidecarsMutex.RUnlock()
 	fake.getApplicationTasksMutex.RLock()
 	defer fake.getApplicationTasksMutex.RUnlock()
 	fake.getApplicationsMutex.RLock()
@@ -14651,6 +15216,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getProcessSidecarsMutex.RUnlock()
 	fake.getProcessesMutex.RLock()
 	defer fake.getProcessesMutex.RUnlock()
+	fake.getRevisionEnvironmentVariablesMutex.RLock()
+	defer fake.getRevisionEnvironmentVariablesMutex.RUnlock()
 	fake.getRolesMutex.RLock()
 	defer fake.getRolesMutex.RUnlock()
 	fake.getRouteBindingsMutex.RLock()
@@ -14729,6 +15296,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.pollJobToEventStreamMutex.RUnlock()
 	fake.purgeServiceOfferingMutex.RLock()
 	defer fake.purgeServiceOfferingMutex.RUnlock()
+	fake.replaceRouteDestinationsMutex.RLock()
+	defer fake.replaceRouteDestinationsMutex.RUnlock()
 	fake.resourceMatchMutex.RLock()
 	defer fake.resourceMatchMutex.RUnlock()
 	fake.rootResponseMutex.RLock()
@@ -14799,6 +15368,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.updateServiceInstanceMutex.RUnlock()
 	fake.updateServicePlanVisibilityMutex.RLock()
 	defer fake.updateServicePlanVisibilityMutex.RUnlock()
+	fake.updateSidecarMutex.RLock()
+	defer fake.updateSidecarMutex.RUnlock()
 	fake.updateSpaceMutex.RLock()
 	defer fake.updateSpaceMutex.RUnlock()
 	fake.updateSpaceApplyManifestMutex.RLock()
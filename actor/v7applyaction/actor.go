@@ -0,0 +1,40 @@
+// Package v7applyaction contains the business logic for reconciling a space
+// against a declarative space manifest.
+package v7applyaction
+
+import "code.cloudfoundry.org/cli/types"
+
+// Warnings is a list of warnings returned back from the cloud controller
+type Warnings []string
+
+const (
+	// ManagedByLabel is the label set on every resource created or updated by
+	// an apply. Only resources carrying it are deleted when pruning.
+	ManagedByLabel = "managed-by"
+	// ManagedByValue is the value of ManagedByLabel.
+	ManagedByValue = "cf-apply"
+)
+
+// Actor handles all business logic for applying space manifests.
+type Actor struct {
+	V7Actor         V7Actor
+	NetworkingActor NetworkingActor
+}
+
+// NewActor returns a new actor.
+func NewActor(v7Actor V7Actor, networkingActor NetworkingActor) *Actor {
+	return &Actor{
+		V7Actor:         v7Actor,
+		NetworkingActor: networkingActor,
+	}
+}
+
+func managedLabels() map[string]types.NullString {
+	return map[string]types.NullString{
+		ManagedByLabel: types.NewNullString(ManagedByValue),
+	}
+}
+
+func isManaged(labels map[string]types.NullString) bool {
+	return labels[ManagedByLabel] == types.NewNullString(ManagedByValue)
+}
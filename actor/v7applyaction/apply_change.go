@@ -0,0 +1,267 @@
+package v7applyaction
+
+import (
+	"fmt"
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"gopkg.in/yaml.v2"
+)
+
+// ApplyChange makes a single change of the plan in the space, waiting for any
+// asynchronous service operations to complete. Created and updated resources
+// are labeled with ManagedByLabel.
+func (actor Actor) ApplyChange(plan Plan, change Change) (Warnings, error) {
+	switch change.Type {
+	case ApplicationResource:
+		return actor.applyApplicationChange(plan, change)
+	case RouteResource:
+		return actor.applyRouteChange(plan, change)
+	case ServiceInstanceResource:
+		return actor.applyServiceInstanceChange(plan, change)
+	case ServiceBindingResource:
+		return actor.applyServiceBindingChange(plan, change)
+	case NetworkPolicyResource:
+		return actor.applyNetworkPolicyChange(plan, change)
+	case SpaceLabelsResource:
+		warnings, err := actor.V7Actor.UpdateSpaceLabelsBySpaceName(plan.SpaceName, plan.OrgGUID, change.Labels)
+		return Warnings(warnings), err
+	}
+
+	return nil, fmt.Errorf("unknown resource type '%s'", change.Type)
+}
+
+func (actor Actor) applyApplicationChange(plan Plan, change Change) (Warnings, error) {
+	if change.Action == ChangeDelete {
+		warnings, err := actor.V7Actor.DeleteApplicationByNameAndSpace(change.Name, plan.SpaceGUID, false)
+		return Warnings(warnings), err
+	}
+
+	rawManifest, routeWeights, err := marshalApplicationManifest(change.Application)
+	if err != nil {
+		return nil, err
+	}
+
+	allWarnings, err := actor.V7Actor.SetSpaceManifest(plan.SpaceGUID, rawManifest)
+	if err != nil {
+		return Warnings(allWarnings), err
+	}
+
+	routes := make([]string, 0, len(routeWeights))
+	for route := range routeWeights {
+		routes = append(routes, route)
+	}
+	sort.Strings(routes)
+	for _, route := range routes {
+		warnings, err := actor.V7Actor.SetRouteDestinationWeightsByAppName(route, plan.SpaceGUID, routeWeights[route])
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Warnings(allWarnings), err
+		}
+	}
+
+	warnings, err := actor.V7Actor.UpdateApplicationLabelsByApplicationName(change.Name, plan.SpaceGUID, managedLabels())
+	allWarnings = append(allWarnings, warnings...)
+	return Warnings(allWarnings), err
+}
+
+// marshalApplicationManifest returns the manifest for a single application
+// along with the route weights it gives, which are applied separately from
+// the manifest.
+func marshalApplicationManifest(app manifestparser.Application) ([]byte, map[string]map[string]int, error) {
+	manifest := manifestparser.Manifest{Applications: []manifestparser.Application{app}}
+	routeWeights, err := manifest.ExtractRouteWeights()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rawManifest, err := yaml.Marshal(manifest)
+	return rawManifest, routeWeights, err
+}
+
+func (actor Actor) applyRouteChange(plan Plan, change Change) (Warnings, error) {
+	spaceRoute := change.Route
+
+	var (
+		route       resources.Route
+		allWarnings v7action.Warnings
+		err         error
+	)
+
+	switch change.Action {
+	case ChangeDelete:
+		allWarnings, err = actor.V7Actor.DeleteRoute(spaceRoute.Domain, spaceRoute.Host, spaceRoute.Path, spaceRoute.Port)
+		return Warnings(allWarnings), err
+	case ChangeCreate:
+		route, allWarnings, err = actor.V7Actor.CreateRoute(plan.SpaceGUID, spaceRoute.Domain, spaceRoute.Host, spaceRoute.Path, spaceRoute.Port)
+	default:
+		route, allWarnings, err = actor.V7Actor.GetRoute(spaceRoute.URL(), plan.SpaceGUID)
+	}
+	if err != nil {
+		return Warnings(allWarnings), err
+	}
+
+	if len(spaceRoute.Apps) > 0 {
+		apps, warnings, err := actor.V7Actor.GetApplicationsByNamesAndSpace(spaceRoute.Apps, plan.SpaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Warnings(allWarnings), err
+		}
+
+		for _, app := range apps {
+			if routeHasApps(route, []string{app.Name}, map[string]resources.Application{app.Name: app}) {
+				continue
+			}
+
+			warnings, err = actor.V7Actor.MapRoute(route.GUID, app.GUID, "")
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return Warnings(allWarnings), err
+			}
+		}
+	}
+
+	warnings, err := actor.V7Actor.UpdateRouteLabels(spaceRoute.URL(), plan.SpaceGUID, managedLabels())
+	allWarnings = append(allWarnings, warnings...)
+	return Warnings(allWarnings), err
+}
+
+func (actor Actor) applyServiceInstanceChange(plan Plan, change Change) (Warnings, error) {
+	instance := change.ServiceInstance
+
+	var (
+		stream      chan v7action.PollJobEvent
+		allWarnings v7action.Warnings
+		err         error
+	)
+
+	switch {
+	case change.Action == ChangeDelete:
+		stream, allWarnings, err = actor.V7Actor.DeleteServiceInstance(instance.Name, plan.SpaceGUID)
+		return waitForJob(stream, allWarnings, err)
+	case instance.UserProvided && change.Action == ChangeCreate:
+		serviceInstance := userProvidedServiceInstance(instance)
+		serviceInstance.Name = instance.Name
+		serviceInstance.SpaceGUID = plan.SpaceGUID
+		allWarnings, err = actor.V7Actor.CreateUserProvidedServiceInstance(serviceInstance)
+	case instance.UserProvided:
+		allWarnings, err = actor.V7Actor.UpdateUserProvidedServiceInstance(instance.Name, plan.SpaceGUID, userProvidedServiceInstance(instance))
+	case change.Action == ChangeCreate:
+		stream, allWarnings, err = actor.V7Actor.CreateManagedServiceInstance(v7action.CreateManagedServiceInstanceParams{
+			ServiceOfferingName: instance.Offering,
+			ServicePlanName:     instance.Plan,
+			ServiceInstanceName: instance.Name,
+			ServiceBrokerName:   instance.Broker,
+			SpaceGUID:           plan.SpaceGUID,
+			Tags:                optionalTags(instance.Tags),
+			Parameters:          optionalObject(instance.Parameters),
+		})
+	default:
+		stream, allWarnings, err = actor.V7Actor.UpdateManagedServiceInstance(v7action.UpdateManagedServiceInstanceParams{
+			ServiceInstanceName: instance.Name,
+			ServicePlanName:     instance.Plan,
+			SpaceGUID:           plan.SpaceGUID,
+			Tags:                optionalTags(instance.Tags),
+			Parameters:          optionalObject(instance.Parameters),
+		})
+	}
+
+	jobWarnings, err := waitForJob(stream, allWarnings, err)
+	if err != nil {
+		return jobWarnings, err
+	}
+
+	warnings, err := actor.V7Actor.UpdateServiceInstanceLabels(instance.Name, plan.SpaceGUID, managedLabels())
+	return append(jobWarnings, warnings...), err
+}
+
+func (actor Actor) applyServiceBindingChange(plan Plan, change Change) (Warnings, error) {
+	binding := change.ServiceBinding
+
+	if change.Action == ChangeDelete {
+		return waitForJob(actor.V7Actor.DeleteServiceAppBinding(v7action.DeleteServiceAppBindingParams{
+			SpaceGUID:           plan.SpaceGUID,
+			ServiceInstanceName: binding.ServiceInstance,
+			AppName:             binding.App,
+		}))
+	}
+
+	return waitForJob(actor.V7Actor.CreateServiceAppBinding(v7action.CreateServiceAppBindingParams{
+		SpaceGUID:           plan.SpaceGUID,
+		ServiceInstanceName: binding.ServiceInstance,
+		AppName:             binding.App,
+		BindingName:         binding.BindingName,
+		Parameters:          optionalObject(binding.Parameters),
+	}))
+}
+
+func (actor Actor) applyNetworkPolicyChange(plan Plan, change Change) (Warnings, error) {
+	policy := change.NetworkPolicy
+
+	startPort, endPort, err := policy.PortRange()
+	if err != nil {
+		return nil, err
+	}
+
+	policyFunc := actor.NetworkingActor.AddNetworkPolicy
+	if change.Action == ChangeDelete {
+		policyFunc = actor.NetworkingActor.RemoveNetworkPolicy
+	}
+
+	warnings, err := policyFunc(plan.SpaceGUID, policy.Source, plan.SpaceGUID, policy.Destination, policy.ProtocolOrDefault(), startPort, endPort)
+	return Warnings(warnings), err
+}
+
+// waitForJob drains the job event stream returned by an asynchronous service
+// operation, collecting its warnings and returning the first error.
+func waitForJob(stream chan v7action.PollJobEvent, warnings v7action.Warnings, err error) (Warnings, error) {
+	allWarnings := Warnings(warnings)
+	if err != nil || stream == nil {
+		return allWarnings, err
+	}
+
+	for event := range stream {
+		allWarnings = append(allWarnings, event.Warnings...)
+		if event.Err != nil {
+			return allWarnings, event.Err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+func userProvidedServiceInstance(instance manifestparser.SpaceServiceInstance) resources.ServiceInstance {
+	serviceInstance := resources.ServiceInstance{
+		Type: resources.UserProvidedServiceInstance,
+		Tags: optionalTags(instance.Tags),
+	}
+
+	if instance.Credentials != nil {
+		serviceInstance.Credentials = types.NewOptionalObject(instance.Credentials)
+	}
+	if instance.RouteServiceURL != "" {
+		serviceInstance.RouteServiceURL = types.NewOptionalString(instance.RouteServiceURL)
+	}
+	if instance.SyslogDrainURL != "" {
+		serviceInstance.SyslogDrainURL = types.NewOptionalString(instance.SyslogDrainURL)
+	}
+
+	return serviceInstance
+}
+
+func optionalTags(tags []string) types.OptionalStringSlice {
+	if len(tags) == 0 {
+		return types.OptionalStringSlice{}
+	}
+	return types.NewOptionalStringSlice(tags...)
+}
+
+func optionalObject(object map[string]interface{}) types.OptionalObject {
+	if object == nil {
+		return types.OptionalObject{}
+	}
+	return types.NewOptionalObject(object)
+}
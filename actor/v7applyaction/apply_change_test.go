@@ -0,0 +1,424 @@
+package v7applyaction_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	. "code.cloudfoundry.org/cli/actor/v7applyaction"
+	"code.cloudfoundry.org/cli/actor/v7applyaction/v7applyactionfakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ApplyChange", func() {
+	var (
+		actor               *Actor
+		fakeV7Actor         *v7applyactionfakes.FakeV7Actor
+		fakeNetworkingActor *v7applyactionfakes.FakeNetworkingActor
+
+		plan   Plan
+		change Change
+
+		warnings   Warnings
+		executeErr error
+
+		managedLabels map[string]types.NullString
+	)
+
+	jobStream := func(events ...v7action.PollJobEvent) chan v7action.PollJobEvent {
+		stream := make(chan v7action.PollJobEvent, len(events))
+		for _, event := range events {
+			stream <- event
+		}
+		close(stream)
+		return stream
+	}
+
+	BeforeEach(func() {
+		actor, fakeV7Actor, fakeNetworkingActor = getTestApplyActor()
+		plan = Plan{OrgGUID: "some-org-guid", SpaceGUID: "some-space-guid", SpaceName: "some-space"}
+		managedLabels = map[string]types.NullString{"managed-by": types.NewNullString("cf-apply")}
+	})
+
+	JustBeforeEach(func() {
+		warnings, executeErr = actor.ApplyChange(plan, change)
+	})
+
+	Describe("applications", func() {
+		When("creating or updating an app", func() {
+			BeforeEach(func() {
+				change = Change{Action: ChangeCreate, Type: ApplicationResource, Name: "some-app", Application: manifestparser.Application{Name: "some-app"}}
+				fakeV7Actor.SetSpaceManifestReturns(v7action.Warnings{"set-manifest-warning"}, nil)
+				fakeV7Actor.UpdateApplicationLabelsByApplicationNameReturns(v7action.Warnings{"labels-warning"}, nil)
+			})
+
+			It("applies a manifest for the app and labels it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("set-manifest-warning", "labels-warning"))
+
+				spaceGUID, rawManifest := fakeV7Actor.SetSpaceManifestArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(string(rawManifest)).To(Equal("applications:\n- name: some-app\n"))
+
+				appName, spaceGUID, labels := fakeV7Actor.UpdateApplicationLabelsByApplicationNameArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(labels).To(Equal(managedLabels))
+			})
+
+			When("applying the manifest fails", func() {
+				BeforeEach(func() {
+					fakeV7Actor.SetSpaceManifestReturns(v7action.Warnings{"set-manifest-warning"}, errors.New("set-manifest-error"))
+				})
+
+				It("returns the error without labeling the app", func() {
+					Expect(executeErr).To(MatchError("set-manifest-error"))
+					Expect(warnings).To(ConsistOf("set-manifest-warning"))
+					Expect(fakeV7Actor.UpdateApplicationLabelsByApplicationNameCallCount()).To(Equal(0))
+				})
+			})
+			When("the app's routes have weights", func() {
+				BeforeEach(func() {
+					change.Application.RemainingManifestFields = map[string]interface{}{
+						"routes": []interface{}{
+							map[interface{}]interface{}{"route": "canary.example.com", "weight": 10},
+						},
+					}
+					fakeV7Actor.SetRouteDestinationWeightsByAppNameReturns(v7action.Warnings{"weights-warning"}, nil)
+				})
+
+				It("applies the manifest without the weights and then sets them", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("set-manifest-warning", "weights-warning", "labels-warning"))
+
+					_, rawManifest := fakeV7Actor.SetSpaceManifestArgsForCall(0)
+					Expect(string(rawManifest)).To(Equal("applications:\n- name: some-app\n  routes:\n  - route: canary.example.com\n"))
+
+					Expect(fakeV7Actor.SetRouteDestinationWeightsByAppNameCallCount()).To(Equal(1))
+					route, spaceGUID, weights := fakeV7Actor.SetRouteDestinationWeightsByAppNameArgsForCall(0)
+					Expect(route).To(Equal("canary.example.com"))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(weights).To(Equal(map[string]int{"some-app": 10}))
+				})
+			})
+		})
+
+		When("deleting an app", func() {
+			BeforeEach(func() {
+				change = Change{Action: ChangeDelete, Type: ApplicationResource, Name: "some-app"}
+				fakeV7Actor.DeleteApplicationByNameAndSpaceReturns(v7action.Warnings{"delete-warning"}, nil)
+			})
+
+			It("deletes the app without its routes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("delete-warning"))
+				appName, spaceGUID, deleteRoutes := fakeV7Actor.DeleteApplicationByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(deleteRoutes).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("routes", func() {
+		var route manifestparser.SpaceRoute
+
+		BeforeEach(func() {
+			route = manifestparser.SpaceRoute{Host: "www", Domain: "example.com", Path: "/api", Apps: []string{"app-1", "app-2"}}
+			fakeV7Actor.GetApplicationsByNamesAndSpaceReturns([]resources.Application{
+				{Name: "app-1", GUID: "app-1-guid"},
+				{Name: "app-2", GUID: "app-2-guid"},
+			}, v7action.Warnings{"get-apps-warning"}, nil)
+		})
+
+		When("creating a route", func() {
+			BeforeEach(func() {
+				change = Change{Action: ChangeCreate, Type: RouteResource, Name: route.URL(), Route: route}
+				fakeV7Actor.CreateRouteReturns(resources.Route{GUID: "route-guid"}, v7action.Warnings{"create-warning"}, nil)
+			})
+
+			It("creates the route, maps the apps and labels it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("create-warning", "get-apps-warning"))
+
+				spaceGUID, domain, host, path, port := fakeV7Actor.CreateRouteArgsForCall(0)
+				Expect([]interface{}{spaceGUID, domain, host, path, port}).To(Equal([]interface{}{"some-space-guid", "example.com", "www", "/api", 0}))
+
+				Expect(fakeV7Actor.MapRouteCallCount()).To(Equal(2))
+				routeGUID, appGUID, _ := fakeV7Actor.MapRouteArgsForCall(1)
+				Expect(routeGUID).To(Equal("route-guid"))
+				Expect(appGUID).To(Equal("app-2-guid"))
+
+				routeName, spaceGUID, labels := fakeV7Actor.UpdateRouteLabelsArgsForCall(0)
+				Expect(routeName).To(Equal("www.example.com/api"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(labels).To(Equal(managedLabels))
+			})
+		})
+
+		When("updating a route", func() {
+			BeforeEach(func() {
+				change = Change{Action: ChangeUpdate, Type: RouteResource, Name: route.URL(), Route: route}
+				fakeV7Actor.GetRouteReturns(resources.Route{
+					GUID:         "route-guid",
+					Destinations: []resources.RouteDestination{{App: resources.RouteDestinationApp{GUID: "app-1-guid"}}},
+				}, nil, nil)
+			})
+
+			It("only maps the apps that are not mapped yet", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				routePath, spaceGUID := fakeV7Actor.GetRouteArgsForCall(0)
+				Expect(routePath).To(Equal("www.example.com/api"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+
+				Expect(fakeV7Actor.MapRouteCallCount()).To(Equal(1))
+				_, appGUID, _ := fakeV7Actor.MapRouteArgsForCall(0)
+				Expect(appGUID).To(Equal("app-2-guid"))
+			})
+		})
+
+		When("deleting a route", func() {
+			BeforeEach(func() {
+				change = Change{Action: ChangeDelete, Type: RouteResource, Name: route.URL(), Route: route}
+			})
+
+			It("deletes the route", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				domain, host, path, port := fakeV7Actor.DeleteRouteArgsForCall(0)
+				Expect([]interface{}{domain, host, path, port}).To(Equal([]interface{}{"example.com", "www", "/api", 0}))
+				Expect(fakeV7Actor.MapRouteCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("service instances", func() {
+		When("creating a managed service instance", func() {
+			BeforeEach(func() {
+				change = Change{Action: ChangeCreate, Type: ServiceInstanceResource, Name: "some-db", ServiceInstance: manifestparser.SpaceServiceInstance{
+					Name:       "some-db",
+					Offering:   "db",
+					Plan:       "small",
+					Broker:     "some-broker",
+					Tags:       []string{"sql"},
+					Parameters: map[string]interface{}{"size": "1GB"},
+				}}
+				fakeV7Actor.CreateManagedServiceInstanceReturns(
+					jobStream(v7action.PollJobEvent{State: v7action.JobPolling, Warnings: v7action.Warnings{"job-warning"}}),
+					v7action.Warnings{"create-warning"},
+					nil,
+				)
+			})
+
+			It("creates the instance, waits for it and labels it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("create-warning", "job-warning"))
+
+				Expect(fakeV7Actor.CreateManagedServiceInstanceArgsForCall(0)).To(Equal(v7action.CreateManagedServiceInstanceParams{
+					ServiceOfferingName: "db",
+					ServicePlanName:     "small",
+					ServiceInstanceName: "some-db",
+					ServiceBrokerName:   "some-broker",
+					SpaceGUID:           "some-space-guid",
+					Tags:                types.NewOptionalStringSlice("sql"),
+					Parameters:          types.NewOptionalObject(map[string]interface{}{"size": "1GB"}),
+				}))
+
+				name, spaceGUID, labels := fakeV7Actor.UpdateServiceInstanceLabelsArgsForCall(0)
+				Expect(name).To(Equal("some-db"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(labels).To(Equal(managedLabels))
+			})
+
+			When("the job fails", func() {
+				BeforeEach(func() {
+					fakeV7Actor.CreateManagedServiceInstanceReturns(
+						jobStream(v7action.PollJobEvent{State: v7action.JobFailed, Err: errors.New("job-error")}),
+						nil,
+						nil,
+					)
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("job-error"))
+					Expect(fakeV7Actor.UpdateServiceInstanceLabelsCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("updating a managed service instance", func() {
+			BeforeEach(func() {
+				change = Change{Action: ChangeUpdate, Type: ServiceInstanceResource, Name: "some-db", ServiceInstance: manifestparser.SpaceServiceInstance{
+					Name: "some-db", Offering: "db", Plan: "large",
+				}}
+				fakeV7Actor.UpdateManagedServiceInstanceReturns(jobStream(), nil, nil)
+			})
+
+			It("updates the instance", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeV7Actor.UpdateManagedServiceInstanceArgsForCall(0)).To(Equal(v7action.UpdateManagedServiceInstanceParams{
+					ServiceInstanceName: "some-db",
+					ServicePlanName:     "large",
+					SpaceGUID:           "some-space-guid",
+				}))
+			})
+		})
+
+		When("creating a user-provided service instance", func() {
+			BeforeEach(func() {
+				change = Change{Action: ChangeCreate, Type: ServiceInstanceResource, Name: "some-ups", ServiceInstance: manifestparser.SpaceServiceInstance{
+					Name:           "some-ups",
+					UserProvided:   true,
+					Credentials:    map[string]interface{}{"user": "admin"},
+					SyslogDrainURL: "syslog://drain",
+				}}
+			})
+
+			It("creates the instance", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeV7Actor.CreateUserProvidedServiceInstanceArgsForCall(0)).To(Equal(resources.ServiceInstance{
+					Type:           resources.UserProvidedServiceInstance,
+					Name:           "some-ups",
+					SpaceGUID:      "some-space-guid",
+					Credentials:    types.NewOptionalObject(map[string]interface{}{"user": "admin"}),
+					SyslogDrainURL: types.NewOptionalString("syslog://drain"),
+				}))
+				Expect(fakeV7Actor.UpdateServiceInstanceLabelsCallCount()).To(Equal(1))
+			})
+		})
+
+		When("updating a user-provided service instance", func() {
+			BeforeEach(func() {
+				change = Change{Action: ChangeUpdate, Type: ServiceInstanceResource, Name: "some-ups", ServiceInstance: manifestparser.SpaceServiceInstance{
+					Name:         "some-ups",
+					UserProvided: true,
+					Tags:         []string{"logs"},
+				}}
+			})
+
+			It("updates the instance", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				name, spaceGUID, updates := fakeV7Actor.UpdateUserProvidedServiceInstanceArgsForCall(0)
+				Expect(name).To(Equal("some-ups"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(updates).To(Equal(resources.ServiceInstance{
+					Type: resources.UserProvidedServiceInstance,
+					Tags: types.NewOptionalStringSlice("logs"),
+				}))
+			})
+		})
+
+		When("deleting a service instance", func() {
+			BeforeEach(func() {
+				change = Change{Action: ChangeDelete, Type: ServiceInstanceResource, Name: "some-db", ServiceInstance: manifestparser.SpaceServiceInstance{Name: "some-db"}}
+				fakeV7Actor.DeleteServiceInstanceReturns(jobStream(), v7action.Warnings{"delete-warning"}, nil)
+			})
+
+			It("deletes the instance without labeling it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("delete-warning"))
+				name, spaceGUID := fakeV7Actor.DeleteServiceInstanceArgsForCall(0)
+				Expect(name).To(Equal("some-db"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(fakeV7Actor.UpdateServiceInstanceLabelsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("service bindings", func() {
+		When("creating a binding", func() {
+			BeforeEach(func() {
+				change = Change{Action: ChangeCreate, Type: ServiceBindingResource, ServiceBinding: manifestparser.SpaceServiceBinding{
+					App: "some-app", ServiceInstance: "some-db", BindingName: "db",
+				}}
+				fakeV7Actor.CreateServiceAppBindingReturns(jobStream(), v7action.Warnings{"bind-warning"}, nil)
+			})
+
+			It("binds the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("bind-warning"))
+				Expect(fakeV7Actor.CreateServiceAppBindingArgsForCall(0)).To(Equal(v7action.CreateServiceAppBindingParams{
+					SpaceGUID:           "some-space-guid",
+					ServiceInstanceName: "some-db",
+					AppName:             "some-app",
+					BindingName:         "db",
+				}))
+			})
+		})
+
+		When("deleting a binding", func() {
+			BeforeEach(func() {
+				change = Change{Action: ChangeDelete, Type: ServiceBindingResource, ServiceBinding: manifestparser.SpaceServiceBinding{
+					App: "some-app", ServiceInstance: "some-db",
+				}}
+				fakeV7Actor.DeleteServiceAppBindingReturns(nil, nil, errors.New("unbind-error"))
+			})
+
+			It("unbinds the app", func() {
+				Expect(executeErr).To(MatchError("unbind-error"))
+				Expect(fakeV7Actor.DeleteServiceAppBindingArgsForCall(0)).To(Equal(v7action.DeleteServiceAppBindingParams{
+					SpaceGUID:           "some-space-guid",
+					ServiceInstanceName: "some-db",
+					AppName:             "some-app",
+				}))
+			})
+		})
+	})
+
+	Describe("network policies", func() {
+		BeforeEach(func() {
+			change = Change{Type: NetworkPolicyResource, NetworkPolicy: manifestparser.SpaceNetworkPolicy{
+				Source: "frontend", Destination: "backend", Ports: "8080-8090",
+			}}
+			fakeNetworkingActor.AddNetworkPolicyReturns(cfnetworkingaction.Warnings{"add-warning"}, nil)
+		})
+
+		When("creating a policy", func() {
+			BeforeEach(func() {
+				change.Action = ChangeCreate
+			})
+
+			It("adds the policy within the space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("add-warning"))
+				srcSpace, srcApp, destSpace, destApp, protocol, startPort, endPort := fakeNetworkingActor.AddNetworkPolicyArgsForCall(0)
+				Expect([]interface{}{srcSpace, srcApp, destSpace, destApp, protocol, startPort, endPort}).To(Equal([]interface{}{
+					"some-space-guid", "frontend", "some-space-guid", "backend", "tcp", 8080, 8090,
+				}))
+			})
+		})
+
+		When("deleting a policy", func() {
+			BeforeEach(func() {
+				change.Action = ChangeDelete
+			})
+
+			It("removes the policy", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeNetworkingActor.AddNetworkPolicyCallCount()).To(Equal(0))
+				Expect(fakeNetworkingActor.RemoveNetworkPolicyCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Describe("space labels", func() {
+		BeforeEach(func() {
+			change = Change{Action: ChangeUpdate, Type: SpaceLabelsResource, Name: "some-space", Labels: map[string]types.NullString{
+				"team": types.NewNullString("payments"),
+			}}
+			fakeV7Actor.UpdateSpaceLabelsBySpaceNameReturns(v7action.Warnings{"labels-warning"}, nil)
+		})
+
+		It("updates the space labels", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("labels-warning"))
+			spaceName, orgGUID, labels := fakeV7Actor.UpdateSpaceLabelsBySpaceNameArgsForCall(0)
+			Expect(spaceName).To(Equal("some-space"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(labels).To(Equal(change.Labels))
+		})
+	})
+})
@@ -0,0 +1,11 @@
+package v7applyaction
+
+import "code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . NetworkingActor
+
+type NetworkingActor interface {
+	AddNetworkPolicy(srcSpaceGUID, srcAppName, destSpaceGUID, destAppName, protocol string, startPort, endPort int) (cfnetworkingaction.Warnings, error)
+	NetworkPoliciesBySpace(spaceGUID string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
+	RemoveNetworkPolicy(srcSpaceGUID, srcAppName, destSpaceGUID, destAppName, protocol string, startPort, endPort int) (cfnetworkingaction.Warnings, error)
+}
@@ -0,0 +1,554 @@
+package v7applyaction
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+)
+
+type ChangeAction string
+
+const (
+	ChangeCreate ChangeAction = "create"
+	ChangeUpdate ChangeAction = "update"
+	ChangeDelete ChangeAction = "delete"
+)
+
+type ResourceType string
+
+const (
+	ApplicationResource     ResourceType = "app"
+	RouteResource           ResourceType = "route"
+	ServiceInstanceResource ResourceType = "service instance"
+	ServiceBindingResource  ResourceType = "service binding"
+	NetworkPolicyResource   ResourceType = "network policy"
+	SpaceLabelsResource     ResourceType = "space labels"
+)
+
+// Change is a single create, update or delete of a resource in the space. Only
+// the field matching Type is set.
+type Change struct {
+	Action ChangeAction
+	Type   ResourceType
+	Name   string
+
+	Application     manifestparser.Application
+	Route           manifestparser.SpaceRoute
+	ServiceInstance manifestparser.SpaceServiceInstance
+	ServiceBinding  manifestparser.SpaceServiceBinding
+	NetworkPolicy   manifestparser.SpaceNetworkPolicy
+	Labels          map[string]types.NullString
+}
+
+// Plan is the ordered list of changes needed to make a space match a space
+// manifest. Creates and updates come first, from apps down to space labels,
+// followed by deletes in the reverse order.
+type Plan struct {
+	OrgGUID   string
+	SpaceGUID string
+	SpaceName string
+	Changes   []Change
+}
+
+// spaceState is the current state of the resources in a space that a space
+// manifest can describe.
+type spaceState struct {
+	appList      []resources.Application
+	routeList    []resources.Route
+	instanceList []v7action.ServiceInstance
+	policies     []cfnetworkingaction.Policy
+
+	apps      map[string]resources.Application
+	routes    map[string]resources.Route
+	instances map[string]v7action.ServiceInstance
+}
+
+// CreatePlan compares the space manifest against the targeted space and
+// returns the changes needed to reconcile them. When prune is true, resources
+// labeled with ManagedByLabel that are no longer in the manifest are deleted.
+//
+// Service instance parameters, tags and credentials cannot be read back from
+// the Cloud Controller, so an existing service instance that declares any of
+// them is always updated.
+func (actor Actor) CreatePlan(orgGUID string, spaceGUID string, spaceName string, manifest manifestparser.SpaceManifest, prune bool) (Plan, Warnings, error) {
+	plan := Plan{
+		OrgGUID:   orgGUID,
+		SpaceGUID: spaceGUID,
+		SpaceName: spaceName,
+	}
+
+	state, allWarnings, err := actor.getSpaceState(spaceGUID)
+	if err != nil {
+		return Plan{}, allWarnings, err
+	}
+
+	planFuncs := []func(Plan, manifestparser.SpaceManifest, spaceState) ([]Change, Warnings, error){
+		actor.planApplications,
+		actor.planRoutes,
+		actor.planServiceInstances,
+		actor.planServiceBindings,
+		actor.planNetworkPolicies,
+		actor.planSpaceLabels,
+	}
+	if prune {
+		planFuncs = append(planFuncs, actor.planPrune)
+	}
+
+	for _, planFunc := range planFuncs {
+		changes, warnings, err := planFunc(plan, manifest, state)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Plan{}, allWarnings, err
+		}
+		plan.Changes = append(plan.Changes, changes...)
+	}
+
+	return plan, allWarnings, nil
+}
+
+func (actor Actor) getSpaceState(spaceGUID string) (spaceState, Warnings, error) {
+	var allWarnings Warnings
+	state := spaceState{
+		apps:      map[string]resources.Application{},
+		routes:    map[string]resources.Route{},
+		instances: map[string]v7action.ServiceInstance{},
+	}
+
+	apps, warnings, err := actor.V7Actor.GetApplicationsBySpace(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return spaceState{}, allWarnings, err
+	}
+	state.appList = apps
+	for _, app := range apps {
+		state.apps[app.Name] = app
+	}
+
+	routes, warnings, err := actor.V7Actor.GetRoutesBySpace(spaceGUID, "")
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return spaceState{}, allWarnings, err
+	}
+	state.routeList = routes
+	for _, route := range routes {
+		state.routes[route.URL] = route
+	}
+
+	instances, warnings, err := actor.V7Actor.GetServiceInstancesForSpace(spaceGUID, false)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return spaceState{}, allWarnings, err
+	}
+	state.instanceList = instances
+	for _, instance := range instances {
+		state.instances[instance.Name] = instance
+	}
+
+	policies, networkingWarnings, err := actor.NetworkingActor.NetworkPoliciesBySpace(spaceGUID)
+	allWarnings = append(allWarnings, networkingWarnings...)
+	if err != nil {
+		return spaceState{}, allWarnings, err
+	}
+	state.policies = policies
+
+	return state, allWarnings, nil
+}
+
+func (actor Actor) planApplications(plan Plan, manifest manifestparser.SpaceManifest, state spaceState) ([]Change, Warnings, error) {
+	var (
+		changes     []Change
+		allWarnings Warnings
+	)
+
+	for _, app := range manifest.Applications {
+		change := Change{Action: ChangeUpdate, Type: ApplicationResource, Name: app.Name, Application: app}
+
+		existingApp, exists := state.apps[app.Name]
+		if !exists {
+			change.Action = ChangeCreate
+			changes = append(changes, change)
+			continue
+		}
+
+		if !isManaged(metadataLabels(existingApp.Metadata)) {
+			changes = append(changes, change)
+			continue
+		}
+
+		rawManifest, _, err := marshalApplicationManifest(app)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		diff, warnings, err := actor.V7Actor.DiffSpaceManifest(plan.SpaceGUID, rawManifest)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			if _, isUnexpectedError := err.(ccerror.V3UnexpectedResponseError); !isUnexpectedError {
+				return nil, allWarnings, err
+			}
+			changes = append(changes, change)
+			continue
+		}
+
+		if len(diff.Diffs) > 0 {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes, allWarnings, nil
+}
+
+func (actor Actor) planRoutes(plan Plan, manifest manifestparser.SpaceManifest, state spaceState) ([]Change, Warnings, error) {
+	var changes []Change
+
+	for _, route := range manifest.Routes {
+		change := Change{Action: ChangeUpdate, Type: RouteResource, Name: route.URL(), Route: route}
+
+		existingRoute, exists := state.routes[route.URL()]
+		if !exists {
+			change.Action = ChangeCreate
+			changes = append(changes, change)
+			continue
+		}
+
+		if !isManaged(metadataLabels(existingRoute.Metadata)) || !routeHasApps(existingRoute, route.Apps, state.apps) {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes, nil, nil
+}
+
+func (actor Actor) planServiceInstances(plan Plan, manifest manifestparser.SpaceManifest, state spaceState) ([]Change, Warnings, error) {
+	var changes []Change
+
+	for _, instance := range manifest.ServiceInstances {
+		change := Change{Action: ChangeUpdate, Type: ServiceInstanceResource, Name: instance.Name, ServiceInstance: instance}
+
+		existingInstance, exists := state.instances[instance.Name]
+		if !exists {
+			change.Action = ChangeCreate
+			changes = append(changes, change)
+			continue
+		}
+
+		requiredType := resources.ManagedServiceInstance
+		if instance.UserProvided {
+			requiredType = resources.UserProvidedServiceInstance
+		}
+		if existingInstance.Type != requiredType {
+			return nil, nil, actionerror.ServiceInstanceTypeError{Name: instance.Name, RequiredType: requiredType}
+		}
+
+		if serviceInstanceNeedsUpdate(instance, existingInstance) {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes, nil, nil
+}
+
+func (actor Actor) planServiceBindings(plan Plan, manifest manifestparser.SpaceManifest, state spaceState) ([]Change, Warnings, error) {
+	var changes []Change
+
+	for _, binding := range manifest.ServiceBindings {
+		if existingInstance, exists := state.instances[binding.ServiceInstance]; exists && containsString(existingInstance.BoundApps, binding.App) {
+			continue
+		}
+
+		changes = append(changes, Change{
+			Action:         ChangeCreate,
+			Type:           ServiceBindingResource,
+			Name:           bindingName(binding),
+			ServiceBinding: binding,
+		})
+	}
+
+	return changes, nil, nil
+}
+
+func (actor Actor) planNetworkPolicies(plan Plan, manifest manifestparser.SpaceManifest, state spaceState) ([]Change, Warnings, error) {
+	existingPolicies := map[string]bool{}
+	for _, policy := range state.policies {
+		if policy.DestinationSpaceName == plan.SpaceName {
+			existingPolicies[existingPolicyKey(policy)] = true
+		}
+	}
+
+	var changes []Change
+	for _, policy := range manifest.NetworkPolicies {
+		key, err := policyKey(policy)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if existingPolicies[key] {
+			continue
+		}
+
+		changes = append(changes, Change{
+			Action:        ChangeCreate,
+			Type:          NetworkPolicyResource,
+			Name:          policyName(policy),
+			NetworkPolicy: policy,
+		})
+	}
+
+	return changes, nil, nil
+}
+
+func (actor Actor) planSpaceLabels(plan Plan, manifest manifestparser.SpaceManifest, state spaceState) ([]Change, Warnings, error) {
+	if len(manifest.Labels) == 0 {
+		return nil, nil, nil
+	}
+
+	existingLabels, warnings, err := actor.V7Actor.GetSpaceLabels(plan.SpaceName, plan.OrgGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	labels := map[string]types.NullString{}
+	for key, value := range manifest.Labels {
+		if existingLabels[key] != types.NewNullString(value) {
+			labels[key] = types.NewNullString(value)
+		}
+	}
+
+	if len(labels) == 0 {
+		return nil, Warnings(warnings), nil
+	}
+
+	return []Change{{
+		Action: ChangeUpdate,
+		Type:   SpaceLabelsResource,
+		Name:   plan.SpaceName,
+		Labels: labels,
+	}}, Warnings(warnings), nil
+}
+
+// planPrune deletes the managed resources that are no longer in the manifest.
+// Network policies and service bindings are deleted before the resources they
+// refer to.
+func (actor Actor) planPrune(plan Plan, manifest manifestparser.SpaceManifest, state spaceState) ([]Change, Warnings, error) {
+	var (
+		allWarnings Warnings
+		changes     []Change
+	)
+
+	desiredApps := map[string]bool{}
+	for _, app := range manifest.Applications {
+		desiredApps[app.Name] = true
+	}
+
+	desiredPolicies := map[string]bool{}
+	for _, policy := range manifest.NetworkPolicies {
+		key, err := policyKey(policy)
+		if err != nil {
+			return nil, nil, err
+		}
+		desiredPolicies[key] = true
+	}
+
+	for _, policy := range state.policies {
+		sourceApp, sourceExists := state.apps[policy.SourceName]
+		if policy.DestinationSpaceName != plan.SpaceName || !sourceExists || desiredPolicies[existingPolicyKey(policy)] {
+			continue
+		}
+		if !isManaged(metadataLabels(sourceApp.Metadata)) {
+			continue
+		}
+
+		spacePolicy := manifestparser.SpaceNetworkPolicy{
+			Source:      policy.SourceName,
+			Destination: policy.DestinationName,
+			Protocol:    policy.Protocol,
+			Ports:       portRange(policy.StartPort, policy.EndPort),
+		}
+		changes = append(changes, Change{
+			Action:        ChangeDelete,
+			Type:          NetworkPolicyResource,
+			Name:          policyName(spacePolicy),
+			NetworkPolicy: spacePolicy,
+		})
+	}
+
+	desiredInstances := map[string]bool{}
+	for _, instance := range manifest.ServiceInstances {
+		desiredInstances[instance.Name] = true
+	}
+
+	var instanceChanges []Change
+	for _, instance := range state.instanceList {
+		if desiredInstances[instance.Name] {
+			continue
+		}
+
+		labels, warnings, err := actor.V7Actor.GetServiceInstanceLabels(instance.Name, plan.SpaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		if !isManaged(labels) {
+			continue
+		}
+
+		for _, appName := range instance.BoundApps {
+			binding := manifestparser.SpaceServiceBinding{App: appName, ServiceInstance: instance.Name}
+			changes = append(changes, Change{
+				Action:         ChangeDelete,
+				Type:           ServiceBindingResource,
+				Name:           bindingName(binding),
+				ServiceBinding: binding,
+			})
+		}
+
+		instanceChanges = append(instanceChanges, Change{
+			Action:          ChangeDelete,
+			Type:            ServiceInstanceResource,
+			Name:            instance.Name,
+			ServiceInstance: manifestparser.SpaceServiceInstance{Name: instance.Name},
+		})
+	}
+	changes = append(changes, instanceChanges...)
+
+	desiredRoutes := map[string]bool{}
+	for _, route := range manifest.Routes {
+		desiredRoutes[route.URL()] = true
+	}
+
+	for _, route := range state.routeList {
+		if desiredRoutes[route.URL] || !isManaged(metadataLabels(route.Metadata)) {
+			continue
+		}
+
+		changes = append(changes, Change{
+			Action: ChangeDelete,
+			Type:   RouteResource,
+			Name:   route.URL,
+			Route:  spaceRouteFor(route),
+		})
+	}
+
+	for _, app := range state.appList {
+		if desiredApps[app.Name] || !isManaged(metadataLabels(app.Metadata)) {
+			continue
+		}
+
+		changes = append(changes, Change{
+			Action:      ChangeDelete,
+			Type:        ApplicationResource,
+			Name:        app.Name,
+			Application: manifestparser.Application{Name: app.Name},
+		})
+	}
+
+	return changes, allWarnings, nil
+}
+
+func serviceInstanceNeedsUpdate(instance manifestparser.SpaceServiceInstance, existingInstance v7action.ServiceInstance) bool {
+	if len(instance.Tags) > 0 {
+		return true
+	}
+
+	if instance.UserProvided {
+		return instance.Credentials != nil || instance.RouteServiceURL != "" || instance.SyslogDrainURL != ""
+	}
+
+	return instance.Plan != existingInstance.ServicePlanName || instance.Parameters != nil
+}
+
+func routeHasApps(route resources.Route, appNames []string, apps map[string]resources.Application) bool {
+	for _, appName := range appNames {
+		app, exists := apps[appName]
+		if !exists {
+			return false
+		}
+
+		mapped := false
+		for _, destination := range route.Destinations {
+			if destination.App.GUID == app.GUID {
+				mapped = true
+				break
+			}
+		}
+		if !mapped {
+			return false
+		}
+	}
+
+	return true
+}
+
+// spaceRouteFor recovers the domain name of an existing route from its URL,
+// which has the form [host.]domain[:port][/path].
+func spaceRouteFor(route resources.Route) manifestparser.SpaceRoute {
+	domain := strings.TrimSuffix(route.URL, route.Path)
+	if route.Port != 0 {
+		domain = strings.TrimSuffix(domain, ":"+strconv.Itoa(route.Port))
+	}
+	if route.Host != "" {
+		domain = strings.TrimPrefix(domain, route.Host+".")
+	}
+
+	return manifestparser.SpaceRoute{
+		Host:   route.Host,
+		Domain: domain,
+		Path:   route.Path,
+		Port:   route.Port,
+	}
+}
+
+func metadataLabels(metadata *resources.Metadata) map[string]types.NullString {
+	if metadata == nil {
+		return nil
+	}
+	return metadata.Labels
+}
+
+func policyKey(policy manifestparser.SpaceNetworkPolicy) (string, error) {
+	startPort, endPort, err := policy.PortRange()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s|%s|%s|%d|%d", policy.Source, policy.Destination, policy.ProtocolOrDefault(), startPort, endPort), nil
+}
+
+func existingPolicyKey(policy cfnetworkingaction.Policy) string {
+	return fmt.Sprintf("%s|%s|%s|%d|%d", policy.SourceName, policy.DestinationName, policy.Protocol, policy.StartPort, policy.EndPort)
+}
+
+func policyName(policy manifestparser.SpaceNetworkPolicy) string {
+	ports := policy.Ports
+	if ports == "" {
+		ports = manifestparser.DefaultNetworkPolicyPorts
+	}
+	return fmt.Sprintf("%s -> %s (%s %s)", policy.Source, policy.Destination, policy.ProtocolOrDefault(), ports)
+}
+
+func bindingName(binding manifestparser.SpaceServiceBinding) string {
+	return fmt.Sprintf("%s -> %s", binding.App, binding.ServiceInstance)
+}
+
+func portRange(startPort int, endPort int) string {
+	if startPort == endPort {
+		return strconv.Itoa(startPort)
+	}
+	return fmt.Sprintf("%d-%d", startPort, endPort)
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
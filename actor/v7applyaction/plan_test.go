@@ -0,0 +1,347 @@
+package v7applyaction_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	. "code.cloudfoundry.org/cli/actor/v7applyaction"
+	"code.cloudfoundry.org/cli/actor/v7applyaction/v7applyactionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CreatePlan", func() {
+	var (
+		actor               *Actor
+		fakeV7Actor         *v7applyactionfakes.FakeV7Actor
+		fakeNetworkingActor *v7applyactionfakes.FakeNetworkingActor
+
+		manifest manifestparser.SpaceManifest
+		prune    bool
+
+		plan       Plan
+		warnings   Warnings
+		executeErr error
+
+		managed *resources.Metadata
+	)
+
+	BeforeEach(func() {
+		actor, fakeV7Actor, fakeNetworkingActor = getTestApplyActor()
+		manifest = manifestparser.SpaceManifest{}
+		prune = false
+		managed = &resources.Metadata{Labels: map[string]types.NullString{
+			"managed-by": types.NewNullString("cf-apply"),
+		}}
+
+		fakeV7Actor.GetApplicationsBySpaceReturns(nil, v7action.Warnings{"get-apps-warning"}, nil)
+		fakeV7Actor.GetRoutesBySpaceReturns(nil, v7action.Warnings{"get-routes-warning"}, nil)
+		fakeV7Actor.GetServiceInstancesForSpaceReturns(nil, v7action.Warnings{"get-instances-warning"}, nil)
+		fakeNetworkingActor.NetworkPoliciesBySpaceReturns(nil, cfnetworkingaction.Warnings{"get-policies-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		plan, warnings, executeErr = actor.CreatePlan("some-org-guid", "some-space-guid", "some-space", manifest, prune)
+	})
+
+	It("reads the current state of the space", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(warnings).To(ConsistOf("get-apps-warning", "get-routes-warning", "get-instances-warning", "get-policies-warning"))
+
+		Expect(fakeV7Actor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+		spaceGUID, labelSelector := fakeV7Actor.GetRoutesBySpaceArgsForCall(0)
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(labelSelector).To(BeEmpty())
+		spaceGUID, omitApps := fakeV7Actor.GetServiceInstancesForSpaceArgsForCall(0)
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(omitApps).To(BeFalse())
+		Expect(fakeNetworkingActor.NetworkPoliciesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+
+		Expect(plan.OrgGUID).To(Equal("some-org-guid"))
+		Expect(plan.SpaceGUID).To(Equal("some-space-guid"))
+		Expect(plan.SpaceName).To(Equal("some-space"))
+		Expect(plan.Changes).To(BeEmpty())
+	})
+
+	When("reading the space fails", func() {
+		BeforeEach(func() {
+			fakeV7Actor.GetRoutesBySpaceReturns(nil, v7action.Warnings{"get-routes-warning"}, errors.New("get-routes-error"))
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError("get-routes-error"))
+			Expect(warnings).To(ConsistOf("get-apps-warning", "get-routes-warning"))
+		})
+	})
+
+	Describe("applications", func() {
+		BeforeEach(func() {
+			manifest.Applications = []manifestparser.Application{{Name: "new-app"}, {Name: "unmanaged-app"}, {Name: "managed-app"}}
+			fakeV7Actor.GetApplicationsBySpaceReturns([]resources.Application{
+				{Name: "unmanaged-app", GUID: "unmanaged-app-guid"},
+				{Name: "managed-app", GUID: "managed-app-guid", Metadata: managed},
+			}, nil, nil)
+		})
+
+		When("the managed app has no differences", func() {
+			BeforeEach(func() {
+				fakeV7Actor.DiffSpaceManifestReturns(resources.ManifestDiff{}, v7action.Warnings{"diff-warning"}, nil)
+			})
+
+			It("creates new apps and adopts unmanaged ones", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ContainElement("diff-warning"))
+				Expect(plan.Changes).To(Equal([]Change{
+					{Action: ChangeCreate, Type: ApplicationResource, Name: "new-app", Application: manifestparser.Application{Name: "new-app"}},
+					{Action: ChangeUpdate, Type: ApplicationResource, Name: "unmanaged-app", Application: manifestparser.Application{Name: "unmanaged-app"}},
+				}))
+
+				Expect(fakeV7Actor.DiffSpaceManifestCallCount()).To(Equal(1))
+				spaceGUID, rawManifest := fakeV7Actor.DiffSpaceManifestArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(string(rawManifest)).To(ContainSubstring("name: managed-app"))
+				Expect(string(rawManifest)).ToNot(ContainSubstring("new-app"))
+			})
+		})
+
+		When("the managed app has differences", func() {
+			BeforeEach(func() {
+				fakeV7Actor.DiffSpaceManifestReturns(resources.ManifestDiff{Diffs: []resources.Diff{{Op: resources.ReplaceOperation, Path: "/applications/0/instances"}}}, nil, nil)
+			})
+
+			It("updates the app", func() {
+				Expect(plan.Changes).To(HaveLen(3))
+				Expect(plan.Changes[2].Action).To(Equal(ChangeUpdate))
+				Expect(plan.Changes[2].Name).To(Equal("managed-app"))
+			})
+		})
+
+		When("the Cloud Controller cannot diff the manifest", func() {
+			BeforeEach(func() {
+				fakeV7Actor.DiffSpaceManifestReturns(resources.ManifestDiff{}, nil, ccerror.V3UnexpectedResponseError{})
+			})
+
+			It("updates the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(plan.Changes).To(HaveLen(3))
+			})
+		})
+
+		When("diffing the manifest fails", func() {
+			BeforeEach(func() {
+				fakeV7Actor.DiffSpaceManifestReturns(resources.ManifestDiff{}, nil, errors.New("diff-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("diff-error"))
+			})
+		})
+	})
+
+	Describe("routes", func() {
+		BeforeEach(func() {
+			manifest.Routes = []manifestparser.SpaceRoute{
+				{Host: "new", Domain: "example.com"},
+				{Host: "mapped", Domain: "example.com", Apps: []string{"some-app"}},
+				{Host: "unmapped", Domain: "example.com", Apps: []string{"some-app"}},
+			}
+			fakeV7Actor.GetApplicationsBySpaceReturns([]resources.Application{{Name: "some-app", GUID: "some-app-guid"}}, nil, nil)
+
+			destinations := []resources.RouteDestination{{App: resources.RouteDestinationApp{GUID: "some-app-guid"}}}
+			fakeV7Actor.GetRoutesBySpaceReturns([]resources.Route{
+				{URL: "mapped.example.com", Destinations: destinations, Metadata: managed},
+				{URL: "unmapped.example.com", Metadata: managed},
+			}, nil, nil)
+		})
+
+		It("creates missing routes and updates routes missing destinations", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(plan.Changes).To(Equal([]Change{
+				{Action: ChangeCreate, Type: RouteResource, Name: "new.example.com", Route: manifest.Routes[0]},
+				{Action: ChangeUpdate, Type: RouteResource, Name: "unmapped.example.com", Route: manifest.Routes[2]},
+			}))
+		})
+	})
+
+	Describe("service instances and bindings", func() {
+		BeforeEach(func() {
+			manifest.ServiceInstances = []manifestparser.SpaceServiceInstance{
+				{Name: "new-db", Offering: "db", Plan: "small"},
+				{Name: "same-db", Offering: "db", Plan: "small"},
+				{Name: "resized-db", Offering: "db", Plan: "large"},
+				{Name: "ups", UserProvided: true, Credentials: map[string]interface{}{"user": "admin"}},
+			}
+			manifest.ServiceBindings = []manifestparser.SpaceServiceBinding{
+				{App: "some-app", ServiceInstance: "same-db"},
+				{App: "some-app", ServiceInstance: "new-db"},
+			}
+			fakeV7Actor.GetServiceInstancesForSpaceReturns([]v7action.ServiceInstance{
+				{Name: "same-db", Type: resources.ManagedServiceInstance, ServicePlanName: "small", BoundApps: []string{"some-app"}},
+				{Name: "resized-db", Type: resources.ManagedServiceInstance, ServicePlanName: "small"},
+				{Name: "ups", Type: resources.UserProvidedServiceInstance},
+			}, nil, nil)
+		})
+
+		It("creates and updates service instances and creates missing bindings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(plan.Changes).To(Equal([]Change{
+				{Action: ChangeCreate, Type: ServiceInstanceResource, Name: "new-db", ServiceInstance: manifest.ServiceInstances[0]},
+				{Action: ChangeUpdate, Type: ServiceInstanceResource, Name: "resized-db", ServiceInstance: manifest.ServiceInstances[2]},
+				{Action: ChangeUpdate, Type: ServiceInstanceResource, Name: "ups", ServiceInstance: manifest.ServiceInstances[3]},
+				{Action: ChangeCreate, Type: ServiceBindingResource, Name: "some-app -> new-db", ServiceBinding: manifest.ServiceBindings[1]},
+			}))
+		})
+
+		When("an existing service instance has a different type", func() {
+			BeforeEach(func() {
+				manifest.ServiceInstances = []manifestparser.SpaceServiceInstance{{Name: "same-db", UserProvided: true}}
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceInstanceTypeError{
+					Name:         "same-db",
+					RequiredType: resources.UserProvidedServiceInstance,
+				}))
+			})
+		})
+	})
+
+	Describe("network policies", func() {
+		BeforeEach(func() {
+			manifest.NetworkPolicies = []manifestparser.SpaceNetworkPolicy{
+				{Source: "frontend", Destination: "backend"},
+				{Source: "frontend", Destination: "worker", Protocol: "udp", Ports: "9000-9010"},
+			}
+			fakeNetworkingActor.NetworkPoliciesBySpaceReturns([]cfnetworkingaction.Policy{
+				{SourceName: "frontend", DestinationName: "backend", Protocol: "tcp", StartPort: 8080, EndPort: 8080, DestinationSpaceName: "some-space"},
+			}, nil, nil)
+		})
+
+		It("creates the missing policies", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(plan.Changes).To(Equal([]Change{
+				{Action: ChangeCreate, Type: NetworkPolicyResource, Name: "frontend -> worker (udp 9000-9010)", NetworkPolicy: manifest.NetworkPolicies[1]},
+			}))
+		})
+	})
+
+	Describe("space labels", func() {
+		BeforeEach(func() {
+			manifest.Labels = map[string]string{"team": "payments", "env": "prod"}
+			fakeV7Actor.GetSpaceLabelsReturns(map[string]types.NullString{
+				"env":   types.NewNullString("prod"),
+				"other": types.NewNullString("value"),
+			}, v7action.Warnings{"get-labels-warning"}, nil)
+		})
+
+		It("updates the labels that differ", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ContainElement("get-labels-warning"))
+
+			spaceName, orgGUID := fakeV7Actor.GetSpaceLabelsArgsForCall(0)
+			Expect(spaceName).To(Equal("some-space"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+
+			Expect(plan.Changes).To(Equal([]Change{{
+				Action: ChangeUpdate,
+				Type:   SpaceLabelsResource,
+				Name:   "some-space",
+				Labels: map[string]types.NullString{"team": types.NewNullString("payments")},
+			}}))
+		})
+	})
+
+	Describe("pruning", func() {
+		BeforeEach(func() {
+			manifest.Applications = []manifestparser.Application{{Name: "kept-app"}}
+			fakeV7Actor.GetApplicationsBySpaceReturns([]resources.Application{
+				{Name: "kept-app", GUID: "kept-app-guid", Metadata: managed},
+				{Name: "old-app", GUID: "old-app-guid", Metadata: managed},
+				{Name: "unmanaged-app", GUID: "unmanaged-app-guid"},
+			}, nil, nil)
+			fakeV7Actor.GetRoutesBySpaceReturns([]resources.Route{
+				{URL: "old.example.com/api", Host: "old", Path: "/api", Metadata: managed},
+				{URL: "tcp.example.com:1024", Port: 1024, Metadata: managed},
+				{URL: "unmanaged.example.com", Host: "unmanaged"},
+			}, nil, nil)
+			fakeV7Actor.GetServiceInstancesForSpaceReturns([]v7action.ServiceInstance{
+				{Name: "old-db", BoundApps: []string{"kept-app"}},
+				{Name: "unmanaged-db"},
+			}, nil, nil)
+			fakeV7Actor.GetServiceInstanceLabelsStub = func(name string, spaceGUID string) (map[string]types.NullString, v7action.Warnings, error) {
+				if name == "old-db" {
+					return managed.Labels, v7action.Warnings{"get-instance-labels-warning"}, nil
+				}
+				return nil, nil, nil
+			}
+			fakeNetworkingActor.NetworkPoliciesBySpaceReturns([]cfnetworkingaction.Policy{
+				{SourceName: "old-app", DestinationName: "kept-app", Protocol: "tcp", StartPort: 8080, EndPort: 8090, DestinationSpaceName: "some-space"},
+				{SourceName: "unmanaged-app", DestinationName: "kept-app", Protocol: "tcp", StartPort: 8080, EndPort: 8080, DestinationSpaceName: "some-space"},
+			}, nil, nil)
+			fakeV7Actor.DiffSpaceManifestReturns(resources.ManifestDiff{}, nil, nil)
+		})
+
+		When("prune is not set", func() {
+			It("does not delete anything", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(plan.Changes).To(BeEmpty())
+				Expect(fakeV7Actor.GetServiceInstanceLabelsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("prune is set", func() {
+			BeforeEach(func() {
+				prune = true
+			})
+
+			It("deletes the managed resources that are not in the manifest", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ContainElement("get-instance-labels-warning"))
+				Expect(plan.Changes).To(Equal([]Change{
+					{
+						Action:        ChangeDelete,
+						Type:          NetworkPolicyResource,
+						Name:          "old-app -> kept-app (tcp 8080-8090)",
+						NetworkPolicy: manifestparser.SpaceNetworkPolicy{Source: "old-app", Destination: "kept-app", Protocol: "tcp", Ports: "8080-8090"},
+					},
+					{
+						Action:         ChangeDelete,
+						Type:           ServiceBindingResource,
+						Name:           "kept-app -> old-db",
+						ServiceBinding: manifestparser.SpaceServiceBinding{App: "kept-app", ServiceInstance: "old-db"},
+					},
+					{
+						Action:          ChangeDelete,
+						Type:            ServiceInstanceResource,
+						Name:            "old-db",
+						ServiceInstance: manifestparser.SpaceServiceInstance{Name: "old-db"},
+					},
+					{
+						Action: ChangeDelete,
+						Type:   RouteResource,
+						Name:   "old.example.com/api",
+						Route:  manifestparser.SpaceRoute{Host: "old", Domain: "example.com", Path: "/api"},
+					},
+					{
+						Action: ChangeDelete,
+						Type:   RouteResource,
+						Name:   "tcp.example.com:1024",
+						Route:  manifestparser.SpaceRoute{Domain: "tcp.example.com", Port: 1024},
+					},
+					{
+						Action:      ChangeDelete,
+						Type:        ApplicationResource,
+						Name:        "old-app",
+						Application: manifestparser.Application{Name: "old-app"},
+					},
+				}))
+			})
+		})
+	})
+})
@@ -0,0 +1,37 @@
+package v7applyaction
+
+import (
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . V7Actor
+
+type V7Actor interface {
+	CreateManagedServiceInstance(params v7action.CreateManagedServiceInstanceParams) (chan v7action.PollJobEvent, v7action.Warnings, error)
+	CreateRoute(spaceGUID, domainName, hostname, path string, port int) (resources.Route, v7action.Warnings, error)
+	CreateServiceAppBinding(params v7action.CreateServiceAppBindingParams) (chan v7action.PollJobEvent, v7action.Warnings, error)
+	CreateUserProvidedServiceInstance(instance resources.ServiceInstance) (v7action.Warnings, error)
+	DeleteApplicationByNameAndSpace(name, spaceGUID string, deleteRoutes bool) (v7action.Warnings, error)
+	DeleteRoute(domainName, hostname, path string, port int) (v7action.Warnings, error)
+	DeleteServiceAppBinding(params v7action.DeleteServiceAppBindingParams) (chan v7action.PollJobEvent, v7action.Warnings, error)
+	DeleteServiceInstance(serviceInstanceName, spaceGUID string) (chan v7action.PollJobEvent, v7action.Warnings, error)
+	DiffSpaceManifest(spaceGUID string, rawManifest []byte) (resources.ManifestDiff, v7action.Warnings, error)
+	GetApplicationsByNamesAndSpace(appNames []string, spaceGUID string) ([]resources.Application, v7action.Warnings, error)
+	GetApplicationsBySpace(spaceGUID string) ([]resources.Application, v7action.Warnings, error)
+	GetRoute(routePath string, spaceGUID string) (resources.Route, v7action.Warnings, error)
+	GetRoutesBySpace(spaceGUID string, labels string) ([]resources.Route, v7action.Warnings, error)
+	GetServiceInstanceLabels(serviceInstanceName, spaceGUID string) (map[string]types.NullString, v7action.Warnings, error)
+	GetServiceInstancesForSpace(spaceGUID string, omitApps bool) ([]v7action.ServiceInstance, v7action.Warnings, error)
+	GetSpaceLabels(spaceName string, orgGUID string) (map[string]types.NullString, v7action.Warnings, error)
+	MapRoute(routeGUID string, appGUID string, destinationProtocol string) (v7action.Warnings, error)
+	SetRouteDestinationWeightsByAppName(routePath string, spaceGUID string, weights map[string]int) (v7action.Warnings, error)
+	SetSpaceManifest(spaceGUID string, rawManifest []byte) (v7action.Warnings, error)
+	UpdateApplicationLabelsByApplicationName(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateManagedServiceInstance(params v7action.UpdateManagedServiceInstanceParams) (chan v7action.PollJobEvent, v7action.Warnings, error)
+	UpdateRouteLabels(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateServiceInstanceLabels(serviceInstanceName, spaceGUID string, labels map[string]types.NullString) (v7action.Warnings, error)
+	UpdateSpaceLabelsBySpaceName(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateUserProvidedServiceInstance(serviceInstanceName, spaceGUID string, serviceInstanceUpdates resources.ServiceInstance) (v7action.Warnings, error)
+}
@@ -0,0 +1,28 @@
+package v7applyaction_test
+
+import (
+	"testing"
+
+	. "code.cloudfoundry.org/cli/actor/v7applyaction"
+	"code.cloudfoundry.org/cli/actor/v7applyaction/v7applyactionfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func TestApplyAction(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "V7 Apply Actions Suite")
+}
+
+var _ = BeforeEach(func() {
+	log.SetLevel(log.PanicLevel)
+})
+
+func getTestApplyActor() (*Actor, *v7applyactionfakes.FakeV7Actor, *v7applyactionfakes.FakeNetworkingActor) {
+	fakeV7Actor := new(v7applyactionfakes.FakeV7Actor)
+	fakeNetworkingActor := new(v7applyactionfakes.FakeNetworkingActor)
+	actor := NewActor(fakeV7Actor, fakeNetworkingActor)
+	return actor, fakeV7Actor, fakeNetworkingActor
+}
@@ -4,6 +4,7 @@ package v7pushaction
 
 import (
 	"regexp"
+	"time"
 )
 
 // Warnings is a list of warnings returned back from the cloud controller
@@ -18,6 +19,13 @@ type Actor struct {
 	ChangeApplicationSequence func(plan PushPlan) []ChangeApplicationFunc
 	TransformManifestSequence []HandleFlagOverrideFunc
 
+	// PackageCache keeps built archives between pushes; archives are not
+	// cached when it is nil.
+	PackageCache PackageCache
+	// UploadRetryInterval is the time waited before retrying a failed
+	// upload; it doubles with every further retry.
+	UploadRetryInterval time.Duration
+
 	startWithProtocol *regexp.Regexp
 	urlValidator      *regexp.Regexp
 }
@@ -31,6 +39,8 @@ func NewActor(v3Actor V7Actor, sharedActor SharedActor) *Actor {
 		SharedActor: sharedActor,
 		V7Actor:     v3Actor,
 
+		UploadRetryInterval: DefaultUploadRetryInterval,
+
 		startWithProtocol: regexp.MustCompile(ProtocolRegexp),
 		urlValidator:      regexp.MustCompile(URLRegexp),
 	}
@@ -27,3 +27,88 @@ func (actor Actor) Actualize(plan PushPlan, progressBar ProgressBar) <-chan *Pus
 
 	return eventStream
 }
+
+// ActualizeAll applies the changes of several push plans, running up to
+// maxInFlight of them at the same time. A plan is only started once every
+// plan it depends on has completed; dependencies on apps that are not part of
+// plans are considered met.
+//
+// The events of all plans are sent on the returned stream, and an event with
+// ActualizeComplete is sent when a plan has completed successfully. Once a
+// plan fails no further plans are started; the stream is closed when the
+// plans already in flight have finished.
+func (actor Actor) ActualizeAll(plans []PushPlan, maxInFlight int, progressBar ProgressBar) <-chan *PushEvent {
+	log.WithField("max_in_flight", maxInFlight).Debugln("Starting to Actualize Push plans:", len(plans))
+	eventStream := make(chan *PushEvent)
+
+	if maxInFlight < 1 {
+		maxInFlight = 1
+	}
+
+	go func() {
+		defer close(eventStream)
+
+		pending := make(map[string]bool, len(plans))
+		for _, plan := range plans {
+			pending[plan.Application.Name] = true
+		}
+
+		started := make([]bool, len(plans))
+		results := make(chan actualizeResult)
+		inFlight := 0
+		failed := false
+
+		for {
+			for i, plan := range plans {
+				if failed || inFlight >= maxInFlight {
+					break
+				}
+				if started[i] || dependsOnPending(plan, pending) {
+					continue
+				}
+
+				started[i] = true
+				inFlight++
+				go func(plan PushPlan) {
+					results <- actor.actualizeAndForward(plan, progressBar, eventStream)
+				}(plan)
+			}
+
+			if inFlight == 0 {
+				log.Debug("completed apply of all plans")
+				return
+			}
+
+			result := <-results
+			inFlight--
+			if result.failed {
+				failed = true
+				continue
+			}
+			delete(pending, result.appName)
+		}
+	}()
+
+	return eventStream
+}
+
+type actualizeResult struct {
+	appName string
+	failed  bool
+}
+
+func (actor Actor) actualizeAndForward(plan PushPlan, progressBar ProgressBar, eventStream chan<- *PushEvent) actualizeResult {
+	log.WithField("app_name", plan.Application.Name).Info("actualizing")
+
+	finalPlan := plan
+	for event := range actor.Actualize(plan, progressBar) {
+		eventStream <- event
+		if event.Err != nil {
+			return actualizeResult{appName: plan.Application.Name, failed: true}
+		}
+		finalPlan = event.Plan
+	}
+
+	eventStream <- &PushEvent{Event: ActualizeComplete, Plan: finalPlan}
+	return actualizeResult{appName: plan.Application.Name}
+}
@@ -3,6 +3,7 @@ package v7pushaction_test
 import (
 	"errors"
 	"fmt"
+	"sync"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	. "code.cloudfoundry.org/cli/actor/v7pushaction"
@@ -11,6 +12,7 @@ import (
 	"code.cloudfoundry.org/cli/resources"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 )
 
 func streamsDrainedAndClosed(eventStream <-chan *PushEvent) bool {
@@ -148,3 +150,168 @@ var _ = Describe("Actualize", func() {
 		})
 	})
 })
+
+var _ = Describe("ActualizeAll", func() {
+	var (
+		actor *Actor
+
+		plans           []PushPlan
+		maxInFlight     int
+		fakeProgressBar *v7pushactionfakes.FakeProgressBar
+
+		mutex    sync.Mutex
+		started  []string
+		release  map[string]chan struct{}
+		failures map[string]error
+
+		eventStream <-chan *PushEvent
+	)
+
+	startedApps := func() []string {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return append([]string{}, started...)
+	}
+
+	finish := func(appName string) {
+		select {
+		case <-release[appName]:
+		default:
+			close(release[appName])
+		}
+	}
+
+	receiveUntilComplete := func(appName string) {
+		Eventually(eventStream).Should(Receive(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Event": Equal(ActualizeComplete),
+			"Plan":  MatchFields(IgnoreExtras, Fields{"Application": MatchFields(IgnoreExtras, Fields{"Name": Equal(appName)})}),
+		}))))
+	}
+
+	BeforeEach(func() {
+		actor, _, _ = getTestPushActor()
+		fakeProgressBar = new(v7pushactionfakes.FakeProgressBar)
+
+		started = nil
+		failures = map[string]error{}
+		release = map[string]chan struct{}{}
+		plans = nil
+		for _, name := range []string{"worker", "backend", "frontend"} {
+			release[name] = make(chan struct{})
+		}
+
+		maxInFlight = 1
+
+		actor.ChangeApplicationSequence = func(plan PushPlan) []ChangeApplicationFunc {
+			return []ChangeApplicationFunc{
+				func(pushPlan PushPlan, _ chan<- *PushEvent, progressBar ProgressBar) (PushPlan, Warnings, error) {
+					mutex.Lock()
+					started = append(started, pushPlan.Application.Name)
+					mutex.Unlock()
+
+					<-release[pushPlan.Application.Name]
+					pushPlan.Application.GUID = pushPlan.Application.Name + "-guid"
+					return pushPlan, Warnings{pushPlan.Application.Name + "-warning"}, failures[pushPlan.Application.Name]
+				},
+			}
+		}
+	})
+
+	AfterEach(func() {
+		for name := range release {
+			finish(name)
+		}
+		Eventually(streamsDrainedAndClosed(eventStream)).Should(BeTrue())
+	})
+
+	JustBeforeEach(func() {
+		eventStream = actor.ActualizeAll(plans, maxInFlight, fakeProgressBar)
+	})
+
+	When("the plans do not depend on each other", func() {
+		BeforeEach(func() {
+			maxInFlight = 2
+			plans = []PushPlan{
+				{Application: resources.Application{Name: "worker"}},
+				{Application: resources.Application{Name: "backend"}},
+				{Application: resources.Application{Name: "frontend"}},
+			}
+		})
+
+		It("runs up to maxInFlight plans at the same time", func() {
+			Eventually(startedApps).Should(ConsistOf("worker", "backend"))
+			Consistently(startedApps).Should(HaveLen(2))
+
+			finish("backend")
+			Eventually(eventStream).Should(Receive(Equal(&PushEvent{
+				Plan:     PushPlan{Application: resources.Application{Name: "backend", GUID: "backend-guid"}},
+				Warnings: Warnings{"backend-warning"},
+			})))
+			receiveUntilComplete("backend")
+			Eventually(startedApps).Should(ConsistOf("worker", "backend", "frontend"))
+
+			finish("worker")
+			receiveUntilComplete("worker")
+			finish("frontend")
+			receiveUntilComplete("frontend")
+			Eventually(eventStream).Should(BeClosed())
+		})
+	})
+
+	When("plans depend on each other", func() {
+		BeforeEach(func() {
+			maxInFlight = 3
+			plans = []PushPlan{
+				{Application: resources.Application{Name: "worker"}},
+				{Application: resources.Application{Name: "backend"}, DependsOn: []string{"worker", "database"}},
+				{Application: resources.Application{Name: "frontend"}, DependsOn: []string{"backend"}},
+			}
+		})
+
+		It("starts a plan only after its dependencies completed", func() {
+			Eventually(startedApps).Should(Equal([]string{"worker"}))
+			Consistently(startedApps).Should(HaveLen(1))
+
+			finish("worker")
+			receiveUntilComplete("worker")
+			Eventually(startedApps).Should(ConsistOf("worker", "backend"))
+			Consistently(startedApps).Should(HaveLen(2))
+
+			finish("backend")
+			receiveUntilComplete("backend")
+			Eventually(startedApps).Should(ConsistOf("worker", "backend", "frontend"))
+
+			finish("frontend")
+			receiveUntilComplete("frontend")
+			Eventually(eventStream).Should(BeClosed())
+		})
+	})
+
+	When("a plan fails", func() {
+		BeforeEach(func() {
+			maxInFlight = 2
+			failures["worker"] = errors.New("worker-error")
+			plans = []PushPlan{
+				{Application: resources.Application{Name: "worker"}},
+				{Application: resources.Application{Name: "backend"}},
+				{Application: resources.Application{Name: "frontend"}},
+			}
+		})
+
+		It("finishes the plans in flight and does not start any others", func() {
+			Eventually(startedApps).Should(ConsistOf("worker", "backend"))
+
+			finish("worker")
+			Eventually(eventStream).Should(Receive(Equal(&PushEvent{
+				Plan:     PushPlan{Application: resources.Application{Name: "worker", GUID: "worker-guid"}},
+				Warnings: Warnings{"worker-warning"},
+				Err:      errors.New("worker-error"),
+			})))
+
+			finish("backend")
+			receiveUntilComplete("backend")
+			Eventually(eventStream).Should(BeClosed())
+			Expect(startedApps()).To(ConsistOf("worker", "backend"))
+		})
+	})
+})
@@ -3,15 +3,13 @@ package v7pushaction
 import (
 	"os"
 
-	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v7action"
-	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/resources"
 	log "github.com/sirupsen/logrus"
 )
 
-const PushRetries = 3
+const PushRetries = 5
 
 func (actor Actor) CreateBitsPackageForApplication(pushPlan PushPlan, eventStream chan<- *PushEvent, progressBar ProgressBar) (PushPlan, Warnings, error) {
 	pkg, warnings, err := actor.CreateAndUploadApplicationBits(pushPlan, eventStream, progressBar)
@@ -67,41 +65,40 @@ func (actor Actor) CreateAndUploadApplicationBits(pushPlan PushPlan, eventStream
 	}
 
 	if len(unmatchedResources) > 0 {
-		eventStream <- &PushEvent{Plan: pushPlan, Event: CreatingArchive}
-		archivePath, archiveErr := actor.CreateAndReturnArchivePath(pushPlan, unmatchedResources)
+		archivePath, cached, archiveErr := actor.archiveForUpload(pushPlan, unmatchedResources, eventStream)
 		if archiveErr != nil {
 			return resources.Package{}, allWarnings, archiveErr
 		}
-		defer os.RemoveAll(archivePath)
+		if !cached {
+			defer os.RemoveAll(archivePath)
+		}
 
 		// Uploading package/app bits
-		for count := 0; count < PushRetries; count++ {
+		uploadWarnings, uploadErr := actor.retryUpload(pushPlan, eventStream, PushRetries, func() (Warnings, error) {
 			eventStream <- &PushEvent{Plan: pushPlan, Event: ReadingArchive}
 			log.WithField("GUID", pushPlan.Application.GUID).Info("reading archive")
 			file, size, readErr := actor.SharedActor.ReadArchive(archivePath)
 			if readErr != nil {
-				return resources.Package{}, allWarnings, readErr
+				return nil, readErr
 			}
 			defer file.Close()
 
 			eventStream <- &PushEvent{Plan: pushPlan, Event: UploadingApplicationWithArchive}
 			progressReader := progressBar.NewProgressBarWrapper(file, size)
-			var uploadWarnings v7action.Warnings
-			pkg, uploadWarnings, err = actor.V7Actor.UploadBitsPackage(pkg, matchedResources, progressReader, size)
-			allWarnings = append(allWarnings, uploadWarnings...)
-
-			if _, ok := err.(ccerror.PipeSeekError); ok {
-				eventStream <- &PushEvent{Plan: pushPlan, Event: RetryUpload}
-				continue
-			}
-			break
+			var (
+				warnings v7action.Warnings
+				err      error
+			)
+			pkg, warnings, err = actor.V7Actor.UploadBitsPackage(pkg, matchedResources, progressReader, size)
+			return Warnings(warnings), err
+		})
+		allWarnings = append(allWarnings, uploadWarnings...)
+		if uploadErr != nil {
+			return resources.Package{}, allWarnings, uploadErr
 		}
 
-		if err != nil {
-			if e, ok := err.(ccerror.PipeSeekError); ok {
-				return resources.Package{}, allWarnings, actionerror.UploadFailedError{Err: e.Err}
-			}
-			return resources.Package{}, allWarnings, err
+		if cached {
+			actor.PackageCache.Remove(v2ResourcesOf(unmatchedResources))
 		}
 
 		eventStream <- &PushEvent{Plan: pushPlan, Event: UploadWithArchiveComplete}
@@ -118,15 +115,52 @@ func (actor Actor) CreateAndUploadApplicationBits(pushPlan PushPlan, eventStream
 	return pkg, allWarnings, nil
 }
 
-func (actor Actor) CreateAndReturnArchivePath(pushPlan PushPlan, unmatchedResources []sharedaction.V3Resource) (string, error) {
-	// translate between v3 and v2 resources
-	var v2Resources []sharedaction.Resource
-	for _, resource := range unmatchedResources {
-		v2Resources = append(v2Resources, resource.ToV2Resource())
+// archiveForUpload returns the path of an archive containing the unmatched
+// resources. When the actor has a PackageCache, a previously built archive is
+// reused, and newly built archives are kept in the cache until they have been
+// uploaded, so a push that is run again after a failed upload does not rebuild
+// the archive.
+func (actor Actor) archiveForUpload(pushPlan PushPlan, unmatchedResources []sharedaction.V3Resource, eventStream chan<- *PushEvent) (string, bool, error) {
+	v2Resources := v2ResourcesOf(unmatchedResources)
+
+	if actor.PackageCache != nil {
+		if archivePath, ok := actor.PackageCache.Get(v2Resources); ok {
+			eventStream <- &PushEvent{Plan: pushPlan, Event: UsingCachedArchive}
+			return archivePath, true, nil
+		}
+	}
+
+	eventStream <- &PushEvent{Plan: pushPlan, Event: CreatingArchive}
+	archivePath, err := actor.CreateAndReturnArchivePath(pushPlan, unmatchedResources)
+	if err != nil {
+		return "", false, err
+	}
+
+	if actor.PackageCache != nil {
+		cachedPath, cacheErr := actor.PackageCache.Put(v2Resources, archivePath)
+		if cacheErr == nil {
+			return cachedPath, true, nil
+		}
+		log.WithField("archivePath", archivePath).Warnln("caching archive:", cacheErr)
 	}
 
+	return archivePath, false, nil
+}
+
+func (actor Actor) CreateAndReturnArchivePath(pushPlan PushPlan, unmatchedResources []sharedaction.V3Resource) (string, error) {
+	v2Resources := v2ResourcesOf(unmatchedResources)
+
 	if pushPlan.Archive {
 		return actor.SharedActor.ZipArchiveResources(pushPlan.BitsPath, v2Resources)
 	}
 	return actor.SharedActor.ZipDirectoryResources(pushPlan.BitsPath, v2Resources)
 }
+
+// v2ResourcesOf translates between v3 and v2 resources.
+func v2ResourcesOf(v3Resources []sharedaction.V3Resource) []sharedaction.Resource {
+	var v2Resources []sharedaction.Resource
+	for _, resource := range v3Resources {
+		v2Resources = append(v2Resources, resource.ToV2Resource())
+	}
+	return v2Resources
+}
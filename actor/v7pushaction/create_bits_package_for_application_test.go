@@ -3,6 +3,7 @@ package v7pushaction_test
 import (
 	"errors"
 	"fmt"
+	"net/http"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -228,11 +229,15 @@ var _ = Describe("CreateBitsPackageForApplication", func() {
 												ReadingArchive, UploadingApplicationWithArchive, RetryUpload,
 												ReadingArchive, UploadingApplicationWithArchive, RetryUpload,
 												ReadingArchive, UploadingApplicationWithArchive, RetryUpload,
+												ReadingArchive, UploadingApplicationWithArchive, RetryUpload,
+												ReadingArchive, UploadingApplicationWithArchive, RetryUpload,
 											))
 
-											Expect(warnings).To(ConsistOf("some-good-good-resource-match-warnings", "some-create-package-warning", "upload-warnings-1", "upload-warnings-2", "upload-warnings-1", "upload-warnings-2", "upload-warnings-1", "upload-warnings-2"))
+											Expect(warnings).To(ConsistOf("some-good-good-resource-match-warnings", "some-create-package-warning",
+												"upload-warnings-1", "upload-warnings-2", "upload-warnings-1", "upload-warnings-2", "upload-warnings-1", "upload-warnings-2",
+												"upload-warnings-1", "upload-warnings-2", "upload-warnings-1", "upload-warnings-2"))
 
-											Expect(fakeV7Actor.UploadBitsPackageCallCount()).To(Equal(3))
+											Expect(fakeV7Actor.UploadBitsPackageCallCount()).To(Equal(PushRetries))
 											Expect(executeErr).To(MatchError(actionerror.UploadFailedError{Err: someErr}))
 										})
 
@@ -249,6 +254,116 @@ var _ = Describe("CreateBitsPackageForApplication", func() {
 											Expect(executeErr).To(MatchError("dios mio"))
 										})
 									})
+
+									When("the upload fails with a transient network error", func() {
+										var networkErr ccerror.RequestError
+
+										BeforeEach(func() {
+											networkErr = ccerror.RequestError{Err: errors.New("connection reset by peer")}
+											fakeV7Actor.UploadBitsPackageReturnsOnCall(0, resources.Package{}, v7action.Warnings{"upload-warnings-1"}, networkErr)
+											fakeV7Actor.UploadBitsPackageReturnsOnCall(1, resources.Package{}, v7action.Warnings{"upload-warnings-2"}, ccerror.V3UnexpectedResponseError{ResponseCode: http.StatusBadGateway})
+											fakeV7Actor.UploadBitsPackageReturnsOnCall(2, resources.Package{GUID: "some-guid"}, v7action.Warnings{"upload-warnings-3"}, nil)
+										})
+
+										It("retries the upload from the start of the archive", func() {
+											Expect(executeErr).ToNot(HaveOccurred())
+											Expect(events).To(Equal([]Event{
+												ResourceMatching, CreatingPackage, CreatingArchive,
+												ReadingArchive, UploadingApplicationWithArchive, RetryUpload,
+												ReadingArchive, UploadingApplicationWithArchive, RetryUpload,
+												ReadingArchive, UploadingApplicationWithArchive, UploadWithArchiveComplete,
+											}))
+											Expect(warnings).To(ContainElements("upload-warnings-1", "upload-warnings-2", "upload-warnings-3"))
+											Expect(fakeSharedActor.ReadArchiveCallCount()).To(Equal(3))
+											Expect(fakeProgressBar.NewProgressBarWrapperCallCount()).To(Equal(3))
+										})
+
+										When("the error persists", func() {
+											BeforeEach(func() {
+												fakeV7Actor.UploadBitsPackageReturns(resources.Package{}, nil, networkErr)
+												fakeV7Actor.UploadBitsPackageReturnsOnCall(2, resources.Package{}, nil, networkErr)
+											})
+
+											It("gives up after PushRetries attempts", func() {
+												Expect(fakeV7Actor.UploadBitsPackageCallCount()).To(Equal(PushRetries))
+												Expect(executeErr).To(MatchError(actionerror.UploadFailedError{Err: networkErr}))
+											})
+										})
+									})
+								})
+							})
+
+							When("the actor has a package cache", func() {
+								var fakePackageCache *v7pushactionfakes.FakePackageCache
+
+								BeforeEach(func() {
+									fakePackageCache = new(v7pushactionfakes.FakePackageCache)
+									actor.PackageCache = fakePackageCache
+									fakeV7Actor.UploadBitsPackageReturns(resources.Package{GUID: "some-guid"}, nil, nil)
+								})
+
+								When("the archive is cached", func() {
+									BeforeEach(func() {
+										fakePackageCache.GetReturns("/cached/archive.zip", true)
+									})
+
+									It("uploads the cached archive without rebuilding it", func() {
+										Expect(executeErr).ToNot(HaveOccurred())
+										Expect(events).To(Equal([]Event{
+											ResourceMatching, CreatingPackage, UsingCachedArchive,
+											ReadingArchive, UploadingApplicationWithArchive, UploadWithArchiveComplete,
+										}))
+
+										Expect(fakePackageCache.GetArgsForCall(0)).To(Equal([]sharedaction.Resource{unmatches[0].ToV2Resource()}))
+										Expect(fakeSharedActor.ZipDirectoryResourcesCallCount()).To(Equal(0))
+										Expect(fakeSharedActor.ReadArchiveArgsForCall(0)).To(Equal("/cached/archive.zip"))
+									})
+
+									It("removes the archive from the cache once it has been uploaded", func() {
+										Expect(fakePackageCache.RemoveCallCount()).To(Equal(1))
+										Expect(fakePackageCache.RemoveArgsForCall(0)).To(Equal([]sharedaction.Resource{unmatches[0].ToV2Resource()}))
+									})
+
+									When("the upload fails", func() {
+										BeforeEach(func() {
+											fakeV7Actor.UploadBitsPackageReturns(resources.Package{}, nil, errors.New("upload-error"))
+										})
+
+										It("keeps the archive in the cache", func() {
+											Expect(executeErr).To(MatchError("upload-error"))
+											Expect(fakePackageCache.RemoveCallCount()).To(Equal(0))
+										})
+									})
+								})
+
+								When("the archive is not cached", func() {
+									BeforeEach(func() {
+										fakePackageCache.PutReturns("/cached/new-archive.zip", nil)
+									})
+
+									It("builds the archive, caches it and uploads the cached copy", func() {
+										Expect(executeErr).ToNot(HaveOccurred())
+										Expect(fakeSharedActor.ZipDirectoryResourcesCallCount()).To(Equal(1))
+
+										Expect(fakePackageCache.PutCallCount()).To(Equal(1))
+										cachedResources, archivePath := fakePackageCache.PutArgsForCall(0)
+										Expect(cachedResources).To(Equal([]sharedaction.Resource{unmatches[0].ToV2Resource()}))
+										Expect(archivePath).To(Equal("/some/archive/path"))
+
+										Expect(fakeSharedActor.ReadArchiveArgsForCall(0)).To(Equal("/cached/new-archive.zip"))
+									})
+
+									When("caching the archive fails", func() {
+										BeforeEach(func() {
+											fakePackageCache.PutReturns("", errors.New("disk full"))
+										})
+
+										It("uploads the archive it built", func() {
+											Expect(executeErr).ToNot(HaveOccurred())
+											Expect(fakeSharedActor.ReadArchiveArgsForCall(0)).To(Equal("/some/archive/path"))
+											Expect(fakePackageCache.RemoveCallCount()).To(Equal(0))
+										})
+									})
 								})
 							})
 
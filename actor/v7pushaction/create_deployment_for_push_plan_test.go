@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v7action"
 	. "code.cloudfoundry.org/cli/actor/v7pushaction"
 	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/resources"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -35,6 +36,7 @@ var _ = Describe("CreateDeploymentForApplication()", func() {
 			Application: resources.Application{
 				GUID: "some-app-guid",
 			},
+			DropletGUID: "some-droplet-guid",
 		}
 	})
 
@@ -52,13 +54,35 @@ var _ = Describe("CreateDeploymentForApplication()", func() {
 					return nil, nil
 				})
 
-				fakeV7Actor.CreateDeploymentByApplicationAndDropletReturns(
+				fakeV7Actor.CreateDeploymentReturns(
 					"some-deployment-guid",
 					v7action.Warnings{"some-deployment-warning"},
 					nil,
 				)
 			})
 
+			It("creates the deployment for the droplet", func() {
+				Expect(fakeV7Actor.CreateDeploymentCallCount()).To(Equal(1))
+				Expect(fakeV7Actor.CreateDeploymentArgsForCall(0)).To(Equal(resources.Deployment{
+					DropletGUID:   "some-droplet-guid",
+					Relationships: resources.Relationships{constant.RelationshipTypeApplication: resources.Relationship{GUID: "some-app-guid"}},
+				}))
+			})
+
+			When("the plan has the canary strategy", func() {
+				BeforeEach(func() {
+					paramPlan.Strategy = constant.DeploymentStrategyCanary
+					paramPlan.CanarySteps = []resources.CanaryStep{{InstanceWeight: 20}, {InstanceWeight: 60}}
+				})
+
+				It("creates a canary deployment with the steps", func() {
+					Expect(fakeV7Actor.CreateDeploymentCallCount()).To(Equal(1))
+					dep := fakeV7Actor.CreateDeploymentArgsForCall(0)
+					Expect(dep.Strategy).To(Equal(constant.DeploymentStrategyCanary))
+					Expect(dep.CanarySteps).To(Equal([]resources.CanaryStep{{InstanceWeight: 20}, {InstanceWeight: 60}}))
+				})
+			})
+
 			It("waits for the app to start", func() {
 				Expect(fakeV7Actor.PollStartForRollingCallCount()).To(Equal(1))
 				givenApp, givenDeploymentGUID, noWait, _ := fakeV7Actor.PollStartForRollingArgsForCall(0)
@@ -85,7 +109,7 @@ var _ = Describe("CreateDeploymentForApplication()", func() {
 			BeforeEach(func() {
 				someErr = errors.New("failed to create deployment")
 
-				fakeV7Actor.CreateDeploymentByApplicationAndDropletReturns(
+				fakeV7Actor.CreateDeploymentReturns(
 					"",
 					v7action.Warnings{"some-deployment-warning"},
 					someErr,
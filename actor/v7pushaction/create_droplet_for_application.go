@@ -1,9 +1,9 @@
 package v7pushaction
 
 import (
+	"io"
+
 	"code.cloudfoundry.org/cli/actor/actionerror"
-	"code.cloudfoundry.org/cli/actor/v7action"
-	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 )
 
 const UploadRetries = 3
@@ -18,31 +18,32 @@ func (actor Actor) CreateDropletForApplication(pushPlan PushPlan, eventStream ch
 		return pushPlan, allWarnings, err
 	}
 
-	for count := 0; count < UploadRetries; count++ {
+	var readErr error
+	uploadWarnings, err := actor.retryUpload(pushPlan, eventStream, UploadRetries, func() (Warnings, error) {
 		eventStream <- &PushEvent{Plan: pushPlan, Event: ReadingArchive}
-		file, size, readErr := actor.SharedActor.ReadArchive(pushPlan.DropletPath)
+		var (
+			file io.ReadCloser
+			size int64
+		)
+		file, size, readErr = actor.SharedActor.ReadArchive(pushPlan.DropletPath)
 		if readErr != nil {
-			return pushPlan, allWarnings, readErr
+			return nil, readErr
 		}
 		defer file.Close()
 
 		eventStream <- &PushEvent{Plan: pushPlan, Event: UploadingDroplet}
 		progressReader := progressBar.NewProgressBarWrapper(file, size)
-		var uploadWarnings v7action.Warnings
-		uploadWarnings, err = actor.V7Actor.UploadDroplet(droplet.GUID, pushPlan.DropletPath, progressReader, size)
-		allWarnings = append(allWarnings, uploadWarnings...)
-
-		if _, ok := err.(ccerror.PipeSeekError); ok {
-			eventStream <- &PushEvent{Plan: pushPlan, Event: RetryUpload}
-			continue
-		}
-
-		break
+		warnings, err := actor.V7Actor.UploadDroplet(droplet.GUID, pushPlan.DropletPath, progressReader, size)
+		return Warnings(warnings), err
+	})
+	allWarnings = append(allWarnings, uploadWarnings...)
+	if readErr != nil {
+		return pushPlan, allWarnings, readErr
 	}
 
 	if err != nil {
-		if e, ok := err.(ccerror.PipeSeekError); ok {
-			return pushPlan, allWarnings, actionerror.UploadFailedError{Err: e.Err}
+		if _, ok := err.(actionerror.UploadFailedError); ok {
+			return pushPlan, allWarnings, err
 		}
 		eventStream <- &PushEvent{Plan: pushPlan, Event: UploadDropletComplete}
 
@@ -9,6 +9,9 @@ import (
 // CreatePushPlans returns a set of PushPlan objects based off the inputs
 // provided. It's assumed that all flag and argument and manifest combinations
 // have been validated prior to calling this function.
+//
+// The plans are ordered so that every app comes after the apps it depends on;
+// apps without dependencies between them keep their manifest order.
 func (actor Actor) CreatePushPlans(
 	spaceGUID string,
 	orgGUID string,
@@ -29,6 +32,7 @@ func (actor Actor) CreatePushPlans(
 			SpaceGUID:   spaceGUID,
 			Application: nameToApp[manifestApplication.Name],
 			BitsPath:    manifestApplication.Path,
+			DependsOn:   manifestApplication.DependsOn,
 		}
 
 		if manifestApplication.Docker != nil {
@@ -51,7 +55,7 @@ func (actor Actor) CreatePushPlans(
 		pushPlans = append(pushPlans, plan)
 	}
 
-	return pushPlans, warnings, nil
+	return sortPushPlansByDependencies(pushPlans), warnings, nil
 }
 
 func (actor Actor) generateAppNameToApplicationMapping(applications []resources.Application) map[string]resources.Application {
@@ -61,3 +65,45 @@ func (actor Actor) generateAppNameToApplicationMapping(applications []resources.
 	}
 	return nameToApp
 }
+
+// sortPushPlansByDependencies returns the plans ordered so that each plan
+// comes after the plans it depends on. Dependencies on apps that are not
+// being pushed are ignored.
+func sortPushPlansByDependencies(plans []PushPlan) []PushPlan {
+	pending := make(map[string]bool, len(plans))
+	for _, plan := range plans {
+		pending[plan.Application.Name] = true
+	}
+
+	sorted := make([]PushPlan, 0, len(plans))
+	remaining := plans
+	for len(remaining) > 0 {
+		var deferred []PushPlan
+		for _, plan := range remaining {
+			if dependsOnPending(plan, pending) {
+				deferred = append(deferred, plan)
+				continue
+			}
+			sorted = append(sorted, plan)
+			delete(pending, plan.Application.Name)
+		}
+
+		// Cycles are rejected when the manifest is parsed; this only guards
+		// against looping forever if one slips through.
+		if len(deferred) == len(remaining) {
+			return append(sorted, deferred...)
+		}
+		remaining = deferred
+	}
+
+	return sorted
+}
+
+func dependsOnPending(plan PushPlan, pending map[string]bool) bool {
+	for _, dependency := range plan.DependsOn {
+		if pending[dependency] {
+			return true
+		}
+	}
+	return false
+}
@@ -130,5 +130,32 @@ var _ = Describe("CreatePushPlans", func() {
 			Expect(pushPlans[1].BitsPath).To(Equal("path2"))
 		})
 
+		When("apps in the manifest depend on each other", func() {
+			BeforeEach(func() {
+				manifest.Applications = []manifestparser.Application{
+					{Name: "frontend", DependsOn: []string{"backend"}},
+					{Name: "name-1"},
+					{Name: "backend", DependsOn: []string{"worker", "not-pushed"}},
+					{Name: "worker"},
+				}
+				fakeV7Actor.GetApplicationsByNamesAndSpaceReturns(
+					[]resources.Application{{Name: "frontend"}, {Name: "name-1"}, {Name: "backend"}, {Name: "worker"}},
+					nil,
+					nil,
+				)
+			})
+
+			It("orders the plans so that dependencies come first", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				var names []string
+				for _, plan := range pushPlans {
+					names = append(names, plan.Application.Name)
+				}
+				Expect(names).To(Equal([]string{"name-1", "worker", "backend", "frontend"}))
+				Expect(pushPlans[2].DependsOn).To(Equal([]string{"worker", "not-pushed"}))
+			})
+		})
+
 	})
 })
@@ -12,6 +12,7 @@ type Event string
 const (
 	ApplyManifest                   Event = "Applying manifest"
 	ApplyManifestComplete           Event = "Applying manifest Complete"
+	ActualizeComplete               Event = "actualize complete"
 	CreatingArchive                 Event = "creating archive"
 	CreatingDroplet                 Event = "creating droplet"
 	CreatingPackage                 Event = "creating package"
@@ -36,5 +37,6 @@ const (
 	UploadingApplicationWithArchive Event = "uploading application with archive"
 	UploadingDroplet                Event = "uploading droplet"
 	UploadWithArchiveComplete       Event = "upload complete"
+	UsingCachedArchive              Event = "using cached archive"
 	WaitingForDeployment            Event = "waiting for deployment"
 )
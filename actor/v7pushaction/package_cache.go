@@ -0,0 +1,13 @@
+package v7pushaction
+
+import "code.cloudfoundry.org/cli/actor/sharedaction"
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . PackageCache
+
+// PackageCache stores the archives built for pushes so that pushing the same
+// resources again does not rebuild the archive.
+type PackageCache interface {
+	Get(resources []sharedaction.Resource) (string, bool)
+	Put(resources []sharedaction.Resource, archivePath string) (string, error)
+	Remove(resources []sharedaction.Resource)
+}
@@ -17,9 +17,14 @@ type PushPlan struct {
 
 	Application resources.Application
 
+	// DependsOn lists the names of the apps that have to be pushed before
+	// this one.
+	DependsOn []string
+
 	NoStart             bool
 	NoWait              bool
 	Strategy            constant.DeploymentStrategy
+	CanarySteps         []resources.CanaryStep
 	TaskTypeApplication bool
 
 	DockerImageCredentials v7action.DockerImageCredentials
@@ -54,6 +59,7 @@ type FlagOverrides struct {
 	RandomRoute         bool
 	StartCommand        types.FilteredString
 	Strategy            constant.DeploymentStrategy
+	InstanceSteps       []int64
 	ManifestPath        string
 	PathsToVarsFiles    []string
 	Vars                []template.VarKV
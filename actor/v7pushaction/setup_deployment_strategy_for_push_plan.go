@@ -1,7 +1,16 @@
 package v7pushaction
 
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+)
+
 func SetupDeploymentStrategyForPushPlan(pushPlan PushPlan, overrides FlagOverrides) (PushPlan, error) {
 	pushPlan.Strategy = overrides.Strategy
 
+	if overrides.Strategy == constant.DeploymentStrategyCanary {
+		pushPlan.CanarySteps = resources.NewCanarySteps(overrides.InstanceSteps)
+	}
+
 	return pushPlan, nil
 }
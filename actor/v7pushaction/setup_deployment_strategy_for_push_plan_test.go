@@ -2,6 +2,7 @@ package v7pushaction_test
 
 import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
 
 	. "code.cloudfoundry.org/cli/actor/v7pushaction"
 
@@ -38,6 +39,19 @@ var _ = Describe("SetupDeploymentStrategyForPushPlan", func() {
 		})
 	})
 
+	When("flag overrides specifies the canary strategy with instance steps", func() {
+		BeforeEach(func() {
+			overrides.Strategy = constant.DeploymentStrategyCanary
+			overrides.InstanceSteps = []int64{10, 50}
+		})
+
+		It("sets the strategy and canary steps on the push plan", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(expectedPushPlan.Strategy).To(Equal(constant.DeploymentStrategyCanary))
+			Expect(expectedPushPlan.CanarySteps).To(Equal([]resources.CanaryStep{{InstanceWeight: 10}, {InstanceWeight: 50}}))
+		})
+	})
+
 	When("flag overrides does not specify strategy", func() {
 		It("leaves the strategy as its default value on the push plan", func() {
 			Expect(executeErr).ToNot(HaveOccurred())
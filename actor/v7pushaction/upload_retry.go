@@ -0,0 +1,73 @@
+package v7pushaction
+
+import (
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultUploadRetryInterval is the time waited before the first retry
+	// of a failed upload; it doubles with every further retry.
+	DefaultUploadRetryInterval = time.Second
+
+	maxUploadRetryInterval = 30 * time.Second
+)
+
+// retryUpload calls upload until it succeeds, fails with an error that is not
+// transient, or has been attempted maxAttempts times. A RetryUpload event is
+// sent after every transient failure, and the wait before the next attempt
+// doubles each time.
+func (actor Actor) retryUpload(pushPlan PushPlan, eventStream chan<- *PushEvent, maxAttempts int, upload func() (Warnings, error)) (Warnings, error) {
+	var (
+		allWarnings Warnings
+		err         error
+	)
+
+	interval := actor.UploadRetryInterval
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		var warnings Warnings
+		warnings, err = upload()
+		allWarnings = append(allWarnings, warnings...)
+		if err == nil || !isTransientUploadError(err) {
+			return allWarnings, err
+		}
+
+		log.WithFields(log.Fields{
+			"attempt": attempt,
+			"error":   err,
+		}).Warn("upload failed with a transient error")
+		eventStream <- &PushEvent{Plan: pushPlan, Event: RetryUpload}
+
+		if attempt < maxAttempts && interval > 0 {
+			time.Sleep(interval)
+			interval *= 2
+			if interval > maxUploadRetryInterval {
+				interval = maxUploadRetryInterval
+			}
+		}
+	}
+
+	if e, ok := err.(ccerror.PipeSeekError); ok {
+		err = e.Err
+	}
+	return allWarnings, actionerror.UploadFailedError{Err: err}
+}
+
+// isTransientUploadError returns true for errors caused by the network or by
+// the Cloud Controller being briefly unavailable, after which the upload can
+// safely be started again.
+func isTransientUploadError(err error) bool {
+	switch e := err.(type) {
+	case ccerror.PipeSeekError, ccerror.RequestError, ccerror.ServiceUnavailableError:
+		return true
+	case ccerror.V3UnexpectedResponseError:
+		return e.ResponseCode == http.StatusBadGateway ||
+			e.ResponseCode == http.StatusServiceUnavailable ||
+			e.ResponseCode == http.StatusGatewayTimeout
+	}
+	return false
+}
@@ -34,6 +34,7 @@ func getTestPushActor() (*Actor, *v7pushactionfakes.FakeV7Actor, *v7pushactionfa
 	fakeV7Actor := new(v7pushactionfakes.FakeV7Actor)
 	fakeSharedActor := new(v7pushactionfakes.FakeSharedActor)
 	actor := NewActor(fakeV7Actor, fakeSharedActor)
+	actor.UploadRetryInterval = 0
 	return actor, fakeV7Actor, fakeSharedActor
 }
 
@@ -165,91 +165,74 @@ var _ = Describe("Deployment", func() {
 		})
 	})
 
-	Describe("CreateApplicationDeployment", func() {
+	Describe("ContinueDeployment", func() {
 		var (
-			deploymentGUID string
-			warnings       Warnings
-			executeErr     error
-			dropletGUID    string
+			warnings   Warnings
+			executeErr error
 		)
 
 		JustBeforeEach(func() {
-			deploymentGUID, warnings, executeErr = client.CreateApplicationDeployment("some-app-guid", dropletGUID)
+			warnings, executeErr = client.ContinueDeployment("some-deployment-guid")
 		})
 
-		Context("when the application exists", func() {
-			var response string
+		When("the deployment exists", func() {
 			BeforeEach(func() {
-				dropletGUID = "some-droplet-guid"
-				response = `{
-  "guid": "some-deployment-guid",
-  "created_at": "2018-04-25T22:42:10Z",
-  "relationships": {
-    "app": {
-      "data": {
-        "guid": "some-app-guid"
-      }
-    }
-  }
-}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments/some-deployment-guid/actions/continue"),
+						RespondWith(http.StatusOK, "", http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
 			})
 
-			Context("when creating the deployment succeeds", func() {
-				BeforeEach(func() {
-					server.AppendHandlers(
-						CombineHandlers(
-							VerifyRequest(http.MethodPost, "/v3/deployments"),
-							VerifyJSON(`{"droplet":{ "guid":"some-droplet-guid" }, "relationships":{"app":{"data":{"guid":"some-app-guid"}}}}`),
-							RespondWith(http.StatusAccepted, response, http.Header{"X-Cf-Warnings": {"warning"}}),
-						),
-					)
-				})
-
-				It("creates the deployment with no errors and returns all warnings", func() {
-					Expect(deploymentGUID).To(Equal("some-deployment-guid"))
-					Expect(executeErr).ToNot(HaveOccurred())
-					Expect(warnings).To(ConsistOf("warning"))
-				})
-
+			It("continues the deployment with no errors and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning"))
 			})
+		})
 
-			Context("when no droplet guid is provided", func() {
-				BeforeEach(func() {
-					dropletGUID = ""
-					server.AppendHandlers(
-						CombineHandlers(
-							VerifyRequest(http.MethodPost, "/v3/deployments"),
-							VerifyJSON(`{"relationships":{"app":{"data":{"guid":"some-app-guid"}}}}`),
-							RespondWith(http.StatusAccepted, response, http.Header{"X-Cf-Warnings": {"warning"}}),
-						),
-					)
-				})
+		When("the deployment is not paused", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10008,
+      "detail": "Cannot continue a deployment with status: ACTIVE and reason: DEPLOYING.",
+      "title": "CF-UnprocessableEntity"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments/some-deployment-guid/actions/continue"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
 
-				It("omits the droplet object in the JSON", func() {
-					Expect(executeErr).ToNot(HaveOccurred())
-					Expect(warnings).To(ConsistOf("warning"))
-				})
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.UnprocessableEntityError{
+					Message: "Cannot continue a deployment with status: ACTIVE and reason: DEPLOYING.",
+				}))
+				Expect(warnings).To(ConsistOf("warning"))
 			})
 		})
 	})
 
-	Describe("CreateApplicationDeploymentByRevision", func() {
+	Describe("CreateApplicationDeployment", func() {
 		var (
 			deploymentGUID string
 			warnings       Warnings
 			executeErr     error
-			revisionGUID   string
+			deployment     resources.Deployment
+			response       string
 		)
 
-		JustBeforeEach(func() {
-			deploymentGUID, warnings, executeErr = client.CreateApplicationDeploymentByRevision("some-app-guid", revisionGUID)
-		})
-
-		Context("when the application exists", func() {
-			var response string
-			BeforeEach(func() {
-				revisionGUID = "some-revision-guid"
-				response = `{
+		BeforeEach(func() {
+			deployment = resources.Deployment{
+				Relationships: resources.Relationships{constant.RelationshipTypeApplication: resources.Relationship{GUID: "some-app-guid"}},
+			}
+			response = `{
   "guid": "some-deployment-guid",
   "created_at": "2018-04-25T22:42:10Z",
   "relationships": {
@@ -260,24 +243,90 @@ var _ = Describe("Deployment", func() {
     }
   }
 }`
+		})
+
+		JustBeforeEach(func() {
+			deploymentGUID, warnings, executeErr = client.CreateApplicationDeployment(deployment)
+		})
+
+		When("a droplet guid is provided", func() {
+			BeforeEach(func() {
+				deployment.DropletGUID = "some-droplet-guid"
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments"),
+						VerifyJSON(`{"droplet":{ "guid":"some-droplet-guid" }, "relationships":{"app":{"data":{"guid":"some-app-guid"}}}}`),
+						RespondWith(http.StatusAccepted, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
 			})
 
-			Context("when creating the deployment succeeds", func() {
-				BeforeEach(func() {
-					server.AppendHandlers(
-						CombineHandlers(
-							VerifyRequest(http.MethodPost, "/v3/deployments"),
-							VerifyJSON(`{"revision":{ "guid":"some-revision-guid" }, "relationships":{"app":{"data":{"guid":"some-app-guid"}}}}`),
-							RespondWith(http.StatusAccepted, response, http.Header{"X-Cf-Warnings": {"warning"}}),
-						),
-					)
-				})
+			It("creates the deployment with no errors and returns all warnings", func() {
+				Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
 
-				It("creates the deployment with no errors and returns all warnings", func() {
-					Expect(deploymentGUID).To(Equal("some-deployment-guid"))
-					Expect(executeErr).ToNot(HaveOccurred())
-					Expect(warnings).To(ConsistOf("warning"))
-				})
+		When("no droplet guid is provided", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments"),
+						VerifyJSON(`{"relationships":{"app":{"data":{"guid":"some-app-guid"}}}}`),
+						RespondWith(http.StatusAccepted, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("omits the droplet object in the JSON", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+
+		When("a revision guid is provided", func() {
+			BeforeEach(func() {
+				deployment.RevisionGUID = "some-revision-guid"
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments"),
+						VerifyJSON(`{"revision":{ "guid":"some-revision-guid" }, "relationships":{"app":{"data":{"guid":"some-app-guid"}}}}`),
+						RespondWith(http.StatusAccepted, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("creates the deployment with no errors and returns all warnings", func() {
+				Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+
+		When("the canary strategy is provided", func() {
+			BeforeEach(func() {
+				deployment.DropletGUID = "some-droplet-guid"
+				deployment.Strategy = constant.DeploymentStrategyCanary
+				deployment.CanarySteps = []resources.CanaryStep{{InstanceWeight: 10}, {InstanceWeight: 50}}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments"),
+						VerifyJSON(`{
+							"droplet": {"guid": "some-droplet-guid"},
+							"strategy": "canary",
+							"options": {"canary": {"steps": [{"instance_weight": 10}, {"instance_weight": 50}]}},
+							"relationships": {"app": {"data": {"guid": "some-app-guid"}}}
+						}`),
+						RespondWith(http.StatusAccepted, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("creates the deployment with the strategy and steps", func() {
+				Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning"))
 			})
 		})
 	})
@@ -328,6 +377,46 @@ var _ = Describe("Deployment", func() {
 			})
 		})
 
+		Context("when the deployment is a paused canary deployment", func() {
+			BeforeEach(func() {
+				response = `{
+					"guid": "some-deployment-guid",
+					"strategy": "canary",
+					"status": {
+						"value": "ACTIVE",
+						"reason": "PAUSED",
+						"canary": {
+							"steps": {
+								"current": 1,
+								"total": 2
+							}
+						}
+					},
+					"options": {
+						"canary": {
+							"steps": [{"instance_weight": 10}, {"instance_weight": 100}]
+						}
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/deployments/some-deployment-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns the strategy and the canary progress", func() {
+				deployment, _, err := client.GetDeployment("some-deployment-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deployment.Strategy).To(Equal(constant.DeploymentStrategyCanary))
+				Expect(deployment.StatusValue).To(Equal(constant.DeploymentStatusValueActive))
+				Expect(deployment.StatusReason).To(Equal(constant.DeploymentStatusReasonPaused))
+				Expect(deployment.CanarySteps).To(Equal([]resources.CanaryStep{{InstanceWeight: 10}, {InstanceWeight: 100}}))
+				Expect(deployment.CanaryStatus).To(Equal(resources.CanaryStatus{CurrentStep: 1, TotalSteps: 2}))
+			})
+		})
+
 		Context("when the deployment doesn't exist", func() {
 			BeforeEach(func() {
 				response := `{
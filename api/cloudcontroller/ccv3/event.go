@@ -8,11 +8,18 @@ import (
 )
 
 type Event struct {
-	GUID      string
-	CreatedAt time.Time
-	Type      string
-	ActorName string
-	Data      map[string]interface{}
+	GUID             string
+	CreatedAt        time.Time
+	Type             string
+	ActorGUID        string
+	ActorType        string
+	ActorName        string
+	TargetGUID       string
+	TargetType       string
+	TargetName       string
+	SpaceGUID        string
+	OrganizationGUID string
+	Data             map[string]interface{}
 }
 
 func (e *Event) UnmarshalJSON(data []byte) error {
@@ -21,8 +28,21 @@ func (e *Event) UnmarshalJSON(data []byte) error {
 		CreatedAt time.Time `json:"created_at"`
 		Type      string    `json:"type"`
 		Actor     struct {
+			GUID string `json:"guid"`
+			Type string `json:"type"`
 			Name string `json:"name"`
 		} `json:"actor"`
+		Target struct {
+			GUID string `json:"guid"`
+			Type string `json:"type"`
+			Name string `json:"name"`
+		} `json:"target"`
+		Space struct {
+			GUID string `json:"guid"`
+		} `json:"space"`
+		Organization struct {
+			GUID string `json:"guid"`
+		} `json:"organization"`
 		Data map[string]interface{} `json:"data"`
 	}
 	err := cloudcontroller.DecodeJSON(data, &ccEvent)
@@ -33,7 +53,14 @@ func (e *Event) UnmarshalJSON(data []byte) error {
 	e.GUID = ccEvent.GUID
 	e.CreatedAt = ccEvent.CreatedAt
 	e.Type = ccEvent.Type
+	e.ActorGUID = ccEvent.Actor.GUID
+	e.ActorType = ccEvent.Actor.Type
 	e.ActorName = ccEvent.Actor.Name
+	e.TargetGUID = ccEvent.Target.GUID
+	e.TargetType = ccEvent.Target.Type
+	e.TargetName = ccEvent.Target.Name
+	e.SpaceGUID = ccEvent.Space.GUID
+	e.OrganizationGUID = ccEvent.Organization.GUID
 	e.Data = ccEvent.Data
 
 	return nil
@@ -56,3 +83,21 @@ func (client *Client) GetEvents(query ...Query) ([]Event, Warnings, error) {
 
 	return responseBody.Resources, warnings, err
 }
+
+// GetAllEvents uses the /v3/audit_events endpoint to retrieve every audit
+// event matching the query, following pagination.
+func (client *Client) GetAllEvents(query ...Query) ([]Event, Warnings, error) {
+	var events []Event
+
+	_, warnings, err := client.MakeListRequest(RequestParams{
+		RequestName:  internal.GetEventsRequest,
+		Query:        query,
+		ResponseBody: Event{},
+		AppendToList: func(item interface{}) error {
+			events = append(events, item.(Event))
+			return nil
+		},
+	})
+
+	return events, warnings, err
+}
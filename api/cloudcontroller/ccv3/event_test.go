@@ -99,10 +99,17 @@ var _ = Describe("Event", func() {
 				Expect(warnings).To(ConsistOf("warning"))
 				Expect(events).To(ConsistOf(
 					Event{
-						GUID:      "some-event-guid",
-						CreatedAt: timestamp,
-						Type:      "audit.app.update",
-						ActorName: "admin",
+						GUID:             "some-event-guid",
+						CreatedAt:        timestamp,
+						Type:             "audit.app.update",
+						ActorGUID:        "d144abe3-3d7b-40d4-b63f-2584798d3ee5",
+						ActorType:        "user",
+						ActorName:        "admin",
+						TargetGUID:       "2e3151ba-9a63-4345-9c5b-6d8c238f4e55",
+						TargetType:       "app",
+						TargetName:       "my-app",
+						SpaceGUID:        "cb97dd25-d4f7-4185-9e6f-ad6e585c207c",
+						OrganizationGUID: "d9be96f5-ea8f-4549-923f-bec882e32e3c",
 						Data: map[string]interface{}{
 							"request": map[string]interface{}{
 								"recursive": true,
@@ -158,4 +165,71 @@ var _ = Describe("Event", func() {
 			})
 		})
 	})
+
+	Describe("GetAllEvents", func() {
+		var (
+			events     []Event
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			events, warnings, executeErr = client.GetAllEvents(
+				Query{Key: SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+				Query{Key: EventTypesFilter, Values: []string{"audit.app.create", "audit.app.delete-request"}},
+				Query{Key: CreatedAtsGreaterThanOrEqualFilter, Values: []string{"2016-06-08T00:00:00Z"}},
+			)
+		})
+
+		When("the events span several pages", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+  "pagination": {
+    "next": {
+      "href": "%s/v3/audit_events?space_guids=some-space-guid&page=2"
+    }
+  },
+  "resources": [
+    {
+      "guid": "event-guid-1",
+      "type": "audit.app.create",
+      "actor": {"name": "admin"}
+    }
+  ]
+}`, server.URL())
+				response2 := `{
+  "pagination": {
+    "next": null
+  },
+  "resources": [
+    {
+      "guid": "event-guid-2",
+      "type": "audit.app.delete-request",
+      "actor": {"name": "admin"}
+    }
+  ]
+}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/audit_events", "space_guids=some-space-guid&types=audit.app.create,audit.app.delete-request&created_ats%5Bgte%5D=2016-06-08T00:00:00Z"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/audit_events", "space_guids=some-space-guid&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the events of every page and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(events).To(Equal([]Event{
+					{GUID: "event-guid-1", Type: "audit.app.create", ActorName: "admin"},
+					{GUID: "event-guid-2", Type: "audit.app.delete-request", ActorName: "admin"},
+				}))
+			})
+		})
+	})
 })
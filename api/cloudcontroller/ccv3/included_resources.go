@@ -12,3 +12,35 @@ type IncludedResources struct {
 	ServicePlans     []resources.ServicePlan     `json:"service_plans,omitempty"`
 	Apps             []resources.Application     `json:"apps,omitempty"`
 }
+
+// Merge appends the resources included in another page of a list response.
+// Resources that are included on more than one page are only added once.
+func (i *IncludedResources) Merge(other IncludedResources) {
+	i.Users = mergeByGUID(i.Users, other.Users, func(user resources.User) string { return user.GUID })
+	i.Organizations = mergeByGUID(i.Organizations, other.Organizations, func(org resources.Organization) string { return org.GUID })
+	i.Spaces = mergeByGUID(i.Spaces, other.Spaces, func(space resources.Space) string { return space.GUID })
+	i.ServiceInstances = mergeByGUID(i.ServiceInstances, other.ServiceInstances, func(instance resources.ServiceInstance) string { return instance.GUID })
+	i.ServiceOfferings = mergeByGUID(i.ServiceOfferings, other.ServiceOfferings, func(offering resources.ServiceOffering) string { return offering.GUID })
+	i.ServiceBrokers = mergeByGUID(i.ServiceBrokers, other.ServiceBrokers, func(broker resources.ServiceBroker) string { return broker.GUID })
+	i.ServicePlans = mergeByGUID(i.ServicePlans, other.ServicePlans, func(plan resources.ServicePlan) string { return plan.GUID })
+	i.Apps = mergeByGUID(i.Apps, other.Apps, func(app resources.Application) string { return app.GUID })
+}
+
+// mergeByGUID appends the items of other whose GUID is not yet in existing.
+func mergeByGUID[T any](existing []T, other []T, guid func(T) string) []T {
+	if len(other) == 0 {
+		return existing
+	}
+
+	seen := map[string]bool{}
+	for _, item := range existing {
+		seen[guid(item)] = true
+	}
+	for _, item := range other {
+		if !seen[guid(item)] {
+			seen[guid(item)] = true
+			existing = append(existing, item)
+		}
+	}
+	return existing
+}
@@ -0,0 +1,63 @@
+package ccv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("IncludedResources", func() {
+	Describe("Merge", func() {
+		var includes IncludedResources
+
+		BeforeEach(func() {
+			includes = IncludedResources{
+				Users:         []resources.User{{GUID: "user-guid-1"}, {GUID: "user-guid-2"}},
+				Organizations: []resources.Organization{{GUID: "org-guid-1", Name: "org-1"}},
+			}
+		})
+
+		It("appends the resources of the other page that are not included yet", func() {
+			includes.Merge(IncludedResources{
+				Users:         []resources.User{{GUID: "user-guid-2"}, {GUID: "user-guid-3"}, {GUID: "user-guid-3"}},
+				Organizations: []resources.Organization{{GUID: "org-guid-1", Name: "org-1-again"}},
+				Spaces:        []resources.Space{{GUID: "space-guid-1"}},
+				Apps:          []resources.Application{{GUID: "app-guid-1"}},
+			})
+
+			Expect(includes).To(Equal(IncludedResources{
+				Users:         []resources.User{{GUID: "user-guid-1"}, {GUID: "user-guid-2"}, {GUID: "user-guid-3"}},
+				Organizations: []resources.Organization{{GUID: "org-guid-1", Name: "org-1"}},
+				Spaces:        []resources.Space{{GUID: "space-guid-1"}},
+				Apps:          []resources.Application{{GUID: "app-guid-1"}},
+			}))
+		})
+
+		It("merges every type of included resource", func() {
+			other := IncludedResources{
+				ServiceInstances: []resources.ServiceInstance{{GUID: "instance-guid-1"}},
+				ServiceOfferings: []resources.ServiceOffering{{GUID: "offering-guid-1"}},
+				ServiceBrokers:   []resources.ServiceBroker{{GUID: "broker-guid-1"}},
+				ServicePlans:     []resources.ServicePlan{{GUID: "plan-guid-1"}},
+			}
+
+			includes.Merge(other)
+			includes.Merge(other)
+
+			Expect(includes.ServiceInstances).To(Equal(other.ServiceInstances))
+			Expect(includes.ServiceOfferings).To(Equal(other.ServiceOfferings))
+			Expect(includes.ServiceBrokers).To(Equal(other.ServiceBrokers))
+			Expect(includes.ServicePlans).To(Equal(other.ServicePlans))
+		})
+
+		It("leaves the resources alone when the other page includes nothing", func() {
+			includes.Merge(IncludedResources{})
+
+			Expect(includes).To(Equal(IncludedResources{
+				Users:         []resources.User{{GUID: "user-guid-1"}, {GUID: "user-guid-2"}},
+				Organizations: []resources.Organization{{GUID: "org-guid-1", Name: "org-1"}},
+			}))
+		})
+	})
+})
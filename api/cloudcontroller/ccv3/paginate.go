@@ -2,46 +2,169 @@ package ccv3
 
 import (
 	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 )
 
+// maxConcurrentPageRequests is the maximum number of pages of a list request
+// that are fetched from the Cloud Controller at the same time.
+const maxConcurrentPageRequests = 5
+
+// pageResult contains the result of fetching a single page of a list request.
+type pageResult struct {
+	resources []interface{}
+	included  IncludedResources
+	warnings  Warnings
+	err       error
+}
+
 func (requester RealRequester) paginate(request *cloudcontroller.Request, obj interface{}, appendToExternalList func(interface{}) error) (IncludedResources, Warnings, error) {
 	fullWarningsList := Warnings{}
 	var includes IncludedResources
 
-	for {
-		wrapper, warnings, err := requester.wrapFirstPage(request, obj, appendToExternalList)
-		fullWarningsList = append(fullWarningsList, warnings...)
-		if err != nil {
-			return IncludedResources{}, fullWarningsList, err
+	wrapper, warnings, err := requester.wrapFirstPage(request, obj, appendToExternalList)
+	fullWarningsList = append(fullWarningsList, warnings...)
+	if err != nil {
+		return IncludedResources{}, fullWarningsList, err
+	}
+	includes.Merge(wrapper.IncludedResources)
+
+	pageURLs, ok := remainingPageURLs(wrapper)
+	if !ok {
+		return requester.paginateSequentially(wrapper, obj, appendToExternalList, includes, fullWarningsList)
+	}
+
+	pages := requester.fetchPages(pageURLs, obj)
+	for _, page := range pages {
+		fullWarningsList = append(fullWarningsList, page.warnings...)
+		if page.err != nil {
+			return IncludedResources{}, fullWarningsList, page.err
 		}
 
-		includes.Apps = append(includes.Apps, wrapper.IncludedResources.Apps...)
-		includes.Users = append(includes.Users, wrapper.IncludedResources.Users...)
-		includes.Organizations = append(includes.Organizations, wrapper.IncludedResources.Organizations...)
-		includes.Spaces = append(includes.Spaces, wrapper.IncludedResources.Spaces...)
-		includes.ServiceBrokers = append(includes.ServiceBrokers, wrapper.IncludedResources.ServiceBrokers...)
-		includes.ServiceInstances = append(includes.ServiceInstances, wrapper.IncludedResources.ServiceInstances...)
-		includes.ServiceOfferings = append(includes.ServiceOfferings, wrapper.IncludedResources.ServiceOfferings...)
-		includes.ServicePlans = append(includes.ServicePlans, wrapper.IncludedResources.ServicePlans...)
-
-		if wrapper.NextPage() == "" {
-			break
+		for _, item := range page.resources {
+			err = appendToExternalList(item)
+			if err != nil {
+				return IncludedResources{}, fullWarningsList, err
+			}
 		}
+		includes.Merge(page.included)
+	}
 
-		request, err = requester.newHTTPRequest(requestOptions{
+	return includes, fullWarningsList, nil
+}
+
+// paginateSequentially follows the next links of a list response one page at
+// a time. It is used when the Cloud Controller does not report the total
+// number of pages.
+func (requester RealRequester) paginateSequentially(wrapper *PaginatedResources, obj interface{}, appendToExternalList func(interface{}) error, includes IncludedResources, fullWarningsList Warnings) (IncludedResources, Warnings, error) {
+	for wrapper.NextPage() != "" {
+		request, err := requester.newHTTPRequest(requestOptions{
 			URL:    wrapper.NextPage(),
 			Method: http.MethodGet,
 		})
 		if err != nil {
 			return IncludedResources{}, fullWarningsList, err
 		}
+
+		var warnings Warnings
+		wrapper, warnings, err = requester.wrapFirstPage(request, obj, appendToExternalList)
+		fullWarningsList = append(fullWarningsList, warnings...)
+		if err != nil {
+			return IncludedResources{}, fullWarningsList, err
+		}
+		includes.Merge(wrapper.IncludedResources)
 	}
 
 	return includes, fullWarningsList, nil
 }
 
+// fetchPages requests the given pages with a bounded number of workers and
+// returns the results in the same order as the URLs.
+func (requester RealRequester) fetchPages(pageURLs []string, obj interface{}) []pageResult {
+	pages := make([]pageResult, len(pageURLs))
+	indexes := make(chan int)
+
+	workers := maxConcurrentPageRequests
+	if len(pageURLs) < workers {
+		workers = len(pageURLs)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				pages[index] = requester.fetchPage(pageURLs[index], obj)
+			}
+		}()
+	}
+
+	for index := range pageURLs {
+		indexes <- index
+	}
+	close(indexes)
+	wg.Wait()
+
+	return pages
+}
+
+func (requester RealRequester) fetchPage(pageURL string, obj interface{}) pageResult {
+	request, err := requester.newHTTPRequest(requestOptions{
+		URL:    pageURL,
+		Method: http.MethodGet,
+	})
+	if err != nil {
+		return pageResult{err: err}
+	}
+
+	var items []interface{}
+	wrapper, warnings, err := requester.wrapFirstPage(request, obj, func(item interface{}) error {
+		items = append(items, item)
+		return nil
+	})
+	if err != nil {
+		return pageResult{warnings: warnings, err: err}
+	}
+
+	return pageResult{
+		resources: items,
+		included:  wrapper.IncludedResources,
+		warnings:  warnings,
+	}
+}
+
+// remainingPageURLs builds the URLs of pages 2 through total_pages from the
+// next link of the first page. It returns false when the pages cannot be
+// determined up front.
+func remainingPageURLs(firstPage *PaginatedResources) ([]string, bool) {
+	if firstPage.NextPage() == "" || firstPage.Pagination.TotalPages < 2 {
+		return nil, false
+	}
+
+	nextURL, err := url.Parse(firstPage.NextPage())
+	if err != nil {
+		return nil, false
+	}
+
+	query := nextURL.Query()
+	if query.Get("page") != "2" {
+		return nil, false
+	}
+
+	var pageURLs []string
+	for pageNumber := 2; pageNumber <= firstPage.Pagination.TotalPages; pageNumber++ {
+		query.Set("page", strconv.Itoa(pageNumber))
+		nextURL.RawQuery = query.Encode()
+		pageURLs = append(pageURLs, nextURL.String())
+	}
+
+	return pageURLs, true
+}
+
 func (requester RealRequester) wrapFirstPage(request *cloudcontroller.Request, obj interface{}, appendToExternalList func(interface{}) error) (*PaginatedResources, Warnings, error) {
 	warnings := Warnings{}
 	wrapper := NewPaginatedResources(obj)
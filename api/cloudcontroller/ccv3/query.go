@@ -35,6 +35,12 @@ const (
 	StatusValueFilter QueryKey = "status_values"
 	// DomainGUIDFilter is a query param for listing events by target_guid
 	TargetGUIDFilter QueryKey = "target_guids"
+	// EventTypesFilter is a query param for listing audit events by type
+	EventTypesFilter QueryKey = "types"
+	// CreatedAtsGreaterThanOrEqualFilter is a query param for listing objects created at or after a timestamp
+	CreatedAtsGreaterThanOrEqualFilter QueryKey = "created_ats[gte]"
+	// CreatedAtsLessThanFilter is a query param for listing objects created before a timestamp
+	CreatedAtsLessThanFilter QueryKey = "created_ats[lt]"
 	// DomainGUIDFilter is a query param for listing objects by domain_guid
 	DomainGUIDFilter QueryKey = "domain_guids"
 	// HostsFilter is a query param for listing objects by hostname
@@ -94,6 +100,8 @@ const (
 	OrderBy QueryKey = "order_by"
 	// PerPage is a query parameter for specifying the number of results per page.
 	PerPage QueryKey = "per_page"
+	// Page is a query parameter for specifying which page of results to return.
+	Page QueryKey = "page"
 	// Include is a query parameter for specifying other resources associated with the
 	// resource returned by the endpoint
 	Include QueryKey = "include"
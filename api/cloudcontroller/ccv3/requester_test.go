@@ -7,14 +7,21 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper/wrapperfakes"
+	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/resources"
 	. "code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/types"
+	"github.com/SermoDigital/jose/crypto"
+	"github.com/SermoDigital/jose/jws"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -938,6 +945,197 @@ var _ = Describe("shared request helpers", func() {
 				})
 			})
 		})
+		Context("when the first page reports the total number of pages", func() {
+			var resourceList []resources.Role
+
+			respondWithPage := func(totalPages int, failingPage string) http.HandlerFunc {
+				return func(w http.ResponseWriter, req *http.Request) {
+					pageNumber := req.URL.Query().Get("page")
+					if pageNumber == "" {
+						pageNumber = "1"
+					}
+
+					w.Header().Set("X-Cf-Warnings", "warning-"+pageNumber)
+					if pageNumber == failingPage {
+						w.WriteHeader(http.StatusTeapot)
+						_, _ = w.Write([]byte(`{"errors": [{"code": 10001, "detail": "page-error", "title": "CF-SomeError"}]}`))
+						return
+					}
+
+					next := "null"
+					if pageNumber == "1" {
+						next = fmt.Sprintf(`{"href": "%s/v3/roles?include=users&page=2&per_page=1"}`, server.URL())
+					}
+
+					_, _ = fmt.Fprintf(w, `{
+						"pagination": {
+							"total_pages": %d,
+							"next": %s
+						},
+						"resources": [
+							{"guid": "role-guid-%s", "type": "organization_user"}
+						],
+						"included": {
+							"users": [
+								{"guid": "user-guid-shared", "username": "shared-user"},
+								{"guid": "user-guid-%s", "username": "user-%s"}
+							]
+						}
+					}`, totalPages, next, pageNumber, pageNumber, pageNumber)
+				}
+			}
+
+			BeforeEach(func() {
+				resourceList = []resources.Role{}
+				requestParams = RequestParams{
+					RequestName:  internal.GetRolesRequest,
+					Query:        []Query{{Key: Include, Values: []string{"users"}}},
+					ResponseBody: resources.Role{},
+					AppendToList: func(item interface{}) error {
+						resourceList = append(resourceList, item.(resources.Role))
+						return nil
+					},
+				}
+			})
+
+			When("all pages are returned successfully", func() {
+				BeforeEach(func() {
+					for i := 0; i < 7; i++ {
+						server.AppendHandlers(respondWithPage(7, ""))
+					}
+				})
+
+				It("requests every page", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					var requestedPages []string
+					for _, request := range server.ReceivedRequests() {
+						requestedPages = append(requestedPages, request.URL.Query().Get("page"))
+					}
+					Expect(requestedPages).To(ConsistOf("", "2", "3", "4", "5", "6", "7"))
+				})
+
+				It("returns the resources in page order", func() {
+					Expect(resourceList).To(HaveLen(7))
+					for i, role := range resourceList {
+						Expect(role.GUID).To(Equal(fmt.Sprintf("role-guid-%d", i+1)))
+					}
+				})
+
+				It("returns the warnings of every page in page order", func() {
+					Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2", "warning-3", "warning-4", "warning-5", "warning-6", "warning-7"}))
+				})
+
+				It("merges the included resources of every page without duplicates", func() {
+					var userGUIDs []string
+					for _, user := range includedResources.Users {
+						userGUIDs = append(userGUIDs, user.GUID)
+					}
+					Expect(userGUIDs).To(Equal([]string{
+						"user-guid-shared",
+						"user-guid-1",
+						"user-guid-2",
+						"user-guid-3",
+						"user-guid-4",
+						"user-guid-5",
+						"user-guid-6",
+						"user-guid-7",
+					}))
+				})
+			})
+
+			When("one of the remaining pages fails", func() {
+				BeforeEach(func() {
+					for i := 0; i < 4; i++ {
+						server.AppendHandlers(respondWithPage(4, "3"))
+					}
+				})
+
+				It("returns the error and the warnings up to the failing page", func() {
+					Expect(executeErr).To(MatchError(ccerror.V3UnexpectedResponseError{
+						ResponseCode: http.StatusTeapot,
+						V3ErrorResponse: ccerror.V3ErrorResponse{
+							Errors: []ccerror.V3Error{{Code: 10001, Detail: "page-error", Title: "CF-SomeError"}},
+						},
+					}))
+					Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2", "warning-3"}))
+					Expect(includedResources).To(Equal(IncludedResources{}))
+				})
+			})
+
+			When("the access token expires after the first page", func() {
+				var (
+					fakeUAAClient  *wrapperfakes.FakeUAAClient
+					tokenLock      sync.Mutex
+					accessToken    string
+					expiredToken   string
+					refreshedToken string
+				)
+
+				BeforeEach(func() {
+					validToken, err := buildAccessToken(time.Now().Add(time.Hour))
+					Expect(err).ToNot(HaveOccurred())
+					expiredToken, err = buildAccessToken(time.Now().Add(-time.Hour))
+					Expect(err).ToNot(HaveOccurred())
+					refreshedToken, err = buildAccessToken(time.Now().Add(2 * time.Hour))
+					Expect(err).ToNot(HaveOccurred())
+					accessToken = "bearer " + validToken
+
+					fakeTokenCache := new(wrapperfakes.FakeTokenCache)
+					fakeTokenCache.AccessTokenStub = func() string {
+						tokenLock.Lock()
+						defer tokenLock.Unlock()
+						return accessToken
+					}
+					fakeTokenCache.SetAccessTokenStub = func(token string) {
+						tokenLock.Lock()
+						defer tokenLock.Unlock()
+						accessToken = token
+					}
+					fakeTokenCache.RefreshTokenReturns("some-refresh-token")
+
+					fakeUAAClient = new(wrapperfakes.FakeUAAClient)
+					fakeUAAClient.RefreshAccessTokenStub = func(string) (uaa.RefreshedTokens, error) {
+						// give concurrent page requests time to find the expired token
+						time.Sleep(50 * time.Millisecond)
+						return uaa.RefreshedTokens{
+							AccessToken:  refreshedToken,
+							RefreshToken: "new-refresh-token",
+							Type:         "bearer",
+						}, nil
+					}
+
+					client, _ = NewTestClient(Config{
+						Wrappers: []ConnectionWrapper{wrapper.NewUAAAuthentication(fakeUAAClient, fakeTokenCache)},
+					})
+
+					server.AppendHandlers(func(w http.ResponseWriter, req *http.Request) {
+						tokenLock.Lock()
+						accessToken = "bearer " + expiredToken
+						tokenLock.Unlock()
+
+						respondWithPage(7, "")(w, req)
+					})
+					for i := 0; i < 6; i++ {
+						server.AppendHandlers(respondWithPage(7, ""))
+					}
+				})
+
+				It("refreshes the token once and uses it for the remaining pages", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(resourceList).To(HaveLen(7))
+
+					Expect(fakeUAAClient.RefreshAccessTokenCallCount()).To(Equal(1))
+					Expect(fakeUAAClient.RefreshAccessTokenArgsForCall(0)).To(Equal("some-refresh-token"))
+
+					for _, request := range server.ReceivedRequests() {
+						if request.URL.Query().Get("page") != "" {
+							Expect(request.Header.Get("Authorization")).To(Equal("bearer " + refreshedToken))
+						}
+					}
+				})
+			})
+		})
 	})
 
 	Describe("MakeRequestReceiveRaw", func() {
@@ -1259,3 +1457,10 @@ var _ = Describe("shared request helpers", func() {
 		})
 	})
 })
+
+func buildAccessToken(expiration time.Time) (string, error) {
+	claims := jws.Claims{}
+	claims.SetExpiration(expiration)
+	token, err := jws.NewJWT(claims, crypto.Unsecured).Serialize(nil)
+	return string(token), err
+}
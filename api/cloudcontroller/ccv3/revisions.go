@@ -35,3 +35,17 @@ func (client *Client) GetApplicationRevisionsDeployed(appGUID string) ([]resourc
 	})
 	return revisions, warnings, err
 }
+
+// GetRevisionEnvironmentVariables returns the environment variables recorded
+// in the revision.
+func (client *Client) GetRevisionEnvironmentVariables(revisionGUID string) (resources.EnvironmentVariables, Warnings, error) {
+	var responseBody resources.EnvironmentVariables
+
+	_, warnings, err := client.MakeRequest(RequestParams{
+		RequestName:  internal.GetRevisionEnvironmentVariablesRequest,
+		URIParams:    internal.Params{"revision_guid": revisionGUID},
+		ResponseBody: &responseBody,
+	})
+
+	return responseBody, warnings, err
+}
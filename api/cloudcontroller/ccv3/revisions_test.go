@@ -133,4 +133,51 @@ var _ = Describe("Revisions", func() {
 			})
 		})
 	})
+
+	Describe("GetRevisionEnvironmentVariables", func() {
+		var (
+			envVars    resources.EnvironmentVariables
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			envVars, warnings, executeErr = client.GetRevisionEnvironmentVariables("some-revision-guid")
+		})
+
+		When("the request succeeds", func() {
+			BeforeEach(func() {
+				requester.MakeRequestCalls(func(requestParams RequestParams) (JobURL, Warnings, error) {
+					*requestParams.ResponseBody.(*resources.EnvironmentVariables) = resources.EnvironmentVariables{
+						"SOME_VAR": {Value: "some-value", IsSet: true},
+					}
+					return "", Warnings{"this is a warning"}, nil
+				})
+			})
+
+			It("returns the environment variables and all warnings", func() {
+				Expect(requester.MakeRequestCallCount()).To(Equal(1))
+				actualParams := requester.MakeRequestArgsForCall(0)
+				Expect(actualParams.RequestName).To(Equal(internal.GetRevisionEnvironmentVariablesRequest))
+				Expect(actualParams.URIParams).To(Equal(internal.Params{"revision_guid": "some-revision-guid"}))
+
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(envVars).To(Equal(resources.EnvironmentVariables{
+					"SOME_VAR": {Value: "some-value", IsSet: true},
+				}))
+			})
+		})
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				requester.MakeRequestReturns("", Warnings{"this is a warning"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
 })
@@ -120,6 +120,52 @@ func (client Client) MapRoute(routeGUID string, appGUID string, destinationProto
 	return warnings, err
 }
 
+// ReplaceRouteDestinations replaces all destinations of the route. Weighted
+// destinations can only be set this way.
+func (client Client) ReplaceRouteDestinations(routeGUID string, destinations []resources.RouteDestination) (Warnings, error) {
+	type destinationProcess struct {
+		Type string `json:"type"`
+	}
+
+	type destinationApp struct {
+		GUID    string              `json:"guid"`
+		Process *destinationProcess `json:"process,omitempty"`
+	}
+
+	type destination struct {
+		App      destinationApp `json:"app"`
+		Port     int            `json:"port,omitempty"`
+		Protocol string         `json:"protocol,omitempty"`
+		Weight   *int           `json:"weight,omitempty"`
+	}
+
+	type body struct {
+		Destinations []destination `json:"destinations"`
+	}
+
+	requestBody := body{Destinations: []destination{}}
+	for _, dst := range destinations {
+		requestDestination := destination{
+			App:      destinationApp{GUID: dst.App.GUID},
+			Port:     dst.Port,
+			Protocol: dst.Protocol,
+			Weight:   dst.Weight,
+		}
+		if dst.App.Process.Type != "" {
+			requestDestination.App.Process = &destinationProcess{Type: dst.App.Process.Type}
+		}
+		requestBody.Destinations = append(requestBody.Destinations, requestDestination)
+	}
+
+	_, warnings, err := client.MakeRequest(RequestParams{
+		RequestName: internal.PatchRouteDestinationsRequest,
+		URIParams:   internal.Params{"route_guid": routeGUID},
+		RequestBody: &requestBody,
+	})
+
+	return warnings, err
+}
+
 func (client Client) UnmapRoute(routeGUID string, destinationGUID string) (Warnings, error) {
 	var responseBody resources.Build
 
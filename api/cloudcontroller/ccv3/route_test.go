@@ -683,7 +683,8 @@ var _ = Describe("Route", func() {
 								"process": {
 									"type": "worker"
 								}
-							}
+							},
+							"weight": 10
 						}
 					]
 				}`
@@ -700,6 +701,7 @@ var _ = Describe("Route", func() {
 				})
 
 				It("returns destinations and all warnings", func() {
+					ten := 10
 					Expect(executeErr).ToNot(HaveOccurred())
 					Expect(warnings).To(ConsistOf("warning-1"))
 
@@ -709,8 +711,9 @@ var _ = Describe("Route", func() {
 							App:  resources.RouteDestinationApp{GUID: "app-1-guid", Process: struct{ Type string }{Type: "web"}},
 						},
 						{
-							GUID: "destination-2-guid",
-							App:  resources.RouteDestinationApp{GUID: "app-2-guid", Process: struct{ Type string }{Type: "worker"}},
+							GUID:   "destination-2-guid",
+							App:    resources.RouteDestinationApp{GUID: "app-2-guid", Process: struct{ Type string }{Type: "worker"}},
+							Weight: &ten,
 						},
 					}))
 				})
@@ -885,6 +888,96 @@ var _ = Describe("Route", func() {
 		})
 	})
 
+	Describe("ReplaceRouteDestinations", func() {
+		var (
+			destinations []resources.RouteDestination
+			warnings     Warnings
+			executeErr   error
+		)
+
+		BeforeEach(func() {
+			ninety := 90
+			ten := 10
+			destinations = []resources.RouteDestination{
+				{
+					App:      resources.RouteDestinationApp{GUID: "app-1-guid", Process: struct{ Type string }{Type: "web"}},
+					Protocol: "http1",
+					Weight:   &ninety,
+				},
+				{
+					App:    resources.RouteDestinationApp{GUID: "app-2-guid"},
+					Weight: &ten,
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = client.ReplaceRouteDestinations("route-guid", destinations)
+		})
+
+		When("the request succeeds", func() {
+			BeforeEach(func() {
+				expectedBody := `{
+					"destinations": [
+						{
+							"app": {"guid": "app-1-guid", "process": {"type": "web"}},
+							"protocol": "http1",
+							"weight": 90
+						},
+						{
+							"app": {"guid": "app-2-guid"},
+							"weight": 10
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/routes/route-guid/destinations"),
+						VerifyJSON(expectedBody),
+						RespondWith(http.StatusOK, `{"destinations": []}`, http.Header{
+							"X-Cf-Warnings": {"this is a warning"},
+						}),
+					),
+				)
+			})
+
+			It("replaces the destinations and returns all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		When("the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "Destinations weights must sum to 100.",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/routes/route-guid/destinations"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{
+							"X-Cf-Warnings": {"this is a warning"},
+						}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.UnprocessableEntityError{
+					Message: "Destinations weights must sum to 100.",
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
 	Describe("DeleteOrphanedRoutes", func() {
 		var (
 			spaceGUID  string
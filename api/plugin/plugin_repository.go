@@ -15,6 +15,11 @@ type PluginBinary struct {
 	Platform string `json:"platform"`
 	URL      string `json:"url"`
 	Checksum string `json:"checksum"`
+	// SHA256 is the hex-encoded SHA-256 digest of the binary. Repositories
+	// that publish it also keep the SHA-1 Checksum for older CLIs.
+	SHA256 string `json:"sha256,omitempty"`
+	// Signature is the base64-encoded ed25519 signature of the binary.
+	Signature string `json:"signature,omitempty"`
 }
 
 type Plugin struct {
@@ -30,7 +30,7 @@ var _ = Describe("PluginRepository", func() {
 							"name": "plugin-1",
 							"description": "useful plugin for useful things",
 							"version": "1.0.0",
-							"binaries": [{"platform":"osx","url":"http://some-url","checksum":"somechecksum"},{"platform":"win64","url":"http://another-url","checksum":"anotherchecksum"},{"platform":"linux64","url":"http://last-url","checksum":"lastchecksum"}]
+							"binaries": [{"platform":"osx","url":"http://some-url","checksum":"somechecksum"},{"platform":"win64","url":"http://another-url","checksum":"anotherchecksum"},{"platform":"linux64","url":"http://last-url","checksum":"lastchecksum","sha256":"lastsha256","signature":"lastsignature"}]
 						},
 						{
 							"name": "plugin-2",
@@ -59,7 +59,7 @@ var _ = Describe("PluginRepository", func() {
 							Binaries: []PluginBinary{
 								{Platform: "osx", URL: "http://some-url", Checksum: "somechecksum"},
 								{Platform: "win64", URL: "http://another-url", Checksum: "anotherchecksum"},
-								{Platform: "linux64", URL: "http://last-url", Checksum: "lastchecksum"},
+								{Platform: "linux64", URL: "http://last-url", Checksum: "lastchecksum", SHA256: "lastsha256", Signature: "lastsignature"},
 							},
 						},
 						{
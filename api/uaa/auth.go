@@ -19,8 +19,8 @@ type AuthResponse struct {
 	RefreshToken string `json:"refresh_token"`
 }
 
-// Authenticate sends a username and password to UAA then returns an access
-// token and a refresh token.
+// Authenticate sends a username and password, an authorization code or a
+// device code to UAA then returns an access token and a refresh token.
 func (client Client) Authenticate(creds map[string]string, origin string, grantType constant.GrantType) (string, string, error) {
 	requestBody := url.Values{
 		"grant_type": {string(grantType)},
@@ -60,7 +60,7 @@ func (client Client) Authenticate(creds map[string]string, origin string, grantT
 		return "", "", err
 	}
 
-	if grantType == constant.GrantTypePassword {
+	if grantType == constant.GrantTypePassword || grantType == constant.GrantTypeAuthorizationCode || grantType == constant.GrantTypeDeviceCode {
 		request.SetBasicAuth(client.config.UAAOAuthClient(), client.config.UAAOAuthClientSecret())
 	}
 
@@ -0,0 +1,32 @@
+package uaa
+
+import (
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/uaa/internal"
+)
+
+// AuthorizationCodeURL returns the URL a user visits in a web browser to log
+// in and be redirected to redirectURI with an authorization code. The code
+// can then be exchanged for a token with Authenticate and the
+// authorization_code grant type.
+func (client Client) AuthorizationCodeURL(redirectURI string, state string, codeChallenge string) (string, error) {
+	query := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {client.config.UAAOAuthClient()},
+		"redirect_uri":          {redirectURI},
+		"state":                 {state},
+		"code_challenge":        {codeChallenge},
+		"code_challenge_method": {PKCECodeChallengeMethod},
+	}
+
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.GetOAuthAuthorizeRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return request.URL.String(), nil
+}
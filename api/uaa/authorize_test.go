@@ -0,0 +1,43 @@
+package uaa_test
+
+import (
+	"net/url"
+
+	. "code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Authorize", func() {
+	var (
+		client     *Client
+		fakeConfig *uaafakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeConfig = NewTestConfig()
+
+		client = NewTestUAAClientAndStore(fakeConfig)
+	})
+
+	Describe("AuthorizationCodeURL", func() {
+		It("returns the authorize URL of the login server", func() {
+			authorizeURL, err := client.AuthorizationCodeURL("http://127.0.0.1:4242/callback", "some-state", "some-challenge")
+			Expect(err).NotTo(HaveOccurred())
+
+			parsedURL, err := url.Parse(authorizeURL)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsedURL.Host).To(Equal(TestAuthorizationResource))
+			Expect(parsedURL.Path).To(Equal("/oauth/authorize"))
+			Expect(parsedURL.Query()).To(Equal(url.Values{
+				"response_type":         {"code"},
+				"client_id":             {"client-id"},
+				"redirect_uri":          {"http://127.0.0.1:4242/callback"},
+				"state":                 {"some-state"},
+				"code_challenge":        {"some-challenge"},
+				"code_challenge_method": {"S256"},
+			}))
+		})
+	})
+})
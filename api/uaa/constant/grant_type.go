@@ -4,9 +4,15 @@ package constant
 type GrantType string
 
 const (
+	// GrantTypeAuthorizationCode is used for exchanging an authorization code,
+	// obtained by a user logging in through a web browser, for a token.
+	GrantTypeAuthorizationCode GrantType = "authorization_code"
 	// GrantTypeClientCredentials is used for a preconfigured client ID/secret
 	// authentication.
 	GrantTypeClientCredentials GrantType = "client_credentials"
+	// GrantTypeDeviceCode is used for exchanging a device code, approved by a
+	// user on another device, for a token (RFC 8628).
+	GrantTypeDeviceCode GrantType = "urn:ietf:params:oauth:grant-type:device_code"
 	// GrantTypePassword is used for user's username/password authentication.
 	GrantTypePassword     GrantType = "password"
 	GrantTypeRefreshToken GrantType = "refresh_token"
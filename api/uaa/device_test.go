@@ -0,0 +1,93 @@
+package uaa_test
+
+import (
+	"net/http"
+	"net/url"
+
+	. "code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Device", func() {
+	var (
+		client     *Client
+		fakeConfig *uaafakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeConfig = NewTestConfig()
+
+		client = NewTestUAAClientAndStore(fakeConfig)
+	})
+
+	Describe("RequestDeviceAuthorization", func() {
+		var (
+			authorization DeviceAuthorization
+			executeErr    error
+		)
+
+		JustBeforeEach(func() {
+			authorization, executeErr = client.RequestDeviceAuthorization()
+		})
+
+		When("no errors occur", func() {
+			BeforeEach(func() {
+				response := `{
+					"device_code": "some-device-code",
+					"user_code": "WDJB-MJHT",
+					"verification_uri": "https://login.example.com/device",
+					"verification_uri_complete": "https://login.example.com/device?user_code=WDJB-MJHT",
+					"expires_in": 1800,
+					"interval": 5
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestAuthorizationResource),
+						VerifyRequest(http.MethodPost, "/oauth/device_authorize"),
+						VerifyHeaderKV("Content-Type", "application/x-www-form-urlencoded"),
+						VerifyForm(url.Values{"client_id": {"client-id"}}),
+						RespondWith(http.StatusOK, response),
+					))
+			})
+
+			It("returns the device authorization", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(authorization).To(Equal(DeviceAuthorization{
+					DeviceCode:              "some-device-code",
+					UserCode:                "WDJB-MJHT",
+					VerificationURI:         "https://login.example.com/device",
+					VerificationURIComplete: "https://login.example.com/device?user_code=WDJB-MJHT",
+					ExpiresIn:               1800,
+					Interval:                5,
+				}))
+			})
+		})
+
+		When("an error occurs", func() {
+			var response string
+
+			BeforeEach(func() {
+				response = `{
+					"error": "unauthorized_client",
+					"error_description": "some-description"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestAuthorizationResource),
+						VerifyRequest(http.MethodPost, "/oauth/device_authorize"),
+						RespondWith(http.StatusTeapot, response),
+					))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(RawHTTPStatusError{
+					StatusCode:  http.StatusTeapot,
+					RawResponse: []byte(response),
+				}))
+			})
+		})
+	})
+})
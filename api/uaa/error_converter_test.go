@@ -83,6 +83,50 @@ var _ = Describe("Error Wrapper", func() {
 						Expect(makeErr).To(MatchError(InvalidSCIMResourceError{Message: "A username must be provided"}))
 					})
 				})
+
+				Context("a device code has not been approved yet", func() {
+					BeforeEach(func() {
+						fakeConnectionErr.RawResponse = []byte(`{"error":"authorization_pending"}`)
+						fakeConnection.MakeReturns(fakeConnectionErr)
+					})
+
+					It("returns an AuthorizationPendingError", func() {
+						Expect(makeErr).To(MatchError(AuthorizationPendingError{}))
+					})
+				})
+
+				Context("a device code is polled too often", func() {
+					BeforeEach(func() {
+						fakeConnectionErr.RawResponse = []byte(`{"error":"slow_down"}`)
+						fakeConnection.MakeReturns(fakeConnectionErr)
+					})
+
+					It("returns a SlowDownError", func() {
+						Expect(makeErr).To(MatchError(SlowDownError{}))
+					})
+				})
+
+				Context("a device code has expired", func() {
+					BeforeEach(func() {
+						fakeConnectionErr.RawResponse = []byte(`{"error":"expired_token","error_description":"The device code has expired"}`)
+						fakeConnection.MakeReturns(fakeConnectionErr)
+					})
+
+					It("returns an ExpiredTokenError", func() {
+						Expect(makeErr).To(MatchError(ExpiredTokenError{Message: "The device code has expired"}))
+					})
+				})
+
+				Context("a device login was denied", func() {
+					BeforeEach(func() {
+						fakeConnectionErr.RawResponse = []byte(`{"error":"access_denied","error_description":"The user denied the request"}`)
+						fakeConnection.MakeReturns(fakeConnectionErr)
+					})
+
+					It("returns an AccessDeniedError", func() {
+						Expect(makeErr).To(MatchError(AccessDeniedError{Message: "The user denied the request"}))
+					})
+				})
 			})
 
 			Context("(401) Unauthorized", func() {
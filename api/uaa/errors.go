@@ -104,3 +104,38 @@ type InvalidPasswordError struct {
 func (e InvalidPasswordError) Error() string {
 	return e.Message
 }
+
+// AuthorizationPendingError is returned when a device code is exchanged for a
+// token before the user has approved the login.
+type AuthorizationPendingError struct{}
+
+func (AuthorizationPendingError) Error() string {
+	return "authorization pending"
+}
+
+// SlowDownError is returned when a device code is exchanged for a token more
+// often than the UAA allows.
+type SlowDownError struct{}
+
+func (SlowDownError) Error() string {
+	return "slow down"
+}
+
+// ExpiredTokenError is returned when a device code has expired before the
+// user approved the login.
+type ExpiredTokenError struct {
+	Message string
+}
+
+func (e ExpiredTokenError) Error() string {
+	return e.Message
+}
+
+// AccessDeniedError is returned when the user denied a device login.
+type AccessDeniedError struct {
+	Message string
+}
+
+func (e AccessDeniedError) Error() string {
+	return e.Message
+}
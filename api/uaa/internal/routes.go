@@ -5,14 +5,16 @@ import (
 )
 
 const (
-	GetClientUser         = "GetClientUser"
-	GetSSHPasscodeRequest = "GetSSHPasscode"
-	PostOAuthTokenRequest = "PostOAuthToken"
-	PostUserRequest       = "PostUser"
-	ListUsersRequest      = "ListUsers"
-	DeleteUserRequest     = "DeleteUser"
-	UpdatePasswordRequest = "UpdatePassword"
-	DeleteTokenRequest    = "DeleteToken"
+	GetClientUser              = "GetClientUser"
+	GetOAuthAuthorizeRequest   = "GetOAuthAuthorize"
+	GetSSHPasscodeRequest      = "GetSSHPasscode"
+	PostDeviceAuthorizeRequest = "PostDeviceAuthorize"
+	PostOAuthTokenRequest      = "PostOAuthToken"
+	PostUserRequest            = "PostUser"
+	ListUsersRequest           = "ListUsers"
+	DeleteUserRequest          = "DeleteUser"
+	UpdatePasswordRequest      = "UpdatePassword"
+	DeleteTokenRequest         = "DeleteToken"
 )
 
 // APIRoutes is a list of routes used by the router to construct request URLs.
@@ -22,7 +24,9 @@ var APIRoutes = []Route{
 	{Path: "/Users/:user_guid", Method: http.MethodDelete, Name: DeleteUserRequest, Resource: UAAResource},
 	{Path: "/Users/:user_guid/password", Method: http.MethodPut, Name: UpdatePasswordRequest, Resource: UAAResource},
 	{Path: "/oauth/authorize", Method: http.MethodGet, Name: GetSSHPasscodeRequest, Resource: UAAResource},
+	{Path: "/oauth/authorize", Method: http.MethodGet, Name: GetOAuthAuthorizeRequest, Resource: AuthorizationResource},
 	{Path: "/oauth/clients/:client_id", Method: http.MethodGet, Name: GetClientUser, Resource: UAAResource},
+	{Path: "/oauth/device_authorize", Method: http.MethodPost, Name: PostDeviceAuthorizeRequest, Resource: AuthorizationResource},
 	{Path: "/oauth/token", Method: http.MethodPost, Name: PostOAuthTokenRequest, Resource: AuthorizationResource},
 	{Path: "/oauth/token/revoke/:token_id", Method: http.MethodDelete, Name: DeleteTokenRequest, Resource: AuthorizationResource},
 }
@@ -0,0 +1,47 @@
+package uaa
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// PKCECodeChallengeMethod is the only code challenge method the CLI sends.
+const PKCECodeChallengeMethod = "S256"
+
+// PKCE is a Proof Key for Code Exchange (RFC 7636). The challenge is sent
+// when requesting an authorization code and the verifier is sent when the
+// code is exchanged for a token, so that an intercepted code is useless on
+// its own.
+type PKCE struct {
+	Verifier  string
+	Challenge string
+}
+
+// NewPKCE returns a PKCE with a random verifier.
+func NewPKCE() (PKCE, error) {
+	verifier, err := randomURLSafeString(32)
+	if err != nil {
+		return PKCE{}, err
+	}
+
+	return PKCE{
+		Verifier:  verifier,
+		Challenge: PKCEChallenge(verifier),
+	}, nil
+}
+
+// PKCEChallenge returns the S256 code challenge for the provided verifier.
+func PKCEChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func randomURLSafeString(size int) (string, error) {
+	bytes := make([]byte, size)
+	_, err := rand.Read(bytes)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
@@ -0,0 +1,29 @@
+package uaa_test
+
+import (
+	. "code.cloudfoundry.org/cli/api/uaa"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PKCE", func() {
+	Describe("NewPKCE", func() {
+		It("returns a random verifier and its challenge", func() {
+			pkce, err := NewPKCE()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pkce.Verifier).To(MatchRegexp(`^[A-Za-z0-9_-]{43}$`))
+			Expect(pkce.Challenge).To(Equal(PKCEChallenge(pkce.Verifier)))
+
+			otherPKCE, err := NewPKCE()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(otherPKCE.Verifier).NotTo(Equal(pkce.Verifier))
+		})
+	})
+
+	Describe("PKCEChallenge", func() {
+		It("returns the base64url encoded SHA-256 of the verifier", func() {
+			// Example from RFC 7636, Appendix B.
+			Expect(PKCEChallenge("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk")).To(Equal("E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"))
+		})
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"sync"
 
 	"code.cloudfoundry.org/cli/api/uaa"
 )
@@ -32,6 +33,11 @@ type UAAAuthentication struct {
 	connection uaa.Connection
 	client     UAAClient
 	cache      TokenCache
+
+	// refreshLock makes concurrent requests, such as the SSH passcodes
+	// fetched for several instances at once, refresh an expired token only
+	// once.
+	refreshLock sync.Mutex
 }
 
 // NewUAAAuthentication returns a pointer to a UAAAuthentication wrapper with
@@ -67,28 +73,54 @@ func (t *UAAAuthentication) Make(request *http.Request, passedResponse *uaa.Resp
 		}
 	}
 
-	request.Header.Set("Authorization", t.cache.AccessToken())
+	accessToken := t.accessToken()
+	request.Header.Set("Authorization", accessToken)
 
 	err = t.connection.Make(request, passedResponse)
 	if _, ok := err.(uaa.InvalidAuthTokenError); ok {
-		tokens, refreshErr := t.client.RefreshAccessToken(t.cache.RefreshToken())
-		if refreshErr != nil {
-			return refreshErr
+		accessToken, err = t.refreshAccessToken(accessToken)
+		if err != nil {
+			return err
 		}
 
-		t.cache.SetAccessToken(tokens.AuthorizationToken())
-		t.cache.SetRefreshToken(tokens.RefreshToken)
-
 		if rawRequestBody != nil {
 			request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
 		}
-		request.Header.Set("Authorization", t.cache.AccessToken())
+		request.Header.Set("Authorization", accessToken)
 		return t.connection.Make(request, passedResponse)
 	}
 
 	return err
 }
 
+func (t *UAAAuthentication) accessToken() string {
+	t.refreshLock.Lock()
+	defer t.refreshLock.Unlock()
+
+	return t.cache.AccessToken()
+}
+
+// refreshAccessToken returns a new access token to replace the rejected one.
+// If another request has refreshed the token in the meantime, its token is
+// returned without refreshing again.
+func (t *UAAAuthentication) refreshAccessToken(rejectedToken string) (string, error) {
+	t.refreshLock.Lock()
+	defer t.refreshLock.Unlock()
+
+	if accessToken := t.cache.AccessToken(); accessToken != rejectedToken {
+		return accessToken, nil
+	}
+
+	tokens, err := t.client.RefreshAccessToken(t.cache.RefreshToken())
+	if err != nil {
+		return "", err
+	}
+
+	t.cache.SetAccessToken(tokens.AuthorizationToken())
+	t.cache.SetRefreshToken(tokens.RefreshToken)
+	return t.cache.AccessToken(), nil
+}
+
 // SetClient sets the UAA client that the wrapper will use.
 func (t *UAAAuthentication) SetClient(client UAAClient) {
 	t.client = client
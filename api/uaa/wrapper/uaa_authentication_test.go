@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
@@ -162,6 +163,69 @@ var _ = Describe("UAA Authentication", func() {
 			})
 		})
 
+		When("several requests are rejected for the same expired token at once", func() {
+			const requestCount = 5
+
+			var (
+				sentTokensLock sync.Mutex
+				sentTokens     []string
+			)
+
+			BeforeEach(func() {
+				sentTokens = nil
+				inMemoryCache.SetAccessToken("bearer expired-token")
+				inMemoryCache.SetRefreshToken("some-refresh-token")
+
+				fakeConnection.MakeStub = func(request *http.Request, response *uaa.Response) error {
+					sentTokensLock.Lock()
+					sentTokens = append(sentTokens, request.Header.Get("Authorization"))
+					sentTokensLock.Unlock()
+
+					if request.Header.Get("Authorization") == "bearer expired-token" {
+						return uaa.InvalidAuthTokenError{}
+					}
+					return nil
+				}
+
+				fakeClient.RefreshAccessTokenReturns(
+					uaa.RefreshedTokens{
+						AccessToken:  "new-token",
+						RefreshToken: "new-refresh-token",
+						Type:         "bearer",
+					},
+					nil,
+				)
+
+				var wg sync.WaitGroup
+				for i := 0; i < requestCount; i++ {
+					wg.Add(1)
+					go func() {
+						defer GinkgoRecover()
+						defer wg.Done()
+
+						request, err := http.NewRequest(http.MethodGet, "https://uaa.example.com/oauth/authorize", nil)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(wrapper.Make(request, nil)).To(Succeed())
+					}()
+				}
+				wg.Wait()
+			})
+
+			It("refreshes the token once and resends every request with the new token", func() {
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeClient.RefreshAccessTokenArgsForCall(0)).To(Equal("some-refresh-token"))
+
+				resent := 0
+				for _, token := range sentTokens {
+					if token == "bearer new-token" {
+						resent++
+					}
+				}
+				Expect(resent).To(Equal(requestCount))
+				Expect(inMemoryCache.RefreshToken()).To(Equal("new-refresh-token"))
+			})
+		})
+
 		When("refreshing the token", func() {
 			var originalAuthHeader string
 			BeforeEach(func() {
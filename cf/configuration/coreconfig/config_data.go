@@ -28,6 +28,7 @@ type Data struct {
 	AuthorizationEndpoint    string
 	ColorEnabled             string
 	ConfigVersion            int
+	CredentialsStore         string `json:",omitempty"`
 	DopplerEndPoint          string
 	Locale                   string
 	LogCacheEndPoint         string
@@ -59,7 +60,27 @@ func NewData() *Data {
 
 func (d *Data) JSONMarshalV3() ([]byte, error) {
 	d.ConfigVersion = configv3.CurrentConfigVersion
-	return json.MarshalIndent(d, "", "  ")
+
+	if d.CredentialsStore == "" {
+		return json.MarshalIndent(d, "", "  ")
+	}
+
+	helper := configv3.NewCredentialHelper(d.CredentialsStore)
+	var err error
+	if credentials := d.credentials(); credentials.IsEmpty() {
+		err = helper.Erase("")
+	} else {
+		err = helper.Store("", credentials)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	persisted := *d
+	persisted.AccessToken = ""
+	persisted.RefreshToken = ""
+	persisted.UAAOAuthClientSecret = ""
+	return json.MarshalIndent(persisted, "", "  ")
 }
 
 func (d *Data) JSONUnmarshalV3(input []byte) error {
@@ -73,5 +94,22 @@ func (d *Data) JSONUnmarshalV3(input []byte) error {
 		return nil
 	}
 
+	if d.CredentialsStore != "" && d.credentials().IsEmpty() {
+		// A helper that fails leaves the session without secrets, as it does
+		// for the v7 config; the user is asked to log in again.
+		credentials, _ := configv3.NewCredentialHelper(d.CredentialsStore).Get("")
+		d.AccessToken = credentials.AccessToken
+		d.RefreshToken = credentials.RefreshToken
+		d.UAAOAuthClientSecret = credentials.UAAOAuthClientSecret
+	}
+
 	return nil
 }
+
+func (d *Data) credentials() configv3.Credentials {
+	return configv3.Credentials{
+		AccessToken:          d.AccessToken,
+		RefreshToken:         d.RefreshToken,
+		UAAOAuthClientSecret: d.UAAOAuthClientSecret,
+	}
+}
@@ -101,6 +101,41 @@ type FakeConfig struct {
 	colorEnabledReturnsOnCall map[int]struct {
 		result1 configv3.ColorSetting
 	}
+	ContextNamesStub        func() []string
+	contextNamesMutex       sync.RWMutex
+	contextNamesArgsForCall []struct {
+	}
+	contextNamesReturns struct {
+		result1 []string
+	}
+	contextNamesReturnsOnCall map[int]struct {
+		result1 []string
+	}
+	CreateContextStub        func(string)
+	createContextMutex       sync.RWMutex
+	createContextArgsForCall []struct {
+		arg1 string
+	}
+	CredentialsStoreStub        func() string
+	credentialsStoreMutex       sync.RWMutex
+	credentialsStoreArgsForCall []struct {
+	}
+	credentialsStoreReturns struct {
+		result1 string
+	}
+	credentialsStoreReturnsOnCall map[int]struct {
+		result1 string
+	}
+	CurrentContextStub        func() string
+	currentContextMutex       sync.RWMutex
+	currentContextArgsForCall []struct {
+	}
+	currentContextReturns struct {
+		result1 string
+	}
+	currentContextReturnsOnCall map[int]struct {
+		result1 string
+	}
 	CurrentUserStub        func() (configv3.User, error)
 	currentUserMutex       sync.RWMutex
 	currentUserArgsForCall []struct {
@@ -125,6 +160,17 @@ type FakeConfig struct {
 		result1 string
 		result2 error
 	}
+	DeleteContextStub        func(string) error
+	deleteContextMutex       sync.RWMutex
+	deleteContextArgsForCall []struct {
+		arg1 string
+	}
+	deleteContextReturns struct {
+		result1 error
+	}
+	deleteContextReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DialTimeoutStub        func() time.Duration
 	dialTimeoutMutex       sync.RWMutex
 	dialTimeoutArgsForCall []struct {
@@ -155,6 +201,19 @@ type FakeConfig struct {
 	experimentalReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	GetContextStub        func(string) (configv3.TargetContext, bool)
+	getContextMutex       sync.RWMutex
+	getContextArgsForCall []struct {
+		arg1 string
+	}
+	getContextReturns struct {
+		result1 configv3.TargetContext
+		result2 bool
+	}
+	getContextReturnsOnCall map[int]struct {
+		result1 configv3.TargetContext
+		result2 bool
+	}
 	GetPluginStub        func(string) (configv3.Plugin, bool)
 	getPluginMutex       sync.RWMutex
 	getPluginArgsForCall []struct {
@@ -181,6 +240,17 @@ type FakeConfig struct {
 		result1 configv3.Plugin
 		result2 bool
 	}
+	HasContextStub        func(string) bool
+	hasContextMutex       sync.RWMutex
+	hasContextArgsForCall []struct {
+		arg1 string
+	}
+	hasContextReturns struct {
+		result1 bool
+	}
+	hasContextReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	HasTargetedOrganizationStub        func() bool
 	hasTargetedOrganizationMutex       sync.RWMutex
 	hasTargetedOrganizationArgsForCall []struct {
@@ -281,6 +351,16 @@ type FakeConfig struct {
 	overallPollingTimeoutReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	PackageCacheDirStub        func() string
+	packageCacheDirMutex       sync.RWMutex
+	packageCacheDirArgsForCall []struct {
+	}
+	packageCacheDirReturns struct {
+		result1 string
+	}
+	packageCacheDirReturnsOnCall map[int]struct {
+		result1 string
+	}
 	PluginHomeStub        func() string
 	pluginHomeMutex       sync.RWMutex
 	pluginHomeArgsForCall []struct {
@@ -381,6 +461,11 @@ type FakeConfig struct {
 	setColorEnabledArgsForCall []struct {
 		arg1 string
 	}
+	SetCredentialsStoreStub        func(string)
+	setCredentialsStoreMutex       sync.RWMutex
+	setCredentialsStoreArgsForCall []struct {
+		arg1 string
+	}
 	SetKubernetesAuthInfoStub        func(string)
 	setKubernetesAuthInfoMutex       sync.RWMutex
 	setKubernetesAuthInfoArgsForCall []struct {
@@ -402,6 +487,12 @@ type FakeConfig struct {
 		arg1 string
 		arg2 string
 	}
+	SetPluginRepositoryPublicKeyStub        func(string, string)
+	setPluginRepositoryPublicKeyMutex       sync.RWMutex
+	setPluginRepositoryPublicKeyArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
 	SetRefreshTokenStub        func(string)
 	setRefreshTokenMutex       sync.RWMutex
 	setRefreshTokenArgsForCall []struct {
@@ -477,6 +568,17 @@ type FakeConfig struct {
 	startupTimeoutReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	SwitchContextStub        func(string) error
+	switchContextMutex       sync.RWMutex
+	switchContextArgsForCall []struct {
+		arg1 string
+	}
+	switchContextReturns struct {
+		result1 error
+	}
+	switchContextReturnsOnCall map[int]struct {
+		result1 error
+	}
 	TargetStub        func() string
 	targetMutex       sync.RWMutex
 	targetArgsForCall []struct {
@@ -1110,6 +1212,193 @@ func (fake *FakeConfig) ColorEnabledReturnsOnCall(i int, result1 configv3.ColorS
 	}{result1}
 }
 
+func (fake *FakeConfig) ContextNames() []string {
+	fake.contextNamesMutex.Lock()
+	ret, specificReturn := fake.contextNamesReturnsOnCall[len(fake.contextNamesArgsForCall)]
+	fake.contextNamesArgsForCall = append(fake.contextNamesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ContextNames", []interface{}{})
+	fake.contextNamesMutex.Unlock()
+	if fake.ContextNamesStub != nil {
+		return fake.ContextNamesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.contextNamesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ContextNamesCallCount() int {
+	fake.contextNamesMutex.RLock()
+	defer fake.contextNamesMutex.RUnlock()
+	return len(fake.contextNamesArgsForCall)
+}
+
+func (fake *FakeConfig) ContextNamesCalls(stub func() []string) {
+	fake.contextNamesMutex.Lock()
+	defer fake.contextNamesMutex.Unlock()
+	fake.ContextNamesStub = stub
+}
+
+func (fake *FakeConfig) ContextNamesReturns(result1 []string) {
+	fake.contextNamesMutex.Lock()
+	defer fake.contextNamesMutex.Unlock()
+	fake.ContextNamesStub = nil
+	fake.contextNamesReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeConfig) ContextNamesReturnsOnCall(i int, result1 []string) {
+	fake.contextNamesMutex.Lock()
+	defer fake.contextNamesMutex.Unlock()
+	fake.ContextNamesStub = nil
+	if fake.contextNamesReturnsOnCall == nil {
+		fake.contextNamesReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.contextNamesReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeConfig) CreateContext(arg1 string) {
+	fake.createContextMutex.Lock()
+	fake.createContextArgsForCall = append(fake.createContextArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("CreateContext", []interface{}{arg1})
+	fake.createContextMutex.Unlock()
+	if fake.CreateContextStub != nil {
+		fake.CreateContextStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) CreateContextCallCount() int {
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
+	return len(fake.createContextArgsForCall)
+}
+
+func (fake *FakeConfig) CreateContextCalls(stub func(string)) {
+	fake.createContextMutex.Lock()
+	defer fake.createContextMutex.Unlock()
+	fake.CreateContextStub = stub
+}
+
+func (fake *FakeConfig) CreateContextArgsForCall(i int) string {
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
+	argsForCall := fake.createContextArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) CredentialsStore() string {
+	fake.credentialsStoreMutex.Lock()
+	ret, specificReturn := fake.credentialsStoreReturnsOnCall[len(fake.credentialsStoreArgsForCall)]
+	fake.credentialsStoreArgsForCall = append(fake.credentialsStoreArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CredentialsStore", []interface{}{})
+	fake.credentialsStoreMutex.Unlock()
+	if fake.CredentialsStoreStub != nil {
+		return fake.CredentialsStoreStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.credentialsStoreReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) CredentialsStoreCallCount() int {
+	fake.credentialsStoreMutex.RLock()
+	defer fake.credentialsStoreMutex.RUnlock()
+	return len(fake.credentialsStoreArgsForCall)
+}
+
+func (fake *FakeConfig) CredentialsStoreCalls(stub func() string) {
+	fake.credentialsStoreMutex.Lock()
+	defer fake.credentialsStoreMutex.Unlock()
+	fake.CredentialsStoreStub = stub
+}
+
+func (fake *FakeConfig) CredentialsStoreReturns(result1 string) {
+	fake.credentialsStoreMutex.Lock()
+	defer fake.credentialsStoreMutex.Unlock()
+	fake.CredentialsStoreStub = nil
+	fake.credentialsStoreReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CredentialsStoreReturnsOnCall(i int, result1 string) {
+	fake.credentialsStoreMutex.Lock()
+	defer fake.credentialsStoreMutex.Unlock()
+	fake.CredentialsStoreStub = nil
+	if fake.credentialsStoreReturnsOnCall == nil {
+		fake.credentialsStoreReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.credentialsStoreReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CurrentContext() string {
+	fake.currentContextMutex.Lock()
+	ret, specificReturn := fake.currentContextReturnsOnCall[len(fake.currentContextArgsForCall)]
+	fake.currentContextArgsForCall = append(fake.currentContextArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CurrentContext", []interface{}{})
+	fake.currentContextMutex.Unlock()
+	if fake.CurrentContextStub != nil {
+		return fake.CurrentContextStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.currentContextReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) CurrentContextCallCount() int {
+	fake.currentContextMutex.RLock()
+	defer fake.currentContextMutex.RUnlock()
+	return len(fake.currentContextArgsForCall)
+}
+
+func (fake *FakeConfig) CurrentContextCalls(stub func() string) {
+	fake.currentContextMutex.Lock()
+	defer fake.currentContextMutex.Unlock()
+	fake.CurrentContextStub = stub
+}
+
+func (fake *FakeConfig) CurrentContextReturns(result1 string) {
+	fake.currentContextMutex.Lock()
+	defer fake.currentContextMutex.Unlock()
+	fake.CurrentContextStub = nil
+	fake.currentContextReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CurrentContextReturnsOnCall(i int, result1 string) {
+	fake.currentContextMutex.Lock()
+	defer fake.currentContextMutex.Unlock()
+	fake.CurrentContextStub = nil
+	if fake.currentContextReturnsOnCall == nil {
+		fake.currentContextReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.currentContextReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) CurrentUser() (configv3.User, error) {
 	fake.currentUserMutex.Lock()
 	ret, specificReturn := fake.currentUserReturnsOnCall[len(fake.currentUserArgsForCall)]
@@ -1220,6 +1509,66 @@ func (fake *FakeConfig) CurrentUserNameReturnsOnCall(i int, result1 string, resu
 	}{result1, result2}
 }
 
+func (fake *FakeConfig) DeleteContext(arg1 string) error {
+	fake.deleteContextMutex.Lock()
+	ret, specificReturn := fake.deleteContextReturnsOnCall[len(fake.deleteContextArgsForCall)]
+	fake.deleteContextArgsForCall = append(fake.deleteContextArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DeleteContext", []interface{}{arg1})
+	fake.deleteContextMutex.Unlock()
+	if fake.DeleteContextStub != nil {
+		return fake.DeleteContextStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.deleteContextReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) DeleteContextCallCount() int {
+	fake.deleteContextMutex.RLock()
+	defer fake.deleteContextMutex.RUnlock()
+	return len(fake.deleteContextArgsForCall)
+}
+
+func (fake *FakeConfig) DeleteContextCalls(stub func(string) error) {
+	fake.deleteContextMutex.Lock()
+	defer fake.deleteContextMutex.Unlock()
+	fake.DeleteContextStub = stub
+}
+
+func (fake *FakeConfig) DeleteContextArgsForCall(i int) string {
+	fake.deleteContextMutex.RLock()
+	defer fake.deleteContextMutex.RUnlock()
+	argsForCall := fake.deleteContextArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) DeleteContextReturns(result1 error) {
+	fake.deleteContextMutex.Lock()
+	defer fake.deleteContextMutex.Unlock()
+	fake.DeleteContextStub = nil
+	fake.deleteContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) DeleteContextReturnsOnCall(i int, result1 error) {
+	fake.deleteContextMutex.Lock()
+	defer fake.deleteContextMutex.Unlock()
+	fake.DeleteContextStub = nil
+	if fake.deleteContextReturnsOnCall == nil {
+		fake.deleteContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) DialTimeout() time.Duration {
 	fake.dialTimeoutMutex.Lock()
 	ret, specificReturn := fake.dialTimeoutReturnsOnCall[len(fake.dialTimeoutArgsForCall)]
@@ -1376,6 +1725,69 @@ func (fake *FakeConfig) ExperimentalReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeConfig) GetContext(arg1 string) (configv3.TargetContext, bool) {
+	fake.getContextMutex.Lock()
+	ret, specificReturn := fake.getContextReturnsOnCall[len(fake.getContextArgsForCall)]
+	fake.getContextArgsForCall = append(fake.getContextArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetContext", []interface{}{arg1})
+	fake.getContextMutex.Unlock()
+	if fake.GetContextStub != nil {
+		return fake.GetContextStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getContextReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeConfig) GetContextCallCount() int {
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	return len(fake.getContextArgsForCall)
+}
+
+func (fake *FakeConfig) GetContextCalls(stub func(string) (configv3.TargetContext, bool)) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = stub
+}
+
+func (fake *FakeConfig) GetContextArgsForCall(i int) string {
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
+	argsForCall := fake.getContextArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) GetContextReturns(result1 configv3.TargetContext, result2 bool) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = nil
+	fake.getContextReturns = struct {
+		result1 configv3.TargetContext
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) GetContextReturnsOnCall(i int, result1 configv3.TargetContext, result2 bool) {
+	fake.getContextMutex.Lock()
+	defer fake.getContextMutex.Unlock()
+	fake.GetContextStub = nil
+	if fake.getContextReturnsOnCall == nil {
+		fake.getContextReturnsOnCall = make(map[int]struct {
+			result1 configv3.TargetContext
+			result2 bool
+		})
+	}
+	fake.getContextReturnsOnCall[i] = struct {
+		result1 configv3.TargetContext
+		result2 bool
+	}{result1, result2}
+}
+
 func (fake *FakeConfig) GetPlugin(arg1 string) (configv3.Plugin, bool) {
 	fake.getPluginMutex.Lock()
 	ret, specificReturn := fake.getPluginReturnsOnCall[len(fake.getPluginArgsForCall)]
@@ -1502,6 +1914,66 @@ func (fake *FakeConfig) GetPluginCaseInsensitiveReturnsOnCall(i int, result1 con
 	}{result1, result2}
 }
 
+func (fake *FakeConfig) HasContext(arg1 string) bool {
+	fake.hasContextMutex.Lock()
+	ret, specificReturn := fake.hasContextReturnsOnCall[len(fake.hasContextArgsForCall)]
+	fake.hasContextArgsForCall = append(fake.hasContextArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("HasContext", []interface{}{arg1})
+	fake.hasContextMutex.Unlock()
+	if fake.HasContextStub != nil {
+		return fake.HasContextStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.hasContextReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) HasContextCallCount() int {
+	fake.hasContextMutex.RLock()
+	defer fake.hasContextMutex.RUnlock()
+	return len(fake.hasContextArgsForCall)
+}
+
+func (fake *FakeConfig) HasContextCalls(stub func(string) bool) {
+	fake.hasContextMutex.Lock()
+	defer fake.hasContextMutex.Unlock()
+	fake.HasContextStub = stub
+}
+
+func (fake *FakeConfig) HasContextArgsForCall(i int) string {
+	fake.hasContextMutex.RLock()
+	defer fake.hasContextMutex.RUnlock()
+	argsForCall := fake.hasContextArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) HasContextReturns(result1 bool) {
+	fake.hasContextMutex.Lock()
+	defer fake.hasContextMutex.Unlock()
+	fake.HasContextStub = nil
+	fake.hasContextReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) HasContextReturnsOnCall(i int, result1 bool) {
+	fake.hasContextMutex.Lock()
+	defer fake.hasContextMutex.Unlock()
+	fake.HasContextStub = nil
+	if fake.hasContextReturnsOnCall == nil {
+		fake.hasContextReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.hasContextReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeConfig) HasTargetedOrganization() bool {
 	fake.hasTargetedOrganizationMutex.Lock()
 	ret, specificReturn := fake.hasTargetedOrganizationReturnsOnCall[len(fake.hasTargetedOrganizationArgsForCall)]
@@ -2022,6 +2494,58 @@ func (fake *FakeConfig) OverallPollingTimeoutReturnsOnCall(i int, result1 time.D
 	}{result1}
 }
 
+func (fake *FakeConfig) PackageCacheDir() string {
+	fake.packageCacheDirMutex.Lock()
+	ret, specificReturn := fake.packageCacheDirReturnsOnCall[len(fake.packageCacheDirArgsForCall)]
+	fake.packageCacheDirArgsForCall = append(fake.packageCacheDirArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PackageCacheDir", []interface{}{})
+	fake.packageCacheDirMutex.Unlock()
+	if fake.PackageCacheDirStub != nil {
+		return fake.PackageCacheDirStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.packageCacheDirReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) PackageCacheDirCallCount() int {
+	fake.packageCacheDirMutex.RLock()
+	defer fake.packageCacheDirMutex.RUnlock()
+	return len(fake.packageCacheDirArgsForCall)
+}
+
+func (fake *FakeConfig) PackageCacheDirCalls(stub func() string) {
+	fake.packageCacheDirMutex.Lock()
+	defer fake.packageCacheDirMutex.Unlock()
+	fake.PackageCacheDirStub = stub
+}
+
+func (fake *FakeConfig) PackageCacheDirReturns(result1 string) {
+	fake.packageCacheDirMutex.Lock()
+	defer fake.packageCacheDirMutex.Unlock()
+	fake.PackageCacheDirStub = nil
+	fake.packageCacheDirReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) PackageCacheDirReturnsOnCall(i int, result1 string) {
+	fake.packageCacheDirMutex.Lock()
+	defer fake.packageCacheDirMutex.Unlock()
+	fake.PackageCacheDirStub = nil
+	if fake.packageCacheDirReturnsOnCall == nil {
+		fake.packageCacheDirReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.packageCacheDirReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) PluginHome() string {
 	fake.pluginHomeMutex.Lock()
 	ret, specificReturn := fake.pluginHomeReturnsOnCall[len(fake.pluginHomeArgsForCall)]
@@ -2562,6 +3086,37 @@ func (fake *FakeConfig) SetColorEnabledArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
+func (fake *FakeConfig) SetCredentialsStore(arg1 string) {
+	fake.setCredentialsStoreMutex.Lock()
+	fake.setCredentialsStoreArgsForCall = append(fake.setCredentialsStoreArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetCredentialsStore", []interface{}{arg1})
+	fake.setCredentialsStoreMutex.Unlock()
+	if fake.SetCredentialsStoreStub != nil {
+		fake.SetCredentialsStoreStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetCredentialsStoreCallCount() int {
+	fake.setCredentialsStoreMutex.RLock()
+	defer fake.setCredentialsStoreMutex.RUnlock()
+	return len(fake.setCredentialsStoreArgsForCall)
+}
+
+func (fake *FakeConfig) SetCredentialsStoreCalls(stub func(string)) {
+	fake.setCredentialsStoreMutex.Lock()
+	defer fake.setCredentialsStoreMutex.Unlock()
+	fake.SetCredentialsStoreStub = stub
+}
+
+func (fake *FakeConfig) SetCredentialsStoreArgsForCall(i int) string {
+	fake.setCredentialsStoreMutex.RLock()
+	defer fake.setCredentialsStoreMutex.RUnlock()
+	argsForCall := fake.setCredentialsStoreArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeConfig) SetKubernetesAuthInfo(arg1 string) {
 	fake.setKubernetesAuthInfoMutex.Lock()
 	fake.setKubernetesAuthInfoArgsForCall = append(fake.setKubernetesAuthInfoArgsForCall, struct {
@@ -2687,6 +3242,38 @@ func (fake *FakeConfig) SetOrganizationInformationArgsForCall(i int) (string, st
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeConfig) SetPluginRepositoryPublicKey(arg1 string, arg2 string) {
+	fake.setPluginRepositoryPublicKeyMutex.Lock()
+	fake.setPluginRepositoryPublicKeyArgsForCall = append(fake.setPluginRepositoryPublicKeyArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("SetPluginRepositoryPublicKey", []interface{}{arg1, arg2})
+	fake.setPluginRepositoryPublicKeyMutex.Unlock()
+	if fake.SetPluginRepositoryPublicKeyStub != nil {
+		fake.SetPluginRepositoryPublicKeyStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeConfig) SetPluginRepositoryPublicKeyCallCount() int {
+	fake.setPluginRepositoryPublicKeyMutex.RLock()
+	defer fake.setPluginRepositoryPublicKeyMutex.RUnlock()
+	return len(fake.setPluginRepositoryPublicKeyArgsForCall)
+}
+
+func (fake *FakeConfig) SetPluginRepositoryPublicKeyCalls(stub func(string, string)) {
+	fake.setPluginRepositoryPublicKeyMutex.Lock()
+	defer fake.setPluginRepositoryPublicKeyMutex.Unlock()
+	fake.SetPluginRepositoryPublicKeyStub = stub
+}
+
+func (fake *FakeConfig) SetPluginRepositoryPublicKeyArgsForCall(i int) (string, string) {
+	fake.setPluginRepositoryPublicKeyMutex.RLock()
+	defer fake.setPluginRepositoryPublicKeyMutex.RUnlock()
+	argsForCall := fake.setPluginRepositoryPublicKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeConfig) SetRefreshToken(arg1 string) {
 	fake.setRefreshTokenMutex.Lock()
 	fake.setRefreshTokenArgsForCall = append(fake.setRefreshTokenArgsForCall, struct {
@@ -3096,6 +3683,66 @@ func (fake *FakeConfig) StartupTimeoutReturnsOnCall(i int, result1 time.Duration
 	}{result1}
 }
 
+func (fake *FakeConfig) SwitchContext(arg1 string) error {
+	fake.switchContextMutex.Lock()
+	ret, specificReturn := fake.switchContextReturnsOnCall[len(fake.switchContextArgsForCall)]
+	fake.switchContextArgsForCall = append(fake.switchContextArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SwitchContext", []interface{}{arg1})
+	fake.switchContextMutex.Unlock()
+	if fake.SwitchContextStub != nil {
+		return fake.SwitchContextStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.switchContextReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) SwitchContextCallCount() int {
+	fake.switchContextMutex.RLock()
+	defer fake.switchContextMutex.RUnlock()
+	return len(fake.switchContextArgsForCall)
+}
+
+func (fake *FakeConfig) SwitchContextCalls(stub func(string) error) {
+	fake.switchContextMutex.Lock()
+	defer fake.switchContextMutex.Unlock()
+	fake.SwitchContextStub = stub
+}
+
+func (fake *FakeConfig) SwitchContextArgsForCall(i int) string {
+	fake.switchContextMutex.RLock()
+	defer fake.switchContextMutex.RUnlock()
+	argsForCall := fake.switchContextArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SwitchContextReturns(result1 error) {
+	fake.switchContextMutex.Lock()
+	defer fake.switchContextMutex.Unlock()
+	fake.SwitchContextStub = nil
+	fake.switchContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) SwitchContextReturnsOnCall(i int, result1 error) {
+	fake.switchContextMutex.Lock()
+	defer fake.switchContextMutex.Unlock()
+	fake.SwitchContextStub = nil
+	if fake.switchContextReturnsOnCall == nil {
+		fake.switchContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.switchContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) Target() string {
 	fake.targetMutex.Lock()
 	ret, specificReturn := fake.targetReturnsOnCall[len(fake.targetArgsForCall)]
@@ -3899,20 +4546,34 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.cFUsernameMutex.RUnlock()
 	fake.colorEnabledMutex.RLock()
 	defer fake.colorEnabledMutex.RUnlock()
+	fake.contextNamesMutex.RLock()
+	defer fake.contextNamesMutex.RUnlock()
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
+	fake.credentialsStoreMutex.RLock()
+	defer fake.credentialsStoreMutex.RUnlock()
+	fake.currentContextMutex.RLock()
+	defer fake.currentContextMutex.RUnlock()
 	fake.currentUserMutex.RLock()
 	defer fake.currentUserMutex.RUnlock()
 	fake.currentUserNameMutex.RLock()
 	defer fake.currentUserNameMutex.RUnlock()
+	fake.deleteContextMutex.RLock()
+	defer fake.deleteContextMutex.RUnlock()
 	fake.dialTimeoutMutex.RLock()
 	defer fake.dialTimeoutMutex.RUnlock()
 	fake.dockerPasswordMutex.RLock()
 	defer fake.dockerPasswordMutex.RUnlock()
 	fake.experimentalMutex.RLock()
 	defer fake.experimentalMutex.RUnlock()
+	fake.getContextMutex.RLock()
+	defer fake.getContextMutex.RUnlock()
 	fake.getPluginMutex.RLock()
 	defer fake.getPluginMutex.RUnlock()
 	fake.getPluginCaseInsensitiveMutex.RLock()
 	defer fake.getPluginCaseInsensitiveMutex.RUnlock()
+	fake.hasContextMutex.RLock()
+	defer fake.hasContextMutex.RUnlock()
 	fake.hasTargetedOrganizationMutex.RLock()
 	defer fake.hasTargetedOrganizationMutex.RUnlock()
 	fake.hasTargetedSpaceMutex.RLock()
@@ -3933,6 +4594,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.networkPolicyV1EndpointMutex.RUnlock()
 	fake.overallPollingTimeoutMutex.RLock()
 	defer fake.overallPollingTimeoutMutex.RUnlock()
+	fake.packageCacheDirMutex.RLock()
+	defer fake.packageCacheDirMutex.RUnlock()
 	fake.pluginHomeMutex.RLock()
 	defer fake.pluginHomeMutex.RUnlock()
 	fake.pluginRepositoriesMutex.RLock()
@@ -3957,6 +4620,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.setAsyncTimeoutMutex.RUnlock()
 	fake.setColorEnabledMutex.RLock()
 	defer fake.setColorEnabledMutex.RUnlock()
+	fake.setCredentialsStoreMutex.RLock()
+	defer fake.setCredentialsStoreMutex.RUnlock()
 	fake.setKubernetesAuthInfoMutex.RLock()
 	defer fake.setKubernetesAuthInfoMutex.RUnlock()
 	fake.setLocaleMutex.RLock()
@@ -3965,6 +4630,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.setMinCLIVersionMutex.RUnlock()
 	fake.setOrganizationInformationMutex.RLock()
 	defer fake.setOrganizationInformationMutex.RUnlock()
+	fake.setPluginRepositoryPublicKeyMutex.RLock()
+	defer fake.setPluginRepositoryPublicKeyMutex.RUnlock()
 	fake.setRefreshTokenMutex.RLock()
 	defer fake.setRefreshTokenMutex.RUnlock()
 	fake.setSpaceInformationMutex.RLock()
@@ -3987,6 +4654,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.stagingTimeoutMutex.RUnlock()
 	fake.startupTimeoutMutex.RLock()
 	defer fake.startupTimeoutMutex.RUnlock()
+	fake.switchContextMutex.RLock()
+	defer fake.switchContextMutex.RUnlock()
 	fake.targetMutex.RLock()
 	defer fake.targetMutex.RUnlock()
 	fake.targetedOrganizationMutex.RLock()
@@ -11,6 +11,10 @@ import (
 )
 
 type FakeUI struct {
+	ClearScreenStub        func()
+	clearScreenMutex       sync.RWMutex
+	clearScreenArgsForCall []struct {
+	}
 	DeferTextStub        func(string, ...map[string]interface{})
 	deferTextMutex       sync.RWMutex
 	deferTextArgsForCall []struct {
@@ -87,6 +91,12 @@ type FakeUI struct {
 	displayInstancesTableForAppArgsForCall []struct {
 		arg1 [][]string
 	}
+	DisplayInstancesTableForTopStub        func([][]string, [][]bool)
+	displayInstancesTableForTopMutex       sync.RWMutex
+	displayInstancesTableForTopArgsForCall []struct {
+		arg1 [][]string
+		arg2 [][]bool
+	}
 	DisplayJSONStub        func(string, interface{}) error
 	displayJSONMutex       sync.RWMutex
 	displayJSONArgsForCall []struct {
@@ -161,6 +171,28 @@ type FakeUI struct {
 		result1 string
 		result2 error
 	}
+	DisplayStructuredLogMessageStub        func(ui.LogMessage) error
+	displayStructuredLogMessageMutex       sync.RWMutex
+	displayStructuredLogMessageArgsForCall []struct {
+		arg1 ui.LogMessage
+	}
+	displayStructuredLogMessageReturns struct {
+		result1 error
+	}
+	displayStructuredLogMessageReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DisplayStructuredOutputStub        func(interface{}) error
+	displayStructuredOutputMutex       sync.RWMutex
+	displayStructuredOutputArgsForCall []struct {
+		arg1 interface{}
+	}
+	displayStructuredOutputReturns struct {
+		result1 error
+	}
+	displayStructuredOutputReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DisplayTableWithHeaderStub        func(string, [][]string, int)
 	displayTableWithHeaderMutex       sync.RWMutex
 	displayTableWithHeaderArgsForCall []struct {
@@ -256,6 +288,16 @@ type FakeUI struct {
 	getOutReturnsOnCall map[int]struct {
 		result1 io.Writer
 	}
+	IsStructuredOutputStub        func() bool
+	isStructuredOutputMutex       sync.RWMutex
+	isStructuredOutputArgsForCall []struct {
+	}
+	isStructuredOutputReturns struct {
+		result1 bool
+	}
+	isStructuredOutputReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	RequestLoggerFileWriterStub        func([]string) *ui.RequestLoggerFileWriter
 	requestLoggerFileWriterMutex       sync.RWMutex
 	requestLoggerFileWriterArgsForCall []struct {
@@ -314,6 +356,30 @@ type FakeUI struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeUI) ClearScreen() {
+	fake.clearScreenMutex.Lock()
+	fake.clearScreenArgsForCall = append(fake.clearScreenArgsForCall, struct {
+	}{})
+	stub := fake.ClearScreenStub
+	fake.recordInvocation("ClearScreen", []interface{}{})
+	fake.clearScreenMutex.Unlock()
+	if stub != nil {
+		stub()
+	}
+}
+
+func (fake *FakeUI) ClearScreenCallCount() int {
+	fake.clearScreenMutex.RLock()
+	defer fake.clearScreenMutex.RUnlock()
+	return len(fake.clearScreenArgsForCall)
+}
+
+func (fake *FakeUI) ClearScreenCalls(stub func()) {
+	fake.clearScreenMutex.Lock()
+	defer fake.clearScreenMutex.Unlock()
+	fake.ClearScreenStub = stub
+}
+
 func (fake *FakeUI) DeferText(arg1 string, arg2 ...map[string]interface{}) {
 	fake.deferTextMutex.Lock()
 	fake.deferTextArgsForCall = append(fake.deferTextArgsForCall, struct {
@@ -730,6 +796,49 @@ func (fake *FakeUI) DisplayInstancesTableForAppArgsForCall(i int) [][]string {
 	return argsForCall.arg1
 }
 
+func (fake *FakeUI) DisplayInstancesTableForTop(arg1 [][]string, arg2 [][]bool) {
+	var arg1Copy [][]string
+	if arg1 != nil {
+		arg1Copy = make([][]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	var arg2Copy [][]bool
+	if arg2 != nil {
+		arg2Copy = make([][]bool, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.displayInstancesTableForTopMutex.Lock()
+	fake.displayInstancesTableForTopArgsForCall = append(fake.displayInstancesTableForTopArgsForCall, struct {
+		arg1 [][]string
+		arg2 [][]bool
+	}{arg1Copy, arg2Copy})
+	stub := fake.DisplayInstancesTableForTopStub
+	fake.recordInvocation("DisplayInstancesTableForTop", []interface{}{arg1Copy, arg2Copy})
+	fake.displayInstancesTableForTopMutex.Unlock()
+	if stub != nil {
+		stub(arg1, arg2)
+	}
+}
+
+func (fake *FakeUI) DisplayInstancesTableForTopCallCount() int {
+	fake.displayInstancesTableForTopMutex.RLock()
+	defer fake.displayInstancesTableForTopMutex.RUnlock()
+	return len(fake.displayInstancesTableForTopArgsForCall)
+}
+
+func (fake *FakeUI) DisplayInstancesTableForTopCalls(stub func([][]string, [][]bool)) {
+	fake.displayInstancesTableForTopMutex.Lock()
+	defer fake.displayInstancesTableForTopMutex.Unlock()
+	fake.DisplayInstancesTableForTopStub = stub
+}
+
+func (fake *FakeUI) DisplayInstancesTableForTopArgsForCall(i int) ([][]string, [][]bool) {
+	fake.displayInstancesTableForTopMutex.RLock()
+	defer fake.displayInstancesTableForTopMutex.RUnlock()
+	argsForCall := fake.displayInstancesTableForTopArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeUI) DisplayJSON(arg1 string, arg2 interface{}) error {
 	fake.displayJSONMutex.Lock()
 	ret, specificReturn := fake.displayJSONReturnsOnCall[len(fake.displayJSONArgsForCall)]
@@ -737,15 +846,16 @@ func (fake *FakeUI) DisplayJSON(arg1 string, arg2 interface{}) error {
 		arg1 string
 		arg2 interface{}
 	}{arg1, arg2})
+	stub := fake.DisplayJSONStub
+	fakeReturns := fake.displayJSONReturns
 	fake.recordInvocation("DisplayJSON", []interface{}{arg1, arg2})
 	fake.displayJSONMutex.Unlock()
-	if fake.DisplayJSONStub != nil {
-		return fake.DisplayJSONStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.displayJSONReturns
 	return fakeReturns.result1
 }
 
@@ -1118,6 +1228,130 @@ func (fake *FakeUI) DisplayPasswordPromptReturnsOnCall(i int, result1 string, re
 	}{result1, result2}
 }
 
+func (fake *FakeUI) DisplayStructuredLogMessage(arg1 ui.LogMessage) error {
+	fake.displayStructuredLogMessageMutex.Lock()
+	ret, specificReturn := fake.displayStructuredLogMessageReturnsOnCall[len(fake.displayStructuredLogMessageArgsForCall)]
+	fake.displayStructuredLogMessageArgsForCall = append(fake.displayStructuredLogMessageArgsForCall, struct {
+		arg1 ui.LogMessage
+	}{arg1})
+	stub := fake.DisplayStructuredLogMessageStub
+	fakeReturns := fake.displayStructuredLogMessageReturns
+	fake.recordInvocation("DisplayStructuredLogMessage", []interface{}{arg1})
+	fake.displayStructuredLogMessageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUI) DisplayStructuredLogMessageCallCount() int {
+	fake.displayStructuredLogMessageMutex.RLock()
+	defer fake.displayStructuredLogMessageMutex.RUnlock()
+	return len(fake.displayStructuredLogMessageArgsForCall)
+}
+
+func (fake *FakeUI) DisplayStructuredLogMessageCalls(stub func(ui.LogMessage) error) {
+	fake.displayStructuredLogMessageMutex.Lock()
+	defer fake.displayStructuredLogMessageMutex.Unlock()
+	fake.DisplayStructuredLogMessageStub = stub
+}
+
+func (fake *FakeUI) DisplayStructuredLogMessageArgsForCall(i int) ui.LogMessage {
+	fake.displayStructuredLogMessageMutex.RLock()
+	defer fake.displayStructuredLogMessageMutex.RUnlock()
+	argsForCall := fake.displayStructuredLogMessageArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUI) DisplayStructuredLogMessageReturns(result1 error) {
+	fake.displayStructuredLogMessageMutex.Lock()
+	defer fake.displayStructuredLogMessageMutex.Unlock()
+	fake.DisplayStructuredLogMessageStub = nil
+	fake.displayStructuredLogMessageReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUI) DisplayStructuredLogMessageReturnsOnCall(i int, result1 error) {
+	fake.displayStructuredLogMessageMutex.Lock()
+	defer fake.displayStructuredLogMessageMutex.Unlock()
+	fake.DisplayStructuredLogMessageStub = nil
+	if fake.displayStructuredLogMessageReturnsOnCall == nil {
+		fake.displayStructuredLogMessageReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.displayStructuredLogMessageReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUI) DisplayStructuredOutput(arg1 interface{}) error {
+	fake.displayStructuredOutputMutex.Lock()
+	ret, specificReturn := fake.displayStructuredOutputReturnsOnCall[len(fake.displayStructuredOutputArgsForCall)]
+	fake.displayStructuredOutputArgsForCall = append(fake.displayStructuredOutputArgsForCall, struct {
+		arg1 interface{}
+	}{arg1})
+	stub := fake.DisplayStructuredOutputStub
+	fakeReturns := fake.displayStructuredOutputReturns
+	fake.recordInvocation("DisplayStructuredOutput", []interface{}{arg1})
+	fake.displayStructuredOutputMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUI) DisplayStructuredOutputCallCount() int {
+	fake.displayStructuredLogMessageMutex.RLock()
+	defer fake.displayStructuredLogMessageMutex.RUnlock()
+	fake.displayStructuredOutputMutex.RLock()
+	defer fake.displayStructuredOutputMutex.RUnlock()
+	return len(fake.displayStructuredOutputArgsForCall)
+}
+
+func (fake *FakeUI) DisplayStructuredOutputCalls(stub func(interface{}) error) {
+	fake.displayStructuredOutputMutex.Lock()
+	defer fake.displayStructuredOutputMutex.Unlock()
+	fake.DisplayStructuredOutputStub = stub
+}
+
+func (fake *FakeUI) DisplayStructuredOutputArgsForCall(i int) interface{} {
+	fake.displayStructuredOutputMutex.RLock()
+	defer fake.displayStructuredOutputMutex.RUnlock()
+	argsForCall := fake.displayStructuredOutputArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUI) DisplayStructuredOutputReturns(result1 error) {
+	fake.displayStructuredOutputMutex.Lock()
+	defer fake.displayStructuredOutputMutex.Unlock()
+	fake.DisplayStructuredOutputStub = nil
+	fake.displayStructuredOutputReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUI) DisplayStructuredOutputReturnsOnCall(i int, result1 error) {
+	fake.displayStructuredOutputMutex.Lock()
+	defer fake.displayStructuredOutputMutex.Unlock()
+	fake.DisplayStructuredOutputStub = nil
+	if fake.displayStructuredOutputReturnsOnCall == nil {
+		fake.displayStructuredOutputReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.displayStructuredOutputReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeUI) DisplayTableWithHeader(arg1 string, arg2 [][]string, arg3 int) {
 	var arg2Copy [][]string
 	if arg2 != nil {
@@ -1621,6 +1855,59 @@ func (fake *FakeUI) GetOutReturnsOnCall(i int, result1 io.Writer) {
 	}{result1}
 }
 
+func (fake *FakeUI) IsStructuredOutput() bool {
+	fake.isStructuredOutputMutex.Lock()
+	ret, specificReturn := fake.isStructuredOutputReturnsOnCall[len(fake.isStructuredOutputArgsForCall)]
+	fake.isStructuredOutputArgsForCall = append(fake.isStructuredOutputArgsForCall, struct {
+	}{})
+	stub := fake.IsStructuredOutputStub
+	fakeReturns := fake.isStructuredOutputReturns
+	fake.recordInvocation("IsStructuredOutput", []interface{}{})
+	fake.isStructuredOutputMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUI) IsStructuredOutputCallCount() int {
+	fake.isStructuredOutputMutex.RLock()
+	defer fake.isStructuredOutputMutex.RUnlock()
+	return len(fake.isStructuredOutputArgsForCall)
+}
+
+func (fake *FakeUI) IsStructuredOutputCalls(stub func() bool) {
+	fake.isStructuredOutputMutex.Lock()
+	defer fake.isStructuredOutputMutex.Unlock()
+	fake.IsStructuredOutputStub = stub
+}
+
+func (fake *FakeUI) IsStructuredOutputReturns(result1 bool) {
+	fake.isStructuredOutputMutex.Lock()
+	defer fake.isStructuredOutputMutex.Unlock()
+	fake.IsStructuredOutputStub = nil
+	fake.isStructuredOutputReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeUI) IsStructuredOutputReturnsOnCall(i int, result1 bool) {
+	fake.isStructuredOutputMutex.Lock()
+	defer fake.isStructuredOutputMutex.Unlock()
+	fake.IsStructuredOutputStub = nil
+	if fake.isStructuredOutputReturnsOnCall == nil {
+		fake.isStructuredOutputReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isStructuredOutputReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeUI) RequestLoggerFileWriter(arg1 []string) *ui.RequestLoggerFileWriter {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -1919,6 +2206,8 @@ func (fake *FakeUI) WriterReturnsOnCall(i int, result1 io.Writer) {
 func (fake *FakeUI) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.clearScreenMutex.RLock()
+	defer fake.clearScreenMutex.RUnlock()
 	fake.deferTextMutex.RLock()
 	defer fake.deferTextMutex.RUnlock()
 	fake.displayBoolPromptMutex.RLock()
@@ -1941,6 +2230,8 @@ func (fake *FakeUI) Invocations() map[string][][]interface{} {
 	defer fake.displayHeaderMutex.RUnlock()
 	fake.displayInstancesTableForAppMutex.RLock()
 	defer fake.displayInstancesTableForAppMutex.RUnlock()
+	fake.displayInstancesTableForTopMutex.RLock()
+	defer fake.displayInstancesTableForTopMutex.RUnlock()
 	fake.displayJSONMutex.RLock()
 	defer fake.displayJSONMutex.RUnlock()
 	fake.displayKeyValueTableMutex.RLock()
@@ -1959,6 +2250,8 @@ func (fake *FakeUI) Invocations() map[string][][]interface{} {
 	defer fake.displayOptionalTextPromptMutex.RUnlock()
 	fake.displayPasswordPromptMutex.RLock()
 	defer fake.displayPasswordPromptMutex.RUnlock()
+	fake.displayStructuredOutputMutex.RLock()
+	defer fake.displayStructuredOutputMutex.RUnlock()
 	fake.displayTableWithHeaderMutex.RLock()
 	defer fake.displayTableWithHeaderMutex.RUnlock()
 	fake.displayTextMutex.RLock()
@@ -1981,6 +2274,8 @@ func (fake *FakeUI) Invocations() map[string][][]interface{} {
 	defer fake.getInMutex.RUnlock()
 	fake.getOutMutex.RLock()
 	defer fake.getOutMutex.RUnlock()
+	fake.isStructuredOutputMutex.RLock()
+	defer fake.isStructuredOutputMutex.RUnlock()
 	fake.requestLoggerFileWriterMutex.RLock()
 	defer fake.requestLoggerFileWriterMutex.RUnlock()
 	fake.requestLoggerTerminalDisplayMutex.RLock()
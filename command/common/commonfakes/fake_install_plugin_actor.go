@@ -144,6 +144,18 @@ type FakeInstallPluginActor struct {
 	validateFileChecksumReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	VerifyPluginSignatureStub        func(string, pluginaction.PluginInfo) error
+	verifyPluginSignatureMutex       sync.RWMutex
+	verifyPluginSignatureArgsForCall []struct {
+		arg1 string
+		arg2 pluginaction.PluginInfo
+	}
+	verifyPluginSignatureReturns struct {
+		result1 error
+	}
+	verifyPluginSignatureReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -782,6 +794,67 @@ func (fake *FakeInstallPluginActor) ValidateFileChecksumReturnsOnCall(i int, res
 	}{result1}
 }
 
+func (fake *FakeInstallPluginActor) VerifyPluginSignature(arg1 string, arg2 pluginaction.PluginInfo) error {
+	fake.verifyPluginSignatureMutex.Lock()
+	ret, specificReturn := fake.verifyPluginSignatureReturnsOnCall[len(fake.verifyPluginSignatureArgsForCall)]
+	fake.verifyPluginSignatureArgsForCall = append(fake.verifyPluginSignatureArgsForCall, struct {
+		arg1 string
+		arg2 pluginaction.PluginInfo
+	}{arg1, arg2})
+	fake.recordInvocation("VerifyPluginSignature", []interface{}{arg1, arg2})
+	fake.verifyPluginSignatureMutex.Unlock()
+	if fake.VerifyPluginSignatureStub != nil {
+		return fake.VerifyPluginSignatureStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.verifyPluginSignatureReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeInstallPluginActor) VerifyPluginSignatureCallCount() int {
+	fake.verifyPluginSignatureMutex.RLock()
+	defer fake.verifyPluginSignatureMutex.RUnlock()
+	return len(fake.verifyPluginSignatureArgsForCall)
+}
+
+func (fake *FakeInstallPluginActor) VerifyPluginSignatureCalls(stub func(string, pluginaction.PluginInfo) error) {
+	fake.verifyPluginSignatureMutex.Lock()
+	defer fake.verifyPluginSignatureMutex.Unlock()
+	fake.VerifyPluginSignatureStub = stub
+}
+
+func (fake *FakeInstallPluginActor) VerifyPluginSignatureArgsForCall(i int) (string, pluginaction.PluginInfo) {
+	fake.verifyPluginSignatureMutex.RLock()
+	defer fake.verifyPluginSignatureMutex.RUnlock()
+	argsForCall := fake.verifyPluginSignatureArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInstallPluginActor) VerifyPluginSignatureReturns(result1 error) {
+	fake.verifyPluginSignatureMutex.Lock()
+	defer fake.verifyPluginSignatureMutex.Unlock()
+	fake.VerifyPluginSignatureStub = nil
+	fake.verifyPluginSignatureReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInstallPluginActor) VerifyPluginSignatureReturnsOnCall(i int, result1 error) {
+	fake.verifyPluginSignatureMutex.Lock()
+	defer fake.verifyPluginSignatureMutex.Unlock()
+	fake.VerifyPluginSignatureStub = nil
+	if fake.verifyPluginSignatureReturnsOnCall == nil {
+		fake.verifyPluginSignatureReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.verifyPluginSignatureReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeInstallPluginActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -805,6 +878,8 @@ func (fake *FakeInstallPluginActor) Invocations() map[string][][]interface{} {
 	defer fake.uninstallPluginMutex.RUnlock()
 	fake.validateFileChecksumMutex.RLock()
 	defer fake.validateFileChecksumMutex.RUnlock()
+	fake.verifyPluginSignatureMutex.RLock()
+	defer fake.verifyPluginSignatureMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
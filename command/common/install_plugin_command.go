@@ -33,6 +33,7 @@ type InstallPluginActor interface {
 	InstallPluginFromPath(path string, plugin configv3.Plugin) error
 	UninstallPlugin(uninstaller pluginaction.PluginUninstaller, name string) error
 	ValidateFileChecksum(path string, checksum string) bool
+	VerifyPluginSignature(path string, pluginInfo pluginaction.PluginInfo) error
 }
 
 const installConfirmationPrompt = "Do you want to install the plugin {{.Path}}?"
@@ -53,16 +54,17 @@ const (
 )
 
 type InstallPluginCommand struct {
-	OptionalArgs         flag.InstallPluginArgs `positional-args:"yes"`
-	SkipSSLValidation    bool                   `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
-	Force                bool                   `short:"f" description:"Force install of plugin without confirmation"`
-	RegisteredRepository string                 `short:"r" description:"Restrict search for plugin to this registered repository"`
-	usage                interface{}            `usage:"CF_NAME install-plugin PLUGIN_NAME [-r REPO_NAME] [-f]\n   CF_NAME install-plugin LOCAL-PATH/TO/PLUGIN | URL [-f]\n\nWARNING:\n   Plugins are binaries written by potentially untrusted authors.\n   Install and use plugins at your own risk.\n\nEXAMPLES:\n   CF_NAME install-plugin ~/Downloads/plugin-foobar\n   CF_NAME install-plugin https://example.com/plugin-foobar_linux_amd64\n   CF_NAME install-plugin -r My-Repo plugin-echo"`
-	relatedCommands      interface{}            `related_commands:"add-plugin-repo, list-plugin-repos, plugins"`
-	UI                   command.UI
-	Config               command.Config
-	Actor                InstallPluginActor
-	ProgressBar          plugin.ProxyReader
+	OptionalArgs              flag.InstallPluginArgs `positional-args:"yes"`
+	SkipSSLValidation         bool                   `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
+	Force                     bool                   `short:"f" description:"Force install of plugin without confirmation"`
+	RegisteredRepository      string                 `short:"r" description:"Restrict search for plugin to this registered repository"`
+	SkipSignatureVerification bool                   `long:"skip-signature-verification" description:"Install the plugin even if its signature is missing or does not match the public key of its repository"`
+	usage                     interface{}            `usage:"CF_NAME install-plugin PLUGIN_NAME [-r REPO_NAME] [-f] [--skip-signature-verification]\n   CF_NAME install-plugin LOCAL-PATH/TO/PLUGIN | URL [-f]\n\nWARNING:\n   Plugins are binaries written by potentially untrusted authors.\n   Install and use plugins at your own risk.\n\nEXAMPLES:\n   CF_NAME install-plugin ~/Downloads/plugin-foobar\n   CF_NAME install-plugin https://example.com/plugin-foobar_linux_amd64\n   CF_NAME install-plugin -r My-Repo plugin-echo"`
+	relatedCommands           interface{}            `related_commands:"add-plugin-repo, list-plugin-repos, plugins"`
+	UI                        command.UI
+	Config                    command.Config
+	Actor                     InstallPluginActor
+	ProgressBar               plugin.ProxyReader
 }
 
 func (cmd *InstallPluginCommand) Setup(config command.Config, ui command.UI) error {
@@ -384,6 +386,11 @@ func (cmd InstallPluginCommand) getPluginFromRepositories(pluginName string, rep
 		return "", 0, translatableerror.InvalidChecksumError{}
 	}
 
+	err = verifyPluginSignature(cmd.UI, cmd.Actor, tempPath, pluginInfo, cmd.SkipSignatureVerification)
+	if err != nil {
+		return "", 0, err
+	}
+
 	return tempPath, PluginFromRepository, err
 }
 
@@ -862,6 +862,60 @@ var _ = Describe("install-plugin command", func() {
 						})
 					})
 				})
+
+				When("the plugin's signature does not verify", func() {
+					BeforeEach(func() {
+						fakeActor.DownloadExecutableBinaryFromURLReturns("some-path", nil)
+						fakeActor.ValidateFileChecksumReturns(true)
+						fakeActor.VerifyPluginSignatureReturns(actionerror.PluginSignatureInvalidError{PluginName: pluginName})
+						fakeActor.CreateExecutableCopyReturns("copy-path", nil)
+						fakeActor.GetAndValidatePluginReturns(configv3.Plugin{Name: pluginName}, nil)
+					})
+
+					When("the -f flag is not provided", func() {
+						BeforeEach(func() {
+							cmd.Force = false
+							_, err := input.Write([]byte("y\n"))
+							Expect(err).ToNot(HaveOccurred())
+						})
+
+						It("returns the signature error without installing", func() {
+							Expect(executeErr).To(MatchError(actionerror.PluginSignatureInvalidError{PluginName: pluginName}))
+
+							Expect(fakeActor.VerifyPluginSignatureCallCount()).To(Equal(1))
+							pathArg, pluginInfoArg := fakeActor.VerifyPluginSignatureArgsForCall(0)
+							Expect(pathArg).To(Equal("some-path"))
+							Expect(pluginInfoArg.Checksum).To(Equal(checksum))
+							Expect(fakeActor.CreateExecutableCopyCallCount()).To(Equal(0))
+							Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+						})
+					})
+
+					When("the -f flag is provided", func() {
+						BeforeEach(func() {
+							cmd.Force = true
+						})
+
+						It("still returns the signature error without installing", func() {
+							Expect(executeErr).To(MatchError(actionerror.PluginSignatureInvalidError{PluginName: pluginName}))
+							Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+						})
+					})
+
+					When("the --skip-signature-verification flag is provided", func() {
+						BeforeEach(func() {
+							cmd.Force = true
+							cmd.SkipSignatureVerification = true
+						})
+
+						It("warns and installs the plugin", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Err).To(Say(`The signature of plugin %s does not match the public key of its repository\. Continuing because --skip-signature-verification was given\.`, pluginName))
+							Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+						})
+					})
+				})
 			})
 		})
 
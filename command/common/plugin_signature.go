@@ -0,0 +1,36 @@
+package common
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/command"
+)
+
+type pluginSignatureVerifier interface {
+	VerifyPluginSignature(path string, pluginInfo pluginaction.PluginInfo) error
+}
+
+// verifyPluginSignature checks the signature of a plugin binary downloaded
+// from a repository. When skipVerification is set, a missing or invalid
+// signature is only reported as a warning.
+func verifyPluginSignature(ui command.UI, actor pluginSignatureVerifier, path string, pluginInfo pluginaction.PluginInfo, skipVerification bool) error {
+	err := actor.VerifyPluginSignature(path, pluginInfo)
+	if err == nil || !skipVerification {
+		return err
+	}
+
+	switch err.(type) {
+	case actionerror.PluginSignatureMissingError:
+		ui.DisplayWarning("Plugin {{.PluginName}} is not signed. Continuing because --skip-signature-verification was given.", map[string]interface{}{
+			"PluginName": pluginInfo.Name,
+		})
+	case actionerror.PluginSignatureInvalidError:
+		ui.DisplayWarning("The signature of plugin {{.PluginName}} does not match the public key of its repository. Continuing because --skip-signature-verification was given.", map[string]interface{}{
+			"PluginName": pluginInfo.Name,
+		})
+	default:
+		return err
+	}
+
+	return nil
+}
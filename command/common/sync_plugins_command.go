@@ -0,0 +1,274 @@
+package common
+
+import (
+	"io/ioutil"
+	"os"
+	"runtime"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultPluginSetFile is the plugin set file sync-plugins reads when no path
+// is given.
+const DefaultPluginSetFile = "cf-plugins.yml"
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SyncPluginsActor
+
+type SyncPluginsActor interface {
+	CreateExecutableCopy(path string, tempPluginDir string) (string, error)
+	DownloadExecutableBinaryFromURL(url string, tempPluginDir string, proxyReader plugin.ProxyReader) (string, error)
+	GetAndValidatePlugin(metadata pluginaction.PluginMetadata, commands pluginaction.CommandList, path string) (configv3.Plugin, error)
+	GetPlatformString(runtimeGOOS string, runtimeGOARCH string) string
+	GetPluginInfoForPluginSetEntry(entry pluginaction.PluginSetEntry, platform string) (pluginaction.PluginInfo, error)
+	GetPluginSetDrift(pluginSet pluginaction.PluginSet) []pluginaction.PluginDrift
+	InstallPluginFromPath(path string, plugin configv3.Plugin) error
+	ReadPluginSet(path string) (pluginaction.PluginSet, error)
+	UninstallPlugin(uninstaller pluginaction.PluginUninstaller, name string) error
+	UpdatePlugin(metadata pluginaction.PluginMetadata, commands pluginaction.CommandList, pluginName string, path string) (configv3.Plugin, error)
+	ValidateFileChecksum(path string, checksum string) bool
+	VerifyPluginSignature(path string, pluginInfo pluginaction.PluginInfo) error
+}
+
+type SyncPluginsCommand struct {
+	OptionalArgs              flag.OptionalPluginSetPath `positional-args:"yes"`
+	Check                     bool                       `long:"check" description:"Report differences from the plugin set file without changing anything; exits with an error if there are any"`
+	SkipSSLValidation         bool                       `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
+	Force                     bool                       `short:"f" description:"Force changes to installed plugins without confirmation"`
+	SkipSignatureVerification bool                       `long:"skip-signature-verification" description:"Install and update plugins even if their signature is missing or does not match the public key of their repository"`
+	usage                     interface{}                `usage:"CF_NAME sync-plugins [PLUGIN_SET_FILE] [--check] [-f] [--skip-signature-verification]\n\n   Installs, updates and uninstalls plugins so that the installed plugins match PLUGIN_SET_FILE\n   (default: cf-plugins.yml). Plugins that are installed but not listed in the file are uninstalled.\n\n   The file lists each plugin's name, the repository to install it from (a registered repository\n   name or a repository URL), its version and, optionally, the SHA-256 checksum of its binary:\n\n   plugins:\n   - name: some-plugin\n     repository: CF-Community\n     version: 1.2.3\n     checksum: 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08\n\nWARNING:\n   Plugins are binaries written by potentially untrusted authors.\n   Install and use plugins at your own risk.\n\nEXAMPLES:\n   CF_NAME sync-plugins\n   CF_NAME sync-plugins team-plugins.yml -f\n   CF_NAME sync-plugins --check"`
+	relatedCommands           interface{}                `related_commands:"install-plugin, plugins, uninstall-plugin, update-plugin"`
+	UI                        command.UI
+	Config                    command.Config
+	Actor                     SyncPluginsActor
+	ProgressBar               plugin.ProxyReader
+}
+
+func (cmd *SyncPluginsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pluginaction.NewActor(config, shared.NewClient(config, ui, cmd.SkipSSLValidation))
+
+	cmd.ProgressBar = shared.NewProgressBarProxyReader(cmd.UI.Writer())
+
+	return nil
+}
+
+func (cmd SyncPluginsCommand) Execute([]string) error {
+	path := cmd.OptionalArgs.Path
+	if path == "" {
+		path = DefaultPluginSetFile
+	}
+
+	pluginSet, err := cmd.Actor.ReadPluginSet(path)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Comparing installed plugins with {{.Path}}...", map[string]interface{}{
+		"Path": path,
+	})
+	cmd.UI.DisplayNewline()
+
+	drift := cmd.Actor.GetPluginSetDrift(pluginSet)
+	if len(drift) == 0 {
+		cmd.UI.DisplayText("Installed plugins match {{.Path}}.", map[string]interface{}{
+			"Path": path,
+		})
+		return nil
+	}
+
+	cmd.displayDrift(drift)
+
+	if cmd.Check {
+		return translatableerror.PluginSetDriftError{Path: path}
+	}
+
+	cmd.UI.DisplayHeader("Attention: Plugins are binaries written by potentially untrusted authors.")
+	cmd.UI.DisplayHeader("Install and use plugins at your own risk.")
+
+	if !cmd.Force {
+		really, promptErr := cmd.UI.DisplayBoolPrompt(false, "Do you want to make these changes?")
+		if promptErr != nil {
+			return promptErr
+		}
+		if !really {
+			log.Debug("plugin confirmation - 'no' inputed")
+			cmd.UI.DisplayText("Plugin sync cancelled.")
+			return nil
+		}
+	}
+
+	tempPluginDir, err := ioutil.TempDir(cmd.Config.PluginHome(), "temp")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tempPluginDir)
+
+	rpcService, err := shared.NewRPCService(cmd.Config, cmd.UI)
+	if err != nil {
+		return err
+	}
+	log.Info("started RPC server")
+
+	platform := cmd.Actor.GetPlatformString(runtime.GOOS, runtime.GOARCH)
+	for _, change := range drift {
+		switch change.Type {
+		case pluginaction.PluginDriftExtra:
+			err = cmd.uninstallPlugin(change, rpcService)
+		case pluginaction.PluginDriftVersion:
+			err = cmd.updatePlugin(change, platform, tempPluginDir, rpcService)
+		default:
+			err = cmd.installPlugin(change, platform, tempPluginDir, rpcService)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayText("Installed plugins now match {{.Path}}.", map[string]interface{}{
+		"Path": path,
+	})
+
+	return nil
+}
+
+func (cmd SyncPluginsCommand) displayDrift(drift []pluginaction.PluginDrift) {
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("plugin"),
+			cmd.UI.TranslateText("installed"),
+			cmd.UI.TranslateText("wanted"),
+			cmd.UI.TranslateText("action"),
+		},
+	}
+
+	for _, change := range drift {
+		var action string
+		switch change.Type {
+		case pluginaction.PluginDriftExtra:
+			action = cmd.UI.TranslateText("uninstall")
+		case pluginaction.PluginDriftVersion:
+			action = cmd.UI.TranslateText("update")
+		default:
+			action = cmd.UI.TranslateText("install")
+		}
+		table = append(table, []string{change.Name, change.InstalledVersion, change.Entry.Version, action})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+	cmd.UI.DisplayNewline()
+}
+
+func (cmd SyncPluginsCommand) downloadPlugin(entry pluginaction.PluginSetEntry, platform string, tempPluginDir string) (string, error) {
+	pluginInfo, err := cmd.Actor.GetPluginInfoForPluginSetEntry(entry, platform)
+	if err != nil {
+		return "", err
+	}
+
+	cmd.UI.DisplayText("Starting download of plugin binary from repository {{.RepositoryName}}...", map[string]interface{}{
+		"RepositoryName": entry.Repository,
+	})
+
+	tempPath, err := cmd.Actor.DownloadExecutableBinaryFromURL(pluginInfo.URL, tempPluginDir, cmd.ProgressBar)
+	if err != nil {
+		return "", err
+	}
+
+	if !cmd.Actor.ValidateFileChecksum(tempPath, pluginInfo.Checksum) {
+		return "", translatableerror.InvalidChecksumError{}
+	}
+
+	err = verifyPluginSignature(cmd.UI, cmd.Actor, tempPath, pluginInfo, cmd.SkipSignatureVerification)
+	if err != nil {
+		return "", err
+	}
+
+	return tempPath, nil
+}
+
+func (cmd SyncPluginsCommand) installPlugin(change pluginaction.PluginDrift, platform string, tempPluginDir string, rpcService pluginaction.PluginMetadata) error {
+	cmd.UI.DisplayTextWithFlavor("Installing plugin {{.Name}} {{.Version}}...", map[string]interface{}{
+		"Name":    change.Name,
+		"Version": change.Entry.Version,
+	})
+
+	tempPath, err := cmd.downloadPlugin(change.Entry, platform, tempPluginDir)
+	if err != nil {
+		return err
+	}
+
+	executablePath, err := cmd.Actor.CreateExecutableCopy(tempPath, tempPluginDir)
+	if err != nil {
+		return err
+	}
+
+	plugin, err := cmd.Actor.GetAndValidatePlugin(rpcService, Commands, executablePath)
+	if err != nil {
+		return err
+	}
+	if plugin.Name != change.Name {
+		return actionerror.PluginNameMismatchError{ExpectedName: change.Name, ActualName: plugin.Name}
+	}
+
+	err = cmd.Actor.InstallPluginFromPath(executablePath, plugin)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
+func (cmd SyncPluginsCommand) updatePlugin(change pluginaction.PluginDrift, platform string, tempPluginDir string, rpcService pluginaction.PluginMetadata) error {
+	cmd.UI.DisplayTextWithFlavor("Updating plugin {{.Name}} from {{.InstalledVersion}} to {{.Version}}...", map[string]interface{}{
+		"Name":             change.Name,
+		"InstalledVersion": change.InstalledVersion,
+		"Version":          change.Entry.Version,
+	})
+
+	tempPath, err := cmd.downloadPlugin(change.Entry, platform, tempPluginDir)
+	if err != nil {
+		return err
+	}
+
+	_, err = cmd.Actor.UpdatePlugin(rpcService, Commands, change.Name, tempPath)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
+func (cmd SyncPluginsCommand) uninstallPlugin(change pluginaction.PluginDrift, rpcService pluginaction.PluginUninstaller) error {
+	cmd.UI.DisplayTextWithFlavor("Uninstalling plugin {{.Name}} {{.InstalledVersion}}...", map[string]interface{}{
+		"Name":             change.Name,
+		"InstalledVersion": change.InstalledVersion,
+	})
+
+	err := cmd.Actor.UninstallPlugin(rpcService, change.Name)
+	if err != nil {
+		switch e := err.(type) {
+		case actionerror.PluginBinaryRemoveFailedError:
+			return translatableerror.PluginBinaryRemoveFailedError{Err: e.Err}
+		case actionerror.PluginExecuteError:
+			return translatableerror.PluginBinaryUninstallError{Err: e.Err}
+		default:
+			return err
+		}
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
@@ -0,0 +1,305 @@
+package common_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/api/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/common/commonfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("sync-plugins command", func() {
+	var (
+		cmd             SyncPluginsCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeActor       *commonfakes.FakeSyncPluginsActor
+		fakeProgressBar *pluginfakes.FakeProxyReader
+		executeErr      error
+		pluginHome      string
+		pluginSet       pluginaction.PluginSet
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(commonfakes.FakeSyncPluginsActor)
+		fakeProgressBar = new(pluginfakes.FakeProxyReader)
+
+		cmd = SyncPluginsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			Actor:       fakeActor,
+			ProgressBar: fakeProgressBar,
+		}
+
+		var err error
+		pluginHome, err = ioutil.TempDir("", "some-pluginhome")
+		Expect(err).NotTo(HaveOccurred())
+
+		fakeConfig.PluginHomeReturns(pluginHome)
+		fakeConfig.BinaryNameReturns("faceman")
+
+		pluginSet = pluginaction.PluginSet{Plugins: []pluginaction.PluginSetEntry{
+			{Name: "new-plugin", Repository: "repo-1", Version: "1.0.0"},
+			{Name: "old-plugin", Repository: "repo-1", Version: "2.0.0", Checksum: "pinned-checksum"},
+		}}
+		fakeActor.ReadPluginSetReturns(pluginSet, nil)
+		fakeActor.GetPluginSetDriftReturns([]pluginaction.PluginDrift{
+			{Type: pluginaction.PluginDriftExtra, Name: "extra-plugin", InstalledVersion: "3.0.0"},
+			{Type: pluginaction.PluginDriftVersion, Name: "old-plugin", InstalledVersion: "1.0.0", Entry: pluginSet.Plugins[1]},
+			{Type: pluginaction.PluginDriftMissing, Name: "new-plugin", Entry: pluginSet.Plugins[0]},
+		})
+		fakeActor.GetPlatformStringReturns("linux64")
+		fakeActor.GetPluginInfoForPluginSetEntryStub = func(entry pluginaction.PluginSetEntry, _ string) (pluginaction.PluginInfo, error) {
+			return pluginaction.PluginInfo{Name: entry.Name, Version: entry.Version, URL: "https://example.com/" + entry.Name, Checksum: entry.Name + "-checksum"}, nil
+		}
+		fakeActor.DownloadExecutableBinaryFromURLStub = func(url string, _ string, _ plugin.ProxyReader) (string, error) {
+			return url + "-downloaded", nil
+		}
+		fakeActor.ValidateFileChecksumReturns(true)
+		fakeActor.CreateExecutableCopyReturns("some-executable-path", nil)
+		fakeActor.GetAndValidatePluginReturns(configv3.Plugin{Name: "new-plugin", Version: configv3.PluginVersion{Major: 1}}, nil)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(pluginHome)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("reads cf-plugins.yml by default", func() {
+		Expect(fakeActor.ReadPluginSetArgsForCall(0)).To(Equal("cf-plugins.yml"))
+	})
+
+	When("a plugin set file is given", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.Path = "team-plugins.yml"
+		})
+
+		It("reads that file", func() {
+			Expect(fakeActor.ReadPluginSetArgsForCall(0)).To(Equal("team-plugins.yml"))
+		})
+	})
+
+	When("reading the plugin set fails", func() {
+		BeforeEach(func() {
+			fakeActor.ReadPluginSetReturns(pluginaction.PluginSet{}, actionerror.InvalidPluginSetError{Path: "cf-plugins.yml", Message: "some-message"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.InvalidPluginSetError{Path: "cf-plugins.yml", Message: "some-message"}))
+			Expect(fakeActor.GetPluginSetDriftCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the installed plugins already match", func() {
+		BeforeEach(func() {
+			fakeActor.GetPluginSetDriftReturns(nil)
+		})
+
+		It("says so and changes nothing", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetPluginSetDriftArgsForCall(0)).To(Equal(pluginSet))
+			Expect(testUI.Out).To(Say(`Installed plugins match cf-plugins\.yml\.`))
+			Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--check is given", func() {
+		BeforeEach(func() {
+			cmd.Check = true
+		})
+
+		It("reports the drift and returns a PluginSetDriftError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.PluginSetDriftError{Path: "cf-plugins.yml"}))
+
+			Expect(testUI.Out).To(Say(`plugin\s+installed\s+wanted\s+action`))
+			Expect(testUI.Out).To(Say(`extra-plugin\s+3\.0\.0\s+uninstall`))
+			Expect(testUI.Out).To(Say(`old-plugin\s+1\.0\.0\s+2\.0\.0\s+update`))
+			Expect(testUI.Out).To(Say(`new-plugin\s+1\.0\.0\s+install`))
+
+			Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(0))
+			Expect(fakeActor.UninstallPluginCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the user does not confirm", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("cancels the sync", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Do you want to make these changes\?`))
+			Expect(testUI.Out).To(Say(`Plugin sync cancelled\.`))
+			Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(0))
+			Expect(fakeActor.UninstallPluginCallCount()).To(Equal(0))
+		})
+	})
+
+	When("-f is given", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		It("uninstalls, updates and installs plugins to match the file", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetPluginInfoForPluginSetEntryCallCount()).To(Equal(2))
+			entry, platform := fakeActor.GetPluginInfoForPluginSetEntryArgsForCall(1)
+			Expect(entry).To(Equal(pluginSet.Plugins[0]))
+			Expect(platform).To(Equal("linux64"))
+
+			Expect(fakeActor.ValidateFileChecksumCallCount()).To(Equal(2))
+			path, checksum := fakeActor.ValidateFileChecksumArgsForCall(1)
+			Expect(path).To(Equal("https://example.com/new-plugin-downloaded"))
+			Expect(checksum).To(Equal("new-plugin-checksum"))
+
+			Expect(fakeActor.CreateExecutableCopyCallCount()).To(Equal(1))
+			Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+			installPath, installedPlugin := fakeActor.InstallPluginFromPathArgsForCall(0)
+			Expect(installPath).To(Equal("some-executable-path"))
+			Expect(installedPlugin.Name).To(Equal("new-plugin"))
+
+			Expect(fakeActor.UpdatePluginCallCount()).To(Equal(1))
+			_, _, updatedName, updatedPath := fakeActor.UpdatePluginArgsForCall(0)
+			Expect(updatedName).To(Equal("old-plugin"))
+			Expect(updatedPath).To(Equal("https://example.com/old-plugin-downloaded"))
+
+			Expect(fakeActor.UninstallPluginCallCount()).To(Equal(1))
+			_, uninstalledName := fakeActor.UninstallPluginArgsForCall(0)
+			Expect(uninstalledName).To(Equal("extra-plugin"))
+
+			Expect(testUI.Out).To(Say(`Uninstalling plugin extra-plugin 3\.0\.0\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`Updating plugin old-plugin from 1\.0\.0 to 2\.0\.0\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`Installing plugin new-plugin 1\.0\.0\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`Installed plugins now match cf-plugins\.yml\.`))
+		})
+
+		When("a plugin is replaced by another plugin with the same commands", func() {
+			BeforeEach(func() {
+				pluginSet = pluginaction.PluginSet{Plugins: []pluginaction.PluginSetEntry{
+					{Name: "plugin-b", Repository: "repo-1", Version: "1.0.0"},
+				}}
+				fakeActor.ReadPluginSetReturns(pluginSet, nil)
+				fakeActor.GetPluginSetDriftReturns([]pluginaction.PluginDrift{
+					{Type: pluginaction.PluginDriftExtra, Name: "plugin-a", InstalledVersion: "1.0.0"},
+					{Type: pluginaction.PluginDriftMissing, Name: "plugin-b", Entry: pluginSet.Plugins[0]},
+				})
+				fakeActor.GetAndValidatePluginStub = func(pluginaction.PluginMetadata, pluginaction.CommandList, string) (configv3.Plugin, error) {
+					if fakeActor.UninstallPluginCallCount() == 0 {
+						return configv3.Plugin{}, actionerror.PluginCommandsConflictError{
+							PluginName:    "plugin-b",
+							PluginVersion: "1.0.0",
+							CommandNames:  []string{"shared-command"},
+						}
+					}
+					return configv3.Plugin{Name: "plugin-b", Version: configv3.PluginVersion{Major: 1}}, nil
+				}
+			})
+
+			It("uninstalls the old plugin before installing the new one", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.UninstallPluginCallCount()).To(Equal(1))
+				_, uninstalledName := fakeActor.UninstallPluginArgsForCall(0)
+				Expect(uninstalledName).To(Equal("plugin-a"))
+
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+				_, installedPlugin := fakeActor.InstallPluginFromPathArgsForCall(0)
+				Expect(installedPlugin.Name).To(Equal("plugin-b"))
+
+				Expect(testUI.Out).To(Say(`Uninstalling plugin plugin-a 1\.0\.0\.\.\.`))
+				Expect(testUI.Out).To(Say(`Installing plugin plugin-b 1\.0\.0\.\.\.`))
+			})
+		})
+
+		When("the downloaded binary does not match the checksum", func() {
+			BeforeEach(func() {
+				fakeActor.ValidateFileChecksumReturns(false)
+			})
+
+			It("returns an InvalidChecksumError without installing", func() {
+				Expect(executeErr).To(MatchError(translatableerror.InvalidChecksumError{}))
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the signature of a downloaded binary is invalid", func() {
+			BeforeEach(func() {
+				fakeActor.VerifyPluginSignatureReturns(actionerror.PluginSignatureInvalidError{PluginName: "new-plugin"})
+			})
+
+			It("returns the error without installing", func() {
+				Expect(executeErr).To(MatchError(actionerror.PluginSignatureInvalidError{PluginName: "new-plugin"}))
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+			})
+
+			When("--skip-signature-verification is given", func() {
+				BeforeEach(func() {
+					cmd.SkipSignatureVerification = true
+				})
+
+				It("warns and installs the plugin", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Err).To(Say(`The signature of plugin new-plugin does not match the public key of its repository\. Continuing because --skip-signature-verification was given\.`))
+					Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		When("the pinned version is not available", func() {
+			BeforeEach(func() {
+				fakeActor.GetPluginInfoForPluginSetEntryStub = nil
+				fakeActor.GetPluginInfoForPluginSetEntryReturns(pluginaction.PluginInfo{}, actionerror.PluginVersionNotAvailableError{PluginName: "new-plugin"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.PluginVersionNotAvailableError{PluginName: "new-plugin"}))
+				Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the downloaded binary is a different plugin", func() {
+			BeforeEach(func() {
+				fakeActor.GetAndValidatePluginReturns(configv3.Plugin{Name: "renamed-plugin"}, nil)
+			})
+
+			It("returns a PluginNameMismatchError", func() {
+				Expect(executeErr).To(MatchError(actionerror.PluginNameMismatchError{ExpectedName: "new-plugin", ActualName: "renamed-plugin"}))
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+			})
+		})
+
+		When("uninstalling a plugin fails", func() {
+			BeforeEach(func() {
+				fakeActor.UninstallPluginReturns(actionerror.PluginBinaryRemoveFailedError{Err: errors.New("some-error")})
+			})
+
+			It("returns a PluginBinaryRemoveFailedError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.PluginBinaryRemoveFailedError{Err: errors.New("some-error")}))
+			})
+		})
+	})
+})
@@ -0,0 +1,235 @@
+package common
+
+import (
+	"io/ioutil"
+	"os"
+	"runtime"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"github.com/blang/semver"
+	log "github.com/sirupsen/logrus"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . UpdatePluginActor
+
+type UpdatePluginActor interface {
+	DownloadExecutableBinaryFromURL(url string, tempPluginDir string, proxyReader plugin.ProxyReader) (string, error)
+	GetOutdatedPluginsFromRepositories(pluginRepos []configv3.PluginRepository) ([]pluginaction.OutdatedPlugin, error)
+	GetPlatformString(runtimeGOOS string, runtimeGOARCH string) string
+	GetPluginInfoFromRepositoriesForPlatform(pluginName string, pluginRepos []configv3.PluginRepository, platform string) (pluginaction.PluginInfo, []string, error)
+	GetPluginRepository(repositoryName string) (configv3.PluginRepository, error)
+	UpdatePlugin(metadata pluginaction.PluginMetadata, commands pluginaction.CommandList, pluginName string, path string) (configv3.Plugin, error)
+	ValidateFileChecksum(path string, checksum string) bool
+	VerifyPluginSignature(path string, pluginInfo pluginaction.PluginInfo) error
+}
+
+type UpdatePluginCommand struct {
+	OptionalArgs              flag.OptionalPluginName `positional-args:"yes"`
+	All                       bool                    `long:"all" description:"Update all installed plugins that have a newer version in the registered repositories"`
+	SkipSSLValidation         bool                    `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
+	Force                     bool                    `short:"f" description:"Force update of plugins without confirmation"`
+	RegisteredRepository      string                  `short:"r" description:"Restrict search for newer versions to this registered repository"`
+	SkipSignatureVerification bool                    `long:"skip-signature-verification" description:"Update plugins even if their signature is missing or does not match the public key of their repository"`
+	usage                     interface{}             `usage:"CF_NAME update-plugin PLUGIN_NAME [-r REPO_NAME] [-f] [--skip-signature-verification]\n   CF_NAME update-plugin --all [-r REPO_NAME] [-f] [--skip-signature-verification]\n\nWARNING:\n   Plugins are binaries written by potentially untrusted authors.\n   Install and use plugins at your own risk.\n\nEXAMPLES:\n   CF_NAME update-plugin plugin-echo\n   CF_NAME update-plugin --all -f"`
+	relatedCommands           interface{}             `related_commands:"install-plugin, plugins, repo-plugins"`
+	UI                        command.UI
+	Config                    command.Config
+	Actor                     UpdatePluginActor
+	ProgressBar               plugin.ProxyReader
+}
+
+func (cmd *UpdatePluginCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pluginaction.NewActor(config, shared.NewClient(config, ui, cmd.SkipSSLValidation))
+
+	cmd.ProgressBar = shared.NewProgressBarProxyReader(cmd.UI.Writer())
+
+	return nil
+}
+
+func (cmd UpdatePluginCommand) Execute([]string) error {
+	pluginName := cmd.OptionalArgs.PluginName
+
+	switch {
+	case pluginName != "" && cmd.All:
+		return translatableerror.ArgumentCombinationError{Args: []string{"PLUGIN_NAME", "--all"}}
+	case pluginName == "" && !cmd.All:
+		return translatableerror.IncorrectUsageError{Message: "Provide a PLUGIN_NAME or use --all."}
+	}
+
+	if pluginName != "" {
+		if _, installed := cmd.Config.GetPlugin(pluginName); !installed {
+			return actionerror.PluginNotFoundError{PluginName: pluginName}
+		}
+	}
+
+	repos := cmd.Config.PluginRepositories()
+	if len(repos) == 0 {
+		return translatableerror.NoPluginRepositoriesError{}
+	}
+
+	if cmd.RegisteredRepository != "" {
+		repo, err := cmd.Actor.GetPluginRepository(cmd.RegisteredRepository)
+		if err != nil {
+			return err
+		}
+		repos = []configv3.PluginRepository{repo}
+	}
+
+	cmd.UI.DisplayText("Searching for plugin updates...")
+
+	outdatedPlugins, err := cmd.Actor.GetOutdatedPluginsFromRepositories(repos)
+	if err != nil {
+		return err
+	}
+
+	var updates []pluginaction.OutdatedPlugin
+	for _, outdatedPlugin := range outdatedPlugins {
+		if pluginName == "" || outdatedPlugin.Name == pluginName {
+			updates = append(updates, outdatedPlugin)
+		}
+	}
+
+	if len(updates) == 0 {
+		if pluginName != "" {
+			installedPlugin, _ := cmd.Config.GetPlugin(pluginName)
+			cmd.UI.DisplayText("Plugin {{.Name}} {{.Version}} is already up to date.", map[string]interface{}{
+				"Name":    installedPlugin.Name,
+				"Version": installedPlugin.Version.String(),
+			})
+		} else {
+			cmd.UI.DisplayText("All plugins are up to date.")
+		}
+		return nil
+	}
+
+	for _, update := range updates {
+		cmd.UI.DisplayText("Plugin {{.Name}} {{.CurrentVersion}} can be updated to {{.LatestVersion}}.", map[string]interface{}{
+			"Name":           update.Name,
+			"CurrentVersion": update.CurrentVersion,
+			"LatestVersion":  update.LatestVersion,
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayHeader("Attention: Plugins are binaries written by potentially untrusted authors.")
+	cmd.UI.DisplayHeader("Install and use plugins at your own risk.")
+
+	if !cmd.Force {
+		really, promptErr := cmd.UI.DisplayBoolPrompt(false, "Do you want to update these plugins?")
+		if promptErr != nil {
+			return promptErr
+		}
+		if !really {
+			log.Debug("plugin confirmation - 'no' inputed")
+			cmd.UI.DisplayText("Plugin update cancelled.")
+			return nil
+		}
+	}
+
+	tempPluginDir, err := ioutil.TempDir(cmd.Config.PluginHome(), "temp")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tempPluginDir)
+
+	rpcService, err := shared.NewRPCService(cmd.Config, cmd.UI)
+	if err != nil {
+		return err
+	}
+	log.Info("started RPC server")
+
+	platform := cmd.Actor.GetPlatformString(runtime.GOOS, runtime.GOARCH)
+	for _, update := range updates {
+		err = cmd.updatePlugin(update, repos, platform, tempPluginDir, rpcService)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cmd UpdatePluginCommand) updatePlugin(update pluginaction.OutdatedPlugin, repos []configv3.PluginRepository, platform string, tempPluginDir string, rpcService pluginaction.PluginMetadata) error {
+	cmd.UI.DisplayTextWithFlavor("Updating plugin {{.Name}}...", map[string]interface{}{
+		"Name": update.Name,
+	})
+
+	pluginInfo, repoList, err := cmd.Actor.GetPluginInfoFromRepositoriesForPlatform(update.Name, repos, platform)
+	if err != nil {
+		if _, ok := err.(actionerror.PluginNotFoundInAnyRepositoryError); ok && cmd.RegisteredRepository != "" {
+			return translatableerror.PluginNotFoundInRepositoryError{
+				BinaryName:     cmd.Config.BinaryName(),
+				PluginName:     update.Name,
+				RepositoryName: cmd.RegisteredRepository,
+			}
+		}
+		return err
+	}
+
+	// The newest version for this platform can be older than the newest
+	// version in the repositories, which may not have a binary for it.
+	if !isNewerVersion(pluginInfo.Version, update.CurrentVersion) {
+		cmd.UI.DisplayText("Plugin {{.Name}} {{.CurrentVersion}} has no newer version for this platform. Skipping.", map[string]interface{}{
+			"Name":           update.Name,
+			"CurrentVersion": update.CurrentVersion,
+		})
+		cmd.UI.DisplayNewline()
+		return nil
+	}
+
+	cmd.UI.DisplayText("Starting download of plugin binary from repository {{.RepositoryName}}...", map[string]interface{}{
+		"RepositoryName": repoList[0],
+	})
+
+	tempPath, err := cmd.Actor.DownloadExecutableBinaryFromURL(pluginInfo.URL, tempPluginDir, cmd.ProgressBar)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Actor.ValidateFileChecksum(tempPath, pluginInfo.Checksum) {
+		return translatableerror.InvalidChecksumError{}
+	}
+
+	err = verifyPluginSignature(cmd.UI, cmd.Actor, tempPath, pluginInfo, cmd.SkipSignatureVerification)
+	if err != nil {
+		return err
+	}
+
+	updatedPlugin, err := cmd.Actor.UpdatePlugin(rpcService, Commands, update.Name, tempPath)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("Plugin {{.Name}} successfully updated from {{.CurrentVersion}} to {{.Version}}.", map[string]interface{}{
+		"Name":           updatedPlugin.Name,
+		"CurrentVersion": update.CurrentVersion,
+		"Version":        updatedPlugin.Version.String(),
+	})
+	cmd.UI.DisplayNewline()
+
+	return nil
+}
+
+func isNewerVersion(version string, currentVersion string) bool {
+	v, err := semver.Make(version)
+	if err != nil {
+		return false
+	}
+
+	current, err := semver.Make(currentVersion)
+	if err != nil {
+		return false
+	}
+
+	return v.GT(current)
+}
@@ -0,0 +1,379 @@
+package common_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/common/commonfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-plugin command", func() {
+	var (
+		cmd             UpdatePluginCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeActor       *commonfakes.FakeUpdatePluginActor
+		fakeProgressBar *pluginfakes.FakeProxyReader
+		executeErr      error
+		pluginHome      string
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(commonfakes.FakeUpdatePluginActor)
+		fakeProgressBar = new(pluginfakes.FakeProxyReader)
+
+		cmd = UpdatePluginCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			Actor:       fakeActor,
+			ProgressBar: fakeProgressBar,
+		}
+
+		var err error
+		pluginHome, err = ioutil.TempDir("", "some-pluginhome")
+		Expect(err).NotTo(HaveOccurred())
+
+		fakeConfig.PluginHomeReturns(pluginHome)
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.PluginRepositoriesReturns([]configv3.PluginRepository{
+			{Name: "repo-1", URL: "https://repo-1.example.com"},
+		})
+		fakeConfig.GetPluginReturns(configv3.Plugin{
+			Name:    "some-plugin",
+			Version: configv3.PluginVersion{Major: 1},
+		}, true)
+
+		fakeActor.GetPlatformStringReturns("linux64")
+		fakeActor.GetOutdatedPluginsFromRepositoriesReturns([]pluginaction.OutdatedPlugin{
+			{Name: "some-plugin", CurrentVersion: "1.0.0", LatestVersion: "1.2.0"},
+			{Name: "other-plugin", CurrentVersion: "2.0.0", LatestVersion: "3.0.0"},
+		}, nil)
+		latestVersions := map[string]string{"some-plugin": "1.2.0", "other-plugin": "3.0.0"}
+		fakeActor.GetPluginInfoFromRepositoriesForPlatformStub = func(name string, _ []configv3.PluginRepository, _ string) (pluginaction.PluginInfo, []string, error) {
+			return pluginaction.PluginInfo{Name: name, Version: latestVersions[name], URL: "https://example.com/" + name, Checksum: "some-checksum"}, []string{"repo-1"}, nil
+		}
+		fakeActor.DownloadExecutableBinaryFromURLReturns("some-temp-path", nil)
+		fakeActor.ValidateFileChecksumReturns(true)
+		fakeActor.UpdatePluginStub = func(_ pluginaction.PluginMetadata, _ pluginaction.CommandList, name string, _ string) (configv3.Plugin, error) {
+			if name == "other-plugin" {
+				return configv3.Plugin{Name: name, Version: configv3.PluginVersion{Major: 3}}, nil
+			}
+			return configv3.Plugin{Name: name, Version: configv3.PluginVersion{Major: 1, Minor: 2}}, nil
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(pluginHome)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("neither a plugin name nor --all is given", func() {
+		It("returns an IncorrectUsageError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "Provide a PLUGIN_NAME or use --all."}))
+		})
+	})
+
+	When("both a plugin name and --all are given", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.PluginName = "some-plugin"
+			cmd.All = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"PLUGIN_NAME", "--all"}}))
+		})
+	})
+
+	When("a plugin name is given", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.PluginName = "some-plugin"
+			cmd.Force = true
+		})
+
+		When("the plugin is not installed", func() {
+			BeforeEach(func() {
+				fakeConfig.GetPluginReturns(configv3.Plugin{}, false)
+			})
+
+			It("returns a PluginNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.PluginNotFoundError{PluginName: "some-plugin"}))
+				Expect(fakeActor.GetOutdatedPluginsFromRepositoriesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("there are no plugin repositories", func() {
+			BeforeEach(func() {
+				fakeConfig.PluginRepositoriesReturns(nil)
+			})
+
+			It("returns a NoPluginRepositoriesError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.NoPluginRepositoriesError{}))
+			})
+		})
+
+		When("the plugin is already up to date", func() {
+			BeforeEach(func() {
+				fakeActor.GetOutdatedPluginsFromRepositoriesReturns([]pluginaction.OutdatedPlugin{
+					{Name: "other-plugin", CurrentVersion: "2.0.0", LatestVersion: "3.0.0"},
+				}, nil)
+			})
+
+			It("does not update anything", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Plugin some-plugin 1\.0\.0 is already up to date\.`))
+				Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(0))
+				Expect(fakeActor.UpdatePluginCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the outdated plugins fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetOutdatedPluginsFromRepositoriesReturns(nil, actionerror.GettingPluginRepositoryError{Name: "repo-1", Message: "boom"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.GettingPluginRepositoryError{Name: "repo-1", Message: "boom"}))
+			})
+		})
+
+		It("downloads, verifies and updates only the named plugin", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetOutdatedPluginsFromRepositoriesCallCount()).To(Equal(1))
+			Expect(fakeActor.GetOutdatedPluginsFromRepositoriesArgsForCall(0)).To(ConsistOf(configv3.PluginRepository{Name: "repo-1", URL: "https://repo-1.example.com"}))
+
+			Expect(fakeActor.GetPluginInfoFromRepositoriesForPlatformCallCount()).To(Equal(1))
+			name, repos, platform := fakeActor.GetPluginInfoFromRepositoriesForPlatformArgsForCall(0)
+			Expect(name).To(Equal("some-plugin"))
+			Expect(repos).To(ConsistOf(configv3.PluginRepository{Name: "repo-1", URL: "https://repo-1.example.com"}))
+			Expect(platform).To(Equal("linux64"))
+
+			Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(1))
+			url, tempDir, proxyReader := fakeActor.DownloadExecutableBinaryFromURLArgsForCall(0)
+			Expect(url).To(Equal("https://example.com/some-plugin"))
+			Expect(tempDir).To(HavePrefix(pluginHome))
+			Expect(proxyReader).To(Equal(fakeProgressBar))
+
+			Expect(fakeActor.ValidateFileChecksumCallCount()).To(Equal(1))
+			path, checksum := fakeActor.ValidateFileChecksumArgsForCall(0)
+			Expect(path).To(Equal("some-temp-path"))
+			Expect(checksum).To(Equal("some-checksum"))
+
+			Expect(fakeActor.UpdatePluginCallCount()).To(Equal(1))
+			_, _, updatedName, updatedPath := fakeActor.UpdatePluginArgsForCall(0)
+			Expect(updatedName).To(Equal("some-plugin"))
+			Expect(updatedPath).To(Equal("some-temp-path"))
+
+			Expect(testUI.Out).To(Say(`Plugin some-plugin 1\.0\.0 can be updated to 1\.2\.0\.`))
+			Expect(testUI.Out).To(Say(`Attention: Plugins are binaries written by potentially untrusted authors\.`))
+			Expect(testUI.Out).To(Say(`Updating plugin some-plugin\.\.\.`))
+			Expect(testUI.Out).To(Say(`Starting download of plugin binary from repository repo-1\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`Plugin some-plugin successfully updated from 1\.0\.0 to 1\.2\.0\.`))
+			Expect(testUI.Out).ToNot(Say("other-plugin"))
+		})
+
+		When("the newest version for this platform is not newer than the installed one", func() {
+			BeforeEach(func() {
+				fakeActor.GetPluginInfoFromRepositoriesForPlatformStub = nil
+				fakeActor.GetPluginInfoFromRepositoriesForPlatformReturns(pluginaction.PluginInfo{Name: "some-plugin", Version: "1.0.0"}, []string{"repo-1"}, nil)
+			})
+
+			It("skips the plugin without downloading it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Plugin some-plugin 1\.0\.0 has no newer version for this platform\. Skipping\.`))
+				Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(0))
+				Expect(fakeActor.UpdatePluginCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the checksum does not match", func() {
+			BeforeEach(func() {
+				fakeActor.ValidateFileChecksumReturns(false)
+			})
+
+			It("returns an InvalidChecksumError without updating the plugin", func() {
+				Expect(executeErr).To(MatchError(translatableerror.InvalidChecksumError{}))
+				Expect(fakeActor.UpdatePluginCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the plugin is not signed but its repository has a public key", func() {
+			BeforeEach(func() {
+				fakeActor.VerifyPluginSignatureReturns(actionerror.PluginSignatureMissingError{PluginName: "some-plugin"})
+			})
+
+			It("returns the error without updating the plugin even though -f was given", func() {
+				Expect(executeErr).To(MatchError(actionerror.PluginSignatureMissingError{PluginName: "some-plugin"}))
+				Expect(fakeActor.UpdatePluginCallCount()).To(Equal(0))
+			})
+
+			When("--skip-signature-verification is given", func() {
+				BeforeEach(func() {
+					cmd.SkipSignatureVerification = true
+				})
+
+				It("warns and updates the plugin", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Err).To(Say(`Plugin some-plugin is not signed\. Continuing because --skip-signature-verification was given\.`))
+					Expect(fakeActor.UpdatePluginCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		When("the signature of the plugin is invalid", func() {
+			BeforeEach(func() {
+				fakeActor.VerifyPluginSignatureReturns(actionerror.PluginSignatureInvalidError{PluginName: "some-plugin"})
+			})
+
+			It("returns the error without updating the plugin even though -f was given", func() {
+				Expect(executeErr).To(MatchError(actionerror.PluginSignatureInvalidError{PluginName: "some-plugin"}))
+				Expect(fakeActor.UpdatePluginCallCount()).To(Equal(0))
+			})
+
+			When("--skip-signature-verification is given", func() {
+				BeforeEach(func() {
+					cmd.SkipSignatureVerification = true
+				})
+
+				It("warns and updates the plugin", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Err).To(Say(`The signature of plugin some-plugin does not match the public key of its repository\. Continuing because --skip-signature-verification was given\.`))
+					Expect(fakeActor.UpdatePluginCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		When("the new binary fails validation", func() {
+			BeforeEach(func() {
+				fakeActor.UpdatePluginReturns(configv3.Plugin{}, actionerror.PluginNameMismatchError{ExpectedName: "some-plugin", ActualName: "renamed-plugin"})
+				fakeActor.UpdatePluginStub = nil
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.PluginNameMismatchError{ExpectedName: "some-plugin", ActualName: "renamed-plugin"}))
+				Expect(testUI.Out).ToNot(Say("successfully updated"))
+			})
+		})
+
+		When("a repository is given with -r", func() {
+			BeforeEach(func() {
+				cmd.RegisteredRepository = "repo-2"
+				fakeActor.GetPluginRepositoryReturns(configv3.PluginRepository{Name: "repo-2", URL: "https://repo-2.example.com"}, nil)
+			})
+
+			It("only searches that repository", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.GetPluginRepositoryArgsForCall(0)).To(Equal("repo-2"))
+				Expect(fakeActor.GetOutdatedPluginsFromRepositoriesArgsForCall(0)).To(ConsistOf(configv3.PluginRepository{Name: "repo-2", URL: "https://repo-2.example.com"}))
+				_, repos, _ := fakeActor.GetPluginInfoFromRepositoriesForPlatformArgsForCall(0)
+				Expect(repos).To(ConsistOf(configv3.PluginRepository{Name: "repo-2", URL: "https://repo-2.example.com"}))
+			})
+
+			When("the plugin is not in that repository", func() {
+				BeforeEach(func() {
+					fakeActor.GetPluginInfoFromRepositoriesForPlatformStub = nil
+					fakeActor.GetPluginInfoFromRepositoriesForPlatformReturns(pluginaction.PluginInfo{}, nil, actionerror.PluginNotFoundInAnyRepositoryError{PluginName: "some-plugin"})
+				})
+
+				It("returns a PluginNotFoundInRepositoryError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.PluginNotFoundInRepositoryError{
+						BinaryName:     "faceman",
+						PluginName:     "some-plugin",
+						RepositoryName: "repo-2",
+					}))
+				})
+			})
+
+			When("the repository is not registered", func() {
+				BeforeEach(func() {
+					fakeActor.GetPluginRepositoryReturns(configv3.PluginRepository{}, actionerror.RepositoryNotRegisteredError{Name: "repo-2"})
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError(actionerror.RepositoryNotRegisteredError{Name: "repo-2"}))
+				})
+			})
+		})
+	})
+
+	When("--all is given", func() {
+		BeforeEach(func() {
+			cmd.All = true
+		})
+
+		When("the user does not confirm", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("cancels the update", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Do you want to update these plugins\?`))
+				Expect(testUI.Out).To(Say(`Plugin update cancelled\.`))
+				Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the user confirms", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("updates every outdated plugin", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.UpdatePluginCallCount()).To(Equal(2))
+				_, _, firstName, _ := fakeActor.UpdatePluginArgsForCall(0)
+				_, _, secondName, _ := fakeActor.UpdatePluginArgsForCall(1)
+				Expect([]string{firstName, secondName}).To(Equal([]string{"some-plugin", "other-plugin"}))
+
+				Expect(testUI.Out).To(Say(`Plugin some-plugin successfully updated from 1\.0\.0 to 1\.2\.0\.`))
+				Expect(testUI.Out).To(Say(`Plugin other-plugin successfully updated from 2\.0\.0 to 3\.0\.0\.`))
+			})
+
+			When("updating a plugin fails", func() {
+				BeforeEach(func() {
+					fakeActor.UpdatePluginStub = nil
+					fakeActor.UpdatePluginReturns(configv3.Plugin{}, errors.New("some-error"))
+				})
+
+				It("stops and returns the error", func() {
+					Expect(executeErr).To(MatchError("some-error"))
+					Expect(fakeActor.UpdatePluginCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		When("no plugins are outdated", func() {
+			BeforeEach(func() {
+				fakeActor.GetOutdatedPluginsFromRepositoriesReturns(nil, nil)
+			})
+
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`All plugins are up to date\.`))
+			})
+		})
+	})
+})
@@ -20,13 +20,20 @@ type Config interface {
 	CFPassword() string
 	CFUsername() string
 	ColorEnabled() configv3.ColorSetting
+	ContextNames() []string
+	CreateContext(name string)
+	CredentialsStore() string
+	CurrentContext() string
 	CurrentUser() (configv3.User, error)
 	CurrentUserName() (string, error)
+	DeleteContext(name string) error
 	DialTimeout() time.Duration
 	DockerPassword() string
 	Experimental() bool
+	GetContext(name string) (configv3.TargetContext, bool)
 	GetPlugin(pluginName string) (configv3.Plugin, bool)
 	GetPluginCaseInsensitive(pluginName string) (configv3.Plugin, bool)
+	HasContext(name string) bool
 	HasTargetedOrganization() bool
 	HasTargetedSpace() bool
 	IsTTY() bool
@@ -36,6 +43,7 @@ type Config interface {
 	NOAARequestRetryCount() int
 	NetworkPolicyV1Endpoint() string
 	OverallPollingTimeout() time.Duration
+	PackageCacheDir() string
 	PluginHome() string
 	PluginRepositories() []configv3.PluginRepository
 	Plugins() []configv3.Plugin
@@ -47,9 +55,11 @@ type Config interface {
 	SetAsyncTimeout(timeout int)
 	SetAccessToken(token string)
 	SetColorEnabled(enabled string)
+	SetCredentialsStore(name string)
 	SetLocale(locale string)
 	SetMinCLIVersion(version string)
 	SetOrganizationInformation(guid string, name string)
+	SetPluginRepositoryPublicKey(name string, publicKey string)
 	SetRefreshToken(token string)
 	SetSpaceInformation(guid string, name string, allowSSH bool)
 	V7SetSpaceInformation(guid string, name string)
@@ -63,6 +73,7 @@ type Config interface {
 	SSHOAuthClient() string
 	StagingTimeout() time.Duration
 	StartupTimeout() time.Duration
+	SwitchContext(name string) error
 	// TODO: Rename to APITarget()
 	Target() string
 	TargetedOrganization() configv3.Organization
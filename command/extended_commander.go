@@ -9,3 +9,9 @@ type ExtendedCommander interface {
 	flags.Commander
 	Setup(Config, UI) error
 }
+
+// StructuredOutputCommander is implemented by commands that can render their
+// results as JSON or YAML when the global --output flag is provided.
+type StructuredOutputCommander interface {
+	SupportsStructuredOutput() bool
+}
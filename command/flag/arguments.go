@@ -21,6 +21,10 @@ type CommandName struct {
 	CommandName string `positional-arg-name:"COMMAND_NAME" description:"The command name"`
 }
 
+type ContextName struct {
+	Context string `positional-arg-name:"CONTEXT_NAME" required:"true" description:"The context"`
+}
+
 type Domain struct {
 	Domain string `positional-arg-name:"DOMAIN" required:"true" description:"The domain"`
 }
@@ -61,6 +65,14 @@ type PluginName struct {
 	PluginName string `positional-arg-name:"PLUGIN_NAME" required:"true" description:"The plugin name"`
 }
 
+type OptionalPluginName struct {
+	PluginName string `positional-arg-name:"PLUGIN_NAME" description:"The plugin name"`
+}
+
+type OptionalPluginSetPath struct {
+	Path string `positional-arg-name:"PLUGIN_SET_FILE" description:"The path to the plugin set file"`
+}
+
 type Quota struct {
 	Quota string `positional-arg-name:"QUOTA" required:"true" description:"The organization quota"`
 }
@@ -124,6 +136,11 @@ type ServiceInstanceKey struct {
 	ServiceKey      string `positional-arg-name:"SERVICE_KEY" required:"true" description:"The service key"`
 }
 
+type AppSidecar struct {
+	AppName     string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	SidecarName string `positional-arg-name:"SIDECAR_NAME" required:"true" description:"The sidecar name"`
+}
+
 type AppDomain struct {
 	App    string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 	Domain string `positional-arg-name:"DOMAIN" required:"true" description:"The domain"`
@@ -408,3 +425,8 @@ type RemoveNetworkPolicyArgsV7 struct {
 	SourceApp string `positional-arg-name:"SOURCE_APP" required:"true" description:"The source app"`
 	DestApp   string `positional-arg-name:"DESTINATION_APP" required:"true" description:"The destination app"`
 }
+
+type SecureCopyArgs struct {
+	Source      string `positional-arg-name:"SOURCE" required:"true" description:"The path to copy from, either LOCAL_PATH or APP_NAME:REMOTE_PATH"`
+	Destination string `positional-arg-name:"DESTINATION" required:"true" description:"The path to copy to, either LOCAL_PATH or APP_NAME:REMOTE_PATH"`
+}
@@ -12,7 +12,7 @@ type DeploymentStrategy struct {
 }
 
 func (DeploymentStrategy) Complete(prefix string) []flags.Completion {
-	return completions([]string{string(constant.DeploymentStrategyRolling)}, prefix, false)
+	return completions([]string{string(constant.DeploymentStrategyCanary), string(constant.DeploymentStrategyRolling)}, prefix, false)
 }
 
 func (h *DeploymentStrategy) UnmarshalFlag(val string) error {
@@ -23,13 +23,13 @@ func (h *DeploymentStrategy) UnmarshalFlag(val string) error {
 	case string(constant.DeploymentStrategyDefault):
 		// Do nothing, leave the default value
 
-	case string(constant.DeploymentStrategyRolling):
+	case string(constant.DeploymentStrategyCanary), string(constant.DeploymentStrategyRolling):
 		h.Name = constant.DeploymentStrategy(valLower)
 
 	default:
 		return &flags.Error{
 			Type:    flags.ErrInvalidChoice,
-			Message: `STRATEGY must be "rolling" or not set`,
+			Message: `STRATEGY must be "canary", "rolling" or not set`,
 		}
 	}
 
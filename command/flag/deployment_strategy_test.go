@@ -20,6 +20,10 @@ var _ = Describe("DeploymentStrategy", func() {
 			},
 			Entry("returns 'rolling' when passed 'r'", "r",
 				[]flags.Completion{{Item: "rolling"}}),
+			Entry("returns 'canary' when passed 'c'", "c",
+				[]flags.Completion{{Item: "canary"}}),
+			Entry("returns all strategies when passed ''", "",
+				[]flags.Completion{{Item: "canary"}, {Item: "rolling"}}),
 		)
 	})
 
@@ -37,6 +41,8 @@ var _ = Describe("DeploymentStrategy", func() {
 			Entry("sets 'rolling' when passed 'rolling'", "rolling", constant.DeploymentStrategyRolling),
 			Entry("sets 'rolling' when passed 'rOlliNg'", "rOlliNg", constant.DeploymentStrategyRolling),
 			Entry("sets 'rolling' when passed 'ROLLING'", "ROLLING", constant.DeploymentStrategyRolling),
+			Entry("sets 'canary' when passed 'canary'", "canary", constant.DeploymentStrategyCanary),
+			Entry("sets 'canary' when passed 'CaNaRy'", "CaNaRy", constant.DeploymentStrategyCanary),
 		)
 
 		When("passed anything else", func() {
@@ -44,7 +50,7 @@ var _ = Describe("DeploymentStrategy", func() {
 				err := strategy.UnmarshalFlag("banana")
 				Expect(err).To(MatchError(&flags.Error{
 					Type:    flags.ErrInvalidChoice,
-					Message: `STRATEGY must be "rolling" or not set`,
+					Message: `STRATEGY must be "canary", "rolling" or not set`,
 				}))
 				Expect(strategy.Name).To(BeEmpty())
 			})
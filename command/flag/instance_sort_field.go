@@ -0,0 +1,15 @@
+package flag
+
+import flags "github.com/jessevdk/go-flags"
+
+type InstanceSortField string
+
+const (
+	InstanceSortByCPU     InstanceSortField = "cpu"
+	InstanceSortByMemory  InstanceSortField = "memory"
+	InstanceSortByCrashes InstanceSortField = "crashes"
+)
+
+func (InstanceSortField) Complete(prefix string) []flags.Completion {
+	return completions([]string{"cpu", "memory", "crashes"}, prefix, false)
+}
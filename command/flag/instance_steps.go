@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strconv"
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type InstanceSteps struct {
+	Weights []int64
+}
+
+func (s *InstanceSteps) UnmarshalFlag(val string) error {
+	s.Weights = nil
+
+	for _, step := range strings.Split(val, ",") {
+		weight, err := strconv.ParseInt(strings.TrimSpace(step), 10, 64)
+		if err != nil || weight < 1 || weight > 100 {
+			return &flags.Error{
+				Type:    flags.ErrMarshal,
+				Message: "invalid argument for flag '--instance-steps' (expected a comma separated list of integers between 1 and 100)",
+			}
+		}
+		s.Weights = append(s.Weights, weight)
+	}
+
+	return nil
+}
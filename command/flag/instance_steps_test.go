@@ -0,0 +1,44 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("InstanceSteps", func() {
+	var steps InstanceSteps
+
+	BeforeEach(func() {
+		steps = InstanceSteps{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		DescribeTable("parses the instance weights",
+			func(input string, expectedWeights []int64) {
+				err := steps.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(steps.Weights).To(Equal(expectedWeights))
+			},
+			Entry("a single step", "50", []int64{50}),
+			Entry("multiple steps", "10,40,100", []int64{10, 40, 100}),
+			Entry("multiple steps with spaces", "10, 40 ,100", []int64{10, 40, 100}),
+		)
+
+		DescribeTable("returns an error",
+			func(input string) {
+				err := steps.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: "invalid argument for flag '--instance-steps' (expected a comma separated list of integers between 1 and 100)",
+				}))
+			},
+			Entry("when a step is not an integer", "10,abc"),
+			Entry("when a step is empty", "10,,20"),
+			Entry("when a step is 0", "0,50"),
+			Entry("when a step is more than 100", "50,101"),
+		)
+	})
+})
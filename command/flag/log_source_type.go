@@ -0,0 +1,32 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+var logSourceTypes = []string{"APP", "API", "CELL", "LGR", "RTR", "SSH", "STG"}
+
+type LogSourceType struct {
+	SourceType string
+}
+
+func (LogSourceType) Complete(prefix string) []flags.Completion {
+	return completions(logSourceTypes, prefix, false)
+}
+
+func (s *LogSourceType) UnmarshalFlag(val string) error {
+	valUpper := strings.ToUpper(val)
+	for _, sourceType := range logSourceTypes {
+		if valUpper == sourceType {
+			s.SourceType = valUpper
+			return nil
+		}
+	}
+
+	return &flags.Error{
+		Type:    flags.ErrInvalidChoice,
+		Message: `SOURCE_TYPE must be one of "APP", "API", "CELL", "LGR", "RTR", "SSH" or "STG"`,
+	}
+}
@@ -0,0 +1,56 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogSourceType", func() {
+	var sourceType LogSourceType
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := sourceType.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'APP' and 'API' when passed 'a'", "a",
+				[]flags.Completion{{Item: "APP"}, {Item: "API"}}),
+			Entry("returns 'RTR' when passed 'R'", "R",
+				[]flags.Completion{{Item: "RTR"}}),
+			Entry("returns nothing when passed 'x'", "x",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			sourceType = LogSourceType{}
+		})
+
+		DescribeTable("upcases and sets the source type",
+			func(value string, expected string) {
+				err := sourceType.UnmarshalFlag(value)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(sourceType.SourceType).To(Equal(expected))
+			},
+			Entry("sets 'APP' when passed 'app'", "app", "APP"),
+			Entry("sets 'STG' when passed 'StG'", "StG", "STG"),
+			Entry("sets 'CELL' when passed 'CELL'", "CELL", "CELL"),
+		)
+
+		When("passed anything else", func() {
+			It("returns an error", func() {
+				err := sourceType.UnmarshalFlag("APP/PROC/WEB")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrInvalidChoice,
+					Message: `SOURCE_TYPE must be one of "APP", "API", "CELL", "LGR", "RTR", "SSH" or "STG"`,
+				}))
+				Expect(sourceType.SourceType).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,30 @@
+package flag
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// LogTime is a point in time given either as an RFC3339 timestamp or as a
+// duration before now, e.g. "2021-01-02T15:04:05Z" or "10m".
+type LogTime struct {
+	time.Time
+}
+
+func (t *LogTime) UnmarshalFlag(val string) error {
+	if timestamp, err := time.Parse(time.RFC3339, val); err == nil {
+		t.Time = timestamp
+		return nil
+	}
+
+	if duration, err := time.ParseDuration(val); err == nil && duration >= 0 {
+		t.Time = time.Now().Add(-duration)
+		return nil
+	}
+
+	return &flags.Error{
+		Type:    flags.ErrMarshal,
+		Message: `TIME must be an RFC3339 timestamp (e.g. 2021-01-02T15:04:05Z) or a duration (e.g. 10m, 1h30m)`,
+	}
+}
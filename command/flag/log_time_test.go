@@ -0,0 +1,51 @@
+package flag_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogTime", func() {
+	var logTime LogTime
+
+	BeforeEach(func() {
+		logTime = LogTime{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		When("passed an RFC3339 timestamp", func() {
+			It("sets the time", func() {
+				err := logTime.UnmarshalFlag("2021-01-02T15:04:05+01:00")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(logTime.Time).To(BeTemporally("==", time.Date(2021, 1, 2, 14, 4, 5, 0, time.UTC)))
+			})
+		})
+
+		When("passed a duration", func() {
+			It("sets the time to that long ago", func() {
+				err := logTime.UnmarshalFlag("1h30m")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(logTime.Time).To(BeTemporally("~", time.Now().Add(-90*time.Minute), time.Second))
+			})
+		})
+
+		DescribeTable("returns an error for invalid values",
+			func(value string) {
+				err := logTime.UnmarshalFlag(value)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: `TIME must be an RFC3339 timestamp (e.g. 2021-01-02T15:04:05Z) or a duration (e.g. 10m, 1h30m)`,
+				}))
+				Expect(logTime.Time.IsZero()).To(BeTrue())
+			},
+			Entry("a date without a time", "2021-01-02"),
+			Entry("a negative duration", "-10m"),
+			Entry("a number without a unit", "10"),
+		)
+	})
+})
@@ -0,0 +1,32 @@
+package flag
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/util/ui"
+	"github.com/jessevdk/go-flags"
+)
+
+type OutputFormat struct {
+	Format ui.OutputFormat
+}
+
+func (OutputFormat) Complete(prefix string) []flags.Completion {
+	return completions([]string{string(ui.OutputFormatJSON), string(ui.OutputFormatYAML)}, prefix, false)
+}
+
+func (o *OutputFormat) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+
+	switch valLower {
+	case string(ui.OutputFormatJSON), string(ui.OutputFormatYAML):
+		o.Format = ui.OutputFormat(valLower)
+	default:
+		return &flags.Error{
+			Type:    flags.ErrInvalidChoice,
+			Message: `OUTPUT must be "json" or "yaml"`,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/ui"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OutputFormat", func() {
+	var format OutputFormat
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := format.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'json' when passed 'j'", "j",
+				[]flags.Completion{{Item: "json"}}),
+			Entry("returns 'yaml' when passed 'Y'", "Y",
+				[]flags.Completion{{Item: "yaml"}}),
+			Entry("returns all formats when passed nothing", "",
+				[]flags.Completion{{Item: "json"}, {Item: "yaml"}}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			format = OutputFormat{}
+		})
+
+		DescribeTable("downcases and sets the format",
+			func(value string, expectedFormat ui.OutputFormat) {
+				err := format.UnmarshalFlag(value)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(format.Format).To(Equal(expectedFormat))
+			},
+			Entry("sets 'json' when passed 'json'", "json", ui.OutputFormatJSON),
+			Entry("sets 'json' when passed 'JSON'", "JSON", ui.OutputFormatJSON),
+			Entry("sets 'yaml' when passed 'yAmL'", "yAmL", ui.OutputFormatYAML),
+		)
+
+		When("passed anything else", func() {
+			It("returns an error", func() {
+				err := format.UnmarshalFlag("xml")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrInvalidChoice,
+					Message: `OUTPUT must be "json" or "yaml"`,
+				}))
+				Expect(format.Format).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,24 @@
+package flag
+
+import (
+	"regexp"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type RegularExpression struct {
+	*regexp.Regexp
+}
+
+func (r *RegularExpression) UnmarshalFlag(val string) error {
+	expression, err := regexp.Compile(val)
+	if err != nil {
+		return &flags.Error{
+			Type:    flags.ErrMarshal,
+			Message: "invalid regular expression: " + err.Error(),
+		}
+	}
+
+	r.Regexp = expression
+	return nil
+}
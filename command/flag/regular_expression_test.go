@@ -0,0 +1,38 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RegularExpression", func() {
+	var expression RegularExpression
+
+	BeforeEach(func() {
+		expression = RegularExpression{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		When("passed a valid expression", func() {
+			It("compiles it", func() {
+				err := expression.UnmarshalFlag(`ERROR|WARN`)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(expression.MatchString("some WARN line")).To(BeTrue())
+				Expect(expression.MatchString("some INFO line")).To(BeFalse())
+			})
+		})
+
+		When("passed an invalid expression", func() {
+			It("returns an error", func() {
+				err := expression.UnmarshalFlag(`(unclosed`)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: "invalid regular expression: error parsing regexp: missing closing ): `(unclosed`",
+				}))
+				Expect(expression.Regexp).To(BeNil())
+			})
+		})
+	})
+})
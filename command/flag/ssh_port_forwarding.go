@@ -16,30 +16,83 @@ type SSHPortForwarding struct {
 }
 
 func (s *SSHPortForwarding) UnmarshalFlag(val string) error {
-	splitHosts := strings.Split(val, ":")
-	for _, piece := range splitHosts {
-		if len(piece) == 0 {
-			return &flags.Error{
-				Type:    flags.ErrRequired,
-				Message: fmt.Sprintf("Bad local forwarding specification '%s'", val),
-			}
-		}
+	localAddress, remoteAddress, err := parseForwardingSpecification(val, "local")
+	if err != nil {
+		return err
 	}
 
+	s.LocalAddress = localAddress
+	s.RemoteAddress = remoteAddress
+	return nil
+}
+
+// SSHRemotePortForwarding is a [BIND_ADDRESS:]REMOTE_PORT:LOCAL_HOST:LOCAL_PORT
+// specification; the bind address is on the app instance.
+type SSHRemotePortForwarding struct {
+	RemoteAddress string
+	LocalAddress  string
+}
+
+func (s *SSHRemotePortForwarding) UnmarshalFlag(val string) error {
+	remoteAddress, localAddress, err := parseForwardingSpecification(val, "remote")
+	if err != nil {
+		return err
+	}
+
+	s.RemoteAddress = remoteAddress
+	s.LocalAddress = localAddress
+	return nil
+}
+
+// SSHDynamicPortForwarding is a [BIND_ADDRESS:]LOCAL_PORT specification.
+type SSHDynamicPortForwarding struct {
+	LocalAddress string
+}
+
+func (s *SSHDynamicPortForwarding) UnmarshalFlag(val string) error {
+	splitHosts := strings.Split(val, ":")
 	re := regexp.MustCompile(`^\d+$`)
 	switch {
-	case len(splitHosts) == 3 && re.MatchString(splitHosts[0]) && re.MatchString(splitHosts[2]):
+	case len(splitHosts) == 1 && re.MatchString(splitHosts[0]):
 		s.LocalAddress = fmt.Sprintf("%s:%s", DefaultLocalAddress, splitHosts[0])
-		s.RemoteAddress = fmt.Sprintf("%s:%s", splitHosts[1], splitHosts[2])
-	case len(splitHosts) == 4 && re.MatchString(splitHosts[1]) && re.MatchString(splitHosts[3]):
-		s.LocalAddress = fmt.Sprintf("%s:%s", splitHosts[0], splitHosts[1])
-		s.RemoteAddress = fmt.Sprintf("%s:%s", splitHosts[2], splitHosts[3])
+	case len(splitHosts) == 2 && len(splitHosts[0]) > 0 && re.MatchString(splitHosts[1]):
+		s.LocalAddress = val
 	default:
 		return &flags.Error{
 			Type:    flags.ErrRequired,
-			Message: fmt.Sprintf("Bad local forwarding specification '%s'", val),
+			Message: fmt.Sprintf("Bad dynamic forwarding specification '%s'", val),
 		}
 	}
 
 	return nil
 }
+
+// parseForwardingSpecification splits a [BIND_ADDRESS:]PORT:HOST:HOSTPORT
+// specification into the address to listen on and the address to connect to.
+func parseForwardingSpecification(val string, kind string) (string, string, error) {
+	specificationError := &flags.Error{
+		Type:    flags.ErrRequired,
+		Message: fmt.Sprintf("Bad %s forwarding specification '%s'", kind, val),
+	}
+
+	splitHosts := strings.Split(val, ":")
+	for _, piece := range splitHosts {
+		if len(piece) == 0 {
+			return "", "", specificationError
+		}
+	}
+
+	re := regexp.MustCompile(`^\d+$`)
+	switch {
+	case len(splitHosts) == 3 && re.MatchString(splitHosts[0]) && re.MatchString(splitHosts[2]):
+		return fmt.Sprintf("%s:%s", DefaultLocalAddress, splitHosts[0]),
+			fmt.Sprintf("%s:%s", splitHosts[1], splitHosts[2]),
+			nil
+	case len(splitHosts) == 4 && re.MatchString(splitHosts[1]) && re.MatchString(splitHosts[3]):
+		return fmt.Sprintf("%s:%s", splitHosts[0], splitHosts[1]),
+			fmt.Sprintf("%s:%s", splitHosts[2], splitHosts[3]),
+			nil
+	default:
+		return "", "", specificationError
+	}
+}
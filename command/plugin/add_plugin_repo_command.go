@@ -12,11 +12,14 @@ import (
 
 type AddPluginRepoActor interface {
 	AddPluginRepository(repoName string, repoURL string) error
+	SetPluginRepositoryPublicKey(repoName string, publicKey string) error
+	ValidatePluginRepositoryPublicKey(publicKey string) error
 }
 
 type AddPluginRepoCommand struct {
 	RequiredArgs      flag.AddPluginRepoArgs `positional-args:"yes"`
-	usage             interface{}            `usage:"CF_NAME add-plugin-repo REPO_NAME URL\n\nEXAMPLES:\n   CF_NAME add-plugin-repo ExampleRepo https://example.com/repo"`
+	PublicKey         string                 `long:"public-key" description:"Base64-encoded ed25519 public key; plugins installed from this repository must be signed with the matching private key"`
+	usage             interface{}            `usage:"CF_NAME add-plugin-repo REPO_NAME URL [--public-key KEY]\n\n   Run the command again with --public-key to require signed plugins from a repository that is already registered.\n\nEXAMPLES:\n   CF_NAME add-plugin-repo ExampleRepo https://example.com/repo\n   CF_NAME add-plugin-repo ExampleRepo https://example.com/repo --public-key 11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo="`
 	relatedCommands   interface{}            `related_commands:"install-plugin, list-plugin-repos"`
 	SkipSSLValidation bool                   `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
 	UI                command.UI
@@ -32,6 +35,13 @@ func (cmd *AddPluginRepoCommand) Setup(config command.Config, ui command.UI) err
 }
 
 func (cmd AddPluginRepoCommand) Execute(args []string) error {
+	if cmd.PublicKey != "" {
+		err := cmd.Actor.ValidatePluginRepositoryPublicKey(cmd.PublicKey)
+		if err != nil {
+			return err
+		}
+	}
+
 	err := cmd.Actor.AddPluginRepository(cmd.RequiredArgs.PluginRepoName, cmd.RequiredArgs.PluginRepoURL)
 	switch e := err.(type) {
 	case actionerror.RepositoryAlreadyExistsError:
@@ -50,5 +60,17 @@ func (cmd AddPluginRepoCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.PublicKey != "" {
+		err = cmd.Actor.SetPluginRepositoryPublicKey(cmd.RequiredArgs.PluginRepoName, cmd.PublicKey)
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayText("Plugins installed from {{.RepositoryName}} must be signed with the given public key.",
+			map[string]interface{}{
+				"RepositoryName": cmd.RequiredArgs.PluginRepoName,
+			})
+	}
+
 	return nil
 }
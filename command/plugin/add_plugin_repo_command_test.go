@@ -92,4 +92,49 @@ var _ = Describe("add-plugin-repo command", func() {
 			Expect(repoURL).To(Equal("https://some-repo-URL"))
 		})
 	})
+
+	When("a public key is given", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.PluginRepoName = "some-repo"
+			cmd.RequiredArgs.PluginRepoURL = "https://some-repo-URL"
+			cmd.PublicKey = "some-key"
+		})
+
+		It("adds the plugin repo and sets its public key", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.ValidatePluginRepositoryPublicKeyArgsForCall(0)).To(Equal("some-key"))
+			Expect(fakeActor.AddPluginRepositoryCallCount()).To(Equal(1))
+			Expect(fakeActor.SetPluginRepositoryPublicKeyCallCount()).To(Equal(1))
+			repoName, publicKey := fakeActor.SetPluginRepositoryPublicKeyArgsForCall(0)
+			Expect(repoName).To(Equal("some-repo"))
+			Expect(publicKey).To(Equal("some-key"))
+
+			Expect(testUI.Out).To(Say("https://some-repo-URL added as some-repo"))
+			Expect(testUI.Out).To(Say("Plugins installed from some-repo must be signed with the given public key."))
+		})
+
+		When("the repository is already registered", func() {
+			BeforeEach(func() {
+				fakeActor.AddPluginRepositoryReturns(actionerror.RepositoryAlreadyExistsError{Name: "some-repo", URL: "https://some-repo-URL"})
+			})
+
+			It("sets the public key of the existing repo", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.SetPluginRepositoryPublicKeyCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the public key is invalid", func() {
+			BeforeEach(func() {
+				fakeActor.ValidatePluginRepositoryPublicKeyReturns(actionerror.InvalidPluginRepositoryPublicKeyError{})
+			})
+
+			It("returns the error without adding the repo", func() {
+				Expect(executeErr).To(MatchError(actionerror.InvalidPluginRepositoryPublicKeyError{}))
+				Expect(fakeActor.AddPluginRepositoryCallCount()).To(Equal(0))
+				Expect(fakeActor.SetPluginRepositoryPublicKeyCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
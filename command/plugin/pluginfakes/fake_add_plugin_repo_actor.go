@@ -20,6 +20,29 @@ type FakeAddPluginRepoActor struct {
 	addPluginRepositoryReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SetPluginRepositoryPublicKeyStub        func(string, string) error
+	setPluginRepositoryPublicKeyMutex       sync.RWMutex
+	setPluginRepositoryPublicKeyArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	setPluginRepositoryPublicKeyReturns struct {
+		result1 error
+	}
+	setPluginRepositoryPublicKeyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidatePluginRepositoryPublicKeyStub        func(string) error
+	validatePluginRepositoryPublicKeyMutex       sync.RWMutex
+	validatePluginRepositoryPublicKeyArgsForCall []struct {
+		arg1 string
+	}
+	validatePluginRepositoryPublicKeyReturns struct {
+		result1 error
+	}
+	validatePluginRepositoryPublicKeyReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -85,11 +108,136 @@ func (fake *FakeAddPluginRepoActor) AddPluginRepositoryReturnsOnCall(i int, resu
 	}{result1}
 }
 
+func (fake *FakeAddPluginRepoActor) SetPluginRepositoryPublicKey(arg1 string, arg2 string) error {
+	fake.setPluginRepositoryPublicKeyMutex.Lock()
+	ret, specificReturn := fake.setPluginRepositoryPublicKeyReturnsOnCall[len(fake.setPluginRepositoryPublicKeyArgsForCall)]
+	fake.setPluginRepositoryPublicKeyArgsForCall = append(fake.setPluginRepositoryPublicKeyArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("SetPluginRepositoryPublicKey", []interface{}{arg1, arg2})
+	fake.setPluginRepositoryPublicKeyMutex.Unlock()
+	if fake.SetPluginRepositoryPublicKeyStub != nil {
+		return fake.SetPluginRepositoryPublicKeyStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setPluginRepositoryPublicKeyReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAddPluginRepoActor) SetPluginRepositoryPublicKeyCallCount() int {
+	fake.setPluginRepositoryPublicKeyMutex.RLock()
+	defer fake.setPluginRepositoryPublicKeyMutex.RUnlock()
+	return len(fake.setPluginRepositoryPublicKeyArgsForCall)
+}
+
+func (fake *FakeAddPluginRepoActor) SetPluginRepositoryPublicKeyCalls(stub func(string, string) error) {
+	fake.setPluginRepositoryPublicKeyMutex.Lock()
+	defer fake.setPluginRepositoryPublicKeyMutex.Unlock()
+	fake.SetPluginRepositoryPublicKeyStub = stub
+}
+
+func (fake *FakeAddPluginRepoActor) SetPluginRepositoryPublicKeyArgsForCall(i int) (string, string) {
+	fake.setPluginRepositoryPublicKeyMutex.RLock()
+	defer fake.setPluginRepositoryPublicKeyMutex.RUnlock()
+	argsForCall := fake.setPluginRepositoryPublicKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAddPluginRepoActor) SetPluginRepositoryPublicKeyReturns(result1 error) {
+	fake.setPluginRepositoryPublicKeyMutex.Lock()
+	defer fake.setPluginRepositoryPublicKeyMutex.Unlock()
+	fake.SetPluginRepositoryPublicKeyStub = nil
+	fake.setPluginRepositoryPublicKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAddPluginRepoActor) SetPluginRepositoryPublicKeyReturnsOnCall(i int, result1 error) {
+	fake.setPluginRepositoryPublicKeyMutex.Lock()
+	defer fake.setPluginRepositoryPublicKeyMutex.Unlock()
+	fake.SetPluginRepositoryPublicKeyStub = nil
+	if fake.setPluginRepositoryPublicKeyReturnsOnCall == nil {
+		fake.setPluginRepositoryPublicKeyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setPluginRepositoryPublicKeyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAddPluginRepoActor) ValidatePluginRepositoryPublicKey(arg1 string) error {
+	fake.validatePluginRepositoryPublicKeyMutex.Lock()
+	ret, specificReturn := fake.validatePluginRepositoryPublicKeyReturnsOnCall[len(fake.validatePluginRepositoryPublicKeyArgsForCall)]
+	fake.validatePluginRepositoryPublicKeyArgsForCall = append(fake.validatePluginRepositoryPublicKeyArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ValidatePluginRepositoryPublicKey", []interface{}{arg1})
+	fake.validatePluginRepositoryPublicKeyMutex.Unlock()
+	if fake.ValidatePluginRepositoryPublicKeyStub != nil {
+		return fake.ValidatePluginRepositoryPublicKeyStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.validatePluginRepositoryPublicKeyReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAddPluginRepoActor) ValidatePluginRepositoryPublicKeyCallCount() int {
+	fake.validatePluginRepositoryPublicKeyMutex.RLock()
+	defer fake.validatePluginRepositoryPublicKeyMutex.RUnlock()
+	return len(fake.validatePluginRepositoryPublicKeyArgsForCall)
+}
+
+func (fake *FakeAddPluginRepoActor) ValidatePluginRepositoryPublicKeyCalls(stub func(string) error) {
+	fake.validatePluginRepositoryPublicKeyMutex.Lock()
+	defer fake.validatePluginRepositoryPublicKeyMutex.Unlock()
+	fake.ValidatePluginRepositoryPublicKeyStub = stub
+}
+
+func (fake *FakeAddPluginRepoActor) ValidatePluginRepositoryPublicKeyArgsForCall(i int) string {
+	fake.validatePluginRepositoryPublicKeyMutex.RLock()
+	defer fake.validatePluginRepositoryPublicKeyMutex.RUnlock()
+	argsForCall := fake.validatePluginRepositoryPublicKeyArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAddPluginRepoActor) ValidatePluginRepositoryPublicKeyReturns(result1 error) {
+	fake.validatePluginRepositoryPublicKeyMutex.Lock()
+	defer fake.validatePluginRepositoryPublicKeyMutex.Unlock()
+	fake.ValidatePluginRepositoryPublicKeyStub = nil
+	fake.validatePluginRepositoryPublicKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAddPluginRepoActor) ValidatePluginRepositoryPublicKeyReturnsOnCall(i int, result1 error) {
+	fake.validatePluginRepositoryPublicKeyMutex.Lock()
+	defer fake.validatePluginRepositoryPublicKeyMutex.Unlock()
+	fake.ValidatePluginRepositoryPublicKeyStub = nil
+	if fake.validatePluginRepositoryPublicKeyReturnsOnCall == nil {
+		fake.validatePluginRepositoryPublicKeyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validatePluginRepositoryPublicKeyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeAddPluginRepoActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.addPluginRepositoryMutex.RLock()
 	defer fake.addPluginRepositoryMutex.RUnlock()
+	fake.setPluginRepositoryPublicKeyMutex.RLock()
+	defer fake.setPluginRepositoryPublicKeyMutex.RUnlock()
+	fake.validatePluginRepositoryPublicKeyMutex.RLock()
+	defer fake.validatePluginRepositoryPublicKeyMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -36,6 +36,10 @@ func ConvertToTranslatableError(err error) error {
 		return BuildpackStackChangeError(e)
 	case actionerror.CommandLineOptionsWithMultipleAppsError:
 		return CommandLineArgsWithMultipleAppsError{}
+	case actionerror.DeviceAuthorizationDeniedError:
+		return DeviceAuthorizationDeniedError(e)
+	case actionerror.DeviceCodeExpiredError:
+		return DeviceCodeExpiredError(e)
 	case actionerror.DockerPasswordNotSetError:
 		return DockerPasswordNotSetError{}
 	case actionerror.DomainNotFoundError:
@@ -60,6 +64,10 @@ func ConvertToTranslatableError(err error) error {
 		return InvalidBuildpacksError{}
 	case actionerror.InvalidHTTPRouteSettings:
 		return PortNotAllowedWithHTTPDomainError(e)
+	case actionerror.InvalidPluginRepositoryPublicKeyError:
+		return InvalidPluginRepositoryPublicKeyError(e)
+	case actionerror.InvalidPluginSetError:
+		return InvalidPluginSetError(e)
 	case actionerror.InvalidRouteError:
 		return InvalidRouteError(e)
 	case actionerror.InvalidTCPRouteSettings:
@@ -82,6 +90,8 @@ func ConvertToTranslatableError(err error) error {
 		return FileNotFoundError(e)
 	case actionerror.NoOrganizationTargetedError:
 		return NoOrganizationTargetedError(e)
+	case actionerror.NoRunningProcessInstancesError:
+		return NoRunningProcessInstancesError(e)
 	case actionerror.NoSpaceTargetedError:
 		return NoSpaceTargetedError(e)
 	case actionerror.NotLoggedInError:
@@ -96,8 +106,16 @@ func ConvertToTranslatableError(err error) error {
 		return PluginCommandsConflictError(e)
 	case actionerror.PluginInvalidError:
 		return PluginInvalidError(e)
+	case actionerror.PluginNameMismatchError:
+		return PluginNameMismatchError(e)
 	case actionerror.PluginNotFoundError:
 		return PluginNotFoundError(e)
+	case actionerror.PluginSignatureInvalidError:
+		return PluginSignatureInvalidError(e)
+	case actionerror.PluginSignatureMissingError:
+		return PluginSignatureMissingError(e)
+	case actionerror.PluginVersionNotAvailableError:
+		return PluginVersionNotAvailableError(e)
 	case actionerror.ProcessInstanceNotFoundError:
 		return ProcessInstanceNotFoundError(e)
 	case actionerror.ProcessInstanceNotRunningError:
@@ -135,6 +153,8 @@ func ConvertToTranslatableError(err error) error {
 		return ServicePlanNotFoundError(e)
 	case actionerror.SharedServiceInstanceNotFoundError:
 		return SharedServiceInstanceNotFoundError(e)
+	case actionerror.SidecarNotFoundError:
+		return SidecarNotFoundError(e)
 	case actionerror.SpaceNotFoundError:
 		return SpaceNotFoundError{Name: e.Name}
 	case actionerror.StackNotFoundError:
@@ -143,6 +163,8 @@ func ConvertToTranslatableError(err error) error {
 		return StagingFailedError{Message: e.Reason}
 	case actionerror.StagingTimeoutError:
 		return StagingTimeoutError(e)
+	case actionerror.TaskTimeoutError:
+		return TaskTimeoutError(e)
 	case actionerror.TaskWorkersUnavailableError:
 		return RunTaskError{Message: "Task workers are unavailable."}
 	case actionerror.TCPRouteOptionsNotProvidedError:
@@ -211,6 +233,8 @@ func ConvertToTranslatableError(err error) error {
 		return DownloadPluginHTTPError{Message: e.Error()}
 
 	// SSH Errors
+	case ssherror.FileTransferError:
+		return SSHFileTransferError(e)
 	case ssherror.UnableToAuthenticateError:
 		return SSHUnableToAuthenticateError{}
 
@@ -67,6 +67,14 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.CommandLineOptionsWithMultipleAppsError{},
 			CommandLineArgsWithMultipleAppsError{}),
 
+		Entry("actionerror.DeviceAuthorizationDeniedError -> DeviceAuthorizationDeniedError",
+			actionerror.DeviceAuthorizationDeniedError{},
+			DeviceAuthorizationDeniedError{}),
+
+		Entry("actionerror.DeviceCodeExpiredError -> DeviceCodeExpiredError",
+			actionerror.DeviceCodeExpiredError{},
+			DeviceCodeExpiredError{}),
+
 		Entry("actionerror.DockerPasswordNotSetError -> DockerPasswordNotSetError",
 			actionerror.DockerPasswordNotSetError{},
 			DockerPasswordNotSetError{}),
@@ -116,6 +124,14 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.InvalidHTTPRouteSettings{Domain: "some-domain"},
 			PortNotAllowedWithHTTPDomainError{Domain: "some-domain"}),
 
+		Entry("actionerror.InvalidPluginRepositoryPublicKeyError -> InvalidPluginRepositoryPublicKeyError",
+			actionerror.InvalidPluginRepositoryPublicKeyError{},
+			InvalidPluginRepositoryPublicKeyError{}),
+
+		Entry("actionerror.InvalidPluginSetError -> InvalidPluginSetError",
+			actionerror.InvalidPluginSetError{Path: "some-path", Message: "some-message"},
+			InvalidPluginSetError{Path: "some-path", Message: "some-message"}),
+
 		Entry("actionerror.InvalidRouteError -> InvalidRouteError",
 			actionerror.InvalidRouteError{Route: "some-invalid-route"},
 			InvalidRouteError{Route: "some-invalid-route"}),
@@ -156,6 +172,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.NoOrganizationTargetedError{BinaryName: "faceman"},
 			NoOrganizationTargetedError{BinaryName: "faceman"}),
 
+		Entry("actionerror.NoRunningProcessInstancesError -> NoRunningProcessInstancesError",
+			actionerror.NoRunningProcessInstancesError{ProcessType: "some-process-type"},
+			NoRunningProcessInstancesError{ProcessType: "some-process-type"}),
+
 		Entry("actionerror.NoSpaceTargetedError -> NoSpaceTargetedError",
 			actionerror.NoSpaceTargetedError{BinaryName: "faceman"},
 			NoSpaceTargetedError{BinaryName: "faceman"}),
@@ -198,10 +218,26 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.PluginInvalidError{Err: genericErr},
 			PluginInvalidError{Err: genericErr}),
 
+		Entry("actionerror.PluginNameMismatchError -> PluginNameMismatchError",
+			actionerror.PluginNameMismatchError{ExpectedName: "some-plugin", ActualName: "other-plugin"},
+			PluginNameMismatchError{ExpectedName: "some-plugin", ActualName: "other-plugin"}),
+
 		Entry("actionerror.PluginNotFoundError -> PluginNotFoundError",
 			actionerror.PluginNotFoundError{PluginName: "some-plugin"},
 			PluginNotFoundError{PluginName: "some-plugin"}),
 
+		Entry("actionerror.PluginSignatureInvalidError -> PluginSignatureInvalidError",
+			actionerror.PluginSignatureInvalidError{PluginName: "some-plugin"},
+			PluginSignatureInvalidError{PluginName: "some-plugin"}),
+
+		Entry("actionerror.PluginSignatureMissingError -> PluginSignatureMissingError",
+			actionerror.PluginSignatureMissingError{PluginName: "some-plugin"},
+			PluginSignatureMissingError{PluginName: "some-plugin"}),
+
+		Entry("actionerror.PluginVersionNotAvailableError -> PluginVersionNotAvailableError",
+			actionerror.PluginVersionNotAvailableError{PluginName: "some-plugin", RepositoryName: "some-repo", Version: "1.0.0", AvailableVersion: "2.0.0"},
+			PluginVersionNotAvailableError{PluginName: "some-plugin", RepositoryName: "some-repo", Version: "1.0.0", AvailableVersion: "2.0.0"}),
+
 		Entry("actionerror.ProcessInstanceNotFoundError -> ProcessInstanceNotFoundError",
 			actionerror.ProcessInstanceNotFoundError{ProcessType: "some-process-type", InstanceIndex: 42},
 			ProcessInstanceNotFoundError{ProcessType: "some-process-type", InstanceIndex: 42}),
@@ -267,6 +303,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.SharedServiceInstanceNotFoundError{},
 			SharedServiceInstanceNotFoundError{}),
 
+		Entry("actionerror.SidecarNotFoundError -> SidecarNotFoundError",
+			actionerror.SidecarNotFoundError{Name: "some-sidecar", AppName: "some-app"},
+			SidecarNotFoundError{Name: "some-sidecar", AppName: "some-app"}),
+
 		Entry("actionerror.SpaceNotFoundError -> SpaceNotFoundError",
 			actionerror.SpaceNotFoundError{Name: "some-space"},
 			SpaceNotFoundError{Name: "some-space"}),
@@ -275,6 +315,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.StackNotFoundError{Name: "some-stack-name", GUID: "some-stack-guid"},
 			StackNotFoundError{Name: "some-stack-name", GUID: "some-stack-guid"}),
 
+		Entry("actionerror.TaskTimeoutError -> TaskTimeoutError",
+			actionerror.TaskTimeoutError{SequenceID: 3, Timeout: time.Minute},
+			TaskTimeoutError{SequenceID: 3, Timeout: time.Minute}),
+
 		Entry("actionerror.TaskWorkersUnavailableError -> RunTaskError",
 			actionerror.TaskWorkersUnavailableError{Message: "fooo: Banana Pants"},
 			RunTaskError{Message: "Task workers are unavailable."}),
@@ -417,6 +461,9 @@ var _ = Describe("ConvertToTranslatableError", func() {
 		),
 
 		// SSH Error
+		Entry("ssherror.FileTransferError -> SSHFileTransferError",
+			ssherror.FileTransferError{Message: "some message"},
+			SSHFileTransferError{Message: "some message"}),
 		Entry("ssherror.UnableToAuthenticateError -> UnableToAuthenticateError",
 			ssherror.UnableToAuthenticateError{},
 			SSHUnableToAuthenticateError{}),
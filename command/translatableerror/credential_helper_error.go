@@ -0,0 +1,17 @@
+package translatableerror
+
+type CredentialHelperError struct {
+	Helper  string
+	Message string
+}
+
+func (e CredentialHelperError) Error() string {
+	return "Credential helper '{{.Helper}}' failed: {{.Message}}"
+}
+
+func (e CredentialHelperError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Helper":  e.Helper,
+		"Message": e.Message,
+	})
+}
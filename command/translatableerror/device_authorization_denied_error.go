@@ -0,0 +1,11 @@
+package translatableerror
+
+type DeviceAuthorizationDeniedError struct{}
+
+func (DeviceAuthorizationDeniedError) Error() string {
+	return "The login request was denied."
+}
+
+func (e DeviceAuthorizationDeniedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
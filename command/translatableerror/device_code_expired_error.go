@@ -0,0 +1,11 @@
+package translatableerror
+
+type DeviceCodeExpiredError struct{}
+
+func (DeviceCodeExpiredError) Error() string {
+	return "The code expired before the login was approved. Please try again."
+}
+
+func (e DeviceCodeExpiredError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,14 @@
+package translatableerror
+
+// DiffFoundError is returned by cf diff when the compared configurations
+// differ. The differences have already been displayed, so the error itself is
+// not; the CLI exits with status 2 to tell drift apart from failures.
+type DiffFoundError struct{}
+
+func (DiffFoundError) Error() string {
+	return "Differences were found."
+}
+
+func (e DiffFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
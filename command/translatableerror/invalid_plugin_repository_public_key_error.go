@@ -0,0 +1,11 @@
+package translatableerror
+
+type InvalidPluginRepositoryPublicKeyError struct{}
+
+func (InvalidPluginRepositoryPublicKeyError) Error() string {
+	return "The plugin repository public key must be a base64-encoded ed25519 public key."
+}
+
+func (e InvalidPluginRepositoryPublicKeyError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
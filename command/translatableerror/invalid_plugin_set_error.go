@@ -0,0 +1,17 @@
+package translatableerror
+
+type InvalidPluginSetError struct {
+	Path    string
+	Message string
+}
+
+func (InvalidPluginSetError) Error() string {
+	return "Plugin set file {{.Path}} is invalid: {{.Message}}"
+}
+
+func (e InvalidPluginSetError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path":    e.Path,
+		"Message": e.Message,
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+// OutputFormatNotSupportedError is returned when the global --output flag is
+// used with a command that can only render human readable output.
+type OutputFormatNotSupportedError struct{}
+
+func (OutputFormatNotSupportedError) DisplayUsage() {}
+
+func (OutputFormatNotSupportedError) Error() string {
+	return "Incorrect Usage: The '--output' flag is not supported by this command."
+}
+
+func (e OutputFormatNotSupportedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
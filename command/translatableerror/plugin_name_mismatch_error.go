@@ -0,0 +1,17 @@
+package translatableerror
+
+type PluginNameMismatchError struct {
+	ExpectedName string
+	ActualName   string
+}
+
+func (PluginNameMismatchError) Error() string {
+	return "The downloaded binary is for plugin {{.ActualName}}, not {{.ExpectedName}}. The installed plugin was left unchanged."
+}
+
+func (e PluginNameMismatchError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ExpectedName": e.ExpectedName,
+		"ActualName":   e.ActualName,
+	})
+}
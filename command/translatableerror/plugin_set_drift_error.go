@@ -0,0 +1,17 @@
+package translatableerror
+
+// PluginSetDriftError is returned when the installed plugins do not match a
+// plugin set file and the command was only asked to check them.
+type PluginSetDriftError struct {
+	Path string
+}
+
+func (PluginSetDriftError) Error() string {
+	return "Installed plugins do not match {{.Path}}."
+}
+
+func (e PluginSetDriftError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path": e.Path,
+	})
+}
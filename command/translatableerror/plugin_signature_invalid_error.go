@@ -0,0 +1,15 @@
+package translatableerror
+
+type PluginSignatureInvalidError struct {
+	PluginName string
+}
+
+func (PluginSignatureInvalidError) Error() string {
+	return "The signature of plugin {{.PluginName}} does not match the public key of its repository.\nUse --skip-signature-verification to install it anyway."
+}
+
+func (e PluginSignatureInvalidError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PluginName": e.PluginName,
+	})
+}
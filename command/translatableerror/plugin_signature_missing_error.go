@@ -0,0 +1,15 @@
+package translatableerror
+
+type PluginSignatureMissingError struct {
+	PluginName string
+}
+
+func (PluginSignatureMissingError) Error() string {
+	return "Plugin {{.PluginName}} is not signed, but its repository requires signed plugins.\nUse --skip-signature-verification to install it anyway."
+}
+
+func (e PluginSignatureMissingError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PluginName": e.PluginName,
+	})
+}
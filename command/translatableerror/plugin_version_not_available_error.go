@@ -0,0 +1,21 @@
+package translatableerror
+
+type PluginVersionNotAvailableError struct {
+	PluginName       string
+	RepositoryName   string
+	Version          string
+	AvailableVersion string
+}
+
+func (PluginVersionNotAvailableError) Error() string {
+	return "Plugin {{.PluginName}} {{.Version}} is not available in repository {{.RepositoryName}}. The repository offers version {{.AvailableVersion}}."
+}
+
+func (e PluginVersionNotAvailableError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PluginName":       e.PluginName,
+		"RepositoryName":   e.RepositoryName,
+		"Version":          e.Version,
+		"AvailableVersion": e.AvailableVersion,
+	})
+}
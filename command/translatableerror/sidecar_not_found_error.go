@@ -0,0 +1,19 @@
+package translatableerror
+
+// SidecarNotFoundError is returned when an app has no sidecar with the
+// requested name.
+type SidecarNotFoundError struct {
+	Name    string
+	AppName string
+}
+
+func (SidecarNotFoundError) Error() string {
+	return "Sidecar {{.Name}} not found for app {{.AppName}}."
+}
+
+func (e SidecarNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name":    e.Name,
+		"AppName": e.AppName,
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+type SpaceManifestNotFoundInDirectoryError struct {
+	PathToDirectory string
+}
+
+func (SpaceManifestNotFoundInDirectoryError) Error() string {
+	return "Could not find any '.yml' or '.yaml' files in {{.PathToDirectory}}"
+}
+
+func (e SpaceManifestNotFoundInDirectoryError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PathToDirectory": e.PathToDirectory,
+	})
+}
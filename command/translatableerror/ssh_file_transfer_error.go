@@ -0,0 +1,15 @@
+package translatableerror
+
+type SSHFileTransferError struct {
+	Message string
+}
+
+func (SSHFileTransferError) Error() string {
+	return "Error copying files: {{.Message}}"
+}
+
+func (e SSHFileTransferError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Message": e.Message,
+	})
+}
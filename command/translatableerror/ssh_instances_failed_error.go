@@ -0,0 +1,21 @@
+package translatableerror
+
+// SSHInstancesFailedError is returned by cf ssh --all-instances when the
+// command did not succeed on every instance. The CLI exits with ExitStatus,
+// the highest exit status of the failed instances.
+type SSHInstancesFailedError struct {
+	Failed     int
+	Total      int
+	ExitStatus int
+}
+
+func (SSHInstancesFailedError) Error() string {
+	return "Command failed on {{.Failed}} of {{.Total}} instances."
+}
+
+func (e SSHInstancesFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Failed": e.Failed,
+		"Total":  e.Total,
+	})
+}
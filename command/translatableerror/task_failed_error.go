@@ -0,0 +1,21 @@
+package translatableerror
+
+// TaskFailedError is returned by cf run-task --wait when the task fails. The
+// CLI exits with ExitStatus, the exit status of the task's command when Cloud
+// Controller reports one.
+type TaskFailedError struct {
+	SequenceID    int64
+	FailureReason string
+	ExitStatus    int
+}
+
+func (TaskFailedError) Error() string {
+	return "Task {{.SequenceID}} failed: {{.FailureReason}}"
+}
+
+func (e TaskFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"SequenceID":    e.SequenceID,
+		"FailureReason": e.FailureReason,
+	})
+}
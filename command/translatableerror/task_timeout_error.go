@@ -0,0 +1,19 @@
+package translatableerror
+
+import "time"
+
+type TaskTimeoutError struct {
+	SequenceID int
+	Timeout    time.Duration
+}
+
+func (TaskTimeoutError) Error() string {
+	return "Timed out after {{.Timeout}} waiting for task {{.SequenceID}} to complete. The task is still running; use 'cf tasks' to check on it."
+}
+
+func (e TaskTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"SequenceID": e.SequenceID,
+		"Timeout":    e.Timeout,
+	})
+}
@@ -49,6 +49,11 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("CFNetworkingEndpointNotFoundError", CFNetworkingEndpointNotFoundError{}),
 		Entry("CommandLineArgsWithMultipleAppsError", CommandLineArgsWithMultipleAppsError{}),
 		Entry("CommandLineOptionsAndManifestConflictError", CommandLineOptionsAndManifestConflictError{}),
+		Entry("ContextNotFoundError", ContextNotFoundError{}),
+		Entry("CredentialHelperError", CredentialHelperError{}),
+		Entry("DeviceAuthorizationDeniedError", DeviceAuthorizationDeniedError{}),
+		Entry("DeviceCodeExpiredError", DeviceCodeExpiredError{}),
+		Entry("DiffFoundError", DiffFoundError{}),
 		Entry("DockerPasswordNotSetError", DockerPasswordNotSetError{}),
 		Entry("DownloadPluginHTTPError", DownloadPluginHTTPError{}),
 		Entry("EmptyDirectoryError", EmptyDirectoryError{}),
@@ -63,6 +68,8 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("HTTPHealthCheckInvalidError", HTTPHealthCheckInvalidError{}),
 		Entry("HTTPStatusError", HTTPStatusError{Status: "some status"}),
 		Entry("InvalidChecksumError", InvalidChecksumError{}),
+		Entry("InvalidPluginRepositoryPublicKeyError", InvalidPluginRepositoryPublicKeyError{}),
+		Entry("InvalidPluginSetError", InvalidPluginSetError{}),
 		Entry("InvalidRouteError", InvalidRouteError{}),
 		Entry("InvalidSSLCertError", InvalidSSLCertError{}),
 		Entry("IsolationSegmentNotFoundError", IsolationSegmentNotFoundError{}),
@@ -83,6 +90,7 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("NoMatchingDomainError", NoMatchingDomainError{}),
 		Entry("NoOrganizationTargetedError", NoOrganizationTargetedError{}),
 		Entry("NoPluginRepositoriesError", NoPluginRepositoriesError{}),
+		Entry("NoRunningProcessInstancesError", NoRunningProcessInstancesError{}),
 		Entry("NoSpaceTargetedError", NoSpaceTargetedError{}),
 		Entry("NotLoggedInError", NotLoggedInError{}),
 		Entry("OrgNotFoundError", OrganizationNotFoundError{}),
@@ -95,9 +103,14 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("PluginCommandsConflictError", PluginCommandsConflictError{}),
 		Entry("PluginInvalidError", PluginInvalidError{Err: errors.New("invalid error")}),
 		Entry("PluginInvalidError", PluginInvalidError{}),
+		Entry("PluginNameMismatchError", PluginNameMismatchError{}),
 		Entry("PluginNotFoundError", PluginNotFoundError{}),
 		Entry("PluginNotFoundInRepositoryError", PluginNotFoundInRepositoryError{}),
 		Entry("PluginNotFoundOnDiskOrInAnyRepositoryError", PluginNotFoundOnDiskOrInAnyRepositoryError{}),
+		Entry("PluginSetDriftError", PluginSetDriftError{}),
+		Entry("PluginSignatureInvalidError", PluginSignatureInvalidError{}),
+		Entry("PluginSignatureMissingError", PluginSignatureMissingError{}),
+		Entry("PluginVersionNotAvailableError", PluginVersionNotAvailableError{}),
 		Entry("PortNotAllowedWithHTTPDomainError", PortNotAllowedWithHTTPDomainError{}),
 		Entry("ProcessInstanceNotFoundError", ProcessInstanceNotFoundError{ProcessType: "some-process", InstanceIndex: 1}),
 		Entry("ProcessInstanceNotRunningError", ProcessInstanceNotRunningError{ProcessType: "some-process", InstanceIndex: 1}),
@@ -112,7 +125,11 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("ServiceInstanceNotShareableError", ServiceInstanceNotShareableError{}),
 		Entry("ServiceInstanceNotFoundError", ServiceInstanceNotFoundError{}),
 		Entry("SharedServiceInstanceNotFoundError", SharedServiceInstanceNotFoundError{}),
+		Entry("SidecarNotFoundError", SidecarNotFoundError{}),
+		Entry("SpaceManifestNotFoundInDirectoryError", SpaceManifestNotFoundInDirectoryError{}),
 		Entry("SpaceNotFoundError", SpaceNotFoundError{}),
+		Entry("SSHFileTransferError", SSHFileTransferError{}),
+		Entry("SSHInstancesFailedError", SSHInstancesFailedError{}),
 		Entry("SSHUnableToAuthenticateError", SSHUnableToAuthenticateError{}),
 		Entry("SSLCertError", SSLCertError{}),
 		Entry("StackNotFoundError with name", SpaceNotFoundError{Name: "steve"}),
@@ -121,6 +138,8 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("StagingFailedNoAppDetectedError", StagingFailedNoAppDetectedError{}),
 		Entry("StagingTimeoutError", StagingTimeoutError{}),
 		Entry("StartupTimeoutError", StartupTimeoutError{}),
+		Entry("TaskFailedError", TaskFailedError{}),
+		Entry("TaskTimeoutError", TaskTimeoutError{}),
 		Entry("ThreeRequiredArgumentsError", ThreeRequiredArgumentsError{}),
 		Entry("TriggerLegacyPushError", TriggerLegacyPushError{}),
 		Entry("UnsupportedURLSchemeError", UnsupportedURLSchemeError{}),
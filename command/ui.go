@@ -10,6 +10,7 @@ import (
 // UI is the interface to STDOUT, STDERR, and STDIN.
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . UI
 type UI interface {
+	ClearScreen()
 	DeferText(template string, data ...map[string]interface{})
 	DisplayBoolPrompt(defaultResponse bool, template string, templateValues ...map[string]interface{}) (bool, error)
 	DisplayChangesForPush(changeSet []ui.Change) error
@@ -21,6 +22,7 @@ type UI interface {
 	DisplayFileDeprecationWarning()
 	DisplayHeader(text string)
 	DisplayInstancesTableForApp(table [][]string)
+	DisplayInstancesTableForTop(table [][]string, highlighted [][]bool)
 	DisplayJSON(name string, jsonData interface{}) error
 	DisplayKeyValueTable(prefix string, table [][]string, padding int)
 	DisplayKeyValueTableForApp(table [][]string)
@@ -30,6 +32,8 @@ type UI interface {
 	DisplayOK()
 	DisplayOptionalTextPrompt(defaultValue string, template string, templateValues ...map[string]interface{}) (string, error)
 	DisplayPasswordPrompt(template string, templateValues ...map[string]interface{}) (string, error)
+	DisplayStructuredLogMessage(message ui.LogMessage) error
+	DisplayStructuredOutput(data interface{}) error
 	DisplayTableWithHeader(prefix string, table [][]string, padding int)
 	DisplayText(template string, data ...map[string]interface{})
 	DisplayTextMenu(choices []string, promptTemplate string, templateValues ...map[string]interface{}) (string, error)
@@ -41,6 +45,7 @@ type UI interface {
 	GetErr() io.Writer
 	GetIn() io.Reader
 	GetOut() io.Writer
+	IsStructuredOutput() bool
 	RequestLoggerFileWriter(filePaths []string) *ui.RequestLoggerFileWriter
 	RequestLoggerTerminalDisplay() *ui.RequestLoggerTerminalDisplay
 	TranslateText(template string, data ...map[string]interface{}) string
@@ -2,6 +2,7 @@ package v7_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
@@ -237,5 +238,85 @@ var _ = Describe("app Command", func() {
 				Expect(withObfuscatedValues).To(BeFalse())
 			})
 		})
+
+		When("structured output is requested", func() {
+			var out *Buffer
+
+			BeforeEach(func() {
+				out = NewBuffer()
+				testUI = ui.NewTestUI(nil, out, NewBuffer())
+				testUI.SetOutputFormat(ui.OutputFormatJSON)
+				cmd.UI = testUI
+
+				summary := v7action.DetailedApplicationSummary{
+					ApplicationSummary: v7action.ApplicationSummary{
+						Application: resources.Application{
+							Name:  "some-app",
+							GUID:  "some-app-guid",
+							State: constant.ApplicationStarted,
+						},
+						ProcessSummaries: v7action.ProcessSummaries{
+							{
+								Process: resources.Process{
+									Type:       constant.ProcessTypeWeb,
+									Instances:  types.NullInt{Value: 1, IsSet: true},
+									MemoryInMB: types.NullUint64{Value: 64, IsSet: true},
+									DiskInMB:   types.NullUint64{Value: 128, IsSet: true},
+								},
+								InstanceDetails: []v7action.ProcessInstance{
+									{
+										Index:       0,
+										State:       constant.ProcessInstanceRunning,
+										CPU:         0.5,
+										MemoryUsage: 1024,
+										MemoryQuota: 2048,
+										DiskUsage:   4096,
+										DiskQuota:   8192,
+										Uptime:      90 * time.Second,
+									},
+								},
+							},
+						},
+					},
+					CurrentDroplet: resources.Droplet{GUID: "some-droplet-guid"},
+				}
+				fakeActor.GetDetailedAppSummaryReturns(summary, v7action.Warnings{"warning-1"}, nil)
+			})
+
+			It("renders the application summary as JSON", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(string(out.Contents())).To(MatchJSON(`{
+					"name": "some-app",
+					"guid": "some-app-guid",
+					"state": "STARTED",
+					"droplet": "some-droplet-guid",
+					"processes": [
+						{
+							"type": "web",
+							"instances": 1,
+							"running_instances": 1,
+							"memory_in_mb": 64,
+							"disk_in_mb": 128,
+							"health_check_type": "",
+							"instance_details": [
+								{
+									"index": 0,
+									"state": "RUNNING",
+									"cpu": 0.5,
+									"memory_usage": 1024,
+									"memory_quota": 2048,
+									"disk_usage": 4096,
+									"disk_quota": 8192,
+									"uptime_in_seconds": 90
+								}
+							]
+						}
+					],
+					"routes": []
+				}`))
+				Expect(testUI.Err).To(Say(`Showing health and status for app some-app`))
+				Expect(testUI.Err).To(Say("warning-1"))
+			})
+		})
 	})
 })
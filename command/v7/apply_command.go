@@ -0,0 +1,211 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/v7applyaction"
+	"code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"code.cloudfoundry.org/cli/util/ui"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"gopkg.in/yaml.v2"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ApplyActor
+
+type ApplyActor interface {
+	CreatePlan(orgGUID string, spaceGUID string, spaceName string, manifest manifestparser.SpaceManifest, prune bool) (v7applyaction.Plan, v7applyaction.Warnings, error)
+	ApplyChange(plan v7applyaction.Plan, change v7applyaction.Change) (v7applyaction.Warnings, error)
+}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SpaceManifestLocator
+
+type SpaceManifestLocator interface {
+	SpaceManifestPaths(filepathOrDirectory string) ([]string, error)
+}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SpaceManifestParser
+
+type SpaceManifestParser interface {
+	InterpolateManifest(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV) ([]byte, error)
+	ParseSpaceManifest(pathToManifest string, rawManifest []byte) (manifestparser.SpaceManifest, error)
+}
+
+type ApplyCommand struct {
+	BaseCommand
+
+	PathsToManifests []flag.PathWithExistenceCheck `short:"f" required:"true" description:"Path to a space manifest or a directory of space manifests; can specify multiple times"`
+	DryRun           bool                          `long:"dry-run" description:"Display the planned changes without applying them"`
+	Prune            bool                          `long:"prune" description:"Delete apps, routes and service instances labeled managed-by=cf-apply that are not in the manifests"`
+	Vars             []template.VarKV              `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
+	PathsToVarsFiles []flag.PathWithExistenceCheck `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
+	usage            interface{}                   `usage:"CF_NAME apply -f PATH [-f PATH]... [--prune] [--dry-run] [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]...\n\n   A space manifest can declare applications, routes, service_instances,\n   service_bindings, network_policies and labels for the targeted space.\n\nEXAMPLES:\n   CF_NAME apply -f ./my-space\n   CF_NAME apply -f apps.yml -f services.yml --prune --dry-run"`
+	relatedCommands  interface{}                   `related_commands:"apply-manifest, create-app-manifest, push"`
+
+	ApplyActor           ApplyActor
+	SpaceManifestLocator SpaceManifestLocator
+	SpaceManifestParser  SpaceManifestParser
+}
+
+func (cmd *ApplyCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	ccClient, uaaClient := cmd.BaseCommand.GetClients()
+
+	networkingClient, err := shared.NewNetworkingClient(config.NetworkPolicyV1Endpoint(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
+
+	cmd.ApplyActor = v7applyaction.NewActor(cmd.Actor, cfnetworkingaction.NewActor(networkingClient, ccClient))
+	cmd.SpaceManifestLocator = manifestparser.NewLocator()
+	cmd.SpaceManifestParser = manifestparser.ManifestParser{}
+
+	return nil
+}
+
+func (cmd ApplyCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	manifest, err := cmd.readSpaceManifest()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Applying space manifests to org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	plan, warnings, err := cmd.ApplyActor.CreatePlan(
+		cmd.Config.TargetedOrganization().GUID,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.Config.TargetedSpace().Name,
+		manifest,
+		cmd.Prune,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(plan.Changes) == 0 {
+		cmd.UI.DisplayText("No changes needed. The space matches the manifests.")
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	cmd.displayPlan(plan)
+
+	if cmd.DryRun {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Dry run complete. No changes were applied.")
+		return nil
+	}
+
+	cmd.UI.DisplayNewline()
+	for _, change := range plan.Changes {
+		cmd.UI.DisplayText(changeMessage(change.Action), map[string]interface{}{
+			"Type": change.Type,
+			"Name": change.Name,
+		})
+
+		warnings, err = cmd.ApplyActor.ApplyChange(plan, change)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func (cmd ApplyCommand) readSpaceManifest() (manifestparser.SpaceManifest, error) {
+	var pathsToVarsFiles []string
+	for _, varFilePath := range cmd.PathsToVarsFiles {
+		pathsToVarsFiles = append(pathsToVarsFiles, string(varFilePath))
+	}
+
+	var spaceManifest manifestparser.SpaceManifest
+	for _, path := range cmd.PathsToManifests {
+		pathsToManifests, err := cmd.SpaceManifestLocator.SpaceManifestPaths(string(path))
+		if err != nil {
+			return manifestparser.SpaceManifest{}, err
+		}
+
+		if len(pathsToManifests) == 0 {
+			return manifestparser.SpaceManifest{}, translatableerror.SpaceManifestNotFoundInDirectoryError{PathToDirectory: string(path)}
+		}
+
+		for _, pathToManifest := range pathsToManifests {
+			rawManifest, err := cmd.SpaceManifestParser.InterpolateManifest(pathToManifest, pathsToVarsFiles, cmd.Vars)
+			if err != nil {
+				return manifestparser.SpaceManifest{}, err
+			}
+
+			manifest, err := cmd.SpaceManifestParser.ParseSpaceManifest(pathToManifest, rawManifest)
+			if err != nil {
+				if _, ok := err.(*yaml.TypeError); ok {
+					return manifestparser.SpaceManifest{}, errors.New("Unable to apply manifest " + pathToManifest + " because its format is invalid.")
+				}
+				return manifestparser.SpaceManifest{}, err
+			}
+
+			spaceManifest.Merge(manifest)
+		}
+	}
+
+	return spaceManifest, spaceManifest.Validate()
+}
+
+func (cmd ApplyCommand) displayPlan(plan v7applyaction.Plan) {
+	cmd.UI.DisplayText("Planned changes:")
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("action"),
+			cmd.UI.TranslateText("type"),
+			cmd.UI.TranslateText("name"),
+		},
+	}
+	for _, change := range plan.Changes {
+		table = append(table, []string{
+			string(change.Action),
+			string(change.Type),
+			change.Name,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+func changeMessage(action v7applyaction.ChangeAction) string {
+	switch action {
+	case v7applyaction.ChangeCreate:
+		return "Creating {{.Type}} {{.Name}}..."
+	case v7applyaction.ChangeDelete:
+		return "Deleting {{.Type}} {{.Name}}..."
+	default:
+		return "Updating {{.Type}} {{.Name}}..."
+	}
+}
@@ -0,0 +1,263 @@
+package v7_test
+
+import (
+	"errors"
+
+	"gopkg.in/yaml.v2"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7applyaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"code.cloudfoundry.org/cli/util/ui"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apply Command", func() {
+	var (
+		cmd             ApplyCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		fakeApplyActor  *v7fakes.FakeApplyActor
+		fakeLocator     *v7fakes.FakeSpaceManifestLocator
+		fakeParser      *v7fakes.FakeSpaceManifestParser
+		binaryName      string
+		executeErr      error
+
+		plan v7applyaction.Plan
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeApplyActor = new(v7fakes.FakeApplyActor)
+		fakeLocator = new(v7fakes.FakeSpaceManifestLocator)
+		fakeParser = new(v7fakes.FakeSpaceManifestParser)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		cmd = ApplyCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			PathsToManifests:     []flag.PathWithExistenceCheck{"some-dir"},
+			ApplyActor:           fakeApplyActor,
+			SpaceManifestLocator: fakeLocator,
+			SpaceManifestParser:  fakeParser,
+		}
+
+		fakeLocator.SpaceManifestPathsReturns([]string{"some-dir/apps.yml", "some-dir/services.yml"}, nil)
+		fakeParser.InterpolateManifestStub = func(path string, _ []string, _ []template.VarKV) ([]byte, error) {
+			return []byte(path), nil
+		}
+		fakeParser.ParseSpaceManifestStub = func(path string, _ []byte) (manifestparser.SpaceManifest, error) {
+			if path == "some-dir/apps.yml" {
+				return manifestparser.SpaceManifest{Applications: []manifestparser.Application{{Name: "some-app"}}}, nil
+			}
+			return manifestparser.SpaceManifest{ServiceInstances: []manifestparser.SpaceServiceInstance{{Name: "some-db", Offering: "db", Plan: "small"}}}, nil
+		}
+
+		plan = v7applyaction.Plan{
+			OrgGUID:   "some-org-guid",
+			SpaceGUID: "some-space-guid",
+			SpaceName: "some-space",
+			Changes: []v7applyaction.Change{
+				{Action: v7applyaction.ChangeCreate, Type: v7applyaction.ApplicationResource, Name: "some-app"},
+				{Action: v7applyaction.ChangeUpdate, Type: v7applyaction.ServiceInstanceResource, Name: "some-db"},
+				{Action: v7applyaction.ChangeDelete, Type: v7applyaction.RouteResource, Name: "old.example.com"},
+			},
+		}
+		fakeApplyActor.CreatePlanReturns(plan, v7applyaction.Warnings{"plan-warning"}, nil)
+		fakeApplyActor.ApplyChangeReturns(v7applyaction.Warnings{"apply-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("getting the current user fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetCurrentUserReturns(configv3.User{}, errors.New("current-user-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("current-user-error"))
+		})
+	})
+
+	It("reads, interpolates and merges every manifest", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(fakeLocator.SpaceManifestPathsArgsForCall(0)).To(Equal("some-dir"))
+		Expect(fakeParser.InterpolateManifestCallCount()).To(Equal(2))
+
+		orgGUID, spaceGUID, spaceName, manifest, prune := fakeApplyActor.CreatePlanArgsForCall(0)
+		Expect(orgGUID).To(Equal("some-org-guid"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(spaceName).To(Equal("some-space"))
+		Expect(prune).To(BeFalse())
+		Expect(manifest.Applications).To(HaveLen(1))
+		Expect(manifest.ServiceInstances).To(HaveLen(1))
+	})
+
+	It("displays the plan and applies every change in order", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Applying space manifests to org some-org / space some-space as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`Planned changes:`))
+		Expect(testUI.Out).To(Say(`action\s+type\s+name`))
+		Expect(testUI.Out).To(Say(`create\s+app\s+some-app`))
+		Expect(testUI.Out).To(Say(`update\s+service instance\s+some-db`))
+		Expect(testUI.Out).To(Say(`delete\s+route\s+old.example.com`))
+		Expect(testUI.Out).To(Say(`Creating app some-app\.\.\.`))
+		Expect(testUI.Out).To(Say(`Updating service instance some-db\.\.\.`))
+		Expect(testUI.Out).To(Say(`Deleting route old.example.com\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+
+		Expect(testUI.Err).To(Say("plan-warning"))
+		Expect(testUI.Err).To(Say("apply-warning"))
+
+		Expect(fakeApplyActor.ApplyChangeCallCount()).To(Equal(3))
+		appliedPlan, change := fakeApplyActor.ApplyChangeArgsForCall(2)
+		Expect(appliedPlan).To(Equal(plan))
+		Expect(change).To(Equal(plan.Changes[2]))
+	})
+
+	When("vars and vars files are provided", func() {
+		BeforeEach(func() {
+			cmd.Vars = []template.VarKV{{Name: "key", Value: "value"}}
+			cmd.PathsToVarsFiles = []flag.PathWithExistenceCheck{"vars.yml"}
+		})
+
+		It("interpolates the manifests with them", func() {
+			_, varsFiles, vars := fakeParser.InterpolateManifestArgsForCall(0)
+			Expect(varsFiles).To(Equal([]string{"vars.yml"}))
+			Expect(vars).To(Equal([]template.VarKV{{Name: "key", Value: "value"}}))
+		})
+	})
+
+	When("the directory contains no manifests", func() {
+		BeforeEach(func() {
+			fakeLocator.SpaceManifestPathsReturns(nil, nil)
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.SpaceManifestNotFoundInDirectoryError{PathToDirectory: "some-dir"}))
+			Expect(fakeApplyActor.CreatePlanCallCount()).To(Equal(0))
+		})
+	})
+
+	When("a manifest is not valid YAML", func() {
+		BeforeEach(func() {
+			fakeParser.ParseSpaceManifestReturns(manifestparser.SpaceManifest{}, &yaml.TypeError{})
+			fakeParser.ParseSpaceManifestStub = nil
+		})
+
+		It("returns an error naming the manifest", func() {
+			Expect(executeErr).To(MatchError("Unable to apply manifest some-dir/apps.yml because its format is invalid."))
+		})
+	})
+
+	When("the merged manifest is not valid", func() {
+		BeforeEach(func() {
+			fakeLocator.SpaceManifestPathsReturns([]string{"some-dir/apps.yml", "some-dir/apps.yml"}, nil)
+		})
+
+		It("returns the validation error", func() {
+			Expect(executeErr).To(MatchError("Application 'some-app' is declared more than once."))
+			Expect(fakeApplyActor.CreatePlanCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--prune is provided", func() {
+		BeforeEach(func() {
+			cmd.Prune = true
+		})
+
+		It("plans with pruning", func() {
+			_, _, _, _, prune := fakeApplyActor.CreatePlanArgsForCall(0)
+			Expect(prune).To(BeTrue())
+		})
+	})
+
+	When("--dry-run is provided", func() {
+		BeforeEach(func() {
+			cmd.DryRun = true
+		})
+
+		It("displays the plan without applying it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`create\s+app\s+some-app`))
+			Expect(testUI.Out).To(Say(`Dry run complete\. No changes were applied\.`))
+			Expect(fakeApplyActor.ApplyChangeCallCount()).To(Equal(0))
+		})
+	})
+
+	When("there are no changes", func() {
+		BeforeEach(func() {
+			fakeApplyActor.CreatePlanReturns(v7applyaction.Plan{}, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`No changes needed\. The space matches the manifests\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeApplyActor.ApplyChangeCallCount()).To(Equal(0))
+		})
+	})
+
+	When("creating the plan fails", func() {
+		BeforeEach(func() {
+			fakeApplyActor.CreatePlanReturns(v7applyaction.Plan{}, v7applyaction.Warnings{"plan-warning"}, errors.New("plan-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("plan-error"))
+			Expect(testUI.Err).To(Say("plan-warning"))
+		})
+	})
+
+	When("applying a change fails", func() {
+		BeforeEach(func() {
+			fakeApplyActor.ApplyChangeReturnsOnCall(1, v7applyaction.Warnings{"apply-warning"}, errors.New("apply-error"))
+		})
+
+		It("stops at the failed change", func() {
+			Expect(executeErr).To(MatchError("apply-error"))
+			Expect(fakeApplyActor.ApplyChangeCallCount()).To(Equal(2))
+			Expect(testUI.Out).ToNot(Say("Deleting route"))
+		})
+	})
+})
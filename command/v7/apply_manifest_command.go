@@ -96,6 +96,11 @@ func (cmd ApplyManifestCommand) Execute(args []string) error {
 		return err
 	}
 
+	routeWeights, err := manifest.ExtractRouteWeights()
+	if err != nil {
+		return err
+	}
+
 	manifestBytes, err := cmd.ManifestParser.MarshalManifest(manifest)
 	if err != nil {
 		return err
@@ -125,6 +130,11 @@ func (cmd ApplyManifestCommand) Execute(args []string) error {
 		return err
 	}
 
+	err = setManifestRouteWeights(cmd.UI, cmd.Actor, spaceGUID, routeWeights)
+	if err != nil {
+		return err
+	}
+
 	cmd.UI.DisplayNewline()
 	cmd.UI.DisplayOK()
 
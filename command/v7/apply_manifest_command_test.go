@@ -211,6 +211,39 @@ var _ = Describe("apply-manifest Command", func() {
 					})
 				})
 
+				When("the manifest gives route weights", func() {
+					BeforeEach(func() {
+						fakeParser.ParseManifestReturns(manifestparser.Manifest{
+							Applications: []manifestparser.Application{{
+								Name: "app-v2",
+								RemainingManifestFields: map[string]interface{}{
+									"routes": []interface{}{
+										map[interface{}]interface{}{"route": "canary.example.com", "weight": 10},
+									},
+								},
+							}},
+						}, nil)
+						fakeActor.SetRouteDestinationWeightsByAppNameReturns(v7action.Warnings{"weights-warning"}, nil)
+					})
+
+					It("applies the manifest without the weights and then sets them", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Out).To(Say("Setting weights for route canary.example.com..."))
+						Expect(testUI.Err).To(Say("weights-warning"))
+
+						manifest := fakeParser.MarshalManifestArgsForCall(0)
+						Expect(manifest.Applications[0].RemainingManifestFields["routes"]).To(Equal([]interface{}{
+							map[interface{}]interface{}{"route": "canary.example.com"},
+						}))
+
+						Expect(fakeActor.SetRouteDestinationWeightsByAppNameCallCount()).To(Equal(1))
+						route, spaceGUID, weights := fakeActor.SetRouteDestinationWeightsByAppNameArgsForCall(0)
+						Expect(route).To(Equal("canary.example.com"))
+						Expect(spaceGUID).To(Equal("some-space-guid"))
+						Expect(weights).To(Equal(map[string]int{"app-v2": 10}))
+					})
+				})
+
 				When("the manifest is unparseable", func() {
 					BeforeEach(func() {
 						fakeParser.ParseManifestReturns(manifestparser.Manifest{}, &yaml.TypeError{
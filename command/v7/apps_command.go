@@ -40,6 +40,14 @@ func (cmd AppsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		output := []appOutput{}
+		for _, summary := range summaries {
+			output = append(output, newAppOutput(summary))
+		}
+		return cmd.UI.DisplayStructuredOutput(output)
+	}
+
 	if len(summaries) == 0 {
 		cmd.UI.DisplayText("No apps found")
 		return nil
@@ -68,6 +76,10 @@ func (cmd AppsCommand) Execute(args []string) error {
 	return nil
 }
 
+func (AppsCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func getURLs(routes []resources.Route) string {
 	var routeURLs []string
 	for _, route := range routes {
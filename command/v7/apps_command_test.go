@@ -11,6 +11,7 @@ import (
 	v7 "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
@@ -306,4 +307,89 @@ var _ = Describe("apps Command", func() {
 		})
 	})
 
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetAppSummariesForSpaceReturns(
+				[]v7action.ApplicationSummary{
+					{
+						Application: resources.Application{
+							GUID:                "app-guid-1",
+							Name:                "some-app-1",
+							State:               constant.ApplicationStarted,
+							StackName:           "cflinuxfs3",
+							LifecycleType:       constant.AppLifecycleTypeBuildpack,
+							LifecycleBuildpacks: []string{"ruby_buildpack"},
+						},
+						ProcessSummaries: []v7action.ProcessSummary{
+							{
+								Process: resources.Process{
+									Type:       constant.ProcessTypeWeb,
+									Instances:  types.NullInt{Value: 2, IsSet: true},
+									MemoryInMB: types.NullUint64{Value: 32, IsSet: true},
+								},
+								InstanceDetails: []v7action.ProcessInstance{
+									{Index: 0, State: constant.ProcessInstanceRunning},
+									{Index: 1, State: constant.ProcessInstanceDown},
+								},
+							},
+						},
+						Routes: []resources.Route{
+							{URL: "some-app-1.some-domain"},
+						},
+					},
+				},
+				v7action.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		It("renders the app summaries as JSON on stdout", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(string(out.Contents())).To(MatchJSON(`[
+				{
+					"name": "some-app-1",
+					"guid": "app-guid-1",
+					"state": "STARTED",
+					"stack": "cflinuxfs3",
+					"buildpacks": ["ruby_buildpack"],
+					"lifecycle": "buildpack",
+					"processes": [
+						{
+							"type": "web",
+							"instances": 2,
+							"running_instances": 1,
+							"memory_in_mb": 32,
+							"disk_in_mb": 0,
+							"health_check_type": ""
+						}
+					],
+					"routes": ["some-app-1.some-domain"]
+				}
+			]`))
+		})
+
+		It("displays the flavor text and warnings on stderr", func() {
+			Expect(testUI.Err).To(Say(`Getting apps in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+
+		When("there are no apps", func() {
+			BeforeEach(func() {
+				fakeActor.GetAppSummariesForSpaceReturns(nil, nil, nil)
+			})
+
+			It("renders an empty list", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(string(out.Contents())).To(MatchJSON(`[]`))
+			})
+		})
+	})
 })
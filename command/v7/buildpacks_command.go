@@ -37,6 +37,14 @@ func (cmd BuildpacksCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		output := []buildpackOutput{}
+		for _, buildpack := range buildpacks {
+			output = append(output, newBuildpackOutput(buildpack))
+		}
+		return cmd.UI.DisplayStructuredOutput(output)
+	}
+
 	if len(buildpacks) == 0 {
 		cmd.UI.DisplayTextWithFlavor("No buildpacks found")
 	} else {
@@ -45,6 +53,10 @@ func (cmd BuildpacksCommand) Execute(args []string) error {
 	return nil
 }
 
+func (BuildpacksCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd BuildpacksCommand) displayTable(buildpacks []resources.Buildpack) {
 	if len(buildpacks) > 0 {
 		var keyValueTable = [][]string{
@@ -152,4 +152,45 @@ var _ = Describe("buildpacks Command", func() {
 			})
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "apple"}, nil)
+			fakeActor.GetBuildpacksReturns([]resources.Buildpack{
+				{
+					Name:     "buildpack-1",
+					GUID:     "buildpack-guid-1",
+					Position: types.NullInt{Value: 1, IsSet: true},
+					Enabled:  types.NullBool{Value: true, IsSet: true},
+					Locked:   types.NullBool{Value: false, IsSet: true},
+					Stack:    "cflinuxfs3",
+					Filename: "buildpack-1.zip",
+				},
+			}, v7action.Warnings{"some-warning"}, nil)
+		})
+
+		It("renders the buildpacks as JSON", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(string(out.Contents())).To(MatchJSON(`[
+				{
+					"name": "buildpack-1",
+					"guid": "buildpack-guid-1",
+					"position": 1,
+					"stack": "cflinuxfs3",
+					"enabled": true,
+					"locked": false,
+					"filename": "buildpack-1.zip"
+				}
+			]`))
+			Expect(testUI.Err).To(Say(`Getting buildpacks as apple\.\.\.`))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
 })
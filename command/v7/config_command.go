@@ -7,13 +7,14 @@ import (
 )
 
 type ConfigCommand struct {
-	UI           command.UI
-	Config       command.Config
-	AsyncTimeout flag.Timeout      `long:"async-timeout" description:"Timeout in minutes for async HTTP requests"`
-	Color        flag.Color        `long:"color" description:"Enable or disable color in CLI output"`
-	Locale       flag.Locale       `long:"locale" description:"Set default locale. If LOCALE is 'CLEAR', previous locale is deleted."`
-	Trace        flag.PathWithBool `long:"trace" description:"Trace HTTP requests by default. If a file path is provided then output will write to the file provided. If the file does not exist it will be created."`
-	usage        interface{}       `usage:"CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)]"`
+	UI               command.UI
+	Config           command.Config
+	AsyncTimeout     flag.Timeout      `long:"async-timeout" description:"Timeout in minutes for async HTTP requests"`
+	Color            flag.Color        `long:"color" description:"Enable or disable color in CLI output"`
+	CredentialsStore string            `long:"credentials-store" description:"Store access tokens and client secrets with the credential helper cf-credential-NAME found on the PATH. If NAME is 'file', they are stored in the config file."`
+	Locale           flag.Locale       `long:"locale" description:"Set default locale. If LOCALE is 'CLEAR', previous locale is deleted."`
+	Trace            flag.PathWithBool `long:"trace" description:"Trace HTTP requests by default. If a file path is provided then output will write to the file provided. If the file does not exist it will be created."`
+	usage            interface{}       `usage:"CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)] [--credentials-store (NAME | file)]"`
 }
 
 func (cmd *ConfigCommand) Setup(config command.Config, ui command.UI) error {
@@ -24,7 +25,7 @@ func (cmd *ConfigCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd ConfigCommand) Execute(args []string) error {
-	if !cmd.Color.IsSet && cmd.Trace == "" && cmd.Locale.Locale == "" && !cmd.AsyncTimeout.IsSet {
+	if !cmd.Color.IsSet && cmd.Trace == "" && cmd.Locale.Locale == "" && !cmd.AsyncTimeout.IsSet && cmd.CredentialsStore == "" {
 		return translatableerror.IncorrectUsageError{Message: "at least one flag must be provided"}
 	}
 
@@ -38,6 +39,10 @@ func (cmd ConfigCommand) Execute(args []string) error {
 		cmd.Config.SetColorEnabled(cmd.Color.Value)
 	}
 
+	if cmd.CredentialsStore != "" {
+		cmd.Config.SetCredentialsStore(credentialsStoreName(cmd.CredentialsStore))
+	}
+
 	if cmd.Locale.Locale != "" {
 		cmd.Config.SetLocale(cmd.Locale.Locale)
 	}
@@ -49,3 +54,12 @@ func (cmd ConfigCommand) Execute(args []string) error {
 	cmd.UI.DisplayOK()
 	return nil
 }
+
+// credentialsStoreName returns the credential helper name stored in the
+// config for the --credentials-store flag; 'file' is stored as an empty name.
+func credentialsStoreName(name string) string {
+	if name == "file" {
+		return ""
+	}
+	return name
+}
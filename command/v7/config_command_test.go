@@ -94,4 +94,28 @@ var _ = Describe("ConfigCommand", func() {
 			Expect(value).To(Equal("my-trace-file"))
 		})
 	})
+
+	When("using the credentials-store flag", func() {
+		BeforeEach(func() {
+			cmd.CredentialsStore = "osxkeychain"
+		})
+
+		It("successfully updates the config", func() {
+			Expect(executeErr).To(Not(HaveOccurred()))
+			Expect(fakeConfig.SetCredentialsStoreCallCount()).To(Equal(1))
+			Expect(fakeConfig.SetCredentialsStoreArgsForCall(0)).To(Equal("osxkeychain"))
+		})
+
+		When("the credentials store is 'file'", func() {
+			BeforeEach(func() {
+				cmd.CredentialsStore = "file"
+			})
+
+			It("stores credentials in the config file", func() {
+				Expect(executeErr).To(Not(HaveOccurred()))
+				Expect(fakeConfig.SetCredentialsStoreCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetCredentialsStoreArgsForCall(0)).To(BeEmpty())
+			})
+		})
+	})
 })
@@ -0,0 +1,63 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type ContextsCommand struct {
+	UI              command.UI
+	Config          command.Config
+	usage           interface{} `usage:"CF_NAME contexts"`
+	relatedCommands interface{} `related_commands:"create-context, delete-context, switch-context"`
+}
+
+func (cmd *ContextsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+
+	return nil
+}
+
+func (cmd ContextsCommand) Execute(args []string) error {
+	cmd.UI.DisplayText("Getting contexts...")
+	cmd.UI.DisplayNewline()
+
+	names := cmd.Config.ContextNames()
+	if len(names) == 0 {
+		cmd.UI.DisplayText("No contexts found")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("current"),
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("api endpoint"),
+			cmd.UI.TranslateText("org"),
+			cmd.UI.TranslateText("space"),
+		},
+	}
+
+	currentContext := cmd.Config.CurrentContext()
+	for _, name := range names {
+		context, _ := cmd.Config.GetContext(name)
+
+		current := ""
+		if name == currentContext {
+			current = "*"
+		}
+
+		table = append(table, []string{
+			current,
+			name,
+			context.Target,
+			context.TargetedOrganization.Name,
+			context.TargetedSpace.Name,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("contexts Command", func() {
+	var (
+		cmd        ContextsCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = ContextsCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("there are no contexts", func() {
+		It("displays that no contexts were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Getting contexts..."))
+			Expect(testUI.Out).To(Say("No contexts found"))
+		})
+	})
+
+	When("there are contexts", func() {
+		BeforeEach(func() {
+			fakeConfig.ContextNamesReturns([]string{"dev", "prod"})
+			fakeConfig.CurrentContextReturns("prod")
+			fakeConfig.GetContextStub = func(name string) (configv3.TargetContext, bool) {
+				return configv3.TargetContext{
+					Target:               "https://api." + name + ".com",
+					TargetedOrganization: configv3.Organization{Name: name + "-org"},
+					TargetedSpace:        configv3.Space{Name: name + "-space"},
+				}, true
+			}
+		})
+
+		It("displays the contexts and marks the current one", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Getting contexts..."))
+			Expect(testUI.Out).To(Say(`current\s+name\s+api endpoint\s+org\s+space`))
+			Expect(testUI.Out).To(Say(`\s+dev\s+https://api.dev.com\s+dev-org\s+dev-space`))
+			Expect(testUI.Out).To(Say(`\*\s+prod\s+https://api.prod.com\s+prod-org\s+prod-space`))
+		})
+	})
+})
@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/configv3"
 )
 
@@ -15,8 +16,9 @@ type CopySourceCommand struct {
 	BaseCommand
 
 	RequiredArgs        flag.CopySourceArgs     `positional-args:"yes"`
-	usage               interface{}             `usage:"CF_NAME copy-source SOURCE_APP DESTINATION_APP [-s TARGET_SPACE [-o TARGET_ORG]] [--no-restart] [--strategy STRATEGY] [--no-wait]"`
-	Strategy            flag.DeploymentStrategy `long:"strategy" description:"Deployment strategy, either rolling or null"`
+	usage               interface{}             `usage:"CF_NAME copy-source SOURCE_APP DESTINATION_APP [-s TARGET_SPACE [-o TARGET_ORG]] [--no-restart] [--strategy STRATEGY] [--instance-steps STEPS] [--no-wait]"`
+	Strategy            flag.DeploymentStrategy `long:"strategy" description:"Deployment strategy can be canary, rolling or null"`
+	InstanceSteps       flag.InstanceSteps      `long:"instance-steps" description:"An array of percentage steps to deploy when using deployment strategy canary. (e.g. 20,40,60)"`
 	NoWait              bool                    `long:"no-wait" description:"Exit when the first instance of the web process is healthy"`
 	NoRestart           bool                    `long:"no-restart" description:"Do not restage the destination application"`
 	Organization        string                  `short:"o" long:"organization" description:"Org that contains the destination application"`
@@ -48,6 +50,13 @@ func (cmd *CopySourceCommand) ValidateFlags() error {
 		}
 	}
 
+	if len(cmd.InstanceSteps.Weights) > 0 && cmd.Strategy.Name != constant.DeploymentStrategyCanary {
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--instance-steps",
+			Arg2: "--strategy=canary",
+		}
+	}
+
 	return nil
 }
 
@@ -164,9 +173,12 @@ func (cmd CopySourceCommand) Execute(args []string) error {
 			targetSpace,
 			targetOrg,
 			pkg.GUID,
-			cmd.Strategy.Name,
-			cmd.NoWait,
-			constant.ApplicationRestarting,
+			shared.AppStartOpts{
+				Strategy:    cmd.Strategy.Name,
+				NoWait:      cmd.NoWait,
+				AppAction:   constant.ApplicationRestarting,
+				CanarySteps: resources.NewCanarySteps(cmd.InstanceSteps.Weights),
+			},
 		)
 		if err != nil {
 			return mapErr(cmd.Config, targetApp.Name, err)
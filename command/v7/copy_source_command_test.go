@@ -153,6 +153,20 @@ var _ = Describe("copy-source Command", func() {
 		})
 	})
 
+	When("instance steps are provided without the canary strategy", func() {
+		BeforeEach(func() {
+			cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyRolling}
+			cmd.InstanceSteps = flag.InstanceSteps{Weights: []int64{20, 60}}
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{
+				Arg1: "--instance-steps",
+				Arg2: "--strategy=canary",
+			}))
+		})
+	})
+
 	When("the no restart and no wait flags are both provided", func() {
 		BeforeEach(func() {
 			cmd.NoRestart = true
@@ -324,6 +338,22 @@ var _ = Describe("copy-source Command", func() {
 		})
 	})
 
+	When("the strategy flag is set to canary", func() {
+		BeforeEach(func() {
+			cmd.Strategy = flag.DeploymentStrategy{
+				Name: constant.DeploymentStrategyCanary,
+			}
+			cmd.InstanceSteps = flag.InstanceSteps{Weights: []int64{20, 60}}
+		})
+
+		It("stages and starts the app with the canary strategy and instance steps", func() {
+			Expect(fakeAppStager.StageAndStartCallCount()).To(Equal(1))
+			_, _, _, _, opts := fakeAppStager.StageAndStartArgsForCall(0)
+			Expect(opts.Strategy).To(Equal(constant.DeploymentStrategyCanary))
+			Expect(opts.CanarySteps).To(Equal([]resources.CanaryStep{{InstanceWeight: 20}, {InstanceWeight: 60}}))
+		})
+	})
+
 	When("the strategy flag is set to rolling", func() {
 		BeforeEach(func() {
 			cmd.Strategy = flag.DeploymentStrategy{
@@ -333,14 +363,14 @@ var _ = Describe("copy-source Command", func() {
 
 		It("stages and starts the app with the appropriate strategy", func() {
 			Expect(fakeAppStager.StageAndStartCallCount()).To(Equal(1))
-			returnedApp, spaceForApp, orgForApp, pkgGUID, strategy, noWait, appAction := fakeAppStager.StageAndStartArgsForCall(0)
+			returnedApp, spaceForApp, orgForApp, pkgGUID, opts := fakeAppStager.StageAndStartArgsForCall(0)
 			Expect(returnedApp).To(Equal(targetApp))
 			Expect(spaceForApp).To(Equal(configv3.Space{Name: "some-space", GUID: "some-space-guid"}))
 			Expect(orgForApp).To(Equal(configv3.Organization{Name: "some-org"}))
 			Expect(pkgGUID).To(Equal("target-package-guid"))
-			Expect(strategy).To(Equal(constant.DeploymentStrategyRolling))
-			Expect(noWait).To(Equal(false))
-			Expect(appAction).To(Equal(constant.ApplicationRestarting))
+			Expect(opts.Strategy).To(Equal(constant.DeploymentStrategyRolling))
+			Expect(opts.NoWait).To(Equal(false))
+			Expect(opts.AppAction).To(Equal(constant.ApplicationRestarting))
 		})
 	})
 
@@ -351,27 +381,27 @@ var _ = Describe("copy-source Command", func() {
 
 		It("stages and starts the app with the appropriate strategy", func() {
 			Expect(fakeAppStager.StageAndStartCallCount()).To(Equal(1))
-			returnedApp, spaceForApp, orgForApp, pkgGUID, strategy, noWait, appAction := fakeAppStager.StageAndStartArgsForCall(0)
+			returnedApp, spaceForApp, orgForApp, pkgGUID, opts := fakeAppStager.StageAndStartArgsForCall(0)
 			Expect(returnedApp).To(Equal(targetApp))
 			Expect(spaceForApp).To(Equal(configv3.Space{Name: "some-space", GUID: "some-space-guid"}))
 			Expect(orgForApp).To(Equal(configv3.Organization{Name: "some-org"}))
 			Expect(pkgGUID).To(Equal("target-package-guid"))
-			Expect(strategy).To(Equal(constant.DeploymentStrategyDefault))
-			Expect(noWait).To(Equal(true))
-			Expect(appAction).To(Equal(constant.ApplicationRestarting))
+			Expect(opts.Strategy).To(Equal(constant.DeploymentStrategyDefault))
+			Expect(opts.NoWait).To(Equal(true))
+			Expect(opts.AppAction).To(Equal(constant.ApplicationRestarting))
 		})
 	})
 
 	It("stages and starts the target app", func() {
 		Expect(fakeAppStager.StageAndStartCallCount()).To(Equal(1))
-		returnedApp, spaceForApp, orgForApp, pkgGUID, strategy, noWait, appAction := fakeAppStager.StageAndStartArgsForCall(0)
+		returnedApp, spaceForApp, orgForApp, pkgGUID, opts := fakeAppStager.StageAndStartArgsForCall(0)
 		Expect(returnedApp).To(Equal(targetApp))
 		Expect(spaceForApp).To(Equal(configv3.Space{Name: "some-space", GUID: "some-space-guid"}))
 		Expect(orgForApp).To(Equal(configv3.Organization{Name: "some-org"}))
 		Expect(pkgGUID).To(Equal("target-package-guid"))
-		Expect(strategy).To(Equal(constant.DeploymentStrategyDefault))
-		Expect(noWait).To(Equal(false))
-		Expect(appAction).To(Equal(constant.ApplicationRestarting))
+		Expect(opts.Strategy).To(Equal(constant.DeploymentStrategyDefault))
+		Expect(opts.NoWait).To(Equal(false))
+		Expect(opts.AppAction).To(Equal(constant.ApplicationRestarting))
 	})
 
 	When("staging and starting the app fails", func() {
@@ -0,0 +1,42 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type CreateContextCommand struct {
+	UI              command.UI
+	Config          command.Config
+	RequiredArgs    flag.ContextName `positional-args:"yes"`
+	usage           interface{}      `usage:"CF_NAME create-context CONTEXT_NAME\n\nEXAMPLES:\n   CF_NAME api https://api.dev.example.com\n   CF_NAME login\n   CF_NAME create-context dev"`
+	relatedCommands interface{}      `related_commands:"api, contexts, login, switch-context, target"`
+}
+
+func (cmd *CreateContextCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+
+	return nil
+}
+
+func (cmd CreateContextCommand) Execute(args []string) error {
+	contextName := cmd.RequiredArgs.Context
+
+	cmd.UI.DisplayTextWithFlavor("Creating context {{.ContextName}} from the current target...", map[string]interface{}{
+		"ContextName": contextName,
+	})
+
+	if cmd.Config.HasContext(contextName) {
+		cmd.UI.DisplayWarning("Context '{{.ContextName}}' already exists.", map[string]interface{}{
+			"ContextName": contextName,
+		})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	cmd.Config.CreateContext(contextName)
+	cmd.UI.DisplayOK()
+
+	return nil
+}
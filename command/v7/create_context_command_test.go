@@ -0,0 +1,57 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-context Command", func() {
+	var (
+		cmd        CreateContextCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = CreateContextCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+		cmd.RequiredArgs.Context = "dev"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("creates the context from the current target", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(testUI.Out).To(Say("Creating context dev from the current target..."))
+		Expect(testUI.Out).To(Say("OK"))
+
+		Expect(fakeConfig.CreateContextCallCount()).To(Equal(1))
+		Expect(fakeConfig.CreateContextArgsForCall(0)).To(Equal("dev"))
+	})
+
+	When("the context already exists", func() {
+		BeforeEach(func() {
+			fakeConfig.HasContextReturns(true)
+		})
+
+		It("warns and does not overwrite the context", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("Context 'dev' already exists."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeConfig.CreateContextCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,61 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+)
+
+type CreateSidecarCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppSidecar `positional-args:"yes"`
+	Command         string          `long:"command" short:"c" required:"true" description:"Command the sidecar runs"`
+	ProcessTypes    string          `long:"process-types" description:"Comma-separated types of the app's processes that the sidecar runs alongside (Default: web)"`
+	Memory          flag.Megabytes  `short:"m" description:"Memory reserved for the sidecar out of the memory of each process it runs alongside (e.g. 64M, 1G)"`
+	usage           interface{}     `usage:"CF_NAME create-sidecar APP_NAME SIDECAR_NAME -c COMMAND [--process-types TYPES] [-m MEMORY]\n\nEXAMPLES:\n   CF_NAME create-sidecar my-app auth-proxy -c './auth-proxy --port 8081'\n   CF_NAME create-sidecar my-app metrics-agent -c ./agent --process-types web,worker -m 64M"`
+	relatedCommands interface{}     `related_commands:"delete-sidecar, restart, sidecars, update-sidecar"`
+}
+
+func (cmd CreateSidecarCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Creating sidecar {{.SidecarName}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"SidecarName": cmd.RequiredArgs.SidecarName,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"CurrentUser": user.Name,
+	})
+
+	processTypes := splitProcessTypes(cmd.ProcessTypes)
+	if len(processTypes) == 0 {
+		processTypes = []string{"web"}
+	}
+
+	_, warnings, err := cmd.Actor.CreateApplicationSidecar(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, resources.Sidecar{
+		Name:         cmd.RequiredArgs.SidecarName,
+		Command:      types.FilteredString{IsSet: true, Value: cmd.Command},
+		ProcessTypes: processTypes,
+		MemoryInMB:   cmd.Memory.NullUint64,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("TIP: Use 'cf restart {{.AppName}}' to start the sidecar.", map[string]interface{}{
+		"AppName": cmd.RequiredArgs.AppName,
+	})
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-sidecar Command", func() {
+	var (
+		cmd             CreateSidecarCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+		binaryName      string
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = CreateSidecarCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs: flag.AppSidecar{AppName: "some-app", SidecarName: "auth-proxy"},
+			Command:      "./proxy",
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeActor.CreateApplicationSidecarCallCount()).To(Equal(0))
+		})
+	})
+
+	When("only the command is provided", func() {
+		BeforeEach(func() {
+			fakeActor.CreateApplicationSidecarReturns(resources.Sidecar{}, v7action.Warnings{"some-warning"}, nil)
+		})
+
+		It("creates the sidecar for the web process", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeActor.CreateApplicationSidecarCallCount()).To(Equal(1))
+			appName, spaceGUID, sidecar := fakeActor.CreateApplicationSidecarArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(sidecar).To(Equal(resources.Sidecar{
+				Name:         "auth-proxy",
+				Command:      types.FilteredString{IsSet: true, Value: "./proxy"},
+				ProcessTypes: []string{"web"},
+			}))
+
+			Expect(testUI.Out).To(Say(`Creating sidecar auth-proxy for app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`TIP: Use 'cf restart some-app' to start the sidecar\.`))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+
+	When("process types and memory are provided", func() {
+		BeforeEach(func() {
+			cmd.ProcessTypes = "web, worker"
+			cmd.Memory = flag.Megabytes{NullUint64: types.NullUint64{IsSet: true, Value: 64}}
+		})
+
+		It("passes them to the actor", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			_, _, sidecar := fakeActor.CreateApplicationSidecarArgsForCall(0)
+			Expect(sidecar.ProcessTypes).To(Equal([]string{"web", "worker"}))
+			Expect(sidecar.MemoryInMB).To(Equal(types.NullUint64{IsSet: true, Value: 64}))
+		})
+	})
+
+	When("creating the sidecar fails", func() {
+		BeforeEach(func() {
+			fakeActor.CreateApplicationSidecarReturns(resources.Sidecar{}, v7action.Warnings{"some-warning"}, errors.New("some-error"))
+		})
+
+		It("displays the warnings and returns the error", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("some-warning"))
+			Expect(testUI.Out).NotTo(Say("OK"))
+		})
+	})
+})
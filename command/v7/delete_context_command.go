@@ -0,0 +1,59 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+type DeleteContextCommand struct {
+	UI              command.UI
+	Config          command.Config
+	RequiredArgs    flag.ContextName `positional-args:"yes"`
+	Force           bool             `long:"force" short:"f" description:"Force deletion without confirmation"`
+	usage           interface{}      `usage:"CF_NAME delete-context CONTEXT_NAME [-f]"`
+	relatedCommands interface{}      `related_commands:"contexts"`
+}
+
+func (cmd *DeleteContextCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+
+	return nil
+}
+
+func (cmd DeleteContextCommand) Execute(args []string) error {
+	contextName := cmd.RequiredArgs.Context
+
+	if !cmd.Force {
+		response, uiErr := cmd.UI.DisplayBoolPrompt(false, "Really delete the context {{.ContextName}}?", map[string]interface{}{
+			"ContextName": contextName,
+		})
+		if uiErr != nil {
+			return uiErr
+		}
+
+		if !response {
+			cmd.UI.DisplayText("Delete cancelled")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deleting context {{.ContextName}}...", map[string]interface{}{
+		"ContextName": contextName,
+	})
+
+	err := cmd.Config.DeleteContext(contextName)
+	if err != nil {
+		if _, ok := err.(translatableerror.ContextNotFoundError); !ok {
+			return err
+		}
+		cmd.UI.DisplayWarning("Context '{{.ContextName}}' does not exist.", map[string]interface{}{
+			"ContextName": contextName,
+		})
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
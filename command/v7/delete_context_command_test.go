@@ -0,0 +1,92 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("delete-context Command", func() {
+	var (
+		cmd        DeleteContextCommand
+		testUI     *ui.UI
+		input      *Buffer
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = DeleteContextCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+		cmd.RequiredArgs.Context = "dev"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the -f flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		It("deletes the context without prompting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("Really delete"))
+			Expect(testUI.Out).To(Say("Deleting context dev..."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeConfig.DeleteContextCallCount()).To(Equal(1))
+			Expect(fakeConfig.DeleteContextArgsForCall(0)).To(Equal("dev"))
+		})
+
+		When("the context does not exist", func() {
+			BeforeEach(func() {
+				fakeConfig.DeleteContextReturns(translatableerror.ContextNotFoundError{Name: "dev"})
+			})
+
+			It("warns and displays OK", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("Context 'dev' does not exist."))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+	})
+
+	When("the user confirms the deletion", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("y\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("deletes the context", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Really delete the context dev\?`))
+			Expect(testUI.Out).To(Say("Deleting context dev..."))
+			Expect(fakeConfig.DeleteContextCallCount()).To(Equal(1))
+		})
+	})
+
+	When("the user cancels the deletion", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not delete the context", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Delete cancelled"))
+			Expect(fakeConfig.DeleteContextCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,325 @@
+package v7
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+)
+
+type DiffCommand struct {
+	BaseCommand
+
+	RequiredArgs     flag.AppName                        `positional-args:"yes"`
+	OtherApp         string                              `long:"app" description:"Compare with another app"`
+	PathToManifest   flag.ManifestPathWithExistenceCheck `long:"manifest" short:"f" description:"Compare with the app in this manifest (Default: manifest.yml in the current directory)"`
+	Organization     string                              `short:"o" long:"organization" description:"Org that contains the app given with --app"`
+	Revision         flag.Revision                       `long:"revision" description:"Compare with the given revision of the app"`
+	Space            string                              `short:"s" long:"space" description:"Space that contains the app given with --app"`
+	Vars             []template.VarKV                    `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
+	PathsToVarsFiles []flag.PathWithExistenceCheck       `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
+	usage            interface{}                         `usage:"CF_NAME diff APP_NAME [-f MANIFEST_PATH] [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]...\n   CF_NAME diff APP_NAME --revision REVISION\n   CF_NAME diff APP_NAME --app OTHER_APP [-s OTHER_SPACE [-o OTHER_ORG]]\n\n   Compares the environment variables, processes, routes, services, buildpacks,\n   stack and droplet of the app. Settings that a manifest does not mention are\n   not compared, and revisions only record the droplet, environment variables\n   and process commands.\n\n   Exits with status 0 when nothing differs, 1 when the command fails and 2 when\n   differences are found.\n\nEXAMPLES:\n   CF_NAME diff my-app -f ./manifest.yml\n   CF_NAME diff my-app --revision 3\n   CF_NAME diff my-app --app my-app -s production"`
+	relatedCommands  interface{}                         `related_commands:"create-app-manifest, push, revisions, rollback"`
+
+	ManifestLocator ManifestLocator
+	ManifestParser  ManifestParser
+	CWD             string
+}
+
+func (cmd *DiffCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	cmd.ManifestLocator = manifestparser.NewLocator()
+	cmd.ManifestParser = manifestparser.ManifestParser{}
+	cmd.CWD, err = os.Getwd()
+	return err
+}
+
+func (DiffCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
+func (cmd DiffCommand) Execute(args []string) error {
+	err := cmd.validateFlags()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	appName := cmd.RequiredArgs.AppName
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+
+	var (
+		other       v7action.ApplicationState
+		source      diffSourceOutput
+		description string
+	)
+	switch {
+	case cmd.Revision.IsSet:
+		source = diffSourceOutput{Type: "revision", Name: fmt.Sprint(cmd.Revision.Value)}
+		description = fmt.Sprintf("revision %d", cmd.Revision.Value)
+	case cmd.OtherApp != "":
+		org, space, err := cmd.otherAppTarget()
+		if err != nil {
+			return err
+		}
+		source = diffSourceOutput{Type: "app", Name: cmd.OtherApp, Org: org.Name, Space: space.Name}
+		description = fmt.Sprintf("app %s in org %s / space %s", cmd.OtherApp, org.Name, space.Name)
+
+		var warnings v7action.Warnings
+		other, warnings, err = cmd.Actor.GetApplicationStateByNameAndSpace(cmd.OtherApp, space.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	default:
+		var pathToManifest string
+		other, pathToManifest, err = cmd.manifestState(appName)
+		if err != nil {
+			return err
+		}
+		source = diffSourceOutput{Type: "manifest", Name: pathToManifest}
+		description = fmt.Sprintf("manifest %s", pathToManifest)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Comparing app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} with {{.Source}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   appName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Source":    description,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	current, warnings, err := cmd.Actor.GetApplicationStateByNameAndSpace(appName, spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Revision.IsSet {
+		other, warnings, err = cmd.Actor.GetApplicationStateByRevision(appName, spaceGUID, cmd.Revision.Value)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	diffs := v7action.DiffApplicationStates(current, other)
+
+	if cmd.UI.IsStructuredOutput() {
+		err = cmd.UI.DisplayStructuredOutput(newDiffOutput(appName, source, diffs))
+		if err != nil {
+			return err
+		}
+	} else {
+		cmd.displayDiffs(diffs)
+	}
+
+	if len(diffs) > 0 {
+		return translatableerror.DiffFoundError{}
+	}
+	return nil
+}
+
+func (cmd DiffCommand) validateFlags() error {
+	var sources []string
+	if cmd.PathToManifest != "" {
+		sources = append(sources, "--manifest, -f")
+	}
+	if cmd.Revision.IsSet {
+		sources = append(sources, "--revision")
+	}
+	if cmd.OtherApp != "" {
+		sources = append(sources, "--app")
+	}
+	if len(sources) > 1 {
+		return translatableerror.ArgumentCombinationError{Args: sources}
+	}
+
+	if (len(cmd.Vars) > 0 || len(cmd.PathsToVarsFiles) > 0) && (cmd.Revision.IsSet || cmd.OtherApp != "") {
+		return translatableerror.ArgumentCombinationError{Args: append(sources, "--var", "--vars-file")}
+	}
+
+	if cmd.Space != "" && cmd.OtherApp == "" {
+		return translatableerror.RequiredFlagsError{Arg1: "--space, -s", Arg2: "--app"}
+	}
+
+	if cmd.Organization != "" && cmd.Space == "" {
+		return translatableerror.RequiredFlagsError{Arg1: "--organization, -o", Arg2: "--space, -s"}
+	}
+
+	return nil
+}
+
+func (cmd DiffCommand) otherAppTarget() (configv3.Organization, configv3.Space, error) {
+	org := cmd.Config.TargetedOrganization()
+	space := cmd.Config.TargetedSpace()
+
+	if cmd.Organization != "" {
+		foundOrg, warnings, err := cmd.Actor.GetOrganizationByName(cmd.Organization)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return org, space, err
+		}
+		org = configv3.Organization{GUID: foundOrg.GUID, Name: foundOrg.Name}
+	}
+
+	if cmd.Space != "" {
+		foundSpace, warnings, err := cmd.Actor.GetSpaceByNameAndOrganization(cmd.Space, org.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return org, space, err
+		}
+		space = configv3.Space{GUID: foundSpace.GUID, Name: foundSpace.Name}
+	}
+
+	return org, space, nil
+}
+
+func (cmd DiffCommand) manifestState(appName string) (v7action.ApplicationState, string, error) {
+	location := cmd.CWD
+	if cmd.PathToManifest != "" {
+		location = string(cmd.PathToManifest)
+	}
+
+	pathToManifest, exists, err := cmd.ManifestLocator.Path(location)
+	if err != nil {
+		return v7action.ApplicationState{}, "", err
+	}
+	if !exists {
+		return v7action.ApplicationState{}, "", translatableerror.ManifestFileNotFoundInDirectoryError{PathToManifest: location}
+	}
+
+	var pathsToVarsFiles []string
+	for _, path := range cmd.PathsToVarsFiles {
+		pathsToVarsFiles = append(pathsToVarsFiles, string(path))
+	}
+
+	rawManifest, err := cmd.ManifestParser.InterpolateManifest(pathToManifest, pathsToVarsFiles, cmd.Vars)
+	if err != nil {
+		return v7action.ApplicationState{}, "", err
+	}
+
+	manifest, err := cmd.ManifestParser.ParseManifest(pathToManifest, rawManifest)
+	if err != nil {
+		return v7action.ApplicationState{}, "", err
+	}
+
+	for _, app := range manifest.Applications {
+		if app.Name == appName {
+			return v7action.ApplicationStateFromManifest(app), pathToManifest, nil
+		}
+	}
+
+	return v7action.ApplicationState{}, "", translatableerror.AppNotFoundInManifestError{Name: appName}
+}
+
+func (cmd DiffCommand) displayDiffs(diffs []resources.Diff) {
+	if len(diffs) == 0 {
+		cmd.UI.DisplayText("No differences found.")
+		return
+	}
+
+	var section, processType string
+	for _, diff := range diffs {
+		parts := strings.Split(strings.TrimPrefix(diff.Path, "/"), "/")
+		if parts[0] != section {
+			section, processType = parts[0], ""
+			if section != "stack" && section != "droplet" {
+				cmd.UI.DisplayDiffUnchanged(section+":", 0, false)
+			}
+		}
+
+		switch section {
+		case "stack", "droplet":
+			cmd.displayDiffValues(diff, section+": ", 0, false)
+		case "env":
+			cmd.displayDiffValues(diff, parts[1]+": ", 1, false)
+		case "processes":
+			if len(parts) == 2 {
+				processType = ""
+				cmd.displayDiffProcess(diff, parts[1])
+				continue
+			}
+			if parts[1] != processType {
+				processType = parts[1]
+				cmd.UI.DisplayDiffUnchanged(processType+":", 1, false)
+			}
+			cmd.displayDiffValues(diff, parts[2]+": ", 2, false)
+		default:
+			cmd.displayDiffValues(diff, "", 1, true)
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	if len(diffs) == 1 {
+		cmd.UI.DisplayText("Found 1 difference.")
+		return
+	}
+	cmd.UI.DisplayText("Found {{.Count}} differences.", map[string]interface{}{
+		"Count": len(diffs),
+	})
+}
+
+func (cmd DiffCommand) displayDiffValues(diff resources.Diff, prefix string, depth int, addHyphen bool) {
+	for _, was := range diffValues(diff.Was) {
+		cmd.UI.DisplayDiffRemoval(prefix+was, depth, addHyphen)
+	}
+	for _, value := range diffValues(diff.Value) {
+		cmd.UI.DisplayDiffAddition(prefix+value, depth, addHyphen)
+	}
+}
+
+func (cmd DiffCommand) displayDiffProcess(diff resources.Diff, processType string) {
+	display := cmd.UI.DisplayDiffAddition
+	fields, _ := diff.Value.(map[string]string)
+	if diff.Op == resources.RemoveOperation {
+		display = cmd.UI.DisplayDiffRemoval
+		fields, _ = diff.Was.(map[string]string)
+	}
+
+	display(processType+":", 1, false)
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		display(name+": "+fields[name], 2, false)
+	}
+}
+
+// diffValues returns the lines displayed for one side of a diff; lists, such
+// as buildpacks, are displayed one item per line.
+func diffValues(value interface{}) []string {
+	switch typedValue := value.(type) {
+	case nil:
+		return nil
+	case []string:
+		return typedValue
+	default:
+		return []string{fmt.Sprint(typedValue)}
+	}
+}
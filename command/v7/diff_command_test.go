@@ -0,0 +1,362 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"code.cloudfoundry.org/cli/util/ui"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("diff Command", func() {
+	var (
+		cmd             DiffCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		fakeLocator     *v7fakes.FakeManifestLocator
+		fakeParser      *v7fakes.FakeManifestParser
+		executeErr      error
+
+		liveState v7action.ApplicationState
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeLocator = new(v7fakes.FakeManifestLocator)
+		fakeParser = new(v7fakes.FakeManifestParser)
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		cmd = DiffCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs:    flag.AppName{AppName: "some-app"},
+			ManifestLocator: fakeLocator,
+			ManifestParser:  fakeParser,
+			CWD:             "/some/dir",
+		}
+
+		liveState = v7action.ApplicationState{
+			Buildpacks: []string{"ruby_buildpack"},
+			Stack:      "cflinuxfs3",
+			Droplet:    "droplet-1",
+			Env:        map[string]string{"SOME_VAR": "old-value"},
+			Processes: map[string]map[string]string{
+				"web": {"command": "", "instances": "2", "memory": "1024M"},
+			},
+			Routes:   []string{"some-app.example.com"},
+			Services: []string{},
+		}
+		fakeActor.GetApplicationStateByNameAndSpaceReturns(liveState, v7action.Warnings{"get-state-warning"}, nil)
+
+		fakeLocator.PathReturns("/some/dir/manifest.yml", true, nil)
+		fakeParser.InterpolateManifestReturns([]byte("some-manifest"), nil)
+		fakeParser.ParseManifestReturns(manifestparser.Manifest{
+			Applications: []manifestparser.Application{
+				{Name: "other-app"},
+				{Name: "some-app", Memory: "1G"},
+			},
+		}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the target", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkTargetedOrg).To(BeTrue())
+		Expect(checkTargetedSpace).To(BeTrue())
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"}))
+			Expect(fakeActor.GetApplicationStateByNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("flag validation", func() {
+		When("more than one source is given", func() {
+			BeforeEach(func() {
+				cmd.PathToManifest = "some-manifest.yml"
+				cmd.OtherApp = "other-app"
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--manifest, -f", "--app"},
+				}))
+			})
+		})
+
+		When("vars are given without a manifest", func() {
+			BeforeEach(func() {
+				cmd.Revision = flag.Revision{NullInt: types.NullInt{Value: 2, IsSet: true}}
+				cmd.Vars = []template.VarKV{{Name: "key", Value: "value"}}
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--revision", "--var", "--vars-file"},
+				}))
+			})
+		})
+
+		When("a space is given without --app", func() {
+			BeforeEach(func() {
+				cmd.Space = "other-space"
+			})
+
+			It("returns a RequiredFlagsError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--space, -s", Arg2: "--app"}))
+			})
+		})
+
+		When("an org is given without a space", func() {
+			BeforeEach(func() {
+				cmd.OtherApp = "other-app"
+				cmd.Organization = "other-org"
+			})
+
+			It("returns a RequiredFlagsError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--organization, -o", Arg2: "--space, -s"}))
+			})
+		})
+	})
+
+	When("comparing with a manifest", func() {
+		It("compares the app with the app of the same name in the manifest in the current directory", func() {
+			Expect(fakeLocator.PathArgsForCall(0)).To(Equal("/some/dir"))
+			path, _, _ := fakeParser.InterpolateManifestArgsForCall(0)
+			Expect(path).To(Equal("/some/dir/manifest.yml"))
+
+			appName, spaceGUID := fakeActor.GetApplicationStateByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(testUI.Out).To(Say(`Comparing app some-app in org some-org / space some-space with manifest /some/dir/manifest.yml as steve\.\.\.`))
+		})
+
+		When("the manifest specifies the same settings as the app", func() {
+			It("reports no differences and succeeds", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("No differences found."))
+				Expect(testUI.Err).To(Say("get-state-warning"))
+			})
+		})
+
+		When("the manifest differs from the app", func() {
+			BeforeEach(func() {
+				fakeParser.ParseManifestReturns(manifestparser.Manifest{
+					Applications: []manifestparser.Application{{
+						Name:   "some-app",
+						Memory: "512M",
+						RemainingManifestFields: map[string]interface{}{
+							"env":    map[interface{}]interface{}{"SOME_VAR": "new-value"},
+							"routes": []interface{}{map[interface{}]interface{}{"route": "new.example.com"}},
+						},
+					}},
+				}, nil)
+			})
+
+			It("displays the differences and returns a DiffFoundError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.DiffFoundError{}))
+
+				Expect(testUI.Out).To(Say(`  env:`))
+				Expect(testUI.Out).To(Say(`-   SOME_VAR: old-value`))
+				Expect(testUI.Out).To(Say(`\+   SOME_VAR: new-value`))
+				Expect(testUI.Out).To(Say(`  processes:`))
+				Expect(testUI.Out).To(Say(`    web:`))
+				Expect(testUI.Out).To(Say(`-     memory: 1024M`))
+				Expect(testUI.Out).To(Say(`\+     memory: 512M`))
+				Expect(testUI.Out).To(Say(`  routes:`))
+				Expect(testUI.Out).To(Say(`\+ - new.example.com`))
+				Expect(testUI.Out).To(Say(`Found 3 differences\.`))
+			})
+		})
+
+		When("a manifest path is given", func() {
+			BeforeEach(func() {
+				cmd.PathToManifest = "/other/dir"
+				cmd.PathsToVarsFiles = []flag.PathWithExistenceCheck{"vars.yml"}
+				cmd.Vars = []template.VarKV{{Name: "key", Value: "value"}}
+			})
+
+			It("interpolates that manifest", func() {
+				Expect(fakeLocator.PathArgsForCall(0)).To(Equal("/other/dir"))
+				_, varsFiles, vars := fakeParser.InterpolateManifestArgsForCall(0)
+				Expect(varsFiles).To(Equal([]string{"vars.yml"}))
+				Expect(vars).To(Equal([]template.VarKV{{Name: "key", Value: "value"}}))
+			})
+		})
+
+		When("there is no manifest", func() {
+			BeforeEach(func() {
+				fakeLocator.PathReturns("", false, nil)
+			})
+
+			It("returns a ManifestFileNotFoundInDirectoryError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ManifestFileNotFoundInDirectoryError{PathToManifest: "/some/dir"}))
+				Expect(fakeActor.GetApplicationStateByNameAndSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the app is not in the manifest", func() {
+			BeforeEach(func() {
+				fakeParser.ParseManifestReturns(manifestparser.Manifest{
+					Applications: []manifestparser.Application{{Name: "other-app"}},
+				}, nil)
+			})
+
+			It("returns an AppNotFoundInManifestError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.AppNotFoundInManifestError{Name: "some-app"}))
+			})
+		})
+	})
+
+	When("comparing with a revision", func() {
+		BeforeEach(func() {
+			cmd.Revision = flag.Revision{NullInt: types.NullInt{Value: 2, IsSet: true}}
+			revisionState := liveState
+			revisionState.Droplet = "droplet-0"
+			fakeActor.GetApplicationStateByRevisionReturns(revisionState, v7action.Warnings{"get-revision-warning"}, nil)
+		})
+
+		It("displays the differences from the revision", func() {
+			Expect(executeErr).To(MatchError(translatableerror.DiffFoundError{}))
+			Expect(fakeParser.InterpolateManifestCallCount()).To(Equal(0))
+
+			appName, spaceGUID, version := fakeActor.GetApplicationStateByRevisionArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(version).To(Equal(2))
+
+			Expect(testUI.Out).To(Say(`Comparing app some-app in org some-org / space some-space with revision 2 as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`- droplet: droplet-1`))
+			Expect(testUI.Out).To(Say(`\+ droplet: droplet-0`))
+			Expect(testUI.Out).To(Say(`Found 1 difference\.`))
+			Expect(testUI.Err).To(Say("get-revision-warning"))
+		})
+
+		When("getting the revision fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationStateByRevisionReturns(v7action.ApplicationState{}, nil, actionerror.RevisionNotFoundError{Version: 2})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.RevisionNotFoundError{Version: 2}))
+			})
+		})
+	})
+
+	When("comparing with another app", func() {
+		BeforeEach(func() {
+			cmd.OtherApp = "other-app"
+			cmd.Space = "other-space"
+			cmd.Organization = "other-org"
+
+			fakeActor.GetOrganizationByNameReturns(resources.Organization{Name: "other-org", GUID: "other-org-guid"}, nil, nil)
+			fakeActor.GetSpaceByNameAndOrganizationReturns(resources.Space{Name: "other-space", GUID: "other-space-guid"}, nil, nil)
+
+			otherState := liveState
+			otherState.Processes = map[string]map[string]string{
+				"web":    {"command": "", "instances": "2", "memory": "1024M"},
+				"worker": {"command": "work"},
+			}
+			fakeActor.GetApplicationStateByNameAndSpaceStub = func(appName string, _ string) (v7action.ApplicationState, v7action.Warnings, error) {
+				if appName == "other-app" {
+					return otherState, nil, nil
+				}
+				return liveState, nil, nil
+			}
+		})
+
+		It("looks up the other app in the given org and space", func() {
+			Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("other-org"))
+			spaceName, orgGUID := fakeActor.GetSpaceByNameAndOrganizationArgsForCall(0)
+			Expect(spaceName).To(Equal("other-space"))
+			Expect(orgGUID).To(Equal("other-org-guid"))
+
+			appName, spaceGUID := fakeActor.GetApplicationStateByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("other-app"))
+			Expect(spaceGUID).To(Equal("other-space-guid"))
+		})
+
+		It("displays the differences from the other app", func() {
+			Expect(executeErr).To(MatchError(translatableerror.DiffFoundError{}))
+			Expect(testUI.Out).To(Say(`Comparing app some-app in org some-org / space some-space with app other-app in org other-org / space other-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`  processes:`))
+			Expect(testUI.Out).To(Say(`\+   worker:`))
+			Expect(testUI.Out).To(Say(`\+     command: work`))
+		})
+
+		When("the space cannot be found", func() {
+			BeforeEach(func() {
+				fakeActor.GetSpaceByNameAndOrganizationReturns(resources.Space{}, v7action.Warnings{"space-warning"}, errors.New("space-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("space-error"))
+				Expect(testUI.Err).To(Say("space-warning"))
+				Expect(fakeActor.GetApplicationStateByNameAndSpaceCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+			cmd.Revision = flag.Revision{NullInt: types.NullInt{Value: 2, IsSet: true}}
+
+			revisionState := liveState
+			revisionState.Droplet = "droplet-0"
+			fakeActor.GetApplicationStateByRevisionReturns(revisionState, nil, nil)
+		})
+
+		It("renders the differences as JSON", func() {
+			Expect(executeErr).To(MatchError(translatableerror.DiffFoundError{}))
+			Expect(string(out.Contents())).To(MatchJSON(`{
+				"app": "some-app",
+				"compared_to": {"type": "revision", "name": "2"},
+				"differences": [
+					{"op": "replace", "path": "/droplet", "was": "droplet-1", "value": "droplet-0"}
+				]
+			}`))
+		})
+	})
+})
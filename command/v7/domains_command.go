@@ -42,6 +42,14 @@ func (cmd DomainsCommand) Execute(args []string) error {
 
 	sort.Slice(domains, func(i, j int) bool { return sorting.LessIgnoreCase(domains[i].Name, domains[j].Name) })
 
+	if cmd.UI.IsStructuredOutput() {
+		output := []domainOutput{}
+		for _, domain := range domains {
+			output = append(output, newDomainOutput(domain))
+		}
+		return cmd.UI.DisplayStructuredOutput(output)
+	}
+
 	if len(domains) > 0 {
 		cmd.displayDomainsTable(domains)
 	} else {
@@ -50,6 +58,10 @@ func (cmd DomainsCommand) Execute(args []string) error {
 	return nil
 }
 
+func (DomainsCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd DomainsCommand) displayDomainsTable(domains []resources.Domain) {
 	var domainsTable = [][]string{
 		{
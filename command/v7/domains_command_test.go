@@ -208,4 +208,35 @@ var _ = Describe("domains Command", func() {
 			})
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "banana"}, nil)
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+			fakeActor.GetOrganizationDomainsReturns(
+				[]resources.Domain{
+					{Name: "b-private.com", GUID: "b-private-guid", OrganizationGUID: "some-org-guid", Protocols: []string{"http"}},
+					{Name: "a-shared.com", GUID: "a-shared-guid", Internal: types.NullBool{IsSet: true, Value: true}, Protocols: []string{"http"}},
+				},
+				v7action.Warnings{"actor-warning"},
+				nil,
+			)
+		})
+
+		It("renders the domains as JSON in alphabetical order", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(string(out.Contents())).To(MatchJSON(`[
+				{"name": "a-shared.com", "guid": "a-shared-guid", "shared": true, "internal": true, "protocols": ["http"]},
+				{"name": "b-private.com", "guid": "b-private-guid", "shared": false, "internal": false, "protocols": ["http"]}
+			]`))
+			Expect(testUI.Err).To(Say("actor-warning"))
+		})
+	})
 })
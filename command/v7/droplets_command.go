@@ -43,6 +43,19 @@ func (cmd DropletsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		output := []dropletOutput{}
+		for _, droplet := range droplets {
+			output = append(output, dropletOutput{
+				GUID:      droplet.GUID,
+				State:     string(droplet.State),
+				Current:   droplet.IsCurrent,
+				CreatedAt: droplet.CreatedAt,
+			})
+		}
+		return cmd.UI.DisplayStructuredOutput(output)
+	}
+
 	if len(droplets) == 0 {
 		cmd.UI.DisplayText("No droplets found")
 		return nil
@@ -78,3 +91,7 @@ func (cmd DropletsCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (DropletsCommand) SupportsStructuredOutput() bool {
+	return true
+}
@@ -175,4 +175,34 @@ var _ = Describe("droplets Command", func() {
 			Expect(testUI.Err).To(Say("warning-2"))
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetApplicationDropletsReturns(
+				[]resources.Droplet{
+					{GUID: "some-droplet-guid-1", State: constant.DropletStaged, CreatedAt: "2017-08-14T21:16:42Z", IsCurrent: true},
+					{GUID: "some-droplet-guid-2", State: constant.DropletFailed, CreatedAt: "2017-08-16T00:18:24Z"},
+				},
+				v7action.Warnings{"warning-1"},
+				nil)
+		})
+
+		It("renders the droplets as JSON", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(string(out.Contents())).To(MatchJSON(`[
+				{"guid": "some-droplet-guid-1", "state": "STAGED", "current": true, "created_at": "2017-08-14T21:16:42Z"},
+				{"guid": "some-droplet-guid-2", "state": "FAILED", "current": false, "created_at": "2017-08-16T00:18:24Z"}
+			]`))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+
 })
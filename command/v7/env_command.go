@@ -44,6 +44,10 @@ func (cmd EnvCommand) Execute(_ []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructuredOutput(newEnvOutput(envGroups))
+	}
+
 	if len(envGroups.System) > 0 || len(envGroups.Application) > 0 {
 		cmd.UI.DisplayHeader("System-Provided:")
 		err = cmd.displaySystem(envGroups.System)
@@ -89,6 +93,10 @@ func (cmd EnvCommand) Execute(_ []string) error {
 	return nil
 }
 
+func (EnvCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd EnvCommand) displayEnvGroup(group map[string]interface{}) {
 	keys := sortKeys(group)
 
@@ -234,4 +234,36 @@ var _ = Describe("env Command", func() {
 			})
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetEnvironmentVariablesByApplicationNameAndSpaceReturns(
+				v7action.EnvironmentVariableGroups{
+					System:               map[string]interface{}{"VCAP_SERVICES": map[string]interface{}{}},
+					EnvironmentVariables: map[string]interface{}{"user-key": "user-value"},
+				},
+				v7action.Warnings{"get-env-warning"},
+				nil)
+		})
+
+		It("renders the env groups as JSON on stdout and everything else on stderr", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(string(out.Contents())).To(MatchJSON(`{
+				"system": {"VCAP_SERVICES": {}},
+				"application": {},
+				"user_provided": {"user-key": "user-value"},
+				"running": {},
+				"staging": {}
+			}`))
+			Expect(testUI.Err).To(Say("get-env-warning"))
+		})
+	})
 })
@@ -1,20 +1,35 @@
 package v7
 
 import (
+	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/util/ui"
 )
 
 type EventsCommand struct {
 	BaseCommand
 
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	usage           interface{}  `usage:"CF_NAME events APP_NAME"`
-	relatedCommands interface{}  `related_commands:"app, logs, map-route, unmap-route"`
+	RequiredArgs    flag.OptionalAppName `positional-args:"yes"`
+	Space           bool                 `long:"space" description:"Show events for every resource in the targeted space"`
+	Org             bool                 `long:"org" description:"Show events for every resource in the targeted org"`
+	TargetGUID      string               `long:"target-guid" description:"Show events for the resource with this GUID"`
+	Types           []string             `long:"type" description:"Only show events of this type (e.g. audit.app.update); can specify multiple times"`
+	ActorName       string               `long:"actor" description:"Only show events caused by the user or client with this name or GUID"`
+	Since           flag.LogTime         `long:"since" description:"Only show events at or after this time, given as an RFC3339 timestamp or a duration ago (e.g. 2021-01-02T15:04:05Z, 24h)"`
+	Until           flag.LogTime         `long:"until" description:"Only show events before this time, given as an RFC3339 timestamp or a duration ago"`
+	All             bool                 `long:"all" description:"Show the complete event history instead of only the most recent events"`
+	usage           interface{}          `usage:"CF_NAME events APP_NAME [--type EVENT_TYPE]... [--actor ACTOR] [--since TIME] [--until TIME] [--all]\n   CF_NAME events (--space | --org | --target-guid GUID) [--type EVENT_TYPE]... [--actor ACTOR] [--since TIME] [--until TIME] [--all]\n\nEXAMPLES:\n   CF_NAME events my-app --since 24h\n   CF_NAME events --space --type audit.app.delete-request --all\n   CF_NAME events --org --actor admin --all --output json"`
+	relatedCommands interface{}          `related_commands:"app, logs, map-route, unmap-route"`
 }
 
 func (cmd EventsCommand) Execute(_ []string) error {
-	err := cmd.SharedActor.CheckTarget(true, true)
+	err := cmd.validateFlags()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.TargetGUID == "", cmd.RequiredArgs.AppName != "" || cmd.Space)
 	if err != nil {
 		return err
 	}
@@ -24,46 +39,135 @@ func (cmd EventsCommand) Execute(_ []string) error {
 		return err
 	}
 
-	appName := cmd.RequiredArgs.AppName
-	cmd.UI.DisplayTextWithFlavor("Getting events for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
-		"AppName":   appName,
-		"OrgName":   cmd.Config.TargetedOrganization().Name,
-		"SpaceName": cmd.Config.TargetedSpace().Name,
-		"Username":  user.Name,
-	})
+	query := v7action.EventQuery{
+		Types:    cmd.Types,
+		Actor:    cmd.ActorName,
+		Since:    cmd.Since.Time,
+		Until:    cmd.Until.Time,
+		AllPages: cmd.All,
+	}
 
-	events, warnings, err := cmd.Actor.GetRecentEventsByApplicationNameAndSpace(
-		appName,
-		cmd.Config.TargetedSpace().GUID,
-	)
+	switch {
+	case cmd.Space:
+		cmd.UI.DisplayTextWithFlavor("Getting events for org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+		query.SpaceGUIDs = []string{cmd.Config.TargetedSpace().GUID}
+	case cmd.Org:
+		cmd.UI.DisplayTextWithFlavor("Getting events for org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":  cmd.Config.TargetedOrganization().Name,
+			"Username": user.Name,
+		})
+		query.OrganizationGUIDs = []string{cmd.Config.TargetedOrganization().GUID}
+	case cmd.TargetGUID != "":
+		cmd.UI.DisplayTextWithFlavor("Getting events for target {{.TargetGUID}} as {{.Username}}...", map[string]interface{}{
+			"TargetGUID": cmd.TargetGUID,
+			"Username":   user.Name,
+		})
+		query.TargetGUIDs = []string{cmd.TargetGUID}
+	default:
+		appName := cmd.RequiredArgs.AppName
+		cmd.UI.DisplayTextWithFlavor("Getting events for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"AppName":   appName,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+
+		app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(appName, cmd.Config.TargetedSpace().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		query.TargetGUIDs = []string{app.GUID}
+	}
+
+	events, warnings, err := cmd.Actor.GetEvents(query)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		output := []eventOutput{}
+		for _, event := range events {
+			output = append(output, newEventOutput(event))
+		}
+		return cmd.UI.DisplayStructuredOutput(output)
+	}
+
 	if len(events) == 0 {
 		cmd.UI.DisplayText("No events found.")
 	}
 
-	table := [][]string{
-		{
-			cmd.UI.TranslateText("time"),
-			cmd.UI.TranslateText("event"),
-			cmd.UI.TranslateText("actor"),
-			cmd.UI.TranslateText("description"),
-		},
+	showTarget := cmd.Space || cmd.Org
+
+	header := []string{
+		cmd.UI.TranslateText("time"),
+		cmd.UI.TranslateText("event"),
+	}
+	if showTarget {
+		header = append(header, cmd.UI.TranslateText("target"))
 	}
+	header = append(header,
+		cmd.UI.TranslateText("actor"),
+		cmd.UI.TranslateText("description"),
+	)
+	table := [][]string{header}
 
 	for _, event := range events {
-		table = append(table, []string{
+		row := []string{
 			event.Time.Local().Format("2006-01-02T15:04:05.00-0700"),
 			event.Type,
+		}
+		if showTarget {
+			row = append(row, event.TargetType+" "+event.TargetName)
+		}
+		row = append(row,
 			event.ActorName,
 			event.Description,
-		})
+		)
+		table = append(table, row)
 	}
 
 	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
 
 	return nil
 }
+
+func (EventsCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
+func (cmd EventsCommand) validateFlags() error {
+	var selectors []string
+	if cmd.RequiredArgs.AppName != "" {
+		selectors = append(selectors, "APP_NAME")
+	}
+	if cmd.Space {
+		selectors = append(selectors, "--space")
+	}
+	if cmd.Org {
+		selectors = append(selectors, "--org")
+	}
+	if cmd.TargetGUID != "" {
+		selectors = append(selectors, "--target-guid")
+	}
+
+	switch {
+	case len(selectors) == 0:
+		return translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}
+	case len(selectors) > 1:
+		return translatableerror.ArgumentCombinationError{Args: selectors}
+	}
+
+	if !cmd.Since.IsZero() && !cmd.Until.IsZero() && !cmd.Since.Before(cmd.Until.Time) {
+		return translatableerror.IncorrectUsageError{
+			Message: "--since must be before --until",
+		}
+	}
+
+	return nil
+}
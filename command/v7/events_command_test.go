@@ -11,8 +11,10 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 
@@ -42,7 +44,7 @@ var _ = Describe("events Command", func() {
 		fakeConfig.BinaryNameReturns(binaryName)
 
 		cmd = EventsCommand{
-			RequiredArgs: flag.AppName{AppName: "some-app"},
+			RequiredArgs: flag.OptionalAppName{AppName: "some-app"},
 			BaseCommand: BaseCommand{
 				UI:          testUI,
 				Config:      fakeConfig,
@@ -61,6 +63,7 @@ var _ = Describe("events Command", func() {
 		})
 
 		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{Name: "some-app", GUID: "some-app-guid"}, v7action.Warnings{"app-warning"}, nil)
 	})
 
 	JustBeforeEach(func() {
@@ -100,7 +103,7 @@ var _ = Describe("events Command", func() {
 
 		BeforeEach(func() {
 			expectedErr = ccerror.RequestError{}
-			fakeActor.GetRecentEventsByApplicationNameAndSpaceReturns(nil, v7action.Warnings{"warning-1", "warning-2"}, expectedErr)
+			fakeActor.GetEventsReturns(nil, v7action.Warnings{"warning-1", "warning-2"}, expectedErr)
 		})
 
 		It("returns the error and prints warnings", func() {
@@ -138,7 +141,7 @@ var _ = Describe("events Command", func() {
 				},
 			}
 
-			fakeActor.GetRecentEventsByApplicationNameAndSpaceReturns(events, v7action.Warnings{"warning-1", "warning-2"}, nil)
+			fakeActor.GetEventsReturns(events, v7action.Warnings{"warning-1", "warning-2"}, nil)
 		})
 
 		It("prints the events and outputs warnings", func() {
@@ -157,16 +160,19 @@ var _ = Describe("events Command", func() {
 			Expect(testUI.Err).To(Say("warning-1"))
 			Expect(testUI.Err).To(Say("warning-2"))
 
-			Expect(fakeActor.GetRecentEventsByApplicationNameAndSpaceCallCount()).To(Equal(1))
-			appName, spaceGUID := fakeActor.GetRecentEventsByApplicationNameAndSpaceArgsForCall(0)
+			Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
 			Expect(appName).To(Equal("some-app"))
 			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(fakeActor.GetEventsCallCount()).To(Equal(1))
+			Expect(fakeActor.GetEventsArgsForCall(0)).To(Equal(v7action.EventQuery{TargetGUIDs: []string{"some-app-guid"}}))
 		})
 	})
 
 	When("getting the application events returns no events", func() {
 		BeforeEach(func() {
-			fakeActor.GetRecentEventsByApplicationNameAndSpaceReturns([]v7action.Event{}, v7action.Warnings{"warning-1", "warning-2"}, nil)
+			fakeActor.GetEventsReturns([]v7action.Event{}, v7action.Warnings{"warning-1", "warning-2"}, nil)
 		})
 
 		It("displays there are no events", func() {
@@ -179,4 +185,186 @@ var _ = Describe("events Command", func() {
 			Expect(testUI.Err).To(Say("warning-2"))
 		})
 	})
+
+	When("getting the application fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{}, v7action.Warnings{"app-warning"}, actionerror.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns the error and prints warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(fakeActor.GetEventsCallCount()).To(Equal(0))
+		})
+	})
+
+	When("filtering flags are provided", func() {
+		BeforeEach(func() {
+			cmd.Types = []string{"audit.app.update", "audit.app.restage"}
+			cmd.ActorName = "admin"
+			cmd.Since = flag.LogTime{Time: time.Unix(10, 0)}
+			cmd.Until = flag.LogTime{Time: time.Unix(20, 0)}
+			cmd.All = true
+		})
+
+		It("passes them to the actor", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetEventsArgsForCall(0)).To(Equal(v7action.EventQuery{
+				TargetGUIDs: []string{"some-app-guid"},
+				Types:       []string{"audit.app.update", "audit.app.restage"},
+				Actor:       "admin",
+				Since:       time.Unix(10, 0),
+				Until:       time.Unix(20, 0),
+				AllPages:    true,
+			}))
+		})
+	})
+
+	When("--since is not before --until", func() {
+		BeforeEach(func() {
+			cmd.Since = flag.LogTime{Time: time.Unix(20, 0)}
+			cmd.Until = flag.LogTime{Time: time.Unix(10, 0)}
+		})
+
+		It("returns an incorrect usage error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{
+				Message: "--since must be before --until",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--space is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppName = ""
+			cmd.Space = true
+
+			fakeActor.GetEventsReturns([]v7action.Event{
+				{
+					GUID:       "some-event-guid",
+					Type:       "audit.route.create",
+					ActorName:  "user1",
+					TargetType: "route",
+					TargetName: "example.com",
+				},
+			}, nil, nil)
+		})
+
+		It("shows the events of every resource in the targeted space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+
+			Expect(testUI.Out).To(Say(`Getting events for org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`time\s+event\s+target\s+actor\s+description`))
+			Expect(testUI.Out).To(Say(`audit.route.create\s+route example.com\s+user1`))
+
+			Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+			Expect(fakeActor.GetEventsArgsForCall(0)).To(Equal(v7action.EventQuery{SpaceGUIDs: []string{"some-space-guid"}}))
+		})
+	})
+
+	When("--org is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppName = ""
+			cmd.Org = true
+		})
+
+		It("shows the events of every resource in the targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+
+			Expect(testUI.Out).To(Say(`Getting events for org some-org as steve\.\.\.`))
+			Expect(fakeActor.GetEventsArgsForCall(0)).To(Equal(v7action.EventQuery{OrganizationGUIDs: []string{"some-org-guid"}}))
+		})
+	})
+
+	When("--target-guid is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppName = ""
+			cmd.TargetGUID = "some-target-guid"
+		})
+
+		It("shows the events of that resource without requiring a target", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+
+			Expect(testUI.Out).To(Say(`Getting events for target some-target-guid as steve\.\.\.`))
+			Expect(fakeActor.GetEventsArgsForCall(0)).To(Equal(v7action.EventQuery{TargetGUIDs: []string{"some-target-guid"}}))
+		})
+	})
+
+	When("neither an app name nor another target is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppName = ""
+		})
+
+		It("returns a required argument error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}))
+		})
+	})
+
+	When("several targets are provided", func() {
+		BeforeEach(func() {
+			cmd.Space = true
+			cmd.TargetGUID = "some-target-guid"
+		})
+
+		It("returns an argument combination error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"APP_NAME", "--space", "--target-guid"},
+			}))
+		})
+	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetEventsReturns([]v7action.Event{
+				{
+					GUID:       "some-event-guid",
+					Time:       time.Date(2017, 8, 14, 21, 16, 42, 0, time.UTC),
+					Type:       "audit.app.update",
+					ActorGUID:  "some-user-guid",
+					ActorType:  "user",
+					ActorName:  "user1",
+					TargetGUID: "some-app-guid",
+					TargetType: "app",
+					TargetName: "some-app",
+					SpaceGUID:  "some-space-guid",
+					Data:       map[string]interface{}{"request": map[string]interface{}{"instances": 2}},
+				},
+			}, nil, nil)
+		})
+
+		It("renders the events as JSON", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(string(out.Contents())).To(MatchJSON(`[
+				{
+					"guid": "some-event-guid",
+					"time": "2017-08-14T21:16:42Z",
+					"type": "audit.app.update",
+					"actor": {"guid": "some-user-guid", "type": "user", "name": "user1"},
+					"target": {"guid": "some-app-guid", "type": "app", "name": "some-app"},
+					"space_guid": "some-space-guid",
+					"data": {"request": {"instances": 2}}
+				}
+			]`))
+			Expect(testUI.Err).To(Say(`Getting events for app some-app`))
+		})
+	})
 })
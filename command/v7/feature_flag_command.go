@@ -38,10 +38,18 @@ func (cmd FeatureFlagCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructuredOutput(featureFlagOutput{Name: featureFlag.Name, Enabled: featureFlag.Enabled})
+	}
+
 	cmd.displayTable(featureFlag)
 	return nil
 }
 
+func (FeatureFlagCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd FeatureFlagCommand) displayTable(featureFlag resources.FeatureFlag) {
 	var keyValueTable = [][]string{
 		{"Features", "State"},
@@ -117,4 +117,22 @@ var _ = Describe("Feature Flag Command", func() {
 		Expect(testUI.Out).To(Say(`Features\s+State`))
 		Expect(testUI.Out).To(Say(`flag1\s+enabled`))
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+		})
+
+		It("renders the feature flag as JSON", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(string(out.Contents())).To(MatchJSON(`{"name": "flag1", "enabled": true}`))
+			Expect(testUI.Err).To(Say("this is a warning"))
+		})
+	})
 })
@@ -35,11 +35,23 @@ func (cmd FeatureFlagsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		output := []featureFlagOutput{}
+		for _, flag := range flags {
+			output = append(output, featureFlagOutput{Name: flag.Name, Enabled: flag.Enabled})
+		}
+		return cmd.UI.DisplayStructuredOutput(output)
+	}
+
 	cmd.displayTable(flags)
 
 	return nil
 }
 
+func (FeatureFlagsCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd FeatureFlagsCommand) displayTable(featureFlags []resources.FeatureFlag) {
 	if len(featureFlags) > 0 {
 		var keyValueTable = [][]string{
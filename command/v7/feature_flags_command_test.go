@@ -122,4 +122,30 @@ var _ = Describe("Feature Flags Command", func() {
 			})
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetFeatureFlagsReturns(
+				[]resources.FeatureFlag{{Name: "flag-1", Enabled: true}, {Name: "flag-2"}},
+				v7action.Warnings{"get-flags-warning"},
+				nil)
+		})
+
+		It("renders the feature flags as JSON on stdout and everything else on stderr", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(string(out.Contents())).To(MatchJSON(`[
+				{"name": "flag-1", "enabled": true},
+				{"name": "flag-2", "enabled": false}
+			]`))
+			Expect(testUI.Err).To(Say("get-flags-warning"))
+		})
+	})
 })
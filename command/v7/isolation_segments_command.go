@@ -35,6 +35,14 @@ func (cmd IsolationSegmentsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		output := []isolationSegmentOutput{}
+		for _, summary := range summaries {
+			output = append(output, isolationSegmentOutput{Name: summary.Name, Orgs: emptyIfNil(summary.EntitledOrgs)})
+		}
+		return cmd.UI.DisplayStructuredOutput(output)
+	}
+
 	table := [][]string{
 		{
 			cmd.UI.TranslateText("name"),
@@ -55,3 +63,7 @@ func (cmd IsolationSegmentsCommand) Execute(args []string) error {
 	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
 	return nil
 }
+
+func (IsolationSegmentsCommand) SupportsStructuredOutput() bool {
+	return true
+}
@@ -151,4 +151,30 @@ var _ = Describe("isolation-segments Command", func() {
 			})
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetIsolationSegmentSummariesReturns(
+				[]v7action.IsolationSegmentSummary{{Name: "iso-1", EntitledOrgs: []string{"org-1"}}, {Name: "iso-2"}},
+				v7action.Warnings{"get-iso-warning"},
+				nil)
+		})
+
+		It("renders the isolation segments as JSON on stdout and everything else on stderr", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(string(out.Contents())).To(MatchJSON(`[
+				{"name": "iso-1", "orgs": ["org-1"]},
+				{"name": "iso-2", "orgs": []}
+			]`))
+			Expect(testUI.Err).To(Say("get-iso-warning"))
+		})
+	})
 })
@@ -104,10 +104,18 @@ func (cmd LabelsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructuredOutput(newLabelValuesOutput(labels))
+	}
+
 	cmd.printLabels(labels)
 	return nil
 }
 
+func (LabelsCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd LabelsCommand) Usage() string {
 	return `CF_NAME labels RESOURCE RESOURCE_NAME`
 }
@@ -229,6 +229,24 @@ var _ = Describe("labels command", func() {
 				Expect(testUI.Out).To(Say(`some-other-label\s+some-other-value`))
 			})
 
+			When("structured output is requested", func() {
+				var out *Buffer
+
+				BeforeEach(func() {
+					out = NewBuffer()
+					testUI = ui.NewTestUI(nil, out, NewBuffer())
+					testUI.SetOutputFormat(ui.OutputFormatJSON)
+					cmd.UI = testUI
+				})
+
+				It("renders the labels as JSON", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(string(out.Contents())).To(MatchJSON(`{"some-label": "some-value", "some-other-label": "some-other-value"}`))
+					Expect(testUI.Err).To(Say(regexp.QuoteMeta(`Getting labels for app dora in org fake-org / space fake-space as some-user...`)))
+				})
+			})
+
 			When("CAPI returns warnings", func() {
 				BeforeEach(func() {
 					fakeLabelsActor.GetApplicationLabelsReturns(
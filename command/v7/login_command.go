@@ -5,9 +5,11 @@ import (
 	"io"
 	"net/url"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/authcode"
 	"code.cloudfoundry.org/cli/util/ui"
 	"code.cloudfoundry.org/clock"
 
@@ -38,15 +40,37 @@ func (a ActualActorReloader) Reload(config command.Config, ui command.UI) (Actor
 	return v7action.NewActor(ccClient, config, nil, uaaClient, routingClient, clock.NewClock()), nil
 }
 
-const maxLoginTries = 3
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . AuthorizationCodeListener
+
+type AuthorizationCodeListener interface {
+	Listen() (redirectURI string, state string, err error)
+	WaitForCode() (string, error)
+	Close() error
+}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . BrowserOpener
+
+type BrowserOpener interface {
+	Open(url string) error
+}
+
+const (
+	maxLoginTries = 3
+
+	browserLoginTimeout = 5 * time.Minute
+)
 
 type LoginCommand struct {
 	UI            command.UI
 	Actor         Actor
 	Config        command.Config
 	ActorReloader ActorReloader
+	CodeListener  AuthorizationCodeListener
+	BrowserOpener BrowserOpener
 
 	APIEndpoint       string      `short:"a" description:"API endpoint (e.g. https://api.example.com)"`
+	Browser           bool        `long:"browser" description:"Log in through a web browser"`
+	DeviceCode        bool        `long:"device-code" description:"Log in by entering a code in a web browser on another device"`
 	Organization      string      `short:"o" description:"Org"`
 	Password          string      `short:"p" description:"Password"`
 	Space             string      `short:"s" description:"Space"`
@@ -55,7 +79,7 @@ type LoginCommand struct {
 	SSOPasscode       string      `long:"sso-passcode" description:"One-time passcode"`
 	Username          string      `short:"u" description:"Username"`
 	Origin            string      `long:"origin" description:"Indicates the identity provider to be used for login"`
-	usage             interface{} `usage:"CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE] [--origin ORIGIN]\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME login (omit username and password to login interactively -- CF_NAME will prompt for both)\n   CF_NAME login -u name@example.com -p pa55woRD (specify username and password as arguments)\n   CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n   CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)\n   CF_NAME login --origin ldap"`
+	usage             interface{} `usage:"CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE | --browser | --device-code] [--origin ORIGIN]\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME login (omit username and password to login interactively -- CF_NAME will prompt for both)\n   CF_NAME login -u name@example.com -p pa55woRD (specify username and password as arguments)\n   CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n   CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)\n   CF_NAME login --browser (CF_NAME will open a web browser to login)\n   CF_NAME login --device-code (CF_NAME will provide a url and a code to login from another device)\n   CF_NAME login --origin ldap"`
 	relatedCommands   interface{} `related_commands:"api, auth, target"`
 }
 
@@ -63,6 +87,8 @@ func (cmd *LoginCommand) Setup(config command.Config, ui command.UI) error {
 	ccClient := shared.NewWrappedCloudControllerClient(config, ui)
 	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil, nil, clock.NewClock())
 	cmd.ActorReloader = ActualActorReloader{}
+	cmd.CodeListener = authcode.NewListener(browserLoginTimeout)
+	cmd.BrowserOpener = authcode.Browser{}
 
 	cmd.UI = ui
 	cmd.Config = config
@@ -123,6 +149,10 @@ func (cmd *LoginCommand) Execute(args []string) error {
 	var authErr error
 	if cmd.SSO || cmd.SSOPasscode != "" {
 		authErr = cmd.authenticateSSO()
+	} else if cmd.Browser {
+		authErr = cmd.displayAuthenticationResult(cmd.authenticateBrowser())
+	} else if cmd.DeviceCode {
+		authErr = cmd.displayAuthenticationResult(cmd.authenticateDeviceCode())
 	} else {
 		authErr = cmd.authenticate()
 	}
@@ -357,6 +387,83 @@ func (cmd *LoginCommand) authenticateSSO() error {
 	return err
 }
 
+func (cmd *LoginCommand) authenticateBrowser() error {
+	pkce, err := uaa.NewPKCE()
+	if err != nil {
+		return err
+	}
+
+	redirectURI, state, err := cmd.CodeListener.Listen()
+	if err != nil {
+		return err
+	}
+	defer cmd.CodeListener.Close()
+
+	authorizeURL, err := cmd.Actor.GetAuthorizationCodeURL(redirectURI, state, pkce.Challenge)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Opening a web browser to log in. If it does not open, visit:")
+	cmd.UI.DisplayText(authorizeURL)
+	cmd.UI.DisplayNewline()
+	if err = cmd.BrowserOpener.Open(authorizeURL); err != nil {
+		cmd.UI.DisplayWarning("Unable to open a web browser: {{.Error}}", map[string]interface{}{
+			"Error": err.Error(),
+		})
+	}
+
+	cmd.UI.DisplayText("Waiting for the web browser login to complete...")
+	code, err := cmd.CodeListener.WaitForCode()
+	if err != nil {
+		return err
+	}
+
+	credentials := map[string]string{
+		"code":          code,
+		"code_verifier": pkce.Verifier,
+		"redirect_uri":  redirectURI,
+	}
+
+	cmd.UI.DisplayText("Authenticating...")
+	return cmd.Actor.Authenticate(credentials, "", constant.GrantTypeAuthorizationCode)
+}
+
+func (cmd *LoginCommand) authenticateDeviceCode() error {
+	authorization, err := cmd.Actor.GetDeviceAuthorization()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("To log in, visit {{.VerificationURI}} on any device and enter the code {{.UserCode}}", map[string]interface{}{
+		"VerificationURI": authorization.VerificationURI,
+		"UserCode":        authorization.UserCode,
+	})
+	if authorization.VerificationURIComplete != "" {
+		cmd.UI.DisplayText("or visit {{.VerificationURIComplete}}", map[string]interface{}{
+			"VerificationURIComplete": authorization.VerificationURIComplete,
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayText("Waiting for the login to be approved...")
+	return cmd.Actor.AuthenticateWithDeviceCode(authorization)
+}
+
+// displayAuthenticationResult displays the outcome of a login that is not
+// retried, such as a web browser or device code login.
+func (cmd *LoginCommand) displayAuthenticationResult(err error) error {
+	if err != nil {
+		cmd.UI.DisplayWarning(translatableerror.ConvertToTranslatableError(err).Error())
+		cmd.UI.DisplayNewline()
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
 func (cmd *LoginCommand) groupPrompts(prompts map[string]coreconfig.AuthPrompt) (map[string]coreconfig.AuthPrompt, map[string]coreconfig.AuthPrompt) {
 	var (
 		nonPasswordPrompts = make(map[string]coreconfig.AuthPrompt)
@@ -577,6 +684,32 @@ func (cmd *LoginCommand) validateFlags() error {
 		}
 	}
 
+	if cmd.Browser || cmd.DeviceCode {
+		mode := "--browser"
+		if cmd.DeviceCode {
+			mode = "--device-code"
+		}
+
+		conflictingFlags := []struct {
+			name  string
+			isSet bool
+		}{
+			{"--browser", cmd.Browser},
+			{"--sso", cmd.SSO},
+			{"--sso-passcode", cmd.SSOPasscode != ""},
+			{"-u", cmd.Username != ""},
+			{"-p", cmd.Password != ""},
+			{"--origin", cmd.Origin != ""},
+		}
+		for _, conflictingFlag := range conflictingFlags {
+			if conflictingFlag.isSet && conflictingFlag.name != mode {
+				return translatableerror.ArgumentCombinationError{
+					Args: []string{mode, conflictingFlag.name},
+				}
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -591,6 +724,12 @@ func (cmd *LoginCommand) validateTargetSpecificFlags() error {
 	if cmd.SSO {
 		return translatableerror.NotSupportedOnKubernetesArgumentError{Arg: "--sso"}
 	}
+	if cmd.Browser {
+		return translatableerror.NotSupportedOnKubernetesArgumentError{Arg: "--browser"}
+	}
+	if cmd.DeviceCode {
+		return translatableerror.NotSupportedOnKubernetesArgumentError{Arg: "--device-code"}
+	}
 	if cmd.SSOPasscode != "" {
 		return translatableerror.NotSupportedOnKubernetesArgumentError{Arg: "--sso-passcode"}
 	}
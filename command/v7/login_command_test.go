@@ -4,6 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"net/url"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
@@ -16,6 +19,7 @@ import (
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/authcode"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
@@ -103,6 +107,32 @@ var _ = Describe("login Command", func() {
 			})
 		})
 
+		When("the --browser flag is used with another way to log in", func() {
+			BeforeEach(func() {
+				cmd.Browser = true
+				cmd.Username = "some-user"
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--browser", "-u"},
+				}))
+			})
+		})
+
+		When("the --device-code and the --browser flag are used together", func() {
+			BeforeEach(func() {
+				cmd.Browser = true
+				cmd.DeviceCode = true
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--device-code", "--browser"},
+				}))
+			})
+		})
+
 		When("the user has manually added their client credentials", func() {
 			BeforeEach(func() {
 				fakeConfig.UAAOAuthClientReturns("some-other-client-id")
@@ -150,6 +180,26 @@ var _ = Describe("login Command", func() {
 				})
 			})
 
+			When("browser flag is provider", func() {
+				BeforeEach(func() {
+					cmd.Browser = true
+				})
+
+				It("returns unsupported flag error", func() {
+					Expect(executeErr).To(Equal(translatableerror.NotSupportedOnKubernetesArgumentError{Arg: "--browser"}))
+				})
+			})
+
+			When("device code flag is provider", func() {
+				BeforeEach(func() {
+					cmd.DeviceCode = true
+				})
+
+				It("returns unsupported flag error", func() {
+					Expect(executeErr).To(Equal(translatableerror.NotSupportedOnKubernetesArgumentError{Arg: "--device-code"}))
+				})
+			})
+
 			When("sso passcode flag is provider", func() {
 				BeforeEach(func() {
 					cmd.SSOPasscode = "sso-pass"
@@ -818,6 +868,197 @@ var _ = Describe("login Command", func() {
 		})
 	})
 
+	Describe("Browser", func() {
+		var (
+			fakeAPI          string
+			fakeCodeListener *v7fakes.FakeAuthorizationCodeListener
+			fakeBrowser      *v7fakes.FakeBrowserOpener
+		)
+
+		BeforeEach(func() {
+			fakeAPI = "whatever.com"
+			fakeConfig.TargetReturns(fakeAPI)
+
+			fakeCodeListener = new(v7fakes.FakeAuthorizationCodeListener)
+			fakeCodeListener.ListenReturns("http://127.0.0.1:4242/callback", "some-state", nil)
+			fakeCodeListener.WaitForCodeReturns("some-code", nil)
+			fakeBrowser = new(v7fakes.FakeBrowserOpener)
+
+			cmd.Browser = true
+			cmd.CodeListener = fakeCodeListener
+			cmd.BrowserOpener = fakeBrowser
+
+			fakeActor.GetAuthorizationCodeURLReturns("https://login.whatever.com/oauth/authorize?some-query", nil)
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "potatoface"}, nil)
+		})
+
+		It("opens the authorize URL in a web browser", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeActor.GetAuthorizationCodeURLCallCount()).To(Equal(1))
+			redirectURI, state, codeChallenge := fakeActor.GetAuthorizationCodeURLArgsForCall(0)
+			Expect(redirectURI).To(Equal("http://127.0.0.1:4242/callback"))
+			Expect(state).To(Equal("some-state"))
+			Expect(codeChallenge).NotTo(BeEmpty())
+
+			Expect(testUI.Out).To(Say("Opening a web browser to log in. If it does not open, visit:"))
+			Expect(testUI.Out).To(Say(`https://login\.whatever\.com/oauth/authorize\?some-query`))
+			Expect(fakeBrowser.OpenCallCount()).To(Equal(1))
+			Expect(fakeBrowser.OpenArgsForCall(0)).To(Equal("https://login.whatever.com/oauth/authorize?some-query"))
+		})
+
+		It("exchanges the code for a token with the PKCE verifier", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Waiting for the web browser login to complete\.\.\.`))
+			Expect(testUI.Out).To(Say(`Authenticating\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`user:\s+potatoface`))
+
+			Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+			credentials, origin, grantType := fakeActor.AuthenticateArgsForCall(0)
+			Expect(credentials["code"]).To(Equal("some-code"))
+			Expect(credentials["redirect_uri"]).To(Equal("http://127.0.0.1:4242/callback"))
+			_, _, codeChallenge := fakeActor.GetAuthorizationCodeURLArgsForCall(0)
+			Expect(uaa.PKCEChallenge(credentials["code_verifier"])).To(Equal(codeChallenge))
+			Expect(origin).To(BeEmpty())
+			Expect(grantType).To(Equal(constant.GrantTypeAuthorizationCode))
+
+			Expect(fakeCodeListener.CloseCallCount()).To(Equal(1))
+		})
+
+		When("the web browser cannot be opened", func() {
+			BeforeEach(func() {
+				fakeBrowser.OpenReturns(errors.New("no browser"))
+			})
+
+			It("warns and keeps waiting for the user to visit the URL", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Err).To(Say("Unable to open a web browser: no browser"))
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the web browser login fails", func() {
+			BeforeEach(func() {
+				fakeCodeListener.WaitForCodeReturns("", errors.New("The web browser login failed: User denied access"))
+			})
+
+			It("displays the failure and does not authenticate", func() {
+				Expect(executeErr).To(MatchError("Unable to authenticate."))
+				Expect(testUI.Err).To(Say("The web browser login failed: User denied access"))
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+				Expect(fakeCodeListener.CloseCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the code is rejected", func() {
+			BeforeEach(func() {
+				fakeActor.AuthenticateReturns(uaa.UnauthorizedError{Message: "Bad credentials"})
+				fakeActor.GetCurrentUserReturns(configv3.User{}, nil)
+			})
+
+			It("does not retry", func() {
+				Expect(executeErr).To(MatchError("Unable to authenticate."))
+				Expect(testUI.Err).To(Say("Credentials were rejected, please try again."))
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the authorization server redirects to the loopback listener", func() {
+			BeforeEach(func() {
+				cmd.CodeListener = authcode.NewListener(time.Minute)
+				fakeActor.GetAuthorizationCodeURLStub = func(redirectURI string, state string, codeChallenge string) (string, error) {
+					return "https://login.whatever.com/oauth/authorize?" + url.Values{
+						"redirect_uri": {redirectURI},
+						"state":        {state},
+					}.Encode(), nil
+				}
+				fakeBrowser.OpenStub = func(authorizeURL string) error {
+					parsedURL, err := url.Parse(authorizeURL)
+					Expect(err).NotTo(HaveOccurred())
+					query := parsedURL.Query()
+					go func() {
+						defer GinkgoRecover()
+						response, err := http.Get(query.Get("redirect_uri") + "?" + url.Values{
+							"code":  {"code-from-uaa"},
+							"state": {query.Get("state")},
+						}.Encode())
+						Expect(err).NotTo(HaveOccurred())
+						response.Body.Close()
+					}()
+					return nil
+				}
+			})
+
+			It("authenticates with the code from the redirect", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+				credentials, _, _ := fakeActor.AuthenticateArgsForCall(0)
+				Expect(credentials["code"]).To(Equal("code-from-uaa"))
+				Expect(credentials["redirect_uri"]).To(MatchRegexp(`^http://127\.0\.0\.1:\d+/callback$`))
+			})
+		})
+	})
+
+	Describe("Device Code", func() {
+		var fakeAPI string
+
+		BeforeEach(func() {
+			fakeAPI = "whatever.com"
+			fakeConfig.TargetReturns(fakeAPI)
+
+			cmd.DeviceCode = true
+			fakeActor.GetDeviceAuthorizationReturns(v7action.DeviceAuthorization{
+				DeviceCode:              "some-device-code",
+				UserCode:                "WDJB-MJHT",
+				VerificationURI:         "https://login.whatever.com/device",
+				VerificationURIComplete: "https://login.whatever.com/device?user_code=WDJB-MJHT",
+			}, nil)
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "potatoface"}, nil)
+		})
+
+		It("displays the code and where to enter it", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`To log in, visit https://login\.whatever\.com/device on any device and enter the code WDJB-MJHT`))
+			Expect(testUI.Out).To(Say(`or visit https://login\.whatever\.com/device\?user_code=WDJB-MJHT`))
+			Expect(testUI.Out).To(Say(`Waiting for the login to be approved\.\.\.`))
+		})
+
+		It("waits for the login to be approved", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeActor.AuthenticateWithDeviceCodeCallCount()).To(Equal(1))
+			Expect(fakeActor.AuthenticateWithDeviceCodeArgsForCall(0).DeviceCode).To(Equal("some-device-code"))
+
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`API endpoint:\s+%s`, fakeAPI))
+			Expect(testUI.Out).To(Say(`user:\s+potatoface`))
+		})
+
+		When("requesting the device code fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetDeviceAuthorizationReturns(v7action.DeviceAuthorization{}, errors.New("some-error"))
+			})
+
+			It("displays the error and does not wait", func() {
+				Expect(executeErr).To(MatchError("Unable to authenticate."))
+				Expect(testUI.Err).To(Say("some-error"))
+				Expect(fakeActor.AuthenticateWithDeviceCodeCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the device code expires", func() {
+			BeforeEach(func() {
+				fakeActor.AuthenticateWithDeviceCodeReturns(actionerror.DeviceCodeExpiredError{})
+				fakeActor.GetCurrentUserReturns(configv3.User{}, nil)
+			})
+
+			It("displays the error", func() {
+				Expect(executeErr).To(MatchError("Unable to authenticate."))
+				Expect(testUI.Err).To(Say("The code expired before the login was approved. Please try again."))
+			})
+		})
+	})
+
 	Describe("Config", func() {
 		When("a user has successfully authenticated", func() {
 			BeforeEach(func() {
@@ -3,6 +3,7 @@ package v7
 import (
 	"os"
 	"os/signal"
+	"strconv"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
@@ -11,15 +12,24 @@ import (
 	"code.cloudfoundry.org/cli/api/logcache"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/types"
 )
 
 type LogsCommand struct {
 	BaseCommand
 
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	Recent          bool         `long:"recent" description:"Dump recent logs instead of tailing"`
-	usage           interface{}  `usage:"CF_NAME logs APP_NAME"`
-	relatedCommands interface{}  `related_commands:"app, apps, ssh"`
+	RequiredArgs    flag.AppName           `positional-args:"yes"`
+	Recent          bool                   `long:"recent" description:"Dump recent logs instead of tailing"`
+	Since           flag.LogTime           `long:"since" description:"Only show logs at or after this time, given as an RFC3339 timestamp or a duration ago (e.g. 2021-01-02T15:04:05Z, 30m)"`
+	Until           flag.LogTime           `long:"until" description:"Only show logs before this time, given as an RFC3339 timestamp or a duration ago; stops tailing once reached"`
+	Process         string                 `long:"process" description:"Only show logs from instances of this process type (e.g. web)"`
+	Instance        types.NullInt          `long:"instance" description:"Only show logs from the app instance with this index"`
+	SourceTypes     []flag.LogSourceType   `long:"source-type" description:"Only show logs from this source (APP, API, CELL, LGR, RTR, SSH, STG); can specify multiple times"`
+	Match           flag.RegularExpression `long:"match" description:"Only show log messages matching this regular expression"`
+	MaxLines        flag.PositiveInteger   `long:"max-lines" description:"Show at most this many log messages; stops tailing once reached"`
+	usage           interface{}            `usage:"CF_NAME logs APP_NAME [--recent] [--since TIME] [--until TIME] [--process PROCESS_TYPE] [--instance INDEX]\n   [--source-type SOURCE_TYPE]... [--match REGEX] [--max-lines NUM]\n\nEXAMPLES:\n   CF_NAME logs my-app --recent --since 30m --source-type APP --match 'ERROR|WARN'\n   CF_NAME logs my-app --process worker --instance 1 --output json"`
+	relatedCommands interface{}            `related_commands:"app, apps, ssh"`
 
 	LogCacheClient sharedaction.LogCacheClient
 }
@@ -35,7 +45,12 @@ func (cmd *LogsCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd LogsCommand) Execute(args []string) error {
-	err := cmd.SharedActor.CheckTarget(true, true)
+	err := cmd.validateFlags()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
 	}
@@ -45,14 +60,7 @@ func (cmd LogsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
-		map[string]interface{}{
-			"AppName":   cmd.RequiredArgs.AppName,
-			"OrgName":   cmd.Config.TargetedOrganization().Name,
-			"SpaceName": cmd.Config.TargetedSpace().Name,
-			"Username":  user.Name,
-		})
-	cmd.UI.DisplayNewline()
+	cmd.displayFlavorText(user.Name)
 
 	if cmd.Recent {
 		return cmd.displayRecentLogs()
@@ -75,15 +83,81 @@ func (cmd LogsCommand) Execute(args []string) error {
 	return err
 }
 
+func (LogsCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
+func (cmd LogsCommand) validateFlags() error {
+	if !cmd.Since.IsZero() && !cmd.Until.IsZero() && !cmd.Since.Before(cmd.Until.Time) {
+		return translatableerror.IncorrectUsageError{
+			Message: "--since must be before --until",
+		}
+	}
+
+	if cmd.Instance.IsSet && cmd.Instance.Value < 0 {
+		return translatableerror.IncorrectUsageError{
+			Message: "--instance must be 0 or greater",
+		}
+	}
+
+	return nil
+}
+
+func (cmd LogsCommand) logFilter() sharedaction.LogFilter {
+	filter := sharedaction.LogFilter{
+		Since:       cmd.Since.Time,
+		Until:       cmd.Until.Time,
+		ProcessType: cmd.Process,
+		Pattern:     cmd.Match.Regexp,
+		MaxLines:    int(cmd.MaxLines.Value),
+	}
+
+	if cmd.Instance.IsSet {
+		filter.Instance = strconv.Itoa(cmd.Instance.Value)
+	}
+
+	for _, sourceType := range cmd.SourceTypes {
+		filter.SourceTypes = append(filter.SourceTypes, sourceType.SourceType)
+	}
+
+	return filter
+}
+
+func (cmd LogsCommand) displayFlavorText(username string) {
+	cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+		map[string]interface{}{
+			"AppName":   cmd.RequiredArgs.AppName,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  username,
+		})
+	cmd.UI.DisplayNewline()
+}
+
+func (cmd LogsCommand) displayLogMessage(message sharedaction.LogMessage) {
+	if !cmd.UI.IsStructuredOutput() {
+		cmd.UI.DisplayLogMessage(message, true)
+		return
+	}
+
+	err := cmd.UI.DisplayStructuredLogMessage(message)
+	if err != nil {
+		cmd.UI.DisplayWarning("Failed to display log message: {{.Error}}", map[string]interface{}{
+			"Error": err,
+		})
+	}
+}
+
 func (cmd LogsCommand) displayRecentLogs() error {
-	messages, warnings, err := cmd.Actor.GetRecentLogsForApplicationByNameAndSpace(
+	messages, warnings, err := cmd.Actor.GetFilteredRecentLogsForApplicationByNameAndSpace(
 		cmd.RequiredArgs.AppName,
 		cmd.Config.TargetedSpace().GUID,
 		cmd.LogCacheClient,
+		cmd.logFilter(),
 	)
 
 	for _, message := range messages {
-		cmd.UI.DisplayLogMessage(message, true)
+		cmd.displayLogMessage(message)
 	}
 
 	cmd.UI.DisplayWarnings(warnings)
@@ -128,10 +202,11 @@ func (cmd LogsCommand) handleLogErr(logErr error) {
 }
 
 func (cmd LogsCommand) streamLogs() error {
-	messages, logErrs, stopStreaming, warnings, err := cmd.Actor.GetStreamingLogsForApplicationByNameAndSpace(
+	messages, logErrs, stopStreaming, warnings, err := cmd.Actor.GetFilteredStreamingLogsForApplicationByNameAndSpace(
 		cmd.RequiredArgs.AppName,
 		cmd.Config.TargetedSpace().GUID,
 		cmd.LogCacheClient,
+		cmd.logFilter(),
 	)
 
 	cmd.UI.DisplayWarnings(warnings)
@@ -150,7 +225,7 @@ func (cmd LogsCommand) streamLogs() error {
 				messagesClosed = true
 				break
 			}
-			cmd.UI.DisplayLogMessage(message, true)
+			cmd.displayLogMessage(message)
 		case logErr, ok := <-logErrs:
 			if !ok {
 				errLogsClosed = true
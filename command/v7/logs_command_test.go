@@ -3,6 +3,7 @@ package v7_test
 import (
 	"context"
 	"errors"
+	"regexp"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
@@ -10,8 +11,11 @@ import (
 	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
@@ -73,6 +77,32 @@ var _ = Describe("logs command", func() {
 		})
 	})
 
+	When("--since is not before --until", func() {
+		BeforeEach(func() {
+			cmd.Since = flag.LogTime{Time: time.Unix(20, 0)}
+			cmd.Until = flag.LogTime{Time: time.Unix(10, 0)}
+		})
+
+		It("returns an incorrect usage error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{
+				Message: "--since must be before --until",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--instance is negative", func() {
+		BeforeEach(func() {
+			cmd.Instance = types.NullInt{Value: -1, IsSet: true}
+		})
+
+		It("returns an incorrect usage error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{
+				Message: "--instance must be 0 or greater",
+			}))
+		})
+	})
+
 	When("checkTarget succeeds", func() {
 		BeforeEach(func() {
 			fakeConfig.TargetedSpaceReturns(configv3.Space{
@@ -97,7 +127,7 @@ var _ = Describe("logs command", func() {
 				var expectedErr error
 				BeforeEach(func() {
 					expectedErr = errors.New("some-error")
-					fakeActor.GetRecentLogsForApplicationByNameAndSpaceReturns(
+					fakeActor.GetFilteredRecentLogsForApplicationByNameAndSpaceReturns(
 						[]sharedaction.LogMessage{
 							*sharedaction.NewLogMessage(
 								"all your base are belong to us",
@@ -121,7 +151,7 @@ var _ = Describe("logs command", func() {
 
 			When("the logs actor returns logs", func() {
 				BeforeEach(func() {
-					fakeActor.GetRecentLogsForApplicationByNameAndSpaceReturns(
+					fakeActor.GetFilteredRecentLogsForApplicationByNameAndSpaceReturns(
 						[]sharedaction.LogMessage{
 							*sharedaction.NewLogMessage(
 								"i am message 1",
@@ -150,12 +180,64 @@ var _ = Describe("logs command", func() {
 					Expect(testUI.Out).To(Say("i am message 1"))
 					Expect(testUI.Out).To(Say("i am message 2"))
 
-					Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
-					appName, spaceGUID, client := fakeActor.GetRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
+					Expect(fakeActor.GetFilteredRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+					appName, spaceGUID, client, filter := fakeActor.GetFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
 
 					Expect(appName).To(Equal("some-app"))
 					Expect(spaceGUID).To(Equal("some-space-guid"))
 					Expect(client).To(Equal(logCacheClient))
+					Expect(filter).To(Equal(sharedaction.LogFilter{}))
+				})
+			})
+
+			When("filtering flags are provided", func() {
+				BeforeEach(func() {
+					cmd.Since = flag.LogTime{Time: time.Unix(10, 0)}
+					cmd.Until = flag.LogTime{Time: time.Unix(20, 0)}
+					cmd.Process = "worker"
+					cmd.Instance = types.NullInt{Value: 2, IsSet: true}
+					cmd.SourceTypes = []flag.LogSourceType{{SourceType: "APP"}, {SourceType: "RTR"}}
+					cmd.Match = flag.RegularExpression{Regexp: regexp.MustCompile("ERROR")}
+					cmd.MaxLines = flag.PositiveInteger{Value: 50}
+				})
+
+				It("passes them to the actor as a filter", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+
+					_, _, _, filter := fakeActor.GetFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
+					Expect(filter).To(Equal(sharedaction.LogFilter{
+						Since:       time.Unix(10, 0),
+						Until:       time.Unix(20, 0),
+						ProcessType: "worker",
+						Instance:    "2",
+						SourceTypes: []string{"APP", "RTR"},
+						Pattern:     regexp.MustCompile("ERROR"),
+						MaxLines:    50,
+					}))
+				})
+			})
+
+			When("structured output is requested", func() {
+				var out *Buffer
+
+				BeforeEach(func() {
+					out = NewBuffer()
+					testUI = ui.NewTestUI(nil, out, NewBuffer())
+					testUI.SetOutputFormat(ui.OutputFormatJSON)
+					cmd.UI = testUI
+					fakeActor.GetFilteredRecentLogsForApplicationByNameAndSpaceReturns(
+						[]sharedaction.LogMessage{
+							*sharedaction.NewLogMessage("i am message 1", "OUT", time.Unix(0, 0).UTC(), "APP/PROC/WEB", "0"),
+						},
+						v7action.Warnings{"some-warning"},
+						nil)
+				})
+
+				It("displays each log message as a line of JSON on stdout and everything else on stderr", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(string(out.Contents())).To(MatchRegexp(`^{"timestamp":"[^"]+","source_type":"APP/PROC/WEB","source_instance":"0","message_type":"OUT","message":"i am message 1"}\n$`))
+					Expect(testUI.Err).To(Say("Retrieving logs"))
+					Expect(testUI.Err).To(Say("some-warning"))
 				})
 			})
 		})
@@ -180,7 +262,7 @@ var _ = Describe("logs command", func() {
 
 				BeforeEach(func() {
 					expectedErr = errors.New("some-error")
-					fakeActor.GetStreamingLogsForApplicationByNameAndSpaceReturns(nil,
+					fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceReturns(nil,
 						nil,
 						nil,
 						v7action.Warnings{"some-warning-1",
@@ -204,8 +286,8 @@ var _ = Describe("logs command", func() {
 				BeforeEach(func() {
 					expectedErr = errors.New("banana")
 
-					fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub =
-						func(appName string, spaceGUID string, client sharedaction.LogCacheClient) (
+					fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub =
+						func(appName string, spaceGUID string, client sharedaction.LogCacheClient, filter sharedaction.LogFilter) (
 							<-chan sharedaction.LogMessage,
 							<-chan error,
 							context.CancelFunc,
@@ -244,7 +326,7 @@ var _ = Describe("logs command", func() {
 					})
 					It("displays the errors", func() {
 						Expect(executeErr).To(MatchError("firs swimming"))
-						Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+						Expect(fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
 					})
 				})
 
@@ -257,8 +339,8 @@ var _ = Describe("logs command", func() {
 
 			When("the logs actor returns logs", func() {
 				BeforeEach(func() {
-					fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub =
-						func(_ string, _ string, _ sharedaction.LogCacheClient) (
+					fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub =
+						func(_ string, _ string, _ sharedaction.LogCacheClient, _ sharedaction.LogFilter) (
 							<-chan sharedaction.LogMessage,
 							<-chan error, context.CancelFunc,
 							v7action.Warnings,
@@ -290,12 +372,13 @@ var _ = Describe("logs command", func() {
 					Expect(testUI.Out).To(Say("Here are some staging logs!"))
 					Expect(testUI.Out).To(Say("Here are some other staging logs!"))
 
-					Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
-					appName, spaceGUID, client := fakeActor.GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
+					Expect(fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+					appName, spaceGUID, client, filter := fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
 
 					Expect(appName).To(Equal("some-app"))
 					Expect(spaceGUID).To(Equal("some-space-guid"))
 					Expect(client).To(Equal(logCacheClient))
+					Expect(filter).To(Equal(sharedaction.LogFilter{}))
 				})
 
 				When("scheduling a token refresh errors immediately", func() {
@@ -305,7 +388,7 @@ var _ = Describe("logs command", func() {
 					})
 					It("displays the errors", func() {
 						Expect(executeErr).To(MatchError("fjords pining"))
-						Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+						Expect(fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
 					})
 				})
 
@@ -326,7 +409,7 @@ var _ = Describe("logs command", func() {
 					})
 					It("displays the errors", func() {
 						Expect(executeErr).NotTo(HaveOccurred())
-						Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+						Expect(fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
 						Expect(testUI.Err).To(Say("fjords pining"))
 					})
 				})
@@ -3,16 +3,18 @@ package v7
 import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/resources"
 )
 
 type MapRouteCommand struct {
 	BaseCommand
 
-	RequiredArgs flag.AppDomain   `positional-args:"yes"`
-	Hostname     string           `long:"hostname" short:"n" description:"Hostname for the HTTP route (required for shared domains)"`
-	Path         flag.V7RoutePath `long:"path" description:"Path for the HTTP route"`
-	Port         int              `long:"port" description:"Port for the TCP route (default: random port)"`
-	AppProtocol  string           `long:"app-protocol" description:"[Beta flag, subject to change] Protocol for the route destination (default: http1). Only applied to HTTP routes"`
+	RequiredArgs flag.AppDomain       `positional-args:"yes"`
+	Hostname     string               `long:"hostname" short:"n" description:"Hostname for the HTTP route (required for shared domains)"`
+	Path         flag.V7RoutePath     `long:"path" description:"Path for the HTTP route"`
+	Port         int                  `long:"port" description:"Port for the TCP route (default: random port)"`
+	AppProtocol  string               `long:"app-protocol" description:"[Beta flag, subject to change] Protocol for the route destination (default: http1). Only applied to HTTP routes"`
+	Weight       flag.PositiveInteger `long:"weight" description:"Percentage (1-100) of the route's traffic to send to the app; the rest is divided among the route's other destinations"`
 
 	relatedCommands interface{} `related_commands:"create-route, routes, unmap-route"`
 }
@@ -20,7 +22,7 @@ type MapRouteCommand struct {
 func (cmd MapRouteCommand) Usage() string {
 	return `
 Map an HTTP route:
-   CF_NAME map-route APP_NAME DOMAIN [--hostname HOSTNAME] [--path PATH] [--app-protocol PROTOCOL]
+   CF_NAME map-route APP_NAME DOMAIN [--hostname HOSTNAME] [--path PATH] [--app-protocol PROTOCOL] [--weight WEIGHT]
 
 Map a TCP route:
    CF_NAME map-route APP_NAME DOMAIN [--port PORT]`
@@ -32,6 +34,7 @@ CF_NAME map-route my-app example.com
 CF_NAME map-route my-app example.com --hostname myhost                              # myhost.example.com
 CF_NAME map-route my-app example.com --hostname myhost --path foo                   # myhost.example.com/foo
 CF_NAME map-route my-app example.com --hostname myhost --app-protocol http2 # myhost.example.com
+CF_NAME map-route my-app-v2 example.com --hostname myhost --weight 10               # myhost.example.com, 10% of traffic to my-app-v2
 CF_NAME map-route my-app example.com --port 5000                                    # example.com:5000`
 }
 
@@ -88,6 +91,10 @@ func (cmd MapRouteCommand) Execute(args []string) error {
 		cmd.UI.DisplayOK()
 	}
 
+	if cmd.Weight.Value != 0 {
+		return cmd.mapRouteWithWeight(route, app, user.Name)
+	}
+
 	if cmd.AppProtocol != "" {
 		cmd.UI.DisplayTextWithFlavor("Mapping route {{.URL}} to app {{.AppName}} with protocol {{.Protocol}} in org {{.OrgName}} / space {{.SpaceName}} as {{.User}}...", map[string]interface{}{
 			"URL":       route.URL,
@@ -130,3 +137,23 @@ func (cmd MapRouteCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (cmd MapRouteCommand) mapRouteWithWeight(route resources.Route, app resources.Application, username string) error {
+	cmd.UI.DisplayTextWithFlavor("Mapping route {{.URL}} to app {{.AppName}} with weight {{.Weight}} in org {{.OrgName}} / space {{.SpaceName}} as {{.User}}...", map[string]interface{}{
+		"URL":       route.URL,
+		"AppName":   cmd.RequiredArgs.App,
+		"Weight":    cmd.Weight.Value,
+		"User":      username,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+	})
+
+	warnings, err := cmd.Actor.SetRouteDestinationWeights(route, map[string]int{app.GUID: int(cmd.Weight.Value)}, cmd.AppProtocol)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -419,6 +419,38 @@ var _ = Describe("map-route Command", func() {
 							})
 						})
 					})
+
+					When("a weight is given", func() {
+						BeforeEach(func() {
+							cmd.Weight = flag.PositiveInteger{Value: 10}
+							fakeActor.SetRouteDestinationWeightsReturns(v7action.Warnings{"set-weights-warnings"}, nil)
+						})
+
+						It("maps the app with the weight", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Out).To(Say(`Mapping route  to app %s with weight 10 in org some-org / space some-space as steve\.\.\.`, appName))
+							Expect(testUI.Out).To(Say("OK"))
+							Expect(testUI.Err).To(Say("set-weights-warnings"))
+
+							Expect(fakeActor.MapRouteCallCount()).To(Equal(0))
+							Expect(fakeActor.SetRouteDestinationWeightsCallCount()).To(Equal(1))
+							actualRoute, actualWeights, actualAppProtocol := fakeActor.SetRouteDestinationWeightsArgsForCall(0)
+							Expect(actualRoute.GUID).To(Equal("route-guid"))
+							Expect(actualWeights).To(Equal(map[string]int{"app-guid": 10}))
+							Expect(actualAppProtocol).To(Equal("http2"))
+						})
+
+						When("setting the weights errors", func() {
+							BeforeEach(func() {
+								fakeActor.SetRouteDestinationWeightsReturns(v7action.Warnings{"set-weights-warnings"}, errors.New("set-weights-error"))
+							})
+
+							It("returns the error and displays warnings", func() {
+								Expect(executeErr).To(MatchError("set-weights-error"))
+								Expect(testUI.Err).To(Say("set-weights-warnings"))
+							})
+						})
+					})
 				})
 
 				When("a tcp route is requested without a port", func() {
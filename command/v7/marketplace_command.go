@@ -46,6 +46,14 @@ func (cmd MarketplaceCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		output := []serviceOfferingOutput{}
+		for _, offering := range offerings {
+			output = append(output, newServiceOfferingOutput(offering, !cmd.NoPlans))
+		}
+		return cmd.UI.DisplayStructuredOutput(output)
+	}
+
 	if len(offerings) == 0 {
 		cmd.UI.DisplayNewline()
 		cmd.UI.DisplayText("No service offerings found.")
@@ -60,6 +68,10 @@ func (cmd MarketplaceCommand) Execute(args []string) error {
 	}
 }
 
+func (MarketplaceCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd MarketplaceCommand) processFlags() (v7action.MarketplaceFilter, error) {
 	if cmd.ServiceOfferingName != "" && cmd.NoPlans {
 		return v7action.MarketplaceFilter{}, translatableerror.ArgumentCombinationError{Args: []string{"--no-plans", "-e"}}
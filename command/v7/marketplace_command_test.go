@@ -630,5 +630,74 @@ var _ = Describe("marketplace command", func() {
 				Expect(testUI.Err).To(Say("warning 2"))
 			})
 		})
+
+		When("structured output is requested", func() {
+			var out *Buffer
+
+			BeforeEach(func() {
+				out = NewBuffer()
+				testUI = ui.NewTestUI(nil, out, NewBuffer())
+				testUI.SetOutputFormat(ui.OutputFormatJSON)
+				cmd.UI = testUI
+
+				fakeActor.MarketplaceReturns(
+					[]v7action.ServiceOfferingWithPlans{{
+						GUID:              "offering-guid-1",
+						Name:              "offering-1",
+						Description:       "about offering 1",
+						ServiceBrokerName: "service-broker-1",
+						Plans: []resources.ServicePlan{{
+							GUID:      "plan-guid-1",
+							Name:      "plan-1",
+							Free:      true,
+							Available: true,
+						}},
+					}},
+					v7action.Warnings{"warning 1"},
+					nil,
+				)
+			})
+
+			It("renders the offerings and their plans as JSON on stdout and everything else on stderr", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(string(out.Contents())).To(MatchJSON(`[{
+					"name": "offering-1",
+					"guid": "offering-guid-1",
+					"description": "about offering 1",
+					"broker": "service-broker-1",
+					"plans": [{"name": "plan-1", "guid": "plan-guid-1", "description": "", "free": true, "available": true}]
+				}]`))
+				Expect(testUI.Err).To(Say("warning 1"))
+			})
+
+			When("the --no-plans flag is specified", func() {
+				BeforeEach(func() {
+					setFlag(&cmd, "--no-plans")
+				})
+
+				It("leaves out the plans", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+
+					Expect(string(out.Contents())).To(MatchJSON(`[{
+						"name": "offering-1",
+						"guid": "offering-guid-1",
+						"description": "about offering 1",
+						"broker": "service-broker-1"
+					}]`))
+				})
+			})
+
+			When("no offerings are returned", func() {
+				BeforeEach(func() {
+					fakeActor.MarketplaceReturns([]v7action.ServiceOfferingWithPlans{}, nil, nil)
+				})
+
+				It("renders an empty list", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(string(out.Contents())).To(MatchJSON(`[]`))
+				})
+			})
+		})
 	})
 })
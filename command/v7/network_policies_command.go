@@ -45,6 +45,10 @@ func (cmd *NetworkPoliciesCommand) Setup(config command.Config, ui command.UI) e
 	return nil
 }
 
+func (NetworkPoliciesCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd NetworkPoliciesCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
@@ -81,6 +85,14 @@ func (cmd NetworkPoliciesCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		output := []networkPolicyOutput{}
+		for _, policy := range policies {
+			output = append(output, newNetworkPolicyOutput(policy))
+		}
+		return cmd.UI.DisplayStructuredOutput(output)
+	}
+
 	cmd.UI.DisplayNewline()
 
 	table := [][]string{
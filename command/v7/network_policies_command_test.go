@@ -188,4 +188,43 @@ var _ = Describe("network-policies Command", func() {
 			})
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeNetworkPoliciesActor.NetworkPoliciesBySpaceReturns(
+				[]cfnetworkingaction.Policy{{
+					SourceName:           "app-1",
+					DestinationName:      "app-2",
+					Protocol:             "tcp",
+					StartPort:            8080,
+					EndPort:              8090,
+					DestinationSpaceName: "space-2",
+					DestinationOrgName:   "org-2",
+				}},
+				cfnetworkingaction.Warnings{"get-policies-warning"},
+				nil)
+		})
+
+		It("renders the policies as JSON on stdout and everything else on stderr", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(string(out.Contents())).To(MatchJSON(`[{
+				"source": "app-1",
+				"destination": "app-2",
+				"protocol": "tcp",
+				"start_port": 8080,
+				"end_port": 8090,
+				"destination_space": "space-2",
+				"destination_org": "org-2"
+			}]`))
+			Expect(testUI.Err).To(Say("get-policies-warning"))
+		})
+	})
 })
@@ -30,6 +30,10 @@ func (cmd OrgCommand) Execute(args []string) error {
 	return cmd.displayOrgSummary()
 }
 
+func (OrgCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd OrgCommand) displayOrgGUID() error {
 	org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.RequiredArgs.Organization)
 	cmd.UI.DisplayWarnings(warnings)
@@ -62,6 +66,16 @@ func (cmd OrgCommand) displayOrgSummary() error {
 		return err
 	}
 
+	isolationSegments, v7Warnings, err := cmd.Actor.GetIsolationSegmentsByOrganization(orgSummary.GUID)
+	cmd.UI.DisplayWarnings(v7Warnings)
+	if err != nil {
+		return err
+	}
+
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructuredOutput(newOrgOutput(orgSummary, isolationSegments))
+	}
+
 	table := [][]string{
 		{cmd.UI.TranslateText("name:"), orgSummary.Name},
 		{cmd.UI.TranslateText("domains:"), strings.Join(orgSummary.DomainNames, ", ")},
@@ -69,12 +83,6 @@ func (cmd OrgCommand) displayOrgSummary() error {
 		{cmd.UI.TranslateText("spaces:"), strings.Join(orgSummary.SpaceNames, ", ")},
 	}
 
-	isolationSegments, v7Warnings, err := cmd.Actor.GetIsolationSegmentsByOrganization(orgSummary.GUID)
-	cmd.UI.DisplayWarnings(v7Warnings)
-	if err != nil {
-		return err
-	}
-
 	isolationSegmentNames := []string{}
 	for _, iso := range isolationSegments {
 		if iso.GUID == orgSummary.DefaultIsolationSegmentGUID {
@@ -278,4 +278,45 @@ var _ = Describe("org Command", func() {
 			})
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetOrganizationSummaryByNameReturns(
+				v7action.OrganizationSummary{
+					Organization:                resources.Organization{Name: "some-org", GUID: "some-org-guid"},
+					DomainNames:                 []string{"a-shared.com"},
+					QuotaName:                   "some-quota",
+					SpaceNames:                  []string{"space-1"},
+					DefaultIsolationSegmentGUID: "iso-guid-2",
+				},
+				v7action.Warnings{"get-org-summary-warning"},
+				nil)
+			fakeActor.GetIsolationSegmentsByOrganizationReturns(
+				[]resources.IsolationSegment{{Name: "iso-2", GUID: "iso-guid-2"}, {Name: "iso-1", GUID: "iso-guid-1"}},
+				nil,
+				nil)
+		})
+
+		It("renders the org as JSON on stdout and everything else on stderr", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(string(out.Contents())).To(MatchJSON(`{
+				"name": "some-org",
+				"guid": "some-org-guid",
+				"domains": ["a-shared.com"],
+				"quota": "some-quota",
+				"spaces": ["space-1"],
+				"isolation_segments": ["iso-1", "iso-2"],
+				"default_isolation_segment": "iso-2"
+			}`))
+			Expect(testUI.Err).To(Say("get-org-summary-warning"))
+		})
+	})
 })
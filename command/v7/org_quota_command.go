@@ -41,8 +41,16 @@ func (cmd OrgQuotaCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructuredOutput(newQuotaOutput(resources.Quota(orgQuota.Quota)))
+	}
+
 	quotaDisplayer := shared.NewQuotaDisplayer(cmd.UI)
 	quotaDisplayer.DisplaySingleQuota(resources.Quota(orgQuota.Quota))
 
 	return nil
 }
+
+func (OrgQuotaCommand) SupportsStructuredOutput() bool {
+	return true
+}
@@ -140,4 +140,46 @@ var _ = Describe("Org Quota Command", func() {
 			Expect(testUI.Out).To(Say(`route ports:\s+unlimited`))
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeActor.GetOrganizationQuotaByNameReturns(
+				resources.OrganizationQuota{Quota: resources.Quota{
+					Name: "some-org-quota",
+					GUID: "some-org-quota-guid",
+					Apps: resources.AppLimit{
+						TotalMemory:    &types.NullInt{IsSet: true, Value: 2048},
+						InstanceMemory: &types.NullInt{IsSet: false},
+					},
+				}},
+				v7action.Warnings{"warning-1"},
+				nil)
+		})
+
+		It("renders the quota as JSON, with unlimited values as null", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(string(out.Contents())).To(MatchJSON(`{
+				"name": "some-org-quota",
+				"guid": "some-org-quota-guid",
+				"total_memory_in_mb": 2048,
+				"instance_memory_in_mb": null,
+				"routes": null,
+				"service_instances": null,
+				"paid_service_plans": false,
+				"app_instances": null,
+				"route_ports": null
+			}`))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+
 })
@@ -39,8 +39,16 @@ func (cmd OrgQuotasCommand) Execute(args []string) error {
 		quotas = append(quotas, resources.Quota(orgQuota.Quota))
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructuredOutput(newQuotasOutput(quotas))
+	}
+
 	quotaDisplayer := shared.NewQuotaDisplayer(cmd.UI)
 	quotaDisplayer.DisplayQuotasTable(quotas, "No organization quotas found.")
 
 	return nil
 }
+
+func (OrgQuotasCommand) SupportsStructuredOutput() bool {
+	return true
+}
@@ -173,4 +173,53 @@ var _ = Describe("org-quotas command", func() {
 			Expect(testUI.Out).To(Say("No organization quotas found."))
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetOrganizationQuotasReturns(
+				[]resources.OrganizationQuota{{Quota: resources.Quota{
+					Name: "some-quota",
+					GUID: "some-quota-guid",
+					Apps: resources.AppLimit{
+						TotalMemory:       &types.NullInt{IsSet: true, Value: 2048},
+						InstanceMemory:    &types.NullInt{IsSet: false},
+						TotalAppInstances: &types.NullInt{IsSet: true, Value: 3},
+					},
+					Services: resources.ServiceLimit{
+						TotalServiceInstances: &types.NullInt{IsSet: false},
+						PaidServicePlans:      &trueValue,
+					},
+					Routes: resources.RouteLimit{
+						TotalRoutes:        &types.NullInt{IsSet: true, Value: 4},
+						TotalReservedPorts: &types.NullInt{IsSet: true, Value: 0},
+					},
+				}}},
+				v7action.Warnings{"get-quotas-warning"},
+				nil)
+		})
+
+		It("renders the quotas as JSON, with unlimited values as null", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(string(out.Contents())).To(MatchJSON(`[{
+				"name": "some-quota",
+				"guid": "some-quota-guid",
+				"total_memory_in_mb": 2048,
+				"instance_memory_in_mb": null,
+				"routes": 4,
+				"service_instances": null,
+				"paid_service_plans": true,
+				"app_instances": 3,
+				"route_ports": 0
+			}]`))
+			Expect(testUI.Err).To(Say("get-quotas-warning"))
+		})
+	})
 })
@@ -45,11 +45,31 @@ func (cmd *OrgUsersCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructuredOutput(newUsersByRoleOutput(cmd.orgUsersByRoleLabel(orgUsersByRoleType)))
+	}
+
 	cmd.displayOrgUsers(orgUsersByRoleType)
 
 	return nil
 }
 
+func (OrgUsersCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
+func (cmd OrgUsersCommand) orgUsersByRoleLabel(orgUsersByRoleType map[constant.RoleType][]resources.User) map[string][]resources.User {
+	if cmd.AllUsers {
+		return map[string][]resources.User{"ORG USERS": getUniqueUsers(orgUsersByRoleType)}
+	}
+
+	return map[string][]resources.User{
+		"ORG MANAGER":     orgUsersByRoleType[constant.OrgManagerRole],
+		"BILLING MANAGER": orgUsersByRoleType[constant.OrgBillingManagerRole],
+		"ORG AUDITOR":     orgUsersByRoleType[constant.OrgAuditorRole],
+	}
+}
+
 func (cmd OrgUsersCommand) displayOrgUsers(orgUsersByRoleType map[constant.RoleType][]resources.User) {
 	if cmd.AllUsers {
 		cmd.displayRoleGroup(getUniqueUsers(orgUsersByRoleType), "ORG USERS")
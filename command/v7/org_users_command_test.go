@@ -266,4 +266,51 @@ var _ = Describe("org-users Command", func() {
 			})
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetOrgUsersByRoleTypeReturns(
+				map[constant.RoleType][]resources.User{
+					constant.OrgUserRole:    {{GUID: "user-guid-1", Username: "user", PresentationName: "user"}},
+					constant.OrgManagerRole: {{GUID: "user-guid-2", Username: "manager", PresentationName: "manager", Origin: "uaa"}},
+				},
+				v7action.Warnings{"get-users-warning"},
+				nil)
+		})
+
+		It("renders the users by role as JSON on stdout and everything else on stderr", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(string(out.Contents())).To(MatchJSON(`{
+				"org_manager": [{"guid": "user-guid-2", "username": "manager", "presentation_name": "manager", "origin": "uaa"}],
+				"billing_manager": [],
+				"org_auditor": []
+			}`))
+			Expect(testUI.Err).To(Say("get-users-warning"))
+		})
+
+		When("--all-users is passed", func() {
+			BeforeEach(func() {
+				cmd.AllUsers = true
+			})
+
+			It("renders every user in the org once", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(string(out.Contents())).To(MatchJSON(`{
+					"org_users": [
+						{"guid": "user-guid-2", "username": "manager", "presentation_name": "manager", "origin": "uaa"},
+						{"guid": "user-guid-1", "username": "user", "presentation_name": "user", "origin": "client"}
+					]
+				}`))
+			})
+		})
+	})
 })
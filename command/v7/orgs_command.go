@@ -35,6 +35,14 @@ func (cmd OrgsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		output := []namedResourceOutput{}
+		for _, org := range orgs {
+			output = append(output, namedResourceOutput{Name: org.Name, GUID: org.GUID, Labels: newLabelsOutput(org.Metadata)})
+		}
+		return cmd.UI.DisplayStructuredOutput(output)
+	}
+
 	if len(orgs) == 0 {
 		cmd.UI.DisplayText("No orgs found.")
 	} else {
@@ -44,6 +52,10 @@ func (cmd OrgsCommand) Execute(args []string) error {
 	return nil
 }
 
+func (OrgsCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd OrgsCommand) displayOrgs(orgs []resources.Organization) {
 	table := [][]string{{cmd.UI.TranslateText("name")}}
 	for _, org := range orgs {
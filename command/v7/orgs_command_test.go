@@ -9,6 +9,7 @@ import (
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
@@ -161,4 +162,51 @@ var _ = Describe("orgs Command", func() {
 			})
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		})
+
+		When("there are orgs", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationsReturns(
+					[]resources.Organization{
+						{Name: "org-1", GUID: "org-guid-1", Metadata: &resources.Metadata{Labels: map[string]types.NullString{"env": types.NewNullString("prod")}}},
+						{Name: "org-2", GUID: "org-guid-2"},
+					},
+					v7action.Warnings{"get-orgs-warning"},
+					nil)
+			})
+
+			It("renders the orgs as JSON on stdout and everything else on stderr", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(string(out.Contents())).To(MatchJSON(`[
+					{"name": "org-1", "guid": "org-guid-1", "labels": {"env": "prod"}},
+					{"name": "org-2", "guid": "org-guid-2"}
+				]`))
+				Expect(testUI.Err).To(Say(`Getting orgs as some-user\.\.\.`))
+				Expect(testUI.Err).To(Say("get-orgs-warning"))
+			})
+		})
+
+		When("there are no orgs", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationsReturns([]resources.Organization{}, nil, nil)
+			})
+
+			It("renders an empty list", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(string(out.Contents())).To(MatchJSON(`[]`))
+			})
+		})
+	})
 })
@@ -41,6 +41,18 @@ func (cmd PackagesCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		output := []packageOutput{}
+		for _, pkg := range packages {
+			output = append(output, packageOutput{
+				GUID:      pkg.GUID,
+				State:     string(pkg.State),
+				CreatedAt: pkg.CreatedAt,
+			})
+		}
+		return cmd.UI.DisplayStructuredOutput(output)
+	}
+
 	if len(packages) == 0 {
 		cmd.UI.DisplayText("No packages found.")
 		return nil
@@ -77,3 +89,7 @@ func (cmd PackagesCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (PackagesCommand) SupportsStructuredOutput() bool {
+	return true
+}
@@ -173,4 +173,32 @@ var _ = Describe("packages Command", func() {
 			Expect(testUI.Err).To(Say("warning-2"))
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetApplicationPackagesReturns(
+				[]resources.Package{
+					{GUID: "some-package-guid-1", State: constant.PackageReady, CreatedAt: "2017-08-14T21:16:42Z"},
+				},
+				v7action.Warnings{"warning-1"},
+				nil)
+		})
+
+		It("renders the packages as JSON", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(string(out.Contents())).To(MatchJSON(`[
+				{"guid": "some-package-guid-1", "state": "READY", "created_at": "2017-08-14T21:16:42Z"}
+			]`))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+
 })
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/cloudfoundry/bosh-cli/director/template"
@@ -43,6 +44,9 @@ type PushActor interface {
 	CreatePushPlans(spaceGUID string, orgGUID string, manifest manifestparser.Manifest, overrides v7pushaction.FlagOverrides) ([]v7pushaction.PushPlan, v7action.Warnings, error)
 	// Actualize applies any necessary changes.
 	Actualize(plan v7pushaction.PushPlan, progressBar v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent
+	// ActualizeAll applies the changes of several plans concurrently, in the
+	// order given by their dependencies.
+	ActualizeAll(plans []v7pushaction.PushPlan, maxInFlight int, progressBar v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent
 }
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . V7ActorForPush
@@ -51,6 +55,7 @@ type V7ActorForPush interface {
 	GetApplicationByNameAndSpace(name string, spaceGUID string) (resources.Application, v7action.Warnings, error)
 	GetDetailedAppSummary(appName string, spaceGUID string, withObfuscatedValues bool) (v7action.DetailedApplicationSummary, v7action.Warnings, error)
 	SetSpaceManifest(spaceGUID string, rawManifest []byte) (v7action.Warnings, error)
+	SetRouteDestinationWeightsByAppName(routePath string, spaceGUID string, weights map[string]int) (v7action.Warnings, error)
 	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)
 	RestartApplication(appGUID string, noWait bool) (v7action.Warnings, error)
 }
@@ -88,22 +93,24 @@ type PushCommand struct {
 	HealthCheckHTTPEndpoint string                              `long:"endpoint"  description:"Valid path on the app for an HTTP health check. Only used when specifying --health-check-type=http"`
 	HealthCheckType         flag.HealthCheckType                `long:"health-check-type" short:"u" description:"Application health check type. Defaults to 'port'. 'http' requires a valid endpoint, for example, '/health'."`
 	Instances               flag.Instances                      `long:"instances" short:"i" description:"Number of instances"`
+	InstanceSteps           flag.InstanceSteps                  `long:"instance-steps" description:"An array of percentage steps to deploy when using deployment strategy canary. (e.g. 20,40,60)"`
 	PathToManifest          flag.ManifestPathWithExistenceCheck `long:"manifest" short:"f" description:"Path to manifest"`
 	Memory                  string                              `long:"memory" short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
 	NoManifest              bool                                `long:"no-manifest" description:"Ignore manifest file"`
 	NoRoute                 bool                                `long:"no-route" description:"Do not map a route to this app"`
 	NoStart                 bool                                `long:"no-start" description:"Do not stage and start the app after pushing"`
 	NoWait                  bool                                `long:"no-wait" description:"Exit when the first instance of the web process is healthy"`
+	Parallel                flag.PositiveInteger                `long:"parallel" description:"Number of apps from the manifest to push at the same time; apps are pushed after the apps listed in their depends-on. Staging logs are not displayed when greater than 1 (Default: 1)"`
 	AppPath                 flag.PathWithExistenceCheck         `long:"path" short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
 	RandomRoute             bool                                `long:"random-route" description:"Create a random route for this app (except when no-route is specified in the manifest)"`
 	Stack                   string                              `long:"stack" short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
 	StartCommand            flag.Command                        `long:"start-command" short:"c" description:"Startup command, set to null to reset to default start command"`
-	Strategy                flag.DeploymentStrategy             `long:"strategy" description:"Deployment strategy, either rolling or null."`
+	Strategy                flag.DeploymentStrategy             `long:"strategy" description:"Deployment strategy can be canary, rolling or null."`
 	Task                    bool                                `long:"task" description:"Push an app that is used only to execute tasks. The app will be staged, but not started and will have no route assigned."`
 	Vars                    []template.VarKV                    `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
 	PathsToVarsFiles        []flag.PathWithExistenceCheck       `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
 	dockerPassword          interface{}                         `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
-	usage                   interface{}                         `usage:"CF_NAME push APP_NAME [-b BUILDPACK_NAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n \n   CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route ]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]..."`
+	usage                   interface{}                         `usage:"CF_NAME push APP_NAME [-b BUILDPACK_NAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [--parallel NUM_APPS] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n \n   CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [--parallel NUM_APPS] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route ]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]..."`
 	envCFStagingTimeout     interface{}                         `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout     interface{}                         `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 
@@ -127,7 +134,9 @@ func (cmd *PushCommand) Setup(config command.Config, ui command.UI) error {
 
 	cmd.ProgressBar = progressbar.NewProgressBar()
 	cmd.VersionActor = cmd.Actor
-	cmd.PushActor = v7pushaction.NewActor(cmd.Actor, sharedaction.NewActor(config))
+	pushActor := v7pushaction.NewActor(cmd.Actor, sharedaction.NewActor(config))
+	pushActor.PackageCache = sharedaction.NewPackageCache(config.PackageCacheDir())
+	cmd.PushActor = pushActor
 
 	cmd.LogCacheClient, err = logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
 	if err != nil {
@@ -181,6 +190,11 @@ func (cmd PushCommand) Execute(args []string) error {
 		return err
 	}
 
+	routeWeights, err := transformedManifest.ExtractRouteWeights()
+	if err != nil {
+		return err
+	}
+
 	transformedRawManifest, err := cmd.ManifestParser.MarshalManifest(transformedManifest)
 	if err != nil {
 		return err
@@ -229,6 +243,11 @@ func (cmd PushCommand) Execute(args []string) error {
 		cmd.UI.DisplayText("Manifest applied")
 	}
 
+	err = setManifestRouteWeights(cmd.UI, cmd.VersionActor, cmd.Config.TargetedSpace().GUID, routeWeights)
+	if err != nil {
+		return err
+	}
+
 	pushPlans, warnings, err := cmd.PushActor.CreatePushPlans(
 		cmd.Config.TargetedSpace().GUID,
 		cmd.Config.TargetedOrganization().GUID,
@@ -247,6 +266,10 @@ func (cmd PushCommand) Execute(args []string) error {
 		}
 	}()
 
+	if cmd.Parallel.Value > 1 && len(pushPlans) > 1 {
+		return cmd.actualizeInParallel(pushPlans)
+	}
+
 	for _, plan := range pushPlans {
 		log.WithField("app_name", plan.Application.Name).Info("actualizing")
 		eventStream := cmd.PushActor.Actualize(plan, cmd.ProgressBar)
@@ -353,6 +376,7 @@ func (cmd PushCommand) GetFlagOverrides() (v7pushaction.FlagOverrides, error) {
 		RandomRoute:         cmd.RandomRoute,
 		StartCommand:        cmd.StartCommand.FilteredString,
 		Strategy:            cmd.Strategy.Name,
+		InstanceSteps:       cmd.InstanceSteps.Weights,
 		ManifestPath:        string(cmd.PathToManifest),
 		PathsToVarsFiles:    pathsToVarsFiles,
 		Vars:                cmd.Vars,
@@ -433,22 +457,28 @@ func (cmd PushCommand) ValidateFlags() error {
 			},
 		}
 
-	case cmd.NoStart && cmd.Strategy == flag.DeploymentStrategy{Name: constant.DeploymentStrategyRolling}:
+	case cmd.NoStart && cmd.Strategy.Name != constant.DeploymentStrategyDefault:
 		return translatableerror.ArgumentCombinationError{
 			Args: []string{
 				"--no-start",
-				"--strategy=rolling",
+				"--strategy=" + string(cmd.Strategy.Name),
 			},
 		}
 
-	case cmd.Task && cmd.Strategy == flag.DeploymentStrategy{Name: constant.DeploymentStrategyRolling}:
+	case cmd.Task && cmd.Strategy.Name != constant.DeploymentStrategyDefault:
 		return translatableerror.ArgumentCombinationError{
 			Args: []string{
 				"--task",
-				"--strategy=rolling",
+				"--strategy=" + string(cmd.Strategy.Name),
 			},
 		}
 
+	case len(cmd.InstanceSteps.Weights) > 0 && cmd.Strategy.Name != constant.DeploymentStrategyCanary:
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--instance-steps",
+			Arg2: "--strategy=canary",
+		}
+
 	case cmd.NoStart && cmd.NoWait:
 		return translatableerror.ArgumentCombinationError{
 			Args: []string{
@@ -552,10 +582,127 @@ func (cmd *PushCommand) eventStreamHandler(eventStream <-chan *v7pushaction.Push
 	return nil
 }
 
+func (cmd PushCommand) actualizeInParallel(pushPlans []v7pushaction.PushPlan) error {
+	log.WithField("parallel", cmd.Parallel.Value).Info("actualizing in parallel")
+
+	width := 0
+	for _, plan := range pushPlans {
+		if len(plan.Application.Name) > width {
+			width = len(plan.Application.Name)
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Pushing up to {{.Parallel}} apps at a time...", map[string]interface{}{
+		"Parallel": cmd.Parallel.Value,
+	})
+
+	var (
+		pushErr      error
+		failedApp    string
+		showsSummary = map[string]bool{}
+	)
+
+	eventStream := cmd.PushActor.ActualizeAll(pushPlans, int(cmd.Parallel.Value), progressbar.SilentProgressBar{})
+	for event := range eventStream {
+		appName := event.Plan.Application.Name
+		prefix := fmt.Sprintf("%-*s | ", width, appName)
+
+		for _, warning := range event.Warnings {
+			cmd.UI.DisplayWarning("{{.Prefix}}{{.Warning}}", map[string]interface{}{
+				"Prefix":  prefix,
+				"Warning": cmd.UI.TranslateText(warning),
+			})
+		}
+
+		if event.Err != nil {
+			cmd.UI.DisplayText("{{.Prefix}}{{.Message}}", map[string]interface{}{
+				"Prefix":  prefix,
+				"Message": cmd.UI.TranslateText("Push failed"),
+			})
+			showsSummary[appName] = cmd.shouldDisplaySummary(event.Err)
+			if pushErr == nil {
+				pushErr = event.Err
+				failedApp = appName
+			}
+			continue
+		}
+
+		if event.Event == v7pushaction.ActualizeComplete {
+			showsSummary[appName] = true
+		}
+
+		message, ok := parallelEventMessage(event.Event)
+		if !ok {
+			log.WithField("event", event.Event).Debug("ignoring event")
+			continue
+		}
+		cmd.UI.DisplayText("{{.Prefix}}{{.Message}}", map[string]interface{}{
+			"Prefix":  prefix,
+			"Message": cmd.UI.TranslateText(message),
+		})
+	}
+
+	for _, plan := range pushPlans {
+		if !showsSummary[plan.Application.Name] {
+			continue
+		}
+		err := cmd.displayAppSummary(plan)
+		if err != nil {
+			return err
+		}
+	}
+
+	if pushErr != nil {
+		return cmd.mapErr(failedApp, pushErr)
+	}
+	return nil
+}
+
+// parallelEventMessage returns the line displayed for an event when several
+// apps are pushed at the same time.
+func parallelEventMessage(event v7pushaction.Event) (string, bool) {
+	switch event {
+	case v7pushaction.CreatingArchive:
+		return "Packaging files to upload...", true
+	case v7pushaction.UsingCachedArchive:
+		return "Reusing files packaged by a previous push...", true
+	case v7pushaction.UploadingApplicationWithArchive:
+		return "Uploading files...", true
+	case v7pushaction.UploadingApplication:
+		return "All files found in remote cache; nothing to upload.", true
+	case v7pushaction.RetryUpload:
+		return "Retrying upload due to an error...", true
+	case v7pushaction.UploadingDroplet:
+		return "Uploading droplet bits...", true
+	case v7pushaction.UploadWithArchiveComplete, v7pushaction.UploadDropletComplete:
+		return "Waiting for API to complete processing files...", true
+	case v7pushaction.StoppingApplication:
+		return "Stopping Application...", true
+	case v7pushaction.StoppingApplicationComplete:
+		return "Application Stopped", true
+	case v7pushaction.StartingStaging:
+		return "Staging app...", true
+	case v7pushaction.StagingComplete:
+		return "Staging complete", true
+	case v7pushaction.RestartingApplication:
+		return "Waiting for app to start...", true
+	case v7pushaction.StartingDeployment:
+		return "Starting deployment...", true
+	case v7pushaction.WaitingForDeployment:
+		return "Waiting for app to deploy...", true
+	case v7pushaction.ActualizeComplete:
+		return "Push complete", true
+	}
+	return "", false
+}
+
 func (cmd *PushCommand) processEvent(event v7pushaction.Event, appName string) error {
 	switch event {
 	case v7pushaction.CreatingArchive:
 		cmd.UI.DisplayText("Packaging files to upload...")
+	case v7pushaction.UsingCachedArchive:
+		cmd.UI.DisplayText("Reusing files packaged by a previous push...")
 	case v7pushaction.UploadingApplicationWithArchive:
 		cmd.UI.DisplayText("Uploading files...")
 		log.Debug("starting progress bar")
@@ -564,6 +711,8 @@ func (cmd *PushCommand) processEvent(event v7pushaction.Event, appName string) e
 		cmd.UI.DisplayText("All files found in remote cache; nothing to upload.")
 		cmd.UI.DisplayText("Waiting for API to complete processing files...")
 	case v7pushaction.RetryUpload:
+		cmd.ProgressBar.Complete()
+		cmd.UI.DisplayNewline()
 		cmd.UI.DisplayText("Retrying upload due to an error...")
 	case v7pushaction.UploadWithArchiveComplete:
 		cmd.ProgressBar.Complete()
@@ -629,6 +778,33 @@ func (cmd *PushCommand) processEvent(event v7pushaction.Event, appName string) e
 	return nil
 }
 
+type routeWeightSetter interface {
+	SetRouteDestinationWeightsByAppName(routePath string, spaceGUID string, weights map[string]int) (v7action.Warnings, error)
+}
+
+// setManifestRouteWeights sets the route weights given in a manifest once
+// the manifest has mapped the routes to its apps.
+func setManifestRouteWeights(ui command.UI, actor routeWeightSetter, spaceGUID string, routeWeights map[string]map[string]int) error {
+	routes := make([]string, 0, len(routeWeights))
+	for route := range routeWeights {
+		routes = append(routes, route)
+	}
+	sort.Strings(routes)
+
+	for _, route := range routes {
+		ui.DisplayText("Setting weights for route {{.Route}}...", map[string]interface{}{
+			"Route": route,
+		})
+		warnings, err := actor.SetRouteDestinationWeightsByAppName(route, spaceGUID, routeWeights[route])
+		ui.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (cmd PushCommand) getLogs(logStream <-chan sharedaction.LogMessage, errStream <-chan error) {
 	for {
 		select {
@@ -331,6 +331,50 @@ var _ = Describe("push Command", func() {
 								Expect(actualManifestBytes).To(Equal([]byte("our-manifest")))
 							})
 
+							When("the manifest gives route weights", func() {
+								BeforeEach(func() {
+									fakeActor.HandleFlagOverridesReturns(
+										manifestparser.Manifest{
+											Applications: []manifestparser.Application{{
+												Name: "some-app-name",
+												RemainingManifestFields: map[string]interface{}{
+													"routes": []interface{}{
+														map[interface{}]interface{}{"route": "canary.example.com", "weight": 90},
+													},
+												},
+											}},
+										},
+										nil,
+									)
+									fakeVersionActor.SetRouteDestinationWeightsByAppNameReturns(v7action.Warnings{"weights-warning"}, nil)
+								})
+
+								It("applies the manifest without the weights and then sets them", func() {
+									Expect(fakeManifestParser.MarshalManifestArgsForCall(0).Applications[0].RemainingManifestFields["routes"]).To(Equal([]interface{}{
+										map[interface{}]interface{}{"route": "canary.example.com"},
+									}))
+
+									Expect(testUI.Out).To(Say("Setting weights for route canary.example.com..."))
+									Expect(testUI.Err).To(Say("weights-warning"))
+									Expect(fakeVersionActor.SetRouteDestinationWeightsByAppNameCallCount()).To(Equal(1))
+									route, spaceGUID, weights := fakeVersionActor.SetRouteDestinationWeightsByAppNameArgsForCall(0)
+									Expect(route).To(Equal("canary.example.com"))
+									Expect(spaceGUID).To(Equal("some-space-guid"))
+									Expect(weights).To(Equal(map[string]int{"some-app-name": 90}))
+								})
+
+								When("setting the weights fails", func() {
+									BeforeEach(func() {
+										fakeVersionActor.SetRouteDestinationWeightsByAppNameReturns(v7action.Warnings{"weights-warning"}, errors.New("weights-error"))
+									})
+
+									It("returns the error without pushing", func() {
+										Expect(executeErr).To(MatchError("weights-error"))
+										Expect(fakeActor.CreatePushPlansCallCount()).To(Equal(0))
+									})
+								})
+							})
+
 							When("the manifest is successfully parsed", func() {
 								var expectedDiff resources.ManifestDiff
 
@@ -520,7 +564,7 @@ var _ = Describe("push Command", func() {
 													Expect(executeErr).ToNot(HaveOccurred())
 
 													Expect(fakeProgressBar.ReadyCallCount()).Should(Equal(2))
-													Expect(fakeProgressBar.CompleteCallCount()).Should(Equal(2))
+													Expect(fakeProgressBar.CompleteCallCount()).Should(Equal(4))
 
 													Expect(testUI.Out).To(Say("Packaging files to upload..."))
 
@@ -552,6 +596,25 @@ var _ = Describe("push Command", func() {
 												})
 											})
 
+											When("an archive from a previous push is reused", func() {
+												BeforeEach(func() {
+													fakeActor.ActualizeStub = func(pushPlan v7pushaction.PushPlan, _ v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+														return FillInEvents([]Step{
+															{Plan: pushPlan, Event: v7pushaction.UsingCachedArchive},
+															{Plan: pushPlan, Event: v7pushaction.UploadingApplicationWithArchive},
+															{Plan: pushPlan, Event: v7pushaction.UploadWithArchiveComplete},
+														})
+													}
+												})
+
+												It("tells the user that the files were not packaged again", func() {
+													Expect(executeErr).ToNot(HaveOccurred())
+													Expect(testUI.Out).To(Say("Reusing files packaged by a previous push..."))
+													Expect(testUI.Out).To(Say("Uploading files..."))
+													Expect(testUI.Out).ToNot(Say("Packaging files to upload..."))
+												})
+											})
+
 											Describe("staging logs", func() {
 												BeforeEach(func() {
 													fakeActor.ActualizeStub = func(pushPlan v7pushaction.PushPlan, _ v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
@@ -718,6 +781,82 @@ var _ = Describe("push Command", func() {
 												})
 											})
 										})
+										When("--parallel is greater than 1", func() {
+											var (
+												firstPlan  v7pushaction.PushPlan
+												secondPlan v7pushaction.PushPlan
+											)
+
+											BeforeEach(func() {
+												cmd.Parallel = flag.PositiveInteger{Value: 4}
+												firstPlan = v7pushaction.PushPlan{Application: resources.Application{Name: "first-app", GUID: "potato"}}
+												secondPlan = v7pushaction.PushPlan{Application: resources.Application{Name: "second-app", GUID: "potato"}}
+
+												fakeActor.ActualizeAllStub = func([]v7pushaction.PushPlan, int, v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+													return FillInEvents([]Step{
+														{Plan: firstPlan, Event: v7pushaction.CreatingArchive},
+														{Plan: secondPlan, Event: v7pushaction.UploadingApplicationWithArchive, Warnings: v7pushaction.Warnings{"upload warning"}},
+														{Plan: firstPlan, Event: v7pushaction.StartingStaging},
+														{Plan: secondPlan, Event: v7pushaction.RestartingApplication},
+														{Plan: secondPlan, Event: v7pushaction.ActualizeComplete},
+														{Plan: firstPlan, Event: v7pushaction.ActualizeComplete},
+													})
+												}
+											})
+
+											It("pushes the apps concurrently and displays their events grouped by app", func() {
+												Expect(executeErr).ToNot(HaveOccurred())
+
+												Expect(fakeActor.ActualizeCallCount()).To(Equal(0))
+												Expect(fakeActor.ActualizeAllCallCount()).To(Equal(1))
+												plans, maxInFlight, _ := fakeActor.ActualizeAllArgsForCall(0)
+												Expect(plans).To(Equal([]v7pushaction.PushPlan{firstPlan, secondPlan}))
+												Expect(maxInFlight).To(Equal(4))
+
+												Expect(testUI.Out).To(Say(`Pushing up to 4 apps at a time\.\.\.`))
+												Expect(testUI.Out).To(Say(`first-app  \| Packaging files to upload\.\.\.`))
+												Expect(testUI.Out).To(Say(`second-app \| Uploading files\.\.\.`))
+												Expect(testUI.Err).To(Say(`second-app \| upload warning`))
+												Expect(testUI.Out).To(Say(`first-app  \| Staging app\.\.\.`))
+												Expect(testUI.Out).To(Say(`second-app \| Waiting for app to start\.\.\.`))
+												Expect(testUI.Out).To(Say(`second-app \| Push complete`))
+												Expect(testUI.Out).To(Say(`first-app  \| Push complete`))
+
+												Expect(fakeProgressBar.ReadyCallCount()).To(Equal(0))
+												Expect(fakeVersionActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+											})
+
+											It("displays the app summaries in plan order", func() {
+												Expect(fakeVersionActor.GetDetailedAppSummaryCallCount()).To(Equal(2))
+												appName, _, _ := fakeVersionActor.GetDetailedAppSummaryArgsForCall(0)
+												Expect(appName).To(Equal("first-app"))
+												appName, _, _ = fakeVersionActor.GetDetailedAppSummaryArgsForCall(1)
+												Expect(appName).To(Equal("second-app"))
+											})
+
+											When("an app fails to push", func() {
+												BeforeEach(func() {
+													fakeActor.ActualizeAllStub = func([]v7pushaction.PushPlan, int, v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+														return FillInEvents([]Step{
+															{Plan: secondPlan, Error: actionerror.StartupTimeoutError{}},
+															{Plan: firstPlan, Event: v7pushaction.ActualizeComplete},
+														})
+													}
+												})
+
+												It("displays the summary of the apps that were pushed and returns the error", func() {
+													Expect(executeErr).To(MatchError(translatableerror.StartupTimeoutError{
+														AppName:    "second-app",
+														BinaryName: binaryName,
+													}))
+													Expect(testUI.Out).To(Say(`second-app \| Push failed`))
+
+													Expect(fakeVersionActor.GetDetailedAppSummaryCallCount()).To(Equal(1))
+													appName, _, _ := fakeVersionActor.GetDetailedAppSummaryArgsForCall(0)
+													Expect(appName).To(Equal("first-app"))
+												})
+											})
+										})
 									})
 								})
 							})
@@ -1072,7 +1211,8 @@ var _ = Describe("push Command", func() {
 			cmd.RandomRoute = false
 			cmd.NoStart = true
 			cmd.NoWait = true
-			cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyRolling}
+			cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyCanary}
+			cmd.InstanceSteps = flag.InstanceSteps{Weights: []int64{20, 60}}
 			cmd.Instances = flag.Instances{NullInt: types.NullInt{Value: 10, IsSet: true}}
 			cmd.PathToManifest = "/manifest/path"
 			cmd.PathsToVarsFiles = []flag.PathWithExistenceCheck{"/vars1", "/vars2"}
@@ -1100,7 +1240,8 @@ var _ = Describe("push Command", func() {
 			Expect(overrides.NoStart).To(BeTrue())
 			Expect(overrides.NoWait).To(BeTrue())
 			Expect(overrides.RandomRoute).To(BeFalse())
-			Expect(overrides.Strategy).To(Equal(constant.DeploymentStrategyRolling))
+			Expect(overrides.Strategy).To(Equal(constant.DeploymentStrategyCanary))
+			Expect(overrides.InstanceSteps).To(Equal([]int64{20, 60}))
 			Expect(overrides.Instances).To(Equal(types.NullInt{Value: 10, IsSet: true}))
 			Expect(overrides.ManifestPath).To(Equal("/manifest/path"))
 			Expect(overrides.PathsToVarsFiles).To(Equal([]string{"/vars1", "/vars2"}))
@@ -1216,6 +1357,34 @@ var _ = Describe("push Command", func() {
 				},
 			}),
 
+		Entry("when strategy 'canary' and no-start flags are passed",
+			func() {
+				cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyCanary}
+				cmd.NoStart = true
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{
+					"--no-start", "--strategy=canary",
+				},
+			}),
+
+		Entry("when instance-steps is passed without strategy 'canary'",
+			func() {
+				cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyRolling}
+				cmd.InstanceSteps = flag.InstanceSteps{Weights: []int64{20, 60}}
+			},
+			translatableerror.RequiredFlagsError{
+				Arg1: "--instance-steps",
+				Arg2: "--strategy=canary",
+			}),
+
+		Entry("when instance-steps is passed with strategy 'canary'",
+			func() {
+				cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyCanary}
+				cmd.InstanceSteps = flag.InstanceSteps{Weights: []int64{20, 60}}
+			},
+			nil),
+
 		Entry("when strategy is not set and no-start flags are passed",
 			func() {
 				cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyDefault}
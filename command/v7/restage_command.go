@@ -9,15 +9,17 @@ import (
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/resources"
 )
 
 type RestageCommand struct {
 	BaseCommand
 
 	RequiredArgs        flag.AppName            `positional-args:"yes"`
-	Strategy            flag.DeploymentStrategy `long:"strategy" description:"Deployment strategy, either rolling or null."`
+	Strategy            flag.DeploymentStrategy `long:"strategy" description:"Deployment strategy can be canary, rolling or null."`
+	InstanceSteps       flag.InstanceSteps      `long:"instance-steps" description:"An array of percentage steps to deploy when using deployment strategy canary. (e.g. 20,40,60)"`
 	NoWait              bool                    `long:"no-wait" description:"Exit when the first instance of the web process is healthy"`
-	usage               interface{}             `usage:"CF_NAME restage APP_NAME\n\n   This command will cause downtime unless you use '--strategy rolling'.\n\nEXAMPLES:\n   CF_NAME restage APP_NAME\n   CF_NAME restage APP_NAME --strategy rolling\n   CF_NAME restage APP_NAME --strategy rolling --no-wait"`
+	usage               interface{}             `usage:"CF_NAME restage APP_NAME\n\n   This command will cause downtime unless you use '--strategy rolling' or '--strategy canary'.\n\nEXAMPLES:\n   CF_NAME restage APP_NAME\n   CF_NAME restage APP_NAME --strategy rolling\n   CF_NAME restage APP_NAME --strategy rolling --no-wait\n   CF_NAME restage APP_NAME --strategy canary --instance-steps 20,50"`
 	relatedCommands     interface{}             `related_commands:"restart"`
 	envCFStagingTimeout interface{}             `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{}             `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
@@ -41,6 +43,17 @@ func (cmd *RestageCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (cmd RestageCommand) ValidateFlags() error {
+	if len(cmd.InstanceSteps.Weights) > 0 && cmd.Strategy.Name != constant.DeploymentStrategyCanary {
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--instance-steps",
+			Arg2: "--strategy=canary",
+		}
+	}
+
+	return nil
+}
+
 func (cmd RestageCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
@@ -52,7 +65,12 @@ func (cmd RestageCommand) Execute(args []string) error {
 		return err
 	}
 
-	if cmd.Strategy.Name != constant.DeploymentStrategyRolling {
+	err = cmd.ValidateFlags()
+	if err != nil {
+		return err
+	}
+
+	if cmd.Strategy.Name == constant.DeploymentStrategyDefault {
 		cmd.UI.DisplayWarning("This action will cause app downtime.")
 	}
 
@@ -82,9 +100,12 @@ func (cmd RestageCommand) Execute(args []string) error {
 		cmd.Config.TargetedSpace(),
 		cmd.Config.TargetedOrganization(),
 		pkg.GUID,
-		cmd.Strategy.Name,
-		cmd.NoWait,
-		constant.ApplicationRestarting,
+		shared.AppStartOpts{
+			Strategy:    cmd.Strategy.Name,
+			NoWait:      cmd.NoWait,
+			AppAction:   constant.ApplicationRestarting,
+			CanarySteps: resources.NewCanarySteps(cmd.InstanceSteps.Weights),
+		},
 	)
 	if err != nil {
 		return mapErr(cmd.Config, cmd.RequiredArgs.AppName, err)
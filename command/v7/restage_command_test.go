@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	v7 "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/shared/sharedfakes"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
@@ -114,6 +115,38 @@ var _ = Describe("restage Command", func() {
 		})
 	})
 
+	When("it's a canary deploy", func() {
+		BeforeEach(func() {
+			cmd.Strategy.Name = constant.DeploymentStrategyCanary
+			cmd.InstanceSteps = flag.InstanceSteps{Weights: []int64{25, 50}}
+		})
+
+		It("does not warn about app downtime", func() {
+			Expect(testUI.Err).NotTo(Say("This action will cause app downtime."))
+		})
+
+		It("stages and starts the app with the instance steps", func() {
+			Expect(fakeAppStager.StageAndStartCallCount()).To(Equal(1))
+			_, _, _, _, opts := fakeAppStager.StageAndStartArgsForCall(0)
+			Expect(opts.Strategy).To(Equal(constant.DeploymentStrategyCanary))
+			Expect(opts.CanarySteps).To(Equal([]resources.CanaryStep{{InstanceWeight: 25}, {InstanceWeight: 50}}))
+		})
+	})
+
+	When("instance steps are provided without the canary strategy", func() {
+		BeforeEach(func() {
+			cmd.InstanceSteps = flag.InstanceSteps{Weights: []int64{25, 50}}
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{
+				Arg1: "--instance-steps",
+				Arg2: "--strategy=canary",
+			}))
+			Expect(fakeAppStager.StageAndStartCallCount()).To(Equal(0))
+		})
+	})
+
 	It("displays that it's restaging", func() {
 		Expect(testUI.Out).To(Say("Restaging app some-app in org some-org / space some-space as steve..."))
 	})
@@ -165,14 +198,14 @@ var _ = Describe("restage Command", func() {
 
 	It("stages and starts the app", func() {
 		Expect(fakeAppStager.StageAndStartCallCount()).To(Equal(1))
-		returnedApp, spaceForApp, orgForApp, pkgGUID, strategy, noWait, appAction := fakeAppStager.StageAndStartArgsForCall(0)
+		returnedApp, spaceForApp, orgForApp, pkgGUID, opts := fakeAppStager.StageAndStartArgsForCall(0)
 		Expect(returnedApp).To(Equal(app))
 		Expect(spaceForApp).To(Equal(fakeConfig.TargetedSpace()))
 		Expect(orgForApp).To(Equal(fakeConfig.TargetedOrganization()))
 		Expect(pkgGUID).To(Equal("earliest-package-guid"))
-		Expect(strategy).To(Equal(constant.DeploymentStrategyDefault))
-		Expect(noWait).To(Equal(false))
-		Expect(appAction).To(Equal(constant.ApplicationRestarting))
+		Expect(opts.Strategy).To(Equal(constant.DeploymentStrategyDefault))
+		Expect(opts.NoWait).To(Equal(false))
+		Expect(opts.AppAction).To(Equal(constant.ApplicationRestarting))
 	})
 
 	When("staging and starting the app fails", func() {
@@ -6,16 +6,19 @@ import (
 	"code.cloudfoundry.org/cli/api/logcache"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/resources"
 )
 
 type RestartCommand struct {
 	BaseCommand
 
 	RequiredArgs        flag.AppName            `positional-args:"yes"`
-	Strategy            flag.DeploymentStrategy `long:"strategy" description:"Deployment strategy, either rolling or null."`
+	Strategy            flag.DeploymentStrategy `long:"strategy" description:"Deployment strategy can be canary, rolling or null."`
+	InstanceSteps       flag.InstanceSteps      `long:"instance-steps" description:"An array of percentage steps to deploy when using deployment strategy canary. (e.g. 20,40,60)"`
 	NoWait              bool                    `long:"no-wait" description:"Exit when the first instance of the web process is healthy"`
-	usage               interface{}             `usage:"CF_NAME restart APP_NAME\n\n   This command will cause downtime unless you use '--strategy rolling'.\n\n   If the app's most recent package is unstaged, restarting the app will stage and run that package.\n   Otherwise, the app's current droplet will be run."`
+	usage               interface{}             `usage:"CF_NAME restart APP_NAME\n\n   This command will cause downtime unless you use '--strategy rolling' or '--strategy canary'.\n\n   If the app's most recent package is unstaged, restarting the app will stage and run that package.\n   Otherwise, the app's current droplet will be run."`
 	relatedCommands     interface{}             `related_commands:"restage, restart-app-instance"`
 	envCFStagingTimeout interface{}             `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{}             `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
@@ -39,6 +42,17 @@ func (cmd *RestartCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (cmd RestartCommand) ValidateFlags() error {
+	if len(cmd.InstanceSteps.Weights) > 0 && cmd.Strategy.Name != constant.DeploymentStrategyCanary {
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--instance-steps",
+			Arg2: "--strategy=canary",
+		}
+	}
+
+	return nil
+}
+
 func (cmd RestartCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
@@ -50,6 +64,11 @@ func (cmd RestartCommand) Execute(args []string) error {
 		return err
 	}
 
+	err = cmd.ValidateFlags()
+	if err != nil {
+		return err
+	}
+
 	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
@@ -62,7 +81,7 @@ func (cmd RestartCommand) Execute(args []string) error {
 		return err
 	}
 
-	if packageGUID != "" || cmd.Strategy.Name == constant.DeploymentStrategyRolling {
+	if packageGUID != "" || cmd.Strategy.Name != constant.DeploymentStrategyDefault {
 		cmd.UI.DisplayTextWithFlavor("Restarting app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
 			"AppName":   cmd.RequiredArgs.AppName,
 			"OrgName":   cmd.Config.TargetedOrganization().Name,
@@ -72,13 +91,20 @@ func (cmd RestartCommand) Execute(args []string) error {
 		cmd.UI.DisplayNewline()
 	}
 
+	opts := shared.AppStartOpts{
+		Strategy:    cmd.Strategy.Name,
+		NoWait:      cmd.NoWait,
+		AppAction:   constant.ApplicationRestarting,
+		CanarySteps: resources.NewCanarySteps(cmd.InstanceSteps.Weights),
+	}
+
 	if packageGUID != "" {
-		err = cmd.Stager.StageAndStart(app, cmd.Config.TargetedSpace(), cmd.Config.TargetedOrganization(), packageGUID, cmd.Strategy.Name, cmd.NoWait, constant.ApplicationRestarting)
+		err = cmd.Stager.StageAndStart(app, cmd.Config.TargetedSpace(), cmd.Config.TargetedOrganization(), packageGUID, opts)
 		if err != nil {
 			return err
 		}
 	} else {
-		err = cmd.Stager.StartApp(app, "", cmd.Strategy.Name, cmd.NoWait, cmd.Config.TargetedSpace(), cmd.Config.TargetedOrganization(), constant.ApplicationRestarting)
+		err = cmd.Stager.StartApp(app, "", opts, cmd.Config.TargetedSpace(), cmd.Config.TargetedOrganization())
 		if err != nil {
 			return err
 		}
@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	v7 "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/shared/sharedfakes"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
@@ -105,6 +106,37 @@ var _ = Describe("restart Command", func() {
 		})
 	})
 
+	When("instance steps are provided without the canary strategy", func() {
+		BeforeEach(func() {
+			cmd.InstanceSteps = flag.InstanceSteps{Weights: []int64{20, 60}}
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{
+				Arg1: "--instance-steps",
+				Arg2: "--strategy=canary",
+			}))
+			Expect(fakeAppStager.StartAppCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the strategy is canary", func() {
+		BeforeEach(func() {
+			cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyCanary}
+			cmd.InstanceSteps = flag.InstanceSteps{Weights: []int64{20, 60}}
+		})
+
+		It("starts the app with the canary strategy and instance steps", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Restarting app app-name in org some-org / space some-space as steve\.\.\.`))
+
+			Expect(fakeAppStager.StartAppCallCount()).To(Equal(1))
+			_, _, opts, _, _ := fakeAppStager.StartAppArgsForCall(0)
+			Expect(opts.Strategy).To(Equal(constant.DeploymentStrategyCanary))
+			Expect(opts.CanarySteps).To(Equal([]resources.CanaryStep{{InstanceWeight: 20}, {InstanceWeight: 60}}))
+		})
+	})
+
 	It("gets the application", func() {
 		Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
 		inputAppName, inputSpaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
@@ -136,14 +168,14 @@ var _ = Describe("restart Command", func() {
 			Expect(executeErr).ToNot(HaveOccurred())
 			Expect(fakeAppStager.StageAndStartCallCount()).To(Equal(1))
 
-			inputApp, inputSpace, inputOrg, inputPkgGUID, inputStrategy, inputNoWait, inputAppAction := fakeAppStager.StageAndStartArgsForCall(0)
+			inputApp, inputSpace, inputOrg, inputPkgGUID, opts := fakeAppStager.StageAndStartArgsForCall(0)
 			Expect(inputApp).To(Equal(app))
 			Expect(inputSpace).To(Equal(cmd.Config.TargetedSpace()))
 			Expect(inputOrg).To(Equal(cmd.Config.TargetedOrganization()))
 			Expect(inputPkgGUID).To(Equal("package-guid"))
-			Expect(inputStrategy).To(Equal(strategy))
-			Expect(inputNoWait).To(Equal(noWait))
-			Expect(inputAppAction).To(Equal(constant.ApplicationRestarting))
+			Expect(opts.Strategy).To(Equal(strategy))
+			Expect(opts.NoWait).To(Equal(noWait))
+			Expect(opts.AppAction).To(Equal(constant.ApplicationRestarting))
 		})
 
 		Context("staging and starting the app returns an error", func() {
@@ -166,14 +198,14 @@ var _ = Describe("restart Command", func() {
 			Expect(executeErr).ToNot(HaveOccurred())
 			Expect(fakeAppStager.StartAppCallCount()).To(Equal(1))
 
-			inputApp, inputDropletGuid, inputStrategy, inputNoWait, inputSpace, inputOrg, inputAppAction := fakeAppStager.StartAppArgsForCall(0)
+			inputApp, inputDropletGuid, opts, inputSpace, inputOrg := fakeAppStager.StartAppArgsForCall(0)
 			Expect(inputApp).To(Equal(app))
 			Expect(inputDropletGuid).To(Equal(""))
-			Expect(inputStrategy).To(Equal(strategy))
-			Expect(inputNoWait).To(Equal(noWait))
+			Expect(opts.Strategy).To(Equal(strategy))
+			Expect(opts.NoWait).To(Equal(noWait))
 			Expect(inputSpace).To(Equal(cmd.Config.TargetedSpace()))
 			Expect(inputOrg).To(Equal(cmd.Config.TargetedOrganization()))
-			Expect(inputAppAction).To(Equal(constant.ApplicationRestarting))
+			Expect(opts.AppAction).To(Equal(constant.ApplicationRestarting))
 		})
 
 		When("starting the app returns an error", func() {
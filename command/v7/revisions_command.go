@@ -83,7 +83,7 @@ func (cmd RevisionsCommand) Execute(_ []string) error {
 		return err
 	}
 
-	if len(revisions) == 0 {
+	if len(revisions) == 0 && !cmd.UI.IsStructuredOutput() {
 		cmd.UI.DisplayText("No revisions found")
 		return nil
 	}
@@ -94,6 +94,14 @@ func (cmd RevisionsCommand) Execute(_ []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		output := []revisionOutput{}
+		for _, revision := range revisions {
+			output = append(output, newRevisionOutput(revision, revisionsDeployed))
+		}
+		return cmd.UI.DisplayStructuredOutput(output)
+	}
+
 	table := [][]string{{
 		"revision",
 		"description",
@@ -122,6 +130,10 @@ func (cmd RevisionsCommand) Execute(_ []string) error {
 	return nil
 }
 
+func (RevisionsCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func decorateVersionWithDeployed(revision resources.Revision, deployedRevisions []resources.Revision) string {
 	for _, revDeployed := range deployedRevisions {
 		if revDeployed.GUID == revision.GUID {
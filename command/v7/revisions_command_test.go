@@ -309,4 +309,36 @@ No revisions found
 			})
 		})
 	})
+
+	When("structured output is requested", func() {
+		BeforeEach(func() {
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "banana"}, nil)
+			fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{GUID: "fake-guid"}, nil, nil)
+			fakeActor.GetAppFeatureReturns(resources.ApplicationFeature{Enabled: true}, nil, nil)
+			fakeActor.GetRevisionsByApplicationNameAndSpaceReturns(
+				[]resources.Revision{
+					{Version: 2, GUID: "revision-guid-2", Description: "Something else", CreatedAt: "2020-03-08T12:43:30Z", Deployable: true},
+					{Version: 1, GUID: "revision-guid-1", Description: "Something", CreatedAt: "2020-03-04T13:23:32Z"},
+				},
+				v7action.Warnings{"get-warning-1"},
+				nil)
+			fakeActor.GetApplicationRevisionsDeployedReturns([]resources.Revision{{GUID: "revision-guid-2"}}, nil, nil)
+		})
+
+		It("renders the revisions as JSON", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(string(out.Contents())).To(MatchJSON(`[
+				{"version": 2, "guid": "revision-guid-2", "description": "Something else", "deployable": true, "deployed": true, "created_at": "2020-03-08T12:43:30Z"},
+				{"version": 1, "guid": "revision-guid-1", "description": "Something", "deployable": false, "deployed": false, "created_at": "2020-03-04T13:23:32Z"}
+			]`))
+			Expect(testUI.Err).To(Say("get-warning-1"))
+		})
+	})
+
 })
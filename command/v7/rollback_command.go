@@ -8,17 +8,21 @@ import (
 	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/resources"
 )
 
 type RollbackCommand struct {
 	BaseCommand
 
-	Force           bool          `short:"f" description:"Force rollback without confirmation"`
-	RequiredArgs    flag.AppName  `positional-args:"yes"`
-	Version         flag.Revision `long:"version" required:"true" description:"Roll back to the specified revision"`
-	relatedCommands interface{}   `related_commands:"revisions"`
-	usage           interface{}   `usage:"CF_NAME rollback APP_NAME [--version VERSION] [-f]"`
+	Force           bool                    `short:"f" description:"Force rollback without confirmation"`
+	InstanceSteps   flag.InstanceSteps      `long:"instance-steps" description:"An array of percentage steps to deploy when using deployment strategy canary. (e.g. 20,40,60)"`
+	RequiredArgs    flag.AppName            `positional-args:"yes"`
+	Strategy        flag.DeploymentStrategy `long:"strategy" description:"Deployment strategy can be canary or rolling. When not specified, it defaults to rolling."`
+	Version         flag.Revision           `long:"version" required:"true" description:"Roll back to the specified revision"`
+	relatedCommands interface{}             `related_commands:"revisions"`
+	usage           interface{}             `usage:"CF_NAME rollback APP_NAME [--version VERSION] [-f] [--strategy STRATEGY] [--instance-steps STEPS]"`
 
 	LogCacheClient sharedaction.LogCacheClient
 	Stager         shared.AppStager
@@ -34,6 +38,17 @@ func (cmd *RollbackCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (cmd RollbackCommand) ValidateFlags() error {
+	if len(cmd.InstanceSteps.Weights) > 0 && cmd.Strategy.Name != constant.DeploymentStrategyCanary {
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--instance-steps",
+			Arg2: "--strategy=canary",
+		}
+	}
+
+	return nil
+}
+
 func (cmd RollbackCommand) Execute(args []string) error {
 	cmd.UI.DisplayWarning(command.ExperimentalWarning)
 	cmd.UI.DisplayNewline()
@@ -49,6 +64,11 @@ func (cmd RollbackCommand) Execute(args []string) error {
 		return err
 	}
 
+	err = cmd.ValidateFlags()
+	if err != nil {
+		return err
+	}
+
 	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
@@ -106,14 +126,21 @@ func (cmd RollbackCommand) Execute(args []string) error {
 		"Username":       user.Name,
 	})
 
+	strategy := cmd.Strategy.Name
+	if strategy == constant.DeploymentStrategyDefault {
+		strategy = constant.DeploymentStrategyRolling
+	}
+
 	startAppErr := cmd.Stager.StartApp(
 		app,
 		revision.GUID,
-		constant.DeploymentStrategyRolling,
-		false,
+		shared.AppStartOpts{
+			Strategy:    strategy,
+			AppAction:   constant.ApplicationRollingBack,
+			CanarySteps: resources.NewCanarySteps(cmd.InstanceSteps.Weights),
+		},
 		cmd.Config.TargetedSpace(),
 		cmd.Config.TargetedOrganization(),
-		constant.ApplicationRollingBack,
 	)
 	if startAppErr != nil {
 		return startAppErr
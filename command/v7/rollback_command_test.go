@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	v7 "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/shared/sharedfakes"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
@@ -188,10 +189,11 @@ var _ = Describe("rollback Command", func() {
 				It("skips the prompt and executes the rollback", func() {
 					Expect(fakeAppStager.StartAppCallCount()).To(Equal(1), "GetStartApp call count")
 
-					application, revisionGUID, _, _, _, _, appAction := fakeAppStager.StartAppArgsForCall(0)
+					application, revisionGUID, opts, _, _ := fakeAppStager.StartAppArgsForCall(0)
 					Expect(application.GUID).To(Equal("123"))
 					Expect(revisionGUID).To(Equal("some-1-guid"))
-					Expect(appAction).To(Equal(constant.ApplicationRollingBack))
+					Expect(opts.AppAction).To(Equal(constant.ApplicationRollingBack))
+					Expect(opts.Strategy).To(Equal(constant.DeploymentStrategyRolling))
 
 					Expect(testUI.Out).ToNot(Say("Rolling '%s' back to revision '1' will create a new revision. The new revision '3' will use the settings from revision '1'.", app))
 					Expect(testUI.Out).ToNot(Say("Are you sure you want to continue?"))
@@ -206,6 +208,38 @@ var _ = Describe("rollback Command", func() {
 				})
 			})
 
+			When("the user passes the canary strategy", func() {
+				BeforeEach(func() {
+					cmd.Force = true
+					cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyCanary}
+					cmd.InstanceSteps = flag.InstanceSteps{Weights: []int64{20, 60}}
+				})
+
+				It("rolls back with a canary deployment", func() {
+					Expect(fakeAppStager.StartAppCallCount()).To(Equal(1), "GetStartApp call count")
+
+					_, revisionGUID, opts, _, _ := fakeAppStager.StartAppArgsForCall(0)
+					Expect(revisionGUID).To(Equal("some-1-guid"))
+					Expect(opts.Strategy).To(Equal(constant.DeploymentStrategyCanary))
+					Expect(opts.CanarySteps).To(Equal([]resources.CanaryStep{{InstanceWeight: 20}, {InstanceWeight: 60}}))
+				})
+			})
+
+			When("the user passes instance steps without the canary strategy", func() {
+				BeforeEach(func() {
+					cmd.Force = true
+					cmd.InstanceSteps = flag.InstanceSteps{Weights: []int64{20, 60}}
+				})
+
+				It("returns an error", func() {
+					Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{
+						Arg1: "--instance-steps",
+						Arg2: "--strategy=canary",
+					}))
+					Expect(fakeAppStager.StartAppCallCount()).To(Equal(0))
+				})
+			})
+
 			When("user says yes to prompt", func() {
 				BeforeEach(func() {
 					_, err := input.Write([]byte("y\n"))
@@ -215,10 +249,10 @@ var _ = Describe("rollback Command", func() {
 				It("successfully executes the command and outputs warnings", func() {
 					Expect(fakeAppStager.StartAppCallCount()).To(Equal(1), "GetStartApp call count")
 
-					application, revisionGUID, _, _, _, _, appAction := fakeAppStager.StartAppArgsForCall(0)
+					application, revisionGUID, opts, _, _ := fakeAppStager.StartAppArgsForCall(0)
 					Expect(application.GUID).To(Equal("123"))
 					Expect(revisionGUID).To(Equal("some-1-guid"))
-					Expect(appAction).To(Equal(constant.ApplicationRollingBack))
+					Expect(opts.AppAction).To(Equal(constant.ApplicationRollingBack))
 
 					Expect(testUI.Out).To(Say("Rolling '%s' back to revision '1' will create a new revision. The new revision will use the settings from revision '1'.", app))
 					Expect(testUI.Out).To(Say("Are you sure you want to continue?"))
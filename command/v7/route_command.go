@@ -91,6 +91,23 @@ func (cmd RouteCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		appNames := make([]string, 0, len(route.Destinations))
+		for _, destination := range route.Destinations {
+			appNames = append(appNames, appMap[destination.App.GUID].Name)
+		}
+		return cmd.UI.DisplayStructuredOutput(routeDetailsOutput{
+			GUID:         route.GUID,
+			URL:          route.URL,
+			Host:         route.Host,
+			Domain:       domain.Name,
+			Port:         route.Port,
+			Path:         route.Path,
+			Protocol:     route.Protocol,
+			Destinations: newRouteDestinationsOutput(route.Destinations, appNames),
+		})
+	}
+
 	table := [][]string{
 		{cmd.UI.TranslateText("domain:"), domain.Name},
 		{cmd.UI.TranslateText("host:"), route.Host},
@@ -108,6 +125,10 @@ func (cmd RouteCommand) Execute(args []string) error {
 	return nil
 }
 
+func (RouteCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd RouteCommand) displayDestinations(route resources.Route, appMap map[string]resources.Application) {
 	destinations := route.Destinations
 	if len(destinations) > 0 {
@@ -120,17 +141,35 @@ func (cmd RouteCommand) displayDestinations(route resources.Route, appMap map[st
 			},
 		}
 
+		weighted := false
+		for _, destination := range destinations {
+			if destination.Weight != nil {
+				weighted = true
+			}
+		}
+		if weighted {
+			keyValueTable[0] = append(keyValueTable[0], cmd.UI.TranslateText("weight"))
+		}
+
 		for _, destination := range destinations {
 			port := ""
 			if destination.Port != 0 {
 				port = strconv.Itoa(destination.Port)
 			}
-			keyValueTable = append(keyValueTable, []string{
+			row := []string{
 				appMap[destination.App.GUID].Name,
 				destination.App.Process.Type,
 				port,
 				destination.Protocol,
-			})
+			}
+			if weighted {
+				weight := ""
+				if destination.Weight != nil {
+					weight = strconv.Itoa(*destination.Weight) + "%"
+				}
+				row = append(row, weight)
+			}
+			keyValueTable = append(keyValueTable, row)
 		}
 
 		cmd.UI.DisplayKeyValueTable("\t", keyValueTable, 3)
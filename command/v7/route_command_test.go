@@ -232,6 +232,41 @@ var _ = Describe("route Command", func() {
 			Expect(givenPort).To(Equal(0))
 		})
 	})
+	When("the route has weighted destinations", func() {
+		BeforeEach(func() {
+			weightA, weightB := 90, 10
+			destAppA := resources.RouteDestinationApp{GUID: "abc", Process: struct{ Type string }{"web"}}
+			destAppB := resources.RouteDestinationApp{GUID: "123", Process: struct{ Type string }{"web"}}
+			destinations := []resources.RouteDestination{
+				{App: destAppA, Port: 8080, Protocol: "http1", Weight: &weightA},
+				{App: destAppB, Port: 8080, Protocol: "http1", Weight: &weightB},
+			}
+
+			fakeActor.GetRouteByAttributesReturns(
+				resources.Route{GUID: "route-guid", Protocol: "http", Destinations: destinations},
+				v7action.Warnings{"get-route-warnings"},
+				nil,
+			)
+			fakeActor.GetApplicationMapForRouteReturns(
+				map[string]resources.Application{
+					"abc": {GUID: "abc", Name: "app-v1"},
+					"123": {GUID: "123", Name: "app-v2"},
+				},
+				nil,
+				nil,
+			)
+		})
+
+		It("displays the weight of each destination", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Destinations:`))
+			Expect(testUI.Out).To(Say(`\s+app\s+process\s+port\s+app-protocol\s+weight`))
+			Expect(testUI.Out).To(Say(`\s+app-v1\s+web\s+8080\s+http1\s+90%`))
+			Expect(testUI.Out).To(Say(`\s+app-v2\s+web\s+8080\s+http1\s+10%`))
+		})
+	})
+
 	Describe("RouteRetrieval display logic", func() {
 		When("passing in just a domain", func() {
 			BeforeEach(func() {
@@ -272,4 +307,59 @@ var _ = Describe("route Command", func() {
 			})
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			weight := 80
+			fakeActor.GetRouteByAttributesReturns(
+				resources.Route{
+					GUID:     "route-guid",
+					Host:     "some-host",
+					URL:      "some-host.some-domain.com",
+					Protocol: "http",
+					Destinations: []resources.RouteDestination{
+						{
+							App:      resources.RouteDestinationApp{GUID: "app-guid", Process: struct{ Type string }{Type: "web"}},
+							Protocol: "http1",
+							Weight:   &weight,
+						},
+						{
+							App:      resources.RouteDestinationApp{GUID: "app-guid", Process: struct{ Type string }{Type: "worker"}},
+							Port:     8080,
+							Protocol: "http2",
+						},
+					},
+				},
+				v7action.Warnings{"get-route-warnings"},
+				nil,
+			)
+		})
+
+		It("renders the route and each of its destinations as JSON", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(string(out.Contents())).To(MatchJSON(`{
+				"guid": "route-guid",
+				"url": "some-host.some-domain.com",
+				"host": "some-host",
+				"domain": "some-domain.com",
+				"path": "",
+				"protocol": "http",
+				"destinations": [
+					{"app": "app-name", "process_type": "web", "protocol": "http1", "weight": 80},
+					{"app": "app-name", "process_type": "worker", "port": 8080, "protocol": "http2"}
+				]
+			}`))
+			Expect(testUI.Err).To(Say("Showing route some-domain.com in org some-org / space some-space as some-user..."))
+			Expect(testUI.Err).To(Say("get-route-warnings"))
+		})
+	})
+
 })
@@ -34,6 +34,19 @@ func (cmd RouterGroupsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		output := []routerGroupOutput{}
+		for _, routerGroup := range routerGroups {
+			output = append(output, routerGroupOutput{
+				Name:            routerGroup.Name,
+				GUID:            routerGroup.GUID,
+				Type:            routerGroup.Type,
+				ReservablePorts: routerGroup.ReservablePorts,
+			})
+		}
+		return cmd.UI.DisplayStructuredOutput(output)
+	}
+
 	if len(routerGroups) == 0 {
 		cmd.UI.DisplayText("No router groups found.")
 	} else {
@@ -43,6 +56,10 @@ func (cmd RouterGroupsCommand) Execute(args []string) error {
 	return nil
 }
 
+func (RouterGroupsCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd RouterGroupsCommand) displayRouterGroupsTable(routerGroups []v7action.RouterGroup) {
 	var table = [][]string{
 		{
@@ -141,4 +141,27 @@ var _ = Describe("router-groups Command", func() {
 			})
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetRouterGroupsReturns(
+				[]v7action.RouterGroup{{Name: "default-tcp", GUID: "router-group-guid", Type: "tcp", ReservablePorts: "1024-1033"}},
+				nil)
+		})
+
+		It("renders the router groups as JSON on stdout", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(string(out.Contents())).To(MatchJSON(`[
+				{"name": "default-tcp", "guid": "router-group-guid", "type": "tcp", "reservable_ports": "1024-1033"}
+			]`))
+		})
+	})
 })
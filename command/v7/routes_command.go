@@ -1,6 +1,7 @@
 package v7
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -64,6 +65,14 @@ func (cmd RoutesCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		output := []routeOutput{}
+		for _, routeSummary := range routeSummaries {
+			output = append(output, newRouteOutput(routeSummary))
+		}
+		return cmd.UI.DisplayStructuredOutput(output)
+	}
+
 	if len(routes) > 0 {
 		cmd.displayRoutesTable(routeSummaries)
 	} else {
@@ -73,6 +82,10 @@ func (cmd RoutesCommand) Execute(args []string) error {
 	return nil
 }
 
+func (RoutesCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd RoutesCommand) displayRoutesTable(routeSummaries []v7action.RouteSummary) {
 	var routesTable = [][]string{
 		{
@@ -101,10 +114,21 @@ func (cmd RoutesCommand) displayRoutesTable(routeSummaries []v7action.RouteSumma
 			routeSummary.Path,
 			routeSummary.Protocol,
 			strings.Join(routeSummary.AppProtocols, ", "),
-			strings.Join(routeSummary.AppNames, ", "),
+			strings.Join(appNamesWithWeights(routeSummary), ", "),
 			routeSummary.ServiceInstanceName,
 		})
 	}
 
 	cmd.UI.DisplayTableWithHeader("", routesTable, ui.DefaultTableSpacePadding)
 }
+
+func appNamesWithWeights(routeSummary v7action.RouteSummary) []string {
+	appNames := make([]string, len(routeSummary.AppNames))
+	for i, appName := range routeSummary.AppNames {
+		appNames[i] = appName
+		if i < len(routeSummary.Destinations) && routeSummary.Destinations[i].Weight != nil {
+			appNames[i] = fmt.Sprintf("%s (%d%%)", appName, *routeSummary.Destinations[i].Weight)
+		}
+	}
+	return appNames
+}
@@ -131,6 +131,7 @@ var _ = Describe("routes Command", func() {
 				)
 
 				BeforeEach(func() {
+					weight90, weight10 := 90, 10
 					routeSummaries = []v7action.RouteSummary{
 						{
 							DomainName:          "domain1",
@@ -174,6 +175,18 @@ var _ = Describe("routes Command", func() {
 							AppNames:     []string{"app1", "app2"},
 							AppProtocols: []string{"http1"},
 						},
+						{
+							DomainName: "domain5",
+							SpaceName:  "space-3",
+							Route: resources.Route{GUID: "route-guid-5", Host: "canary",
+								Destinations: []resources.RouteDestination{
+									{GUID: "app1-guid", Protocol: "http1", Weight: &weight90},
+									{GUID: "app2-guid", Protocol: "http1", Weight: &weight10},
+								},
+							},
+							AppNames:     []string{"app1", "app2"},
+							AppProtocols: []string{"http1"},
+						},
 					}
 
 					fakeActor.GetRouteSummariesReturns(
@@ -195,6 +208,7 @@ var _ = Describe("routes Command", func() {
 					Expect(testUI.Out).To(Say(`space-3\s+host-1\s+domain3\s+http1, http2\s+app1, app2\s+si-3`))
 					Expect(testUI.Out).To(Say(`space-3\s+tcp\.domain\s+1024\s+app1, app2`))
 					Expect(testUI.Out).To(Say(`space-3\s+domain4\s+1024\s+http1\s+app1, app2`))
+					Expect(testUI.Out).To(Say(`space-3\s+canary\s+domain5\s+http1\s+app1 \(90%\), app2 \(10%\)`))
 				})
 			})
 
@@ -273,4 +287,68 @@ var _ = Describe("routes Command", func() {
 			})
 		})
 	})
+
+	When("structured output is requested", func() {
+		var (
+			out                  *Buffer
+			seventy, twenty, ten int
+		)
+
+		BeforeEach(func() {
+			seventy, twenty, ten = 70, 20, 10
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatYAML)
+			cmd.UI = testUI
+
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "banana"}, nil)
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+			fakeActor.GetRoutesBySpaceReturns([]resources.Route{{GUID: "route-guid-1"}}, nil, nil)
+			fakeActor.GetRouteSummariesReturns(
+				[]v7action.RouteSummary{
+					{
+						Route: resources.Route{
+							GUID:     "route-guid-1",
+							Host:     "host-1",
+							Path:     "/path",
+							Protocol: "http",
+							URL:      "host-1.domain1/path",
+							Destinations: []resources.RouteDestination{
+								{App: resources.RouteDestinationApp{GUID: "app1-guid", Process: struct{ Type string }{Type: "web"}}, Protocol: "http1", Weight: &seventy},
+								{App: resources.RouteDestinationApp{GUID: "app1-guid", Process: struct{ Type string }{Type: "worker"}}, Protocol: "http1", Weight: &twenty},
+								{App: resources.RouteDestinationApp{GUID: "app2-guid", Process: struct{ Type string }{Type: "web"}}, Protocol: "http1", Weight: &ten},
+							},
+						},
+						DomainName:   "domain1",
+						SpaceName:    "space-1",
+						AppNames:     []string{"app1", "app1", "app2"},
+						AppProtocols: []string{"http1"},
+					},
+				},
+				v7action.Warnings{"route-summary-warning"},
+				nil,
+			)
+		})
+
+		It("renders the route summaries, with one entry per destination, as YAML on stdout", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(string(out.Contents())).To(MatchYAML(`
+- guid: route-guid-1
+  url: host-1.domain1/path
+  space: space-1
+  host: host-1
+  domain: domain1
+  path: /path
+  protocol: http
+  apps: [app1, app1, app2]
+  app_protocols: [http1]
+  destinations:
+  - {app: app1, process_type: web, protocol: http1, weight: 70}
+  - {app: app1, process_type: worker, protocol: http1, weight: 20}
+  - {app: app2, process_type: web, protocol: http1, weight: 10}
+`))
+			Expect(testUI.Err).To(Say("route-summary-warning"))
+		})
+	})
 })
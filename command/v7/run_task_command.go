@@ -2,11 +2,26 @@ package v7
 
 import (
 	"fmt"
-
+	"regexp"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/resources"
 )
 
+// taskLogDrainTime is how long run-task --wait keeps displaying logs after
+// the task has finished, since Log Cache delivers them with a delay.
+const taskLogDrainTime = 3 * time.Second
+
+var taskExitStatusRegexp = regexp.MustCompile(`Exited with status (\d+)`)
+
 type RunTaskCommand struct {
 	BaseCommand
 
@@ -16,11 +31,33 @@ type RunTaskCommand struct {
 	Memory          flag.Megabytes     `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
 	Name            string             `long:"name" description:"Name to give the task (generated if omitted)"`
 	Process         string             `long:"process" description:"Process type to use as a template for command, memory, and disk for the created task."`
-	usage           interface{}        `usage:"CF_NAME run-task APP_NAME [--command COMMAND] [-k DISK] [-m MEMORY] [--name TASK_NAME] [--process PROCESS_TYPE]\n\nTIP:\n   Use 'cf logs' to display the logs of the app and all its tasks. If your task name is unique, grep this command's output for the task name to view task-specific logs.\n\nEXAMPLES:\n   CF_NAME run-task my-app --command \"bundle exec rake db:migrate\" --name migrate\n\n   CF_NAME run-task my-app --process batch_job\n\n   CF_NAME run-task my-app"`
+	Wait            bool               `long:"wait" short:"w" description:"Wait for the task to complete while displaying its logs, and exit with a non-zero status if it fails"`
+	Timeout         flag.Timeout       `long:"timeout" description:"Time in seconds to wait for the task to complete when using --wait (Default: no limit)"`
+	usage           interface{}        `usage:"CF_NAME run-task APP_NAME [--command COMMAND] [-k DISK] [-m MEMORY] [--name TASK_NAME] [--process PROCESS_TYPE] [--wait [--timeout SECONDS]]\n\nTIP:\n   Use 'cf logs' to display the logs of the app and all its tasks. If your task name is unique, grep this command's output for the task name to view task-specific logs.\n\n   Use --wait in scripts to block until the task has finished. The command exits with the task's exit status when it fails.\n\nEXAMPLES:\n   CF_NAME run-task my-app --command \"bundle exec rake db:migrate\" --name migrate\n\n   CF_NAME run-task my-app --command \"bundle exec rake db:migrate\" --name migrate --wait --timeout 600\n\n   CF_NAME run-task my-app --process batch_job\n\n   CF_NAME run-task my-app"`
 	relatedCommands interface{}        `related_commands:"logs, tasks, terminate-task"`
+
+	LogCacheClient sharedaction.LogCacheClient
+}
+
+func (cmd *RunTaskCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Wait {
+		return nil
+	}
+
+	cmd.LogCacheClient, err = logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
+	return err
 }
 
 func (cmd RunTaskCommand) Execute(args []string) error {
+	if cmd.Timeout.IsSet && !cmd.Wait {
+		return translatableerror.RequiredFlagsError{Arg1: "--timeout", Arg2: "--wait"}
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
@@ -90,5 +127,123 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 		{cmd.UI.TranslateText("task id:"), fmt.Sprint(task.SequenceID)},
 	}, 3)
 
+	if !cmd.Wait {
+		return nil
+	}
+
+	return cmd.waitForTask(application.GUID, task)
+}
+
+func (cmd RunTaskCommand) waitForTask(appGUID string, task resources.Task) error {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Waiting for task {{.SequenceID}} to complete...", map[string]interface{}{
+		"SequenceID": task.SequenceID,
+	})
+	cmd.UI.DisplayNewline()
+
+	logsDone, stopLogs := cmd.streamTaskLogs(task)
+
+	var timeout time.Duration
+	if cmd.Timeout.IsSet {
+		timeout = time.Duration(cmd.Timeout.Value) * time.Second
+	}
+	finishedTask, warnings, err := cmd.Actor.PollTask(appGUID, int(task.SequenceID), timeout)
+
+	if err == nil {
+		select {
+		case <-logsDone:
+		case <-time.After(taskLogDrainTime):
+		}
+	}
+	stopLogs()
+	<-logsDone
+
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	if finishedTask.State == constant.TaskFailed {
+		return newTaskFailedError(finishedTask)
+	}
+
+	cmd.UI.DisplayText("Task {{.SequenceID}} succeeded.", map[string]interface{}{
+		"SequenceID": finishedTask.SequenceID,
+	})
 	return nil
 }
+
+// streamTaskLogs displays the logs of the task until stopLogs is called or
+// the log streams end, after which logsDone is closed. Failing to stream the
+// logs is not fatal, as the task's outcome is still reported.
+func (cmd RunTaskCommand) streamTaskLogs(task resources.Task) (logsDone <-chan struct{}, stopLogs func()) {
+	done := make(chan struct{})
+
+	filter := sharedaction.LogFilter{
+		SourceTypes: []string{"APP/TASK/" + task.Name},
+	}
+	if createdAt, err := time.Parse(time.RFC3339, task.CreatedAt); err == nil {
+		filter.Since = createdAt
+	} else {
+		filter.Since = time.Now()
+	}
+
+	messages, logErrs, stopStreaming, warnings, err := cmd.Actor.GetFilteredStreamingLogsForApplicationByNameAndSpace(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.LogCacheClient,
+		filter,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		cmd.UI.DisplayWarning("Failed to retrieve logs from Log Cache: {{.Error}}", map[string]interface{}{
+			"Error": err,
+		})
+		close(done)
+		return done, func() {}
+	}
+
+	go func() {
+		defer close(done)
+
+		for messages != nil || logErrs != nil {
+			select {
+			case message, ok := <-messages:
+				if !ok {
+					messages = nil
+					continue
+				}
+				cmd.UI.DisplayLogMessage(message, true)
+			case logErr, ok := <-logErrs:
+				if !ok {
+					logErrs = nil
+					continue
+				}
+				cmd.UI.DisplayWarning("Failed to retrieve logs from Log Cache: {{.Error}}", map[string]interface{}{
+					"Error": logErr,
+				})
+			}
+		}
+	}()
+
+	return done, stopStreaming
+}
+
+func newTaskFailedError(task resources.Task) translatableerror.TaskFailedError {
+	taskErr := translatableerror.TaskFailedError{
+		SequenceID: task.SequenceID,
+		ExitStatus: 1,
+	}
+
+	if task.Result != nil {
+		taskErr.FailureReason = task.Result.FailureReason
+		if matches := taskExitStatusRegexp.FindStringSubmatch(task.Result.FailureReason); matches != nil {
+			if status, err := strconv.Atoi(matches[1]); err == nil && status > 0 && status < 256 {
+				taskErr.ExitStatus = status
+			}
+		}
+	}
+
+	return taskErr
+}
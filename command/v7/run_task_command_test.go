@@ -2,12 +2,16 @@ package v7_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
@@ -388,4 +392,149 @@ var _ = Describe("run-task Command", func() {
 			})
 		})
 	})
+
+	When("--timeout is provided without --wait", func() {
+		BeforeEach(func() {
+			cmd.Timeout = flag.Timeout{NullInt: types.NullInt{Value: 60, IsSet: true}}
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--timeout", Arg2: "--wait"}))
+			Expect(fakeActor.RunTaskCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--wait is provided", func() {
+		var (
+			messages chan sharedaction.LogMessage
+			logErrs  chan error
+			stopped  bool
+		)
+
+		BeforeEach(func() {
+			cmd.Wait = true
+
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{GUID: "some-app-guid"}, nil, nil)
+			fakeActor.RunTaskReturns(resources.Task{
+				Name:       "migrate",
+				SequenceID: 3,
+				CreatedAt:  "2026-01-02T15:04:05Z",
+			}, nil, nil)
+
+			messages = make(chan sharedaction.LogMessage, 1)
+			logErrs = make(chan error)
+			stopped = false
+			messages <- *sharedaction.NewLogMessage("migrating", "OUT", time.Now(), "APP/TASK/migrate", "0")
+			close(messages)
+			close(logErrs)
+			fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceReturns(
+				messages, logErrs, func() { stopped = true }, v7action.Warnings{"logs-warning"}, nil)
+		})
+
+		When("the task succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.PollTaskReturns(resources.Task{SequenceID: 3, State: constant.TaskSucceeded}, v7action.Warnings{"poll-warning"}, nil)
+			})
+
+			It("streams the task's logs and waits for the task to complete", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+				appName, spaceGUID, _, filter := fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app-name"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(filter.SourceTypes).To(Equal([]string{"APP/TASK/migrate"}))
+				Expect(filter.Since).To(Equal(time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)))
+
+				Expect(fakeActor.PollTaskCallCount()).To(Equal(1))
+				appGUID, sequenceID, timeout := fakeActor.PollTaskArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(sequenceID).To(Equal(3))
+				Expect(timeout).To(BeZero())
+				Expect(stopped).To(BeTrue())
+
+				Expect(testUI.Out).To(Say(`task id:\s+3`))
+				Expect(testUI.Out).To(Say(`Waiting for task 3 to complete\.\.\.`))
+				Expect(testUI.Out).To(Say(`\[APP/TASK/migrate/0\] OUT migrating`))
+				Expect(testUI.Out).To(Say(`Task 3 succeeded\.`))
+				Expect(testUI.Err).To(Say("logs-warning"))
+				Expect(testUI.Err).To(Say("poll-warning"))
+			})
+
+			When("a timeout is provided", func() {
+				BeforeEach(func() {
+					cmd.Timeout = flag.Timeout{NullInt: types.NullInt{Value: 90, IsSet: true}}
+				})
+
+				It("passes it to the actor", func() {
+					_, _, timeout := fakeActor.PollTaskArgsForCall(0)
+					Expect(timeout).To(Equal(90 * time.Second))
+				})
+			})
+		})
+
+		When("the task fails", func() {
+			BeforeEach(func() {
+				fakeActor.PollTaskReturns(resources.Task{
+					SequenceID: 3,
+					State:      constant.TaskFailed,
+					Result:     &resources.TaskResult{FailureReason: "APP/TASK/migrate: Exited with status 3"},
+				}, nil, nil)
+			})
+
+			It("returns a TaskFailedError with the task's exit status", func() {
+				Expect(executeErr).To(MatchError(translatableerror.TaskFailedError{
+					SequenceID:    3,
+					FailureReason: "APP/TASK/migrate: Exited with status 3",
+					ExitStatus:    3,
+				}))
+			})
+
+			When("the failure reason has no exit status", func() {
+				BeforeEach(func() {
+					fakeActor.PollTaskReturns(resources.Task{
+						SequenceID: 3,
+						State:      constant.TaskFailed,
+						Result:     &resources.TaskResult{FailureReason: "task was cancelled"},
+					}, nil, nil)
+				})
+
+				It("exits with status 1", func() {
+					Expect(executeErr).To(MatchError(translatableerror.TaskFailedError{
+						SequenceID:    3,
+						FailureReason: "task was cancelled",
+						ExitStatus:    1,
+					}))
+				})
+			})
+		})
+
+		When("polling the task fails", func() {
+			BeforeEach(func() {
+				fakeActor.PollTaskReturns(resources.Task{}, v7action.Warnings{"poll-warning"}, actionerror.TaskTimeoutError{SequenceID: 3, Timeout: time.Minute})
+			})
+
+			It("stops streaming logs and returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.TaskTimeoutError{SequenceID: 3, Timeout: time.Minute}))
+				Expect(stopped).To(BeTrue())
+				Expect(testUI.Err).To(Say("poll-warning"))
+			})
+		})
+
+		When("streaming the logs fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceReturns(nil, nil, nil, nil, errors.New("log-cache-error"))
+				fakeActor.PollTaskReturns(resources.Task{SequenceID: 3, State: constant.TaskSucceeded}, nil, nil)
+			})
+
+			It("warns and still waits for the task", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("Failed to retrieve logs from Log Cache: log-cache-error"))
+				Expect(testUI.Out).To(Say(`Task 3 succeeded\.`))
+			})
+		})
+	})
 })
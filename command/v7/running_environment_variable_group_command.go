@@ -34,6 +34,10 @@ func (cmd RunningEnvironmentVariableGroupCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructuredOutput(newEnvironmentVariableGroupOutput(envVars))
+	}
+
 	if len(envVars) == 0 {
 		cmd.UI.DisplayTextWithFlavor("No running environment variable group has been set.")
 	} else {
@@ -47,3 +51,7 @@ func (cmd RunningEnvironmentVariableGroupCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (RunningEnvironmentVariableGroupCommand) SupportsStructuredOutput() bool {
+	return true
+}
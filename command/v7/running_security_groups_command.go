@@ -33,6 +33,14 @@ func (cmd RunningSecurityGroupsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		output := []securityGroupOutput{}
+		for _, securityGroup := range runningSecurityGroups {
+			output = append(output, newSecurityGroupOutput(securityGroup.Name, securityGroup.Rules, true))
+		}
+		return cmd.UI.DisplayStructuredOutput(output)
+	}
+
 	if len(runningSecurityGroups) == 0 {
 		cmd.UI.DisplayText("No global running security groups found.")
 		return nil
@@ -50,3 +58,7 @@ func (cmd RunningSecurityGroupsCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (RunningSecurityGroupsCommand) SupportsStructuredOutput() bool {
+	return true
+}
@@ -0,0 +1,149 @@
+package v7
+
+import (
+	"runtime"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/progressbar"
+)
+
+type SCPCommand struct {
+	BaseCommand
+
+	RequiredArgs       flag.SecureCopyArgs `positional-args:"yes"`
+	ProcessIndex       uint                `long:"app-instance-index" short:"i" default:"0" description:"App process instance index"`
+	ProcessType        string              `long:"process" default:"web" description:"App process name"`
+	Recursive          bool                `long:"recursive" short:"r" description:"Copy directories recursively"`
+	SkipHostValidation bool                `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+
+	usage           interface{} `usage:"CF_NAME scp [--process PROCESS] [-i INDEX] [-r] APP_NAME:REMOTE_PATH LOCAL_PATH\n   CF_NAME scp [--process PROCESS] [-i INDEX] [-r] LOCAL_PATH APP_NAME:REMOTE_PATH\n\n   Remote paths are relative to the home directory of the app instance. Files are\n   copied as a tar archive, so the app instance must provide a tar command. Only\n   regular files and directories are copied.\n\nEXAMPLES:\n   CF_NAME scp my-app:/tmp/heapdump.hprof .\n   CF_NAME scp -r ./config my-app:app/config\n   CF_NAME scp --process worker -i 1 my-app:logs/worker.log worker.log"`
+	relatedCommands interface{} `related_commands:"enable-ssh, ssh, ssh-code"`
+	allproxy        interface{} `environmentName:"all_proxy" environmentDescription:"Specify a proxy server to enable proxying for all requests"`
+
+	SSHActor    SharedSSHActor
+	SSHClient   *clissh.SecureShell
+	ProgressBar ProgressBar
+}
+
+func (cmd *SCPCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+	cmd.SSHActor = sharedActor
+	cmd.SSHClient = clissh.NewDefaultSecureShell()
+	cmd.ProgressBar = progressbar.NewProgressBar()
+
+	return nil
+}
+
+func (cmd SCPCommand) Execute(args []string) error {
+	appName, remotePath, localPath, download, err := cmd.parsePaths()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	template := "Uploading {{.LocalPath}} to {{.RemotePath}} on app {{.AppName}} process {{.ProcessType}} instance {{.Index}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}..."
+	if download {
+		template = "Downloading {{.RemotePath}} from app {{.AppName}} process {{.ProcessType}} instance {{.Index}} to {{.LocalPath}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}..."
+	}
+	cmd.UI.DisplayTextWithFlavor(template, map[string]interface{}{
+		"LocalPath":   localPath,
+		"RemotePath":  remotePath,
+		"AppName":     appName,
+		"ProcessType": cmd.ProcessType,
+		"Index":       cmd.ProcessIndex,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"Username":    user.Name,
+	})
+
+	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
+		appName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.ProcessType,
+		cmd.ProcessIndex,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	// The progress bar is displayed as soon as the size of the files is known.
+	go cmd.ProgressBar.Ready()
+	err = cmd.SSHActor.ExecuteSecureCopy(
+		cmd.SSHClient,
+		sharedaction.SecureCopyOptions{
+			Download:           download,
+			Endpoint:           sshAuth.Endpoint,
+			HostKeyFingerprint: sshAuth.HostKeyFingerprint,
+			LocalPath:          localPath,
+			Passcode:           sshAuth.Passcode,
+			Recursive:          cmd.Recursive,
+			RemotePath:         remotePath,
+			SkipHostValidation: cmd.SkipHostValidation,
+			Username:           sshAuth.Username,
+		},
+		cmd.ProgressBar,
+	)
+	cmd.ProgressBar.Complete()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+// parsePaths determines which of SOURCE and DESTINATION is on the app
+// instance.
+func (cmd SCPCommand) parsePaths() (string, string, string, bool, error) {
+	sourceApp, sourcePath, sourceIsRemote := parseRemotePath(cmd.RequiredArgs.Source)
+	destinationApp, destinationPath, destinationIsRemote := parseRemotePath(cmd.RequiredArgs.Destination)
+
+	switch {
+	case sourceIsRemote && !destinationIsRemote:
+		return sourceApp, sourcePath, cmd.RequiredArgs.Destination, true, nil
+	case destinationIsRemote && !sourceIsRemote:
+		return destinationApp, destinationPath, cmd.RequiredArgs.Source, false, nil
+	default:
+		return "", "", "", false, translatableerror.IncorrectUsageError{
+			Message: "exactly one of SOURCE and DESTINATION must be APP_NAME:REMOTE_PATH",
+		}
+	}
+}
+
+// parseRemotePath splits an APP_NAME:REMOTE_PATH argument. Paths containing
+// a slash before the first colon, and Windows drive letters such as C:\, are
+// local paths.
+func parseRemotePath(arg string) (string, string, bool) {
+	i := strings.Index(arg, ":")
+	if i <= 0 || strings.ContainsAny(arg[:i], `/\`) || (runtime.GOOS == "windows" && i == 1) {
+		return "", "", false
+	}
+
+	remotePath := arg[i+1:]
+	if remotePath == "" {
+		remotePath = "."
+	}
+	return arg[:i], remotePath, true
+}
@@ -0,0 +1,179 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("scp Command", func() {
+	var (
+		cmd             SCPCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		fakeSSHActor    *v7fakes.FakeSharedSSHActor
+		fakeProgressBar *v7fakes.FakeProgressBar
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeSSHActor = new(v7fakes.FakeSharedSSHActor)
+		fakeProgressBar = new(v7fakes.FakeProgressBar)
+
+		cmd = SCPCommand{
+			RequiredArgs: flag.SecureCopyArgs{Source: "some-app:/tmp/heapdump.hprof", Destination: "./heapdump.hprof"},
+
+			ProcessType:  "some-process-type",
+			ProcessIndex: 1,
+			Recursive:    true,
+
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			SSHActor:    fakeSSHActor,
+			ProgressBar: fakeProgressBar,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(
+			v7action.SSHAuthentication{
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				Passcode:           "some-passcode",
+				Username:           "some-username",
+			},
+			v7action.Warnings{"some-warnings"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "steve"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "steve"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("downloading", func() {
+		It("gets the secure shell configuration for the app instance", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("some-warnings"))
+
+			appNameArg, spaceGUIDArg, processTypeArg, processIndexArg := fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall(0)
+			Expect(appNameArg).To(Equal("some-app"))
+			Expect(spaceGUIDArg).To(Equal("some-space-guid"))
+			Expect(processTypeArg).To(Equal("some-process-type"))
+			Expect(processIndexArg).To(Equal(uint(1)))
+		})
+
+		It("downloads the remote path with a progress bar", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Downloading /tmp/heapdump.hprof from app some-app process some-process-type instance 1 to \./heapdump\.hprof in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeSSHActor.ExecuteSecureCopyCallCount()).To(Equal(1))
+			_, copyOptions, progressBar := fakeSSHActor.ExecuteSecureCopyArgsForCall(0)
+			Expect(copyOptions).To(Equal(sharedaction.SecureCopyOptions{
+				Download:           true,
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				LocalPath:          "./heapdump.hprof",
+				Passcode:           "some-passcode",
+				Recursive:          true,
+				RemotePath:         "/tmp/heapdump.hprof",
+				Username:           "some-username",
+			}))
+			Expect(progressBar).To(Equal(fakeProgressBar))
+			Eventually(fakeProgressBar.ReadyCallCount).Should(Equal(1))
+			Expect(fakeProgressBar.CompleteCallCount()).To(Equal(1))
+		})
+	})
+
+	When("uploading", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.SecureCopyArgs{Source: "./config", Destination: "some-app:"}
+		})
+
+		It("uploads to the home directory of the app instance", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Uploading \./config to \. on app some-app process some-process-type instance 1 in org some-org / space some-space as steve\.\.\.`))
+
+			_, copyOptions, _ := fakeSSHActor.ExecuteSecureCopyArgsForCall(0)
+			Expect(copyOptions.Download).To(BeFalse())
+			Expect(copyOptions.LocalPath).To(Equal("./config"))
+			Expect(copyOptions.RemotePath).To(Equal("."))
+		})
+	})
+
+	When("copying fails", func() {
+		BeforeEach(func() {
+			fakeSSHActor.ExecuteSecureCopyReturns(errors.New("some-copy-error"))
+		})
+
+		It("returns the error and completes the progress bar", func() {
+			Expect(executeErr).To(MatchError("some-copy-error"))
+			Expect(fakeProgressBar.CompleteCallCount()).To(Equal(1))
+			Expect(testUI.Out).NotTo(Say("OK"))
+		})
+	})
+
+	When("getting the secure shell configuration fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(v7action.SSHAuthentication{}, v7action.Warnings{"some-warnings"}, errors.New("some-ssh-error"))
+		})
+
+		It("returns the error without copying", func() {
+			Expect(executeErr).To(MatchError("some-ssh-error"))
+			Expect(testUI.Err).To(Say("some-warnings"))
+			Expect(fakeSSHActor.ExecuteSecureCopyCallCount()).To(Equal(0))
+		})
+	})
+
+	DescribeTable("paths that are not exactly one remote path",
+		func(source string, destination string) {
+			cmd.RequiredArgs = flag.SecureCopyArgs{Source: source, Destination: destination}
+			Expect(cmd.Execute(nil)).To(MatchError(translatableerror.IncorrectUsageError{
+				Message: "exactly one of SOURCE and DESTINATION must be APP_NAME:REMOTE_PATH",
+			}))
+		},
+		Entry("two local paths", "./a", "./b"),
+		Entry("two remote paths", "app-a:file", "app-b:file"),
+		Entry("a local path with a colon after a slash", "./a:b", "./c"),
+		Entry("an empty app name", ":file", "./c"),
+	)
+})
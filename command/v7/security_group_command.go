@@ -36,6 +36,10 @@ func (cmd SecurityGroupCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructuredOutput(newSecurityGroupSummaryOutput(securityGroupSummary))
+	}
+
 	cmd.UI.DisplayKeyValueTable("", [][]string{
 		{cmd.UI.TranslateText("name:"), securityGroupSummary.Name},
 		{cmd.UI.TranslateText("rules:"), ""},
@@ -60,3 +64,7 @@ func (cmd SecurityGroupCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (SecurityGroupCommand) SupportsStructuredOutput() bool {
+	return true
+}
@@ -170,4 +170,39 @@ var _ = Describe("Security Group Command", func() {
 			})
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeActor.GetSecurityGroupSummaryReturns(
+				v7action.SecurityGroupSummary{
+					Name:  "some-security-group",
+					Rules: []resources.Rule{{Protocol: "tcp", Destination: "10.0.0.0/8"}},
+					SecurityGroupSpaces: []v7action.SecurityGroupSpace{
+						{OrgName: "some-org", SpaceName: "some-space", Lifecycle: "running"},
+					},
+				},
+				v7action.Warnings{"warning-1"},
+				nil)
+		})
+
+		It("renders the security group, its rules and bindings as JSON", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(string(out.Contents())).To(MatchJSON(`{
+				"name": "some-security-group",
+				"rules": [{"protocol": "tcp", "destination": "10.0.0.0/8"}],
+				"bindings": [{"org": "some-org", "space": "some-space", "lifecycle": "running"}]
+			}`))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+
 })
@@ -33,6 +33,14 @@ func (cmd SecurityGroupsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		output := []securityGroupOutput{}
+		for _, summary := range securityGroupSummaries {
+			output = append(output, newSecurityGroupSummaryOutput(summary))
+		}
+		return cmd.UI.DisplayStructuredOutput(output)
+	}
+
 	if len(securityGroupSummaries) == 0 {
 		cmd.UI.DisplayText("No security groups found.")
 		return nil
@@ -67,3 +75,7 @@ func (cmd SecurityGroupsCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (SecurityGroupsCommand) SupportsStructuredOutput() bool {
+	return true
+}
@@ -167,4 +167,41 @@ var _ = Describe("Security Groups Command", func() {
 			})
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetSecurityGroupsReturns(
+				[]v7action.SecurityGroupSummary{
+					{
+						Name:                "group-1",
+						Rules:               []resources.Rule{{Protocol: "all", Destination: "0.0.0.0/0"}},
+						SecurityGroupSpaces: []v7action.SecurityGroupSpace{{OrgName: "org-1", SpaceName: "space-1", Lifecycle: "running"}},
+					},
+					{Name: "group-2"},
+				},
+				v7action.Warnings{"get-security-groups-warning"},
+				nil)
+		})
+
+		It("renders the security groups as JSON on stdout and everything else on stderr", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(string(out.Contents())).To(MatchJSON(`[
+				{
+					"name": "group-1",
+					"rules": [{"protocol": "all", "destination": "0.0.0.0/0"}],
+					"bindings": [{"org": "org-1", "space": "space-1", "lifecycle": "running"}]
+				},
+				{"name": "group-2"}
+			]`))
+			Expect(testUI.Err).To(Say("get-security-groups-warning"))
+		})
+	})
 })
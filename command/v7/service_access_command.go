@@ -34,6 +34,14 @@ func (cmd ServiceAccessCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		output := []servicePlanAccessOutput{}
+		for _, plan := range servicePlanAccess {
+			output = append(output, newServicePlanAccessOutput(plan))
+		}
+		return cmd.UI.DisplayStructuredOutput(output)
+	}
+
 	if len(servicePlanAccess) == 0 {
 		cmd.UI.DisplayText("No service plans found.")
 		return nil
@@ -74,6 +82,10 @@ func (cmd ServiceAccessCommand) Execute(args []string) error {
 	return nil
 }
 
+func (ServiceAccessCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func getTableHeaders(plan v7action.ServicePlanAccess) []string {
 	if string(plan.VisibilityType) == "space" {
 		return []string{"offering", "plan", "access", "space"}
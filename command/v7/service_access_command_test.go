@@ -188,6 +188,39 @@ var _ = Describe("service-access Command", func() {
 			Expect(fakeActor.GetCurrentUserCallCount()).To(Equal(1))
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeActor.GetServiceAccessReturns(
+				[]v7action.ServicePlanAccess{
+					{BrokerName: "broker-one", ServiceOfferingName: "service-one", ServicePlanName: "plan-one", VisibilityType: "public"},
+					{BrokerName: "broker-two", ServiceOfferingName: "service-two", ServicePlanName: "plan-two", VisibilityType: "organization", VisibilityDetails: []string{"org-1", "org-2"}},
+					{BrokerName: "broker-two", ServiceOfferingName: "service-three", ServicePlanName: "plan-three", VisibilityType: "space", VisibilityDetails: []string{"space-1"}},
+				},
+				v7action.Warnings{"warning"},
+				nil)
+		})
+
+		It("renders the access of each plan as JSON", func() {
+			Expect(cmd.Execute(nil)).To(Succeed())
+
+			Expect(string(out.Contents())).To(MatchJSON(`[
+				{"broker": "broker-one", "offering": "service-one", "plan": "plan-one", "access": "all"},
+				{"broker": "broker-two", "offering": "service-two", "plan": "plan-two", "access": "limited", "orgs": ["org-1", "org-2"]},
+				{"broker": "broker-two", "offering": "service-three", "plan": "plan-three", "access": "limited", "space": "space-1"}
+			]`))
+			Expect(testUI.Err).To(Say("Getting service access as some-user..."))
+			Expect(testUI.Err).To(Say("warning"))
+		})
+	})
 })
 
 func fakeServiceAccessResult() []v7action.ServicePlanAccess {
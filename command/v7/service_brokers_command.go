@@ -31,11 +31,27 @@ func (cmd *ServiceBrokersCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		output := []serviceBrokerOutput{}
+		for _, serviceBroker := range serviceBrokers {
+			output = append(output, serviceBrokerOutput{
+				Name: serviceBroker.Name,
+				GUID: serviceBroker.GUID,
+				URL:  serviceBroker.URL,
+			})
+		}
+		return cmd.UI.DisplayStructuredOutput(output)
+	}
+
 	cmd.displayServiceBrokers(serviceBrokers)
 
 	return nil
 }
 
+func (ServiceBrokersCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd *ServiceBrokersCommand) displayServiceBrokers(serviceBrokers []resources.ServiceBroker) {
 	if len(serviceBrokers) == 0 {
 		cmd.UI.DisplayText("No service brokers found")
@@ -161,4 +161,29 @@ var _ = Describe("service-brokers Command", func() {
 			})
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetServiceBrokersReturns(
+				[]resources.ServiceBroker{{Name: "broker-1", GUID: "broker-guid-1", URL: "https://broker.example.com"}},
+				v7action.Warnings{"get-brokers-warning"},
+				nil)
+		})
+
+		It("renders the service brokers as JSON on stdout and everything else on stderr", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(string(out.Contents())).To(MatchJSON(`[
+				{"name": "broker-1", "guid": "broker-guid-1", "url": "https://broker.example.com"}
+			]`))
+			Expect(testUI.Err).To(Say("get-brokers-warning"))
+		})
+	})
 })
@@ -36,6 +36,10 @@ func (cmd ServiceCommand) Execute(args []string) error {
 	}
 }
 
+func (ServiceCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd ServiceCommand) fetchAndDisplayGUID() error {
 	serviceInstance, _, err := cmd.Actor.GetServiceInstanceByNameAndSpace(
 		string(cmd.RequiredArgs.ServiceInstance),
@@ -81,6 +85,10 @@ func (cmd ServiceCommand) fetchAndDisplayDetails() error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructuredOutput(newServiceInstanceDetailsOutput(serviceInstanceWithDetails))
+	}
+
 	switch {
 	case serviceInstanceWithDetails.Type == resources.UserProvidedServiceInstance:
 		cmd.displayPropertiesUserProvided(serviceInstanceWithDetails)
@@ -832,4 +832,51 @@ var _ = Describe("service command", func() {
 			Expect(executeErr).To(MatchError("explode"))
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetServiceInstanceDetailsReturns(
+				v7action.ServiceInstanceDetails{
+					ServiceInstance: resources.ServiceInstance{
+						Name:          "some-instance",
+						GUID:          "some-instance-guid",
+						Type:          resources.ManagedServiceInstance,
+						Tags:          types.NewOptionalStringSlice("foo"),
+						LastOperation: resources.LastOperation{Type: resources.CreateOperation, State: resources.OperationSucceeded},
+					},
+					ServiceBrokerName: "some-broker",
+					ServiceOffering:   resources.ServiceOffering{Name: "some-offering"},
+					ServicePlan:       resources.ServicePlan{Name: "some-plan"},
+					BoundApps:         []resources.ServiceCredentialBinding{{AppName: "some-app", Name: "some-binding"}},
+					UpgradeStatus:     v7action.ServiceInstanceUpgradeStatus{State: v7action.ServiceInstanceUpgradeNotAvailable},
+				},
+				v7action.Warnings{"get-details-warning"},
+				nil)
+		})
+
+		It("renders the service instance as JSON on stdout and everything else on stderr", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(string(out.Contents())).To(MatchJSON(`{
+				"name": "some-instance",
+				"guid": "some-instance-guid",
+				"type": "managed",
+				"broker": "some-broker",
+				"offering": "some-offering",
+				"plan": "some-plan",
+				"tags": ["foo"],
+				"last_operation": {"type": "create", "state": "succeeded", "description": "", "created_at": "", "updated_at": ""},
+				"bound_apps": [{"app": "some-app", "name": "some-binding"}],
+				"upgrade_available": false
+			}`))
+			Expect(testUI.Err).To(Say("get-details-warning"))
+		})
+	})
 })
@@ -32,6 +32,10 @@ func (cmd ServiceKeyCommand) Examples() string {
 	return `CF_NAME service-key mydb mykey`
 }
 
+func (ServiceKeyCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd ServiceKeyCommand) guid() error {
 	key, warnings, err := cmd.Actor.GetServiceKeyByServiceInstanceAndName(
 		cmd.RequiredArgs.ServiceInstance,
@@ -70,6 +74,14 @@ func (cmd ServiceKeyCommand) details() error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructuredOutput(serviceKeyOutput{
+			Name:            cmd.RequiredArgs.ServiceKey,
+			ServiceInstance: cmd.RequiredArgs.ServiceInstance,
+			Credentials:     emptyMapIfNil(details.Credentials),
+		})
+	}
+
 	cmd.UI.DisplayNewline()
 
 	err = cmd.UI.DisplayJSON("", details)
@@ -167,4 +167,31 @@ var _ = Describe("service-key Command", func() {
 			})
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetServiceKeyDetailsByServiceInstanceAndNameReturns(
+				resources.ServiceCredentialBindingDetails{Credentials: map[string]interface{}{"password": "secret"}},
+				v7action.Warnings{"get-key-warning"},
+				nil)
+		})
+
+		It("renders the service key as JSON on stdout and everything else on stderr", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(string(out.Contents())).To(MatchJSON(`{
+				"name": "fake-service-key-name",
+				"service_instance": "fake-service-instance-name",
+				"credentials": {"password": "secret"}
+			}`))
+			Expect(testUI.Err).To(Say("get-key-warning"))
+		})
+	})
 })
@@ -33,6 +33,17 @@ func (cmd ServiceKeysCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		output := []serviceKeySummaryOutput{}
+		for _, key := range keys {
+			output = append(output, serviceKeySummaryOutput{
+				Name:          key.Name,
+				LastOperation: newLastOperationOutput(key.LastOperation),
+			})
+		}
+		return cmd.UI.DisplayStructuredOutput(output)
+	}
+
 	switch len(keys) {
 	case 0:
 		cmd.displayEmptyResult()
@@ -42,6 +53,10 @@ func (cmd ServiceKeysCommand) Execute(args []string) error {
 	return nil
 }
 
+func (ServiceKeysCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd ServiceKeysCommand) Usage() string {
 	return `CF_NAME service-keys SERVICE_INSTANCE`
 }
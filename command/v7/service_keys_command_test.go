@@ -151,4 +151,29 @@ var _ = Describe("service-keys Command", func() {
 			Expect(executeErr).To(MatchError("boom"))
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+		})
+
+		It("renders the keys and their last operations as JSON", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(string(out.Contents())).To(MatchJSON(`[
+				{"name": "flopsy", "last_operation": {"type": "create", "state": "succeeded", "description": "desc-1", "created_at": "", "updated_at": ""}},
+				{"name": "mopsy", "last_operation": {"type": "update", "state": "failed", "description": "desc-2", "created_at": "", "updated_at": ""}},
+				{"name": "cottontail"},
+				{"name": "peter", "last_operation": {"type": "create", "state": "in progress", "description": "", "created_at": "", "updated_at": ""}}
+			]`))
+			Expect(testUI.Err).To(Say("Getting keys for service instance fake-service-instance-name as fake-user-name..."))
+			Expect(testUI.Err).To(Say("fake warning"))
+		})
+	})
+
 })
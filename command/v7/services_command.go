@@ -32,10 +32,22 @@ func (cmd ServicesCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		output := []serviceInstanceOutput{}
+		for _, instance := range instances {
+			output = append(output, newServiceInstanceOutput(instance))
+		}
+		return cmd.UI.DisplayStructuredOutput(output)
+	}
+
 	cmd.displayTable(instances)
 	return nil
 }
 
+func (ServicesCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd ServicesCommand) Usage() string {
 	return "CF_NAME services"
 }
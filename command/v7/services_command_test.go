@@ -213,4 +213,59 @@ var _ = Describe("services command", func() {
 			Expect(executeErr).To(MatchError("a bad thing happened"))
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetServiceInstancesForSpaceReturns(
+				[]v7action.ServiceInstance{
+					{
+						Name:                "msi1",
+						Type:                resources.ManagedServiceInstance,
+						ServicePlanName:     "fake-plan-1",
+						ServiceOfferingName: "fake-offering-1",
+						ServiceBrokerName:   "fake-broker-1",
+						UpgradeAvailable:    types.NewOptionalBoolean(true),
+						BoundApps:           []string{"foo"},
+						LastOperation:       "create succeeded",
+					},
+					{
+						Name: "upsi1",
+						Type: resources.UserProvidedServiceInstance,
+					},
+				},
+				v7action.Warnings{"something silly"},
+				nil,
+			)
+		})
+
+		It("renders the service instances as JSON", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(string(out.Contents())).To(MatchJSON(`[
+				{
+					"name": "msi1",
+					"type": "managed",
+					"offering": "fake-offering-1",
+					"plan": "fake-plan-1",
+					"broker": "fake-broker-1",
+					"bound_apps": ["foo"],
+					"last_operation": "create succeeded",
+					"upgrade_available": true
+				},
+				{
+					"name": "upsi1",
+					"type": "user-provided",
+					"offering": "user-provided",
+					"bound_apps": []
+				}
+			]`))
+			Expect(testUI.Err).To(Say("something silly"))
+		})
+	})
 })
@@ -26,9 +26,7 @@ type AppStager interface {
 		space configv3.Space,
 		organization configv3.Organization,
 		packageGUID string,
-		strategy constant.DeploymentStrategy,
-		noWait bool,
-		appAction constant.ApplicationAction,
+		opts AppStartOpts,
 	) error
 
 	StageApp(
@@ -40,14 +38,20 @@ type AppStager interface {
 	StartApp(
 		app resources.Application,
 		resourceGuid string,
-		strategy constant.DeploymentStrategy,
-		noWait bool,
+		opts AppStartOpts,
 		space configv3.Space,
 		organization configv3.Organization,
-		appAction constant.ApplicationAction,
 	) error
 }
 
+// AppStartOpts configures how StartApp starts an application.
+type AppStartOpts struct {
+	AppAction   constant.ApplicationAction
+	CanarySteps []resources.CanaryStep
+	NoWait      bool
+	Strategy    constant.DeploymentStrategy
+}
+
 type Stager struct {
 	Actor    stagingAndStartActor
 	UI       command.UI
@@ -56,8 +60,7 @@ type Stager struct {
 }
 
 type stagingAndStartActor interface {
-	CreateDeploymentByApplicationAndDroplet(appGUID string, dropletGUID string) (string, v7action.Warnings, error)
-	CreateDeploymentByApplicationAndRevision(appGUID string, revisionGUID string) (string, v7action.Warnings, error)
+	CreateDeployment(dep resources.Deployment) (string, v7action.Warnings, error)
 	GetCurrentUser() (configv3.User, error)
 	GetDetailedAppSummary(appName string, spaceGUID string, withObfuscatedValues bool) (v7action.DetailedApplicationSummary, v7action.Warnings, error)
 	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)
@@ -83,9 +86,7 @@ func (stager *Stager) StageAndStart(
 	space configv3.Space,
 	organization configv3.Organization,
 	packageGUID string,
-	strategy constant.DeploymentStrategy,
-	noWait bool,
-	appAction constant.ApplicationAction,
+	opts AppStartOpts,
 ) error {
 
 	droplet, err := stager.StageApp(app, packageGUID, space)
@@ -95,7 +96,7 @@ func (stager *Stager) StageAndStart(
 
 	stager.UI.DisplayNewline()
 
-	err = stager.StartApp(app, droplet.GUID, strategy, noWait, space, organization, appAction)
+	err = stager.StartApp(app, droplet.GUID, opts, space, organization)
 	if err != nil {
 		return err
 	}
@@ -129,32 +130,33 @@ func (stager *Stager) StageApp(app resources.Application, packageGUID string, sp
 func (stager *Stager) StartApp(
 	app resources.Application,
 	resourceGuid string,
-	strategy constant.DeploymentStrategy,
-	noWait bool,
+	opts AppStartOpts,
 	space configv3.Space,
 	organization configv3.Organization,
-	appAction constant.ApplicationAction,
 ) error {
-	if strategy == constant.DeploymentStrategyRolling {
+	if opts.Strategy != constant.DeploymentStrategyDefault {
 		stager.UI.DisplayText("Creating deployment for app {{.AppName}}...\n",
 			map[string]interface{}{
 				"AppName": app.Name,
 			},
 		)
 
-		var (
-			deploymentGUID string
-			warnings       v7action.Warnings
-			err            error
-		)
+		dep := resources.Deployment{
+			Strategy:      opts.Strategy,
+			Relationships: resources.Relationships{constant.RelationshipTypeApplication: resources.Relationship{GUID: app.GUID}},
+		}
+		if opts.Strategy == constant.DeploymentStrategyCanary {
+			dep.CanarySteps = opts.CanarySteps
+		}
 
-		switch appAction {
+		switch opts.AppAction {
 		case constant.ApplicationRollingBack:
-			deploymentGUID, warnings, err = stager.Actor.CreateDeploymentByApplicationAndRevision(app.GUID, resourceGuid)
+			dep.RevisionGUID = resourceGuid
 		default:
-			deploymentGUID, warnings, err = stager.Actor.CreateDeploymentByApplicationAndDroplet(app.GUID, resourceGuid)
+			dep.DropletGUID = resourceGuid
 		}
 
+		deploymentGUID, warnings, err := stager.Actor.CreateDeployment(dep)
 		stager.UI.DisplayWarnings(warnings)
 		if err != nil {
 			return err
@@ -166,7 +168,7 @@ func (stager *Stager) StartApp(
 			stager.UI.DisplayText(instanceDetails)
 		}
 
-		warnings, err = stager.Actor.PollStartForRolling(app, deploymentGUID, noWait, handleInstanceDetails)
+		warnings, err = stager.Actor.PollStartForRolling(app, deploymentGUID, opts.NoWait, handleInstanceDetails)
 		stager.UI.DisplayNewline()
 		stager.UI.DisplayWarnings(warnings)
 		if err != nil {
@@ -178,7 +180,7 @@ func (stager *Stager) StartApp(
 			return err
 		}
 
-		flavorText := fmt.Sprintf("%s app {{.App}} in org {{.Org}} / space {{.Space}} as {{.UserName}}...", appAction)
+		flavorText := fmt.Sprintf("%s app {{.App}} in org {{.Org}} / space {{.Space}} as {{.UserName}}...", opts.AppAction)
 		stager.UI.DisplayTextWithFlavor(flavorText,
 			map[string]interface{}{
 				"App":      app.Name,
@@ -190,7 +192,7 @@ func (stager *Stager) StartApp(
 		stager.UI.DisplayNewline()
 
 		if app.Started() {
-			if appAction == constant.ApplicationStarting {
+			if opts.AppAction == constant.ApplicationStarting {
 				stager.UI.DisplayText("App '{{.AppName}}' is already started.",
 					map[string]interface{}{
 						"AppName": app.Name,
@@ -233,7 +235,7 @@ func (stager *Stager) StartApp(
 			stager.UI.DisplayText(instanceDetails)
 		}
 
-		warnings, err = stager.Actor.PollStart(app, noWait, handleInstanceDetails)
+		warnings, err = stager.Actor.PollStart(app, opts.NoWait, handleInstanceDetails)
 		stager.UI.DisplayNewline()
 		stager.UI.DisplayWarnings(warnings)
 		if err != nil {
@@ -254,5 +256,15 @@ func (stager *Stager) StartApp(
 	appSummaryDisplayer := NewAppSummaryDisplayer(stager.UI)
 	appSummaryDisplayer.AppDisplay(summary, false)
 
+	if opts.Strategy == constant.DeploymentStrategyCanary {
+		stager.UI.DisplayNewline()
+		stager.UI.DisplayText("TIP: Run '{{.BinaryName}} continue-deployment {{.AppName}}' to promote the canary deployment, or '{{.BinaryName}} cancel-deployment {{.AppName}}' to roll it back.",
+			map[string]interface{}{
+				"AppName":    app.Name,
+				"BinaryName": stager.Config.BinaryName(),
+			},
+		)
+	}
+
 	return nil
 }
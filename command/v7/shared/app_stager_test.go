@@ -37,6 +37,7 @@ var _ = Describe("app stager", func() {
 		strategy     constant.DeploymentStrategy
 		noWait       bool
 		appAction    constant.ApplicationAction
+		canarySteps  []resources.CanaryStep
 
 		allLogsWritten   chan bool
 		closedTheStreams bool
@@ -111,9 +112,12 @@ var _ = Describe("app stager", func() {
 				space,
 				organization,
 				pkgGUID,
-				strategy,
-				noWait,
-				appAction,
+				shared.AppStartOpts{
+					Strategy:    strategy,
+					NoWait:      noWait,
+					AppAction:   appAction,
+					CanarySteps: canarySteps,
+				},
 			)
 		})
 
@@ -324,6 +328,7 @@ var _ = Describe("app stager", func() {
 			strategy = constant.DeploymentStrategyDefault
 			noWait = true
 			appAction = constant.ApplicationRestarting
+			canarySteps = nil
 
 			app = resources.Application{GUID: "app-guid", Name: "app-name", State: constant.ApplicationStarted}
 			space = configv3.Space{Name: "some-space", GUID: "some-space-guid"}
@@ -339,18 +344,21 @@ var _ = Describe("app stager", func() {
 			executeErr = appStager.StartApp(
 				app,
 				resourceGUID,
-				strategy,
-				noWait,
+				shared.AppStartOpts{
+					Strategy:    strategy,
+					NoWait:      noWait,
+					AppAction:   appAction,
+					CanarySteps: canarySteps,
+				},
 				space,
 				organization,
-				appAction,
 			)
 		})
 
 		When("the deployment strategy is rolling", func() {
 			BeforeEach(func() {
 				strategy = constant.DeploymentStrategyRolling
-				fakeActor.CreateDeploymentByApplicationAndDropletReturns(
+				fakeActor.CreateDeploymentReturns(
 					"some-deployment-guid",
 					v7action.Warnings{"create-deployment-warning"},
 					nil,
@@ -366,21 +374,19 @@ var _ = Describe("app stager", func() {
 				BeforeEach(func() {
 					appAction = constant.ApplicationRollingBack
 					resourceGUID = "revision-guid"
-					fakeActor.CreateDeploymentByApplicationAndRevisionReturns(
-						"some-deployment-guid",
-						v7action.Warnings{"create-deployment-warning"},
-						nil,
-					)
 				})
 
 				It("displays output for each step of rolling back", func() {
 					Expect(executeErr).NotTo(HaveOccurred())
 
 					Expect(testUI.Out).To(Say("Creating deployment for app %s...", app.Name))
-					Expect(fakeActor.CreateDeploymentByApplicationAndRevisionCallCount()).To(Equal(1), "CreateDeployment...")
-					appGUID, revisionGUID := fakeActor.CreateDeploymentByApplicationAndRevisionArgsForCall(0)
-					Expect(appGUID).To(Equal(app.GUID))
-					Expect(revisionGUID).To(Equal("revision-guid"))
+					Expect(fakeActor.CreateDeploymentCallCount()).To(Equal(1), "CreateDeployment...")
+					dep := fakeActor.CreateDeploymentArgsForCall(0)
+					Expect(dep).To(Equal(resources.Deployment{
+						Strategy:      constant.DeploymentStrategyRolling,
+						RevisionGUID:  "revision-guid",
+						Relationships: resources.Relationships{constant.RelationshipTypeApplication: resources.Relationship{GUID: app.GUID}},
+					}))
 					Expect(testUI.Err).To(Say("create-deployment-warning"))
 
 					Expect(testUI.Out).To(Say("Waiting for app to deploy..."))
@@ -394,10 +400,13 @@ var _ = Describe("app stager", func() {
 					Expect(executeErr).To(BeNil())
 
 					Expect(testUI.Out).To(Say("Creating deployment for app %s...", app.Name))
-					Expect(fakeActor.CreateDeploymentByApplicationAndDropletCallCount()).To(Equal(1))
-					appGUID, dropletGUID := fakeActor.CreateDeploymentByApplicationAndDropletArgsForCall(0)
-					Expect(appGUID).To(Equal(app.GUID))
-					Expect(dropletGUID).To(Equal("droplet-guid"))
+					Expect(fakeActor.CreateDeploymentCallCount()).To(Equal(1))
+					dep := fakeActor.CreateDeploymentArgsForCall(0)
+					Expect(dep).To(Equal(resources.Deployment{
+						Strategy:      constant.DeploymentStrategyRolling,
+						DropletGUID:   "droplet-guid",
+						Relationships: resources.Relationships{constant.RelationshipTypeApplication: resources.Relationship{GUID: app.GUID}},
+					}))
 					Expect(testUI.Err).To(Say("create-deployment-warning"))
 
 					Expect(testUI.Out).To(Say("Waiting for app to deploy..."))
@@ -408,7 +417,7 @@ var _ = Describe("app stager", func() {
 
 			When("creating a deployment fails", func() {
 				BeforeEach(func() {
-					fakeActor.CreateDeploymentByApplicationAndDropletReturns(
+					fakeActor.CreateDeploymentReturns(
 						"",
 						v7action.Warnings{"create-deployment-warning"},
 						errors.New("create-deployment-error"),
@@ -434,6 +443,46 @@ var _ = Describe("app stager", func() {
 			})
 		})
 
+		When("the deployment strategy is canary", func() {
+			BeforeEach(func() {
+				strategy = constant.DeploymentStrategyCanary
+				noWait = false
+				canarySteps = []resources.CanaryStep{{InstanceWeight: 20}, {InstanceWeight: 60}}
+				fakeActor.CreateDeploymentReturns(
+					"some-deployment-guid",
+					v7action.Warnings{"create-deployment-warning"},
+					nil,
+				)
+				fakeActor.PollStartForRollingReturns(
+					v7action.Warnings{"poll-start-warning"},
+					nil,
+				)
+			})
+
+			It("creates a canary deployment with the instance steps", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Creating deployment for app %s...", app.Name))
+				Expect(fakeActor.CreateDeploymentCallCount()).To(Equal(1))
+				dep := fakeActor.CreateDeploymentArgsForCall(0)
+				Expect(dep).To(Equal(resources.Deployment{
+					Strategy:      constant.DeploymentStrategyCanary,
+					DropletGUID:   "droplet-guid",
+					CanarySteps:   []resources.CanaryStep{{InstanceWeight: 20}, {InstanceWeight: 60}},
+					Relationships: resources.Relationships{constant.RelationshipTypeApplication: resources.Relationship{GUID: app.GUID}},
+				}))
+
+				Expect(testUI.Out).To(Say("Waiting for app to deploy..."))
+				Expect(fakeActor.PollStartForRollingCallCount()).To(Equal(1))
+				_, deploymentGUID, _, _ := fakeActor.PollStartForRollingArgsForCall(0)
+				Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+			})
+
+			It("tells the user how to continue or cancel the deployment", func() {
+				Expect(testUI.Out).To(Say(`TIP: Run 'some-binary-name continue-deployment app-name' to promote the canary deployment, or 'some-binary-name cancel-deployment app-name' to roll it back\.`))
+			})
+		})
+
 		When("the deployment strategy is NOT rolling", func() {
 			BeforeEach(func() {
 				fakeActor.StopApplicationReturns(
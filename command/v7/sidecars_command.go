@@ -0,0 +1,86 @@
+package v7
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/bytefmt"
+
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type SidecarsCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	usage           interface{}  `usage:"CF_NAME sidecars APP_NAME"`
+	relatedCommands interface{}  `related_commands:"create-app-manifest, create-sidecar, delete-sidecar, update-sidecar"`
+}
+
+func (cmd SidecarsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting sidecars for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"CurrentUser": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	sidecars, warnings, err := cmd.Actor.GetApplicationSidecarsByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if cmd.UI.IsStructuredOutput() {
+		output := []sidecarOutput{}
+		for _, sidecar := range sidecars {
+			output = append(output, newSidecarOutput(sidecar))
+		}
+		return cmd.UI.DisplayStructuredOutput(output)
+	}
+
+	if len(sidecars) == 0 {
+		cmd.UI.DisplayText("No sidecars found for app.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("process types"),
+			cmd.UI.TranslateText("memory"),
+			cmd.UI.TranslateText("command"),
+		},
+	}
+	for _, sidecar := range sidecars {
+		memory := ""
+		if sidecar.MemoryInMB.IsSet {
+			memory = bytefmt.ByteSize(sidecar.MemoryInMB.Value * bytefmt.MEGABYTE)
+		}
+		table = append(table, []string{
+			sidecar.Name,
+			strings.Join(sidecar.ProcessTypes, ", "),
+			memory,
+			sidecar.Command.Value,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
+
+func (SidecarsCommand) SupportsStructuredOutput() bool {
+	return true
+}
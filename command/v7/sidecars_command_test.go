@@ -0,0 +1,163 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("sidecars Command", func() {
+	var (
+		cmd             SidecarsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+		binaryName      string
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = SidecarsCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("getting the sidecars fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationSidecarsByNameAndSpaceReturns(nil, v7action.Warnings{"some-warning"}, errors.New("some-error"))
+		})
+
+		It("displays the warnings and returns the error", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+
+	When("the app has no sidecars", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationSidecarsByNameAndSpaceReturns(nil, v7action.Warnings{"some-warning"}, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Getting sidecars for app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("No sidecars found for app."))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+
+	When("the app has sidecars", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationSidecarsByNameAndSpaceReturns([]resources.Sidecar{
+				{
+					Name:         "auth-proxy",
+					Command:      types.FilteredString{IsSet: true, Value: "./proxy"},
+					ProcessTypes: []string{"web", "worker"},
+					MemoryInMB:   types.NullUint64{IsSet: true, Value: 64},
+				},
+				{
+					Name:         "metrics",
+					Command:      types.FilteredString{IsSet: true, Value: "./agent"},
+					ProcessTypes: []string{"web"},
+				},
+			}, nil, nil)
+		})
+
+		It("displays a table of the sidecars", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeActor.GetApplicationSidecarsByNameAndSpaceCallCount()).To(Equal(1))
+			appName, spaceGUID := fakeActor.GetApplicationSidecarsByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(testUI.Out).To(Say(`name\s+process types\s+memory\s+command`))
+			Expect(testUI.Out).To(Say(`auth-proxy\s+web, worker\s+64M\s+\./proxy`))
+			Expect(testUI.Out).To(Say(`metrics\s+web\s+\./agent`))
+		})
+	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetApplicationSidecarsByNameAndSpaceReturns([]resources.Sidecar{
+				{
+					GUID:         "sidecar-guid",
+					Name:         "auth-proxy",
+					Command:      types.FilteredString{IsSet: true, Value: "./proxy"},
+					ProcessTypes: []string{"web"},
+					MemoryInMB:   types.NullUint64{IsSet: true, Value: 64},
+				},
+			}, v7action.Warnings{"some-warning"}, nil)
+		})
+
+		It("renders the sidecars as JSON", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(string(out.Contents())).To(MatchJSON(`[
+				{
+					"guid": "sidecar-guid",
+					"name": "auth-proxy",
+					"process_types": ["web"],
+					"memory_in_mb": 64,
+					"command": "./proxy"
+				}
+			]`))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+})
@@ -50,6 +50,11 @@ func (cmd SpaceCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructuredOutput(newSpaceOutput(spaceSummary, cmd.SecurityGroupRules))
+	}
+
 	table := [][]string{
 		{cmd.UI.TranslateText("name:"), spaceSummary.Name},
 		{cmd.UI.TranslateText("org:"), spaceSummary.OrgName},
@@ -70,6 +75,10 @@ func (cmd SpaceCommand) Execute(args []string) error {
 	return nil
 }
 
+func (SpaceCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd SpaceCommand) displaySpaceGUID(spaceName string, orgGUID string) error {
 	space, warnings, err := cmd.Actor.GetSpaceByNameAndOrganization(spaceName, orgGUID)
 	cmd.UI.DisplayWarnings(warnings)
@@ -1,6 +1,7 @@
 package v7_test
 
 import (
+	"encoding/json"
 	"errors"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
@@ -352,4 +353,64 @@ var _ = Describe("space command", func() {
 			})
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetSpaceSummaryByNameAndOrganizationReturns(
+				v7action.SpaceSummary{
+					Space:                 resources.Space{Name: "some-space", GUID: "some-space-guid"},
+					Name:                  "some-space",
+					OrgName:               "some-org",
+					AppNames:              []string{"app-1"},
+					IsolationSegmentName:  "some-iso",
+					QuotaName:             "some-quota",
+					RunningSecurityGroups: []resources.SecurityGroup{{Name: "running-group", Rules: []resources.Rule{{Protocol: "tcp", Destination: "10.0.0.0/8"}}}},
+				},
+				v7action.Warnings{"get-space-summary-warning"},
+				nil)
+		})
+
+		It("renders the space as JSON on stdout and everything else on stderr", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(string(out.Contents())).To(MatchJSON(`{
+				"name": "some-space",
+				"guid": "some-space-guid",
+				"org": "some-org",
+				"apps": ["app-1"],
+				"services": [],
+				"isolation_segment": "some-iso",
+				"quota": "some-quota",
+				"running_security_groups": [{"name": "running-group"}],
+				"staging_security_groups": []
+			}`))
+			Expect(testUI.Err).To(Say("get-space-summary-warning"))
+		})
+
+		When("--security-group-rules is passed", func() {
+			BeforeEach(func() {
+				cmd.SecurityGroupRules = true
+			})
+
+			It("includes the rules of each security group", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				var output map[string]interface{}
+				Expect(json.Unmarshal(out.Contents(), &output)).To(Succeed())
+				Expect(output["running_security_groups"]).To(Equal([]interface{}{
+					map[string]interface{}{
+						"name":  "running-group",
+						"rules": []interface{}{map[string]interface{}{"protocol": "tcp", "destination": "10.0.0.0/8"}},
+					},
+				}))
+			})
+		})
+	})
 })
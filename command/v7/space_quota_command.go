@@ -42,8 +42,16 @@ func (cmd SpaceQuotaCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructuredOutput(newQuotaOutput(resources.Quota(spaceQuota.Quota)))
+	}
+
 	quotaDisplayer := shared.NewQuotaDisplayer(cmd.UI)
 	quotaDisplayer.DisplaySingleQuota(resources.Quota(spaceQuota.Quota))
 
 	return nil
 }
+
+func (SpaceQuotaCommand) SupportsStructuredOutput() bool {
+	return true
+}
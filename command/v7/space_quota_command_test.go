@@ -137,4 +137,45 @@ var _ = Describe("Space Quota Command", func() {
 			Expect(testUI.Out).To(Say(`route ports:\s+unlimited`))
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeActor.GetSpaceQuotaByNameReturns(
+				resources.SpaceQuota{Quota: resources.Quota{
+					Name: "some-space-quota",
+					GUID: "some-space-quota-guid",
+					Routes: resources.RouteLimit{
+						TotalRoutes: &types.NullInt{IsSet: true, Value: 10},
+					},
+				}},
+				v7action.Warnings{"warning-1"},
+				nil)
+		})
+
+		It("renders the quota as JSON, with unlimited values as null", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(string(out.Contents())).To(MatchJSON(`{
+				"name": "some-space-quota",
+				"guid": "some-space-quota-guid",
+				"total_memory_in_mb": null,
+				"instance_memory_in_mb": null,
+				"routes": 10,
+				"service_instances": null,
+				"paid_service_plans": false,
+				"app_instances": null,
+				"route_ports": null
+			}`))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+
 })
@@ -40,8 +40,16 @@ func (cmd SpaceQuotasCommand) Execute(args []string) error {
 		quotas = append(quotas, resources.Quota(orgQuota.Quota))
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructuredOutput(newQuotasOutput(quotas))
+	}
+
 	quotaDisplayer := shared.NewQuotaDisplayer(cmd.UI)
 	quotaDisplayer.DisplayQuotasTable(quotas, "No space quotas found.")
 
 	return nil
 }
+
+func (SpaceQuotasCommand) SupportsStructuredOutput() bool {
+	return true
+}
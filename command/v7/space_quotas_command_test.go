@@ -180,4 +180,22 @@ var _ = Describe("space-quotas command", func() {
 			Expect(testUI.Out).To(Say("No space quotas found."))
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetSpaceQuotasByOrgGUIDReturns([]resources.SpaceQuota{}, nil, nil)
+		})
+
+		It("renders an empty list", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(string(out.Contents())).To(MatchJSON(`[]`))
+		})
+	})
 })
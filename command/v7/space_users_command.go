@@ -50,11 +50,24 @@ func (cmd *SpaceUsersCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructuredOutput(newUsersByRoleOutput(map[string][]resources.User{
+			"SPACE MANAGER":   spaceUsersByRoleType[constant.SpaceManagerRole],
+			"SPACE DEVELOPER": spaceUsersByRoleType[constant.SpaceDeveloperRole],
+			"SPACE SUPPORTER": spaceUsersByRoleType[constant.SpaceSupporterRole],
+			"SPACE AUDITOR":   spaceUsersByRoleType[constant.SpaceAuditorRole],
+		}))
+	}
+
 	cmd.displaySpaceUsers(spaceUsersByRoleType)
 
 	return nil
 }
 
+func (SpaceUsersCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd SpaceUsersCommand) displaySpaceUsers(orgUsersByRoleType map[constant.RoleType][]resources.User) {
 	cmd.displayRoleGroup(orgUsersByRoleType[constant.SpaceManagerRole], "SPACE MANAGER")
 	cmd.displayRoleGroup(orgUsersByRoleType[constant.SpaceDeveloperRole], "SPACE DEVELOPER")
@@ -268,4 +268,34 @@ var _ = Describe("space-users Command", func() {
 			})
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetSpaceUsersByRoleTypeReturns(
+				map[constant.RoleType][]resources.User{
+					constant.SpaceManagerRole: {{GUID: "user-guid-1", Username: "manager", PresentationName: "manager", Origin: "uaa"}},
+				},
+				v7action.Warnings{"get-users-warning"},
+				nil)
+		})
+
+		It("renders the users by role as JSON on stdout and everything else on stderr", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(string(out.Contents())).To(MatchJSON(`{
+				"space_manager": [{"guid": "user-guid-1", "username": "manager", "presentation_name": "manager", "origin": "uaa"}],
+				"space_developer": [],
+				"space_supporter": [],
+				"space_auditor": []
+			}`))
+			Expect(testUI.Err).To(Say("get-users-warning"))
+		})
+	})
 })
@@ -36,6 +36,14 @@ func (cmd SpacesCommand) Execute([]string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		output := []namedResourceOutput{}
+		for _, space := range spaces {
+			output = append(output, namedResourceOutput{Name: space.Name, GUID: space.GUID, Labels: newLabelsOutput(space.Metadata)})
+		}
+		return cmd.UI.DisplayStructuredOutput(output)
+	}
+
 	if len(spaces) == 0 {
 		cmd.UI.DisplayText("No spaces found.")
 	} else {
@@ -45,6 +53,10 @@ func (cmd SpacesCommand) Execute([]string) error {
 	return nil
 }
 
+func (SpacesCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd SpacesCommand) displaySpaces(spaces []resources.Space) {
 	table := [][]string{{cmd.UI.TranslateText("name")}}
 
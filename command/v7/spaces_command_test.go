@@ -183,4 +183,30 @@ var _ = Describe("spaces Command", func() {
 			})
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatYAML)
+			cmd.UI = testUI
+
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeActor.GetOrganizationSpacesWithLabelSelectorReturns(
+				[]resources.Space{
+					{Name: "space-1", GUID: "space-guid-1"},
+				},
+				v7action.Warnings{"get-spaces-warning"},
+				nil,
+			)
+		})
+
+		It("renders the spaces as YAML", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(string(out.Contents())).To(MatchYAML("- name: space-1\n  guid: space-guid-1\n"))
+			Expect(testUI.Err).To(Say("get-spaces-warning"))
+		})
+	})
 })
@@ -1,39 +1,50 @@
 package v7
 
 import (
+	"fmt"
+	"strconv"
+
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SharedSSHActor
 
 type SharedSSHActor interface {
+	ExecuteSecureCopy(sshClient sharedaction.SecureShellClient, copyOptions sharedaction.SecureCopyOptions, progressBar clissh.ProgressBar) error
 	ExecuteSecureShell(sshClient sharedaction.SecureShellClient, sshOptions sharedaction.SSHOptions) error
+	ExecuteSecureShellOnInstances(newSSHClient func() sharedaction.SecureShellClient, sshOptions sharedaction.MultiInstanceSSHOptions) []sharedaction.InstanceCommandResult
 }
 
 type SSHCommand struct {
 	BaseCommand
 
-	RequiredArgs          flag.AppName             `positional-args:"yes"`
-	ProcessIndex          uint                     `long:"app-instance-index" short:"i" default:"0" description:"App process instance index"`
-	Commands              []string                 `long:"command" short:"c" description:"Command to run"`
-	DisablePseudoTTY      bool                     `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
-	ForcePseudoTTY        bool                     `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
-	LocalPortForwardSpecs []flag.SSHPortForwarding `short:"L" description:"Local port forward specification"`
-	ProcessType           string                   `long:"process" default:"web" description:"App process name"`
-	RequestPseudoTTY      bool                     `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
-	SkipHostValidation    bool                     `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
-	SkipRemoteExecution   bool                     `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
-
-	usage           interface{} `usage:"CF_NAME ssh APP_NAME [--process PROCESS] [-i INDEX] [-c COMMAND]...\n   [-L [BIND_ADDRESS:]LOCAL_PORT:REMOTE_HOST:REMOTE_PORT]... [--skip-remote-execution]\n   [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty] [--skip-host-validation]"`
+	RequiredArgs            flag.AppName                    `positional-args:"yes"`
+	AllInstances            bool                            `long:"all-instances" description:"Run the command on every running instance of the process; output is prefixed with the instance index"`
+	ProcessIndex            uint                            `long:"app-instance-index" short:"i" default:"0" description:"App process instance index"`
+	Commands                []string                        `long:"command" short:"c" description:"Command to run"`
+	DynamicPortForwardSpecs []flag.SSHDynamicPortForwarding `short:"D" description:"Dynamic (SOCKS5) port forward specification"`
+	DisablePseudoTTY        bool                            `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
+	ForcePseudoTTY          bool                            `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
+	LocalPortForwardSpecs   []flag.SSHPortForwarding        `short:"L" description:"Local port forward specification"`
+	Parallel                flag.PositiveInteger            `long:"parallel" description:"Number of instances to run the command on at the same time with --all-instances (Default: 10)"`
+	ProcessType             string                          `long:"process" default:"web" description:"App process name"`
+	RemotePortForwardSpecs  []flag.SSHRemotePortForwarding  `short:"R" description:"Remote port forward specification"`
+	RequestPseudoTTY        bool                            `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
+	SkipHostValidation      bool                            `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+	SkipRemoteExecution     bool                            `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
+
+	usage           interface{} `usage:"CF_NAME ssh APP_NAME [--process PROCESS] [-i INDEX] [-c COMMAND]...\n   [-L [BIND_ADDRESS:]LOCAL_PORT:REMOTE_HOST:REMOTE_PORT]...\n   [-R [BIND_ADDRESS:]REMOTE_PORT:LOCAL_HOST:LOCAL_PORT]... [-D [BIND_ADDRESS:]LOCAL_PORT]... [--skip-remote-execution]\n   [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty] [--skip-host-validation]\n\n   CF_NAME ssh APP_NAME --all-instances [--process PROCESS] [--parallel COUNT] -c COMMAND... [--skip-host-validation]"`
 	relatedCommands interface{} `related_commands:"allow-space-ssh, enable-ssh, space-ssh-allowed, ssh-code, ssh-enabled"`
 	allproxy        interface{} `environmentName:"all_proxy" environmentDescription:"Specify a proxy server to enable proxying for all requests"`
 
-	SSHActor  SharedSSHActor
-	SSHClient *clissh.SecureShell
+	SSHActor     SharedSSHActor
+	SSHClient    *clissh.SecureShell
+	NewSSHClient func() sharedaction.SecureShellClient
 }
 
 func (cmd *SSHCommand) Setup(config command.Config, ui command.UI) error {
@@ -46,6 +57,9 @@ func (cmd *SSHCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.SharedActor = sharedActor
 	cmd.SSHActor = sharedActor
 	cmd.SSHClient = clissh.NewDefaultSecureShell()
+	cmd.NewSSHClient = func() sharedaction.SecureShellClient {
+		return clissh.NewDefaultSecureShell()
+	}
 
 	return nil
 }
@@ -57,6 +71,10 @@ func (cmd SSHCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.AllInstances {
+		return cmd.executeOnAllInstances()
+	}
+
 	ttyOption, err := cmd.EvaluateTTYOption()
 	if err != nil {
 		return err
@@ -67,6 +85,16 @@ func (cmd SSHCommand) Execute(args []string) error {
 		forwardSpecs = append(forwardSpecs, sharedaction.LocalPortForward(spec))
 	}
 
+	var remoteForwardSpecs []sharedaction.RemotePortForward
+	for _, spec := range cmd.RemotePortForwardSpecs {
+		remoteForwardSpecs = append(remoteForwardSpecs, sharedaction.RemotePortForward(spec))
+	}
+
+	var dynamicForwardSpecs []sharedaction.DynamicPortForward
+	for _, spec := range cmd.DynamicPortForwardSpecs {
+		dynamicForwardSpecs = append(dynamicForwardSpecs, sharedaction.DynamicPortForward(spec))
+	}
+
 	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
 		cmd.RequiredArgs.AppName,
 		cmd.Config.TargetedSpace().GUID,
@@ -81,15 +109,17 @@ func (cmd SSHCommand) Execute(args []string) error {
 	err = cmd.SSHActor.ExecuteSecureShell(
 		cmd.SSHClient,
 		sharedaction.SSHOptions{
-			Commands:              cmd.Commands,
-			Endpoint:              sshAuth.Endpoint,
-			HostKeyFingerprint:    sshAuth.HostKeyFingerprint,
-			LocalPortForwardSpecs: forwardSpecs,
-			Passcode:              sshAuth.Passcode,
-			SkipHostValidation:    cmd.SkipHostValidation,
-			SkipRemoteExecution:   cmd.SkipRemoteExecution,
-			TTYOption:             ttyOption,
-			Username:              sshAuth.Username,
+			Commands:                cmd.Commands,
+			DynamicPortForwardSpecs: dynamicForwardSpecs,
+			Endpoint:                sshAuth.Endpoint,
+			HostKeyFingerprint:      sshAuth.HostKeyFingerprint,
+			LocalPortForwardSpecs:   forwardSpecs,
+			Passcode:                sshAuth.Passcode,
+			RemotePortForwardSpecs:  remoteForwardSpecs,
+			SkipHostValidation:      cmd.SkipHostValidation,
+			SkipRemoteExecution:     cmd.SkipRemoteExecution,
+			TTYOption:               ttyOption,
+			Username:                sshAuth.Username,
 		})
 	if err != nil {
 		return err
@@ -98,6 +128,120 @@ func (cmd SSHCommand) Execute(args []string) error {
 	return nil
 }
 
+func (cmd SSHCommand) executeOnAllInstances() error {
+	err := cmd.validateAllInstancesFlags()
+	if err != nil {
+		return err
+	}
+
+	sshAuths, warnings, err := cmd.Actor.GetSecureShellConfigurationsForRunningInstances(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.ProcessType,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	var instances []sharedaction.InstanceSSHOptions
+	for _, sshAuth := range sshAuths {
+		instances = append(instances, sharedaction.InstanceSSHOptions{
+			Index:              sshAuth.Index,
+			Username:           sshAuth.Username,
+			GetPasscode:        cmd.Actor.GetSSHPasscode,
+			Endpoint:           sshAuth.Endpoint,
+			HostKeyFingerprint: sshAuth.HostKeyFingerprint,
+		})
+	}
+
+	parallel := 10
+	if cmd.Parallel.Value > 0 {
+		parallel = int(cmd.Parallel.Value)
+	}
+
+	results := cmd.SSHActor.ExecuteSecureShellOnInstances(
+		cmd.NewSSHClient,
+		sharedaction.MultiInstanceSSHOptions{
+			Commands:           cmd.Commands,
+			Instances:          instances,
+			MaxInFlight:        parallel,
+			SkipHostValidation: cmd.SkipHostValidation,
+			Stdout:             cmd.UI.GetOut(),
+			Stderr:             cmd.UI.GetErr(),
+		})
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("instance"),
+			cmd.UI.TranslateText("exit status"),
+			cmd.UI.TranslateText("error"),
+		},
+	}
+
+	var failed, exitStatus int
+	for _, result := range results {
+		var errMessage string
+		if result.Err != nil {
+			errMessage = result.Err.Error()
+		}
+		table = append(table, []string{
+			fmt.Sprintf("#%d", result.Index),
+			strconv.Itoa(result.ExitStatus),
+			errMessage,
+		})
+
+		if result.ExitStatus != 0 {
+			failed++
+			if result.ExitStatus > exitStatus {
+				exitStatus = result.ExitStatus
+			}
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	if failed > 0 {
+		return translatableerror.SSHInstancesFailedError{
+			Failed:     failed,
+			Total:      len(results),
+			ExitStatus: exitStatus,
+		}
+	}
+
+	return nil
+}
+
+// validateAllInstancesFlags returns an error when --all-instances is used
+// without a command or with flags that only apply to a single interactive
+// session.
+func (cmd SSHCommand) validateAllInstancesFlags() error {
+	if len(cmd.Commands) == 0 {
+		return translatableerror.RequiredFlagsError{Arg1: "--all-instances", Arg2: "--command, -c"}
+	}
+
+	conflicts := []struct {
+		set  bool
+		flag string
+	}{
+		{cmd.ProcessIndex != 0, "--app-instance-index, -i"},
+		{len(cmd.LocalPortForwardSpecs) > 0, "-L"},
+		{len(cmd.RemotePortForwardSpecs) > 0, "-R"},
+		{len(cmd.DynamicPortForwardSpecs) > 0, "-D"},
+		{cmd.SkipRemoteExecution, "--skip-remote-execution, -N"},
+		{cmd.ForcePseudoTTY, "--force-pseudo-tty"},
+		{cmd.RequestPseudoTTY, "--request-pseudo-tty, -t"},
+	}
+	for _, conflict := range conflicts {
+		if conflict.set {
+			return translatableerror.ArgumentCombinationError{Args: []string{"--all-instances", conflict.flag}}
+		}
+	}
+
+	return nil
+}
+
 // EvaluateTTYOption determines which TTY options are mutually exclusive and
 // returns an error accordingly.
 func (cmd SSHCommand) EvaluateTTYOption() (sharedaction.TTYOption, error) {
@@ -2,6 +2,7 @@ package v7_test
 
 import (
 	"errors"
+	"fmt"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -157,6 +158,30 @@ var _ = Describe("ssh Command", func() {
 							}))
 						})
 					})
+
+					When("working with remote and dynamic port forwarding", func() {
+						BeforeEach(func() {
+							cmd.RemotePortForwardSpecs = []flag.SSHRemotePortForwarding{
+								{RemoteAddress: "localhost:9000", LocalAddress: "localhost:5005"},
+							}
+							cmd.DynamicPortForwardSpecs = []flag.SSHDynamicPortForwarding{
+								{LocalAddress: "localhost:1080"},
+							}
+						})
+
+						It("passes along port forwarding information", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeSSHActor.ExecuteSecureShellCallCount()).To(Equal(1))
+							_, sshOptionsArg := fakeSSHActor.ExecuteSecureShellArgsForCall(0)
+							Expect(sshOptionsArg.RemotePortForwardSpecs).To(Equal([]sharedaction.RemotePortForward{
+								{RemoteAddress: "localhost:9000", LocalAddress: "localhost:5005"},
+							}))
+							Expect(sshOptionsArg.DynamicPortForwardSpecs).To(Equal([]sharedaction.DynamicPortForward{
+								{LocalAddress: "localhost:1080"},
+							}))
+						})
+					})
 				})
 
 				When("executing the secure shell fails", func() {
@@ -183,6 +208,163 @@ var _ = Describe("ssh Command", func() {
 					Expect(testUI.Err).To(Say("some-warnings"))
 				})
 			})
+
+			When("--all-instances is provided", func() {
+				BeforeEach(func() {
+					cmd.AllInstances = true
+					cmd.ProcessIndex = 0
+					cmd.SkipRemoteExecution = false
+					cmd.NewSSHClient = func() sharedaction.SecureShellClient { return nil }
+
+					fakeActor.GetSecureShellConfigurationsForRunningInstancesReturns(
+						[]v7action.InstanceSSHAuthentication{
+							{
+								Endpoint:           "some-endpoint",
+								HostKeyFingerprint: "some-fingerprint",
+								Username:           "some-username-0",
+								Index:              0,
+							},
+							{
+								Endpoint:           "some-endpoint",
+								HostKeyFingerprint: "some-fingerprint",
+								Username:           "some-username-2",
+								Index:              2,
+							},
+						},
+						v7action.Warnings{"some-warnings"},
+						nil,
+					)
+				})
+
+				When("the command succeeds on every instance", func() {
+					BeforeEach(func() {
+						fakeSSHActor.ExecuteSecureShellOnInstancesReturns([]sharedaction.InstanceCommandResult{
+							{Index: 0},
+							{Index: 2},
+						})
+					})
+
+					It("runs the command on every running instance and displays the results", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Err).To(Say("some-warnings"))
+
+						Expect(fakeActor.GetSecureShellConfigurationsForRunningInstancesCallCount()).To(Equal(1))
+						appNameArg, spaceGUIDArg, processTypeArg := fakeActor.GetSecureShellConfigurationsForRunningInstancesArgsForCall(0)
+						Expect(appNameArg).To(Equal(appName))
+						Expect(spaceGUIDArg).To(Equal("some-space-guid"))
+						Expect(processTypeArg).To(Equal("some-process-type"))
+
+						Expect(fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexCallCount()).To(Equal(0))
+						Expect(fakeSSHActor.ExecuteSecureShellCallCount()).To(Equal(0))
+
+						Expect(fakeSSHActor.ExecuteSecureShellOnInstancesCallCount()).To(Equal(1))
+						_, sshOptionsArg := fakeSSHActor.ExecuteSecureShellOnInstancesArgsForCall(0)
+						Expect(sshOptionsArg.Commands).To(Equal([]string{"some", "commands"}))
+						Expect(sshOptionsArg.MaxInFlight).To(Equal(10))
+						Expect(sshOptionsArg.SkipHostValidation).To(BeTrue())
+						Expect(sshOptionsArg.Stdout).To(Equal(testUI.Out))
+						Expect(sshOptionsArg.Stderr).To(Equal(testUI.Err))
+						Expect(sshOptionsArg.Instances).To(HaveLen(2))
+						for i, index := range []uint{0, 2} {
+							instance := sshOptionsArg.Instances[i]
+							Expect(instance.Index).To(Equal(index))
+							Expect(instance.Username).To(Equal(fmt.Sprintf("some-username-%d", index)))
+							Expect(instance.Endpoint).To(Equal("some-endpoint"))
+							Expect(instance.HostKeyFingerprint).To(Equal("some-fingerprint"))
+						}
+
+						Expect(fakeActor.GetSSHPasscodeCallCount()).To(Equal(0))
+						fakeActor.GetSSHPasscodeReturns("some-passcode", nil)
+						passcode, err := sshOptionsArg.Instances[1].GetPasscode()
+						Expect(err).ToNot(HaveOccurred())
+						Expect(passcode).To(Equal("some-passcode"))
+						Expect(fakeActor.GetSSHPasscodeCallCount()).To(Equal(1))
+
+						Expect(testUI.Out).To(Say(`instance\s+exit status\s+error`))
+						Expect(testUI.Out).To(Say(`#0\s+0`))
+						Expect(testUI.Out).To(Say(`#2\s+0`))
+					})
+
+					When("--parallel is provided", func() {
+						BeforeEach(func() {
+							cmd.Parallel = flag.PositiveInteger{Value: 3}
+						})
+
+						It("runs the command on that many instances at the same time", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							_, sshOptionsArg := fakeSSHActor.ExecuteSecureShellOnInstancesArgsForCall(0)
+							Expect(sshOptionsArg.MaxInFlight).To(Equal(3))
+						})
+					})
+				})
+
+				When("the command fails on some instances", func() {
+					BeforeEach(func() {
+						fakeSSHActor.ExecuteSecureShellOnInstancesReturns([]sharedaction.InstanceCommandResult{
+							{Index: 0, ExitStatus: 3},
+							{Index: 1},
+							{Index: 2, ExitStatus: 255, Err: errors.New("unable to connect")},
+						})
+					})
+
+					It("displays the results and returns an error with the highest exit status", func() {
+						Expect(executeErr).To(MatchError(translatableerror.SSHInstancesFailedError{
+							Failed:     2,
+							Total:      3,
+							ExitStatus: 255,
+						}))
+
+						Expect(testUI.Out).To(Say(`#0\s+3`))
+						Expect(testUI.Out).To(Say(`#1\s+0`))
+						Expect(testUI.Out).To(Say(`#2\s+255\s+unable to connect`))
+					})
+				})
+
+				When("getting the secure shell authentication fails", func() {
+					BeforeEach(func() {
+						fakeActor.GetSecureShellConfigurationsForRunningInstancesReturns(nil, v7action.Warnings{"some-warnings"}, actionerror.NoRunningProcessInstancesError{ProcessType: "some-process-type"})
+					})
+
+					It("returns the error and displays all warnings", func() {
+						Expect(executeErr).To(MatchError(actionerror.NoRunningProcessInstancesError{ProcessType: "some-process-type"}))
+						Expect(testUI.Err).To(Say("some-warnings"))
+						Expect(fakeSSHActor.ExecuteSecureShellOnInstancesCallCount()).To(Equal(0))
+					})
+				})
+
+				When("no command is provided", func() {
+					BeforeEach(func() {
+						cmd.Commands = nil
+					})
+
+					It("returns a RequiredFlagsError", func() {
+						Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--all-instances", Arg2: "--command, -c"}))
+						Expect(fakeActor.GetSecureShellConfigurationsForRunningInstancesCallCount()).To(Equal(0))
+					})
+				})
+
+				DescribeTable("flags that only apply to a single instance",
+					func(setFlag func(), flagName string) {
+						setFlag()
+						Expect(cmd.Execute(nil)).To(MatchError(translatableerror.ArgumentCombinationError{
+							Args: []string{"--all-instances", flagName},
+						}))
+					},
+					Entry("-i", func() { cmd.ProcessIndex = 1 }, "--app-instance-index, -i"),
+					Entry("-L", func() {
+						cmd.LocalPortForwardSpecs = []flag.SSHPortForwarding{{LocalAddress: "localhost:8888", RemoteAddress: "remote:4444"}}
+					}, "-L"),
+					Entry("-R", func() {
+						cmd.RemotePortForwardSpecs = []flag.SSHRemotePortForwarding{{RemoteAddress: "localhost:9000", LocalAddress: "localhost:5005"}}
+					}, "-R"),
+					Entry("-D", func() {
+						cmd.DynamicPortForwardSpecs = []flag.SSHDynamicPortForwarding{{LocalAddress: "localhost:1080"}}
+					}, "-D"),
+					Entry("-N", func() { cmd.SkipRemoteExecution = true }, "--skip-remote-execution, -N"),
+					Entry("--force-pseudo-tty", func() { cmd.ForcePseudoTTY = true }, "--force-pseudo-tty"),
+					Entry("-t", func() { cmd.RequestPseudoTTY = true }, "--request-pseudo-tty, -t"),
+				)
+			})
 		})
 	})
 
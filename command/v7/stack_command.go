@@ -27,6 +27,10 @@ func (cmd *StackCommand) Execute(args []string) error {
 	return cmd.displayStackInfo()
 }
 
+func (StackCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd *StackCommand) getStack(stackName string) (resources.Stack, error) {
 	stack, warnings, err := cmd.Actor.GetStackByName(cmd.RequiredArgs.StackName)
 	cmd.UI.DisplayWarnings(warnings)
@@ -60,6 +64,10 @@ func (cmd *StackCommand) displayStackInfo() error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructuredOutput(newStackOutput(stack))
+	}
+
 	cmd.UI.DisplayKeyValueTable("", [][]string{
 		{cmd.UI.TranslateText("name:"), stack.Name},
 		{cmd.UI.TranslateText("description:"), stack.Description},
@@ -125,6 +125,27 @@ var _ = Describe("Stack Command", func() {
 					Expect(testUI.Err).To(Say("some-warning-1"))
 				})
 			})
+
+			When("structured output is requested", func() {
+				var out *Buffer
+
+				BeforeEach(func() {
+					out = NewBuffer()
+					testUI = ui.NewTestUI(nil, out, NewBuffer())
+					testUI.SetOutputFormat(ui.OutputFormatJSON)
+					cmd.UI = testUI
+				})
+
+				It("renders the stack as JSON", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(string(out.Contents())).To(MatchJSON(`{
+						"name": "some-stack-name",
+						"guid": "some-stack-guid",
+						"description": "some-stack-desc"
+					}`))
+					Expect(testUI.Err).To(Say("some-warning-1"))
+				})
+			})
 		})
 
 		When("The Stack does not Exist", func() {
@@ -40,11 +40,23 @@ func (cmd StacksCommand) Execute(args []string) error {
 
 	sort.Slice(stacks, func(i, j int) bool { return sorting.LessIgnoreCase(stacks[i].Name, stacks[j].Name) })
 
+	if cmd.UI.IsStructuredOutput() {
+		output := []stackOutput{}
+		for _, stack := range stacks {
+			output = append(output, newStackOutput(stack))
+		}
+		return cmd.UI.DisplayStructuredOutput(output)
+	}
+
 	cmd.displayTable(stacks)
 
 	return nil
 }
 
+func (StacksCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd StacksCommand) displayTable(stacks []resources.Stack) {
 	if len(stacks) > 0 {
 		var keyValueTable = [][]string{
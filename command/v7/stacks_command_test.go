@@ -129,6 +129,26 @@ var _ = Describe("stacks Command", func() {
 			It("prints the flavor text", func() {
 				Expect(testUI.Out).To(Say("Getting stacks as banana\\.\\.\\."))
 			})
+
+			When("structured output is requested", func() {
+				var out *Buffer
+
+				BeforeEach(func() {
+					out = NewBuffer()
+					testUI = ui.NewTestUI(nil, out, NewBuffer())
+					testUI.SetOutputFormat(ui.OutputFormatJSON)
+					cmd.UI = testUI
+				})
+
+				It("renders the stacks as JSON in alphabetical order", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(string(out.Contents())).To(MatchJSON(`[
+						{"name": "stack1", "guid": "", "description": "desc1"},
+						{"name": "Stack2", "guid": "", "description": "desc2"}
+					]`))
+					Expect(testUI.Err).To(Say("Getting stacks as banana\\.\\.\\."))
+				})
+			})
 		})
 	})
 })
@@ -35,6 +35,10 @@ func (cmd StagingEnvironmentVariableGroupCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructuredOutput(newEnvironmentVariableGroupOutput(envVars))
+	}
+
 	if len(envVars) == 0 {
 		cmd.UI.DisplayTextWithFlavor("No staging environment variable group has been set.")
 	} else {
@@ -49,6 +53,10 @@ func (cmd StagingEnvironmentVariableGroupCommand) Execute(args []string) error {
 	return nil
 }
 
+func (StagingEnvironmentVariableGroupCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func buildEnvVarsTable(envVars v7action.EnvironmentVariableGroup) ([][]string, error) {
 	var keyValueTable = [][]string{
 		{"variable name", "assigned value"},
@@ -137,4 +137,32 @@ var _ = Describe("staging-environment-variable-group Command", func() {
 			})
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "apple"}, nil)
+			fakeActor.GetEnvironmentVariableGroupReturns(
+				v7action.EnvironmentVariableGroup{
+					"key_one": {Value: "one", IsSet: true},
+					"key_two": {Value: "two", IsSet: true},
+				},
+				v7action.Warnings{"warning-1"},
+				nil)
+		})
+
+		It("renders the environment variable group as JSON", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(string(out.Contents())).To(MatchJSON(`{"key_one": "one", "key_two": "two"}`))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+
 })
@@ -33,6 +33,14 @@ func (cmd StagingSecurityGroupsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		output := []securityGroupOutput{}
+		for _, securityGroup := range stagingSecurityGroups {
+			output = append(output, newSecurityGroupOutput(securityGroup.Name, securityGroup.Rules, true))
+		}
+		return cmd.UI.DisplayStructuredOutput(output)
+	}
+
 	if len(stagingSecurityGroups) == 0 {
 		cmd.UI.DisplayText("No global staging security groups found.")
 		return nil
@@ -50,3 +58,7 @@ func (cmd StagingSecurityGroupsCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (StagingSecurityGroupsCommand) SupportsStructuredOutput() bool {
+	return true
+}
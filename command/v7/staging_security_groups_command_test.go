@@ -117,4 +117,35 @@ var _ = Describe("Staging Security Groups Command", func() {
 			})
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeActor.GetGlobalStagingSecurityGroupsReturns(
+				[]resources.SecurityGroup{
+					{Name: "group-1", Rules: []resources.Rule{{Protocol: "all", Destination: "0.0.0.0-9.255.255.255"}}},
+					{Name: "group-2"},
+				},
+				v7action.Warnings{"warning-1"},
+				nil)
+		})
+
+		It("renders the security groups and their rules as JSON", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(string(out.Contents())).To(MatchJSON(`[
+				{"name": "group-1", "rules": [{"protocol": "all", "destination": "0.0.0.0-9.255.255.255"}]},
+				{"name": "group-2"}
+			]`))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+
 })
@@ -0,0 +1,944 @@
+package v7
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// The types in this file describe the stable shape of the data rendered by
+// the global --output flag. They are intentionally decoupled from the
+// resources and actor structs, whose JSON representation mirrors the Cloud
+// Controller API and may change between API versions.
+
+type labelsOutput map[string]string
+
+type appOutput struct {
+	Name       string          `json:"name" yaml:"name"`
+	GUID       string          `json:"guid" yaml:"guid"`
+	State      string          `json:"state" yaml:"state"`
+	Stack      string          `json:"stack,omitempty" yaml:"stack,omitempty"`
+	Buildpacks []string        `json:"buildpacks,omitempty" yaml:"buildpacks,omitempty"`
+	Lifecycle  string          `json:"lifecycle,omitempty" yaml:"lifecycle,omitempty"`
+	Droplet    string          `json:"droplet,omitempty" yaml:"droplet,omitempty"`
+	Processes  []processOutput `json:"processes" yaml:"processes"`
+	Routes     []string        `json:"routes" yaml:"routes"`
+	Labels     labelsOutput    `json:"labels,omitempty" yaml:"labels,omitempty"`
+}
+
+type processOutput struct {
+	Type             string           `json:"type" yaml:"type"`
+	Instances        int              `json:"instances" yaml:"instances"`
+	RunningInstances int              `json:"running_instances" yaml:"running_instances"`
+	MemoryInMB       uint64           `json:"memory_in_mb" yaml:"memory_in_mb"`
+	DiskInMB         uint64           `json:"disk_in_mb" yaml:"disk_in_mb"`
+	HealthCheckType  string           `json:"health_check_type" yaml:"health_check_type"`
+	InstanceDetails  []instanceOutput `json:"instance_details,omitempty" yaml:"instance_details,omitempty"`
+}
+
+type instanceOutput struct {
+	Index       int64   `json:"index" yaml:"index"`
+	State       string  `json:"state" yaml:"state"`
+	CPU         float64 `json:"cpu" yaml:"cpu"`
+	MemoryUsage uint64  `json:"memory_usage" yaml:"memory_usage"`
+	MemoryQuota uint64  `json:"memory_quota" yaml:"memory_quota"`
+	DiskUsage   uint64  `json:"disk_usage" yaml:"disk_usage"`
+	DiskQuota   uint64  `json:"disk_quota" yaml:"disk_quota"`
+	Uptime      int64   `json:"uptime_in_seconds" yaml:"uptime_in_seconds"`
+	Details     string  `json:"details,omitempty" yaml:"details,omitempty"`
+}
+
+type routeOutput struct {
+	GUID                string                   `json:"guid" yaml:"guid"`
+	URL                 string                   `json:"url" yaml:"url"`
+	Space               string                   `json:"space" yaml:"space"`
+	Host                string                   `json:"host" yaml:"host"`
+	Domain              string                   `json:"domain" yaml:"domain"`
+	Port                int                      `json:"port,omitempty" yaml:"port,omitempty"`
+	Path                string                   `json:"path" yaml:"path"`
+	Protocol            string                   `json:"protocol" yaml:"protocol"`
+	Apps                []string                 `json:"apps" yaml:"apps"`
+	AppProtocols        []string                 `json:"app_protocols" yaml:"app_protocols"`
+	Destinations        []routeDestinationOutput `json:"destinations" yaml:"destinations"`
+	ServiceInstanceName string                   `json:"service_instance,omitempty" yaml:"service_instance,omitempty"`
+}
+
+type routeDetailsOutput struct {
+	GUID         string                   `json:"guid" yaml:"guid"`
+	URL          string                   `json:"url" yaml:"url"`
+	Host         string                   `json:"host" yaml:"host"`
+	Domain       string                   `json:"domain" yaml:"domain"`
+	Port         int                      `json:"port,omitempty" yaml:"port,omitempty"`
+	Path         string                   `json:"path" yaml:"path"`
+	Protocol     string                   `json:"protocol" yaml:"protocol"`
+	Destinations []routeDestinationOutput `json:"destinations" yaml:"destinations"`
+}
+
+type routeDestinationOutput struct {
+	App         string `json:"app" yaml:"app"`
+	ProcessType string `json:"process_type" yaml:"process_type"`
+	Port        int    `json:"port,omitempty" yaml:"port,omitempty"`
+	Protocol    string `json:"protocol" yaml:"protocol"`
+	Weight      *int   `json:"weight,omitempty" yaml:"weight,omitempty"`
+}
+
+type serviceInstanceOutput struct {
+	Name             string   `json:"name" yaml:"name"`
+	Type             string   `json:"type" yaml:"type"`
+	Offering         string   `json:"offering" yaml:"offering"`
+	Plan             string   `json:"plan,omitempty" yaml:"plan,omitempty"`
+	Broker           string   `json:"broker,omitempty" yaml:"broker,omitempty"`
+	BoundApps        []string `json:"bound_apps" yaml:"bound_apps"`
+	LastOperation    string   `json:"last_operation,omitempty" yaml:"last_operation,omitempty"`
+	UpgradeAvailable *bool    `json:"upgrade_available,omitempty" yaml:"upgrade_available,omitempty"`
+}
+
+type namedResourceOutput struct {
+	Name   string       `json:"name" yaml:"name"`
+	GUID   string       `json:"guid" yaml:"guid"`
+	Labels labelsOutput `json:"labels,omitempty" yaml:"labels,omitempty"`
+}
+
+type stackOutput struct {
+	Name        string       `json:"name" yaml:"name"`
+	GUID        string       `json:"guid" yaml:"guid"`
+	Description string       `json:"description" yaml:"description"`
+	Labels      labelsOutput `json:"labels,omitempty" yaml:"labels,omitempty"`
+}
+
+type buildpackOutput struct {
+	Name     string `json:"name" yaml:"name"`
+	GUID     string `json:"guid" yaml:"guid"`
+	Position int    `json:"position" yaml:"position"`
+	Stack    string `json:"stack" yaml:"stack"`
+	Enabled  bool   `json:"enabled" yaml:"enabled"`
+	Locked   bool   `json:"locked" yaml:"locked"`
+	Filename string `json:"filename" yaml:"filename"`
+}
+
+type domainOutput struct {
+	Name      string   `json:"name" yaml:"name"`
+	GUID      string   `json:"guid" yaml:"guid"`
+	Shared    bool     `json:"shared" yaml:"shared"`
+	Internal  bool     `json:"internal" yaml:"internal"`
+	Protocols []string `json:"protocols" yaml:"protocols"`
+}
+
+type taskOutput struct {
+	ID         int64  `json:"id" yaml:"id"`
+	GUID       string `json:"guid" yaml:"guid"`
+	Name       string `json:"name" yaml:"name"`
+	State      string `json:"state" yaml:"state"`
+	Command    string `json:"command,omitempty" yaml:"command,omitempty"`
+	MemoryInMB uint64 `json:"memory_in_mb" yaml:"memory_in_mb"`
+	DiskInMB   uint64 `json:"disk_in_mb" yaml:"disk_in_mb"`
+	CreatedAt  string `json:"created_at" yaml:"created_at"`
+}
+
+type sidecarOutput struct {
+	GUID         string   `json:"guid" yaml:"guid"`
+	Name         string   `json:"name" yaml:"name"`
+	ProcessTypes []string `json:"process_types" yaml:"process_types"`
+	MemoryInMB   *uint64  `json:"memory_in_mb,omitempty" yaml:"memory_in_mb,omitempty"`
+	Command      string   `json:"command" yaml:"command"`
+}
+
+type eventOutput struct {
+	GUID             string                 `json:"guid" yaml:"guid"`
+	Time             string                 `json:"time" yaml:"time"`
+	Type             string                 `json:"type" yaml:"type"`
+	Actor            eventResourceOutput    `json:"actor" yaml:"actor"`
+	Target           eventResourceOutput    `json:"target" yaml:"target"`
+	SpaceGUID        string                 `json:"space_guid,omitempty" yaml:"space_guid,omitempty"`
+	OrganizationGUID string                 `json:"organization_guid,omitempty" yaml:"organization_guid,omitempty"`
+	Data             map[string]interface{} `json:"data,omitempty" yaml:"data,omitempty"`
+}
+
+type eventResourceOutput struct {
+	GUID string `json:"guid" yaml:"guid"`
+	Type string `json:"type" yaml:"type"`
+	Name string `json:"name" yaml:"name"`
+}
+
+type diffOutput struct {
+	App         string            `json:"app" yaml:"app"`
+	ComparedTo  diffSourceOutput  `json:"compared_to" yaml:"compared_to"`
+	Differences []diffEntryOutput `json:"differences" yaml:"differences"`
+}
+
+type diffSourceOutput struct {
+	Type  string `json:"type" yaml:"type"`
+	Name  string `json:"name" yaml:"name"`
+	Org   string `json:"org,omitempty" yaml:"org,omitempty"`
+	Space string `json:"space,omitempty" yaml:"space,omitempty"`
+}
+
+type diffEntryOutput struct {
+	Op    string      `json:"op" yaml:"op"`
+	Path  string      `json:"path" yaml:"path"`
+	Was   interface{} `json:"was,omitempty" yaml:"was,omitempty"`
+	Value interface{} `json:"value,omitempty" yaml:"value,omitempty"`
+}
+
+type orgOutput struct {
+	Name                    string       `json:"name" yaml:"name"`
+	GUID                    string       `json:"guid" yaml:"guid"`
+	Domains                 []string     `json:"domains" yaml:"domains"`
+	Quota                   string       `json:"quota" yaml:"quota"`
+	Spaces                  []string     `json:"spaces" yaml:"spaces"`
+	IsolationSegments       []string     `json:"isolation_segments" yaml:"isolation_segments"`
+	DefaultIsolationSegment string       `json:"default_isolation_segment,omitempty" yaml:"default_isolation_segment,omitempty"`
+	Labels                  labelsOutput `json:"labels,omitempty" yaml:"labels,omitempty"`
+}
+
+type spaceOutput struct {
+	Name                  string                `json:"name" yaml:"name"`
+	GUID                  string                `json:"guid" yaml:"guid"`
+	Org                   string                `json:"org" yaml:"org"`
+	Apps                  []string              `json:"apps" yaml:"apps"`
+	Services              []string              `json:"services" yaml:"services"`
+	IsolationSegment      string                `json:"isolation_segment" yaml:"isolation_segment"`
+	Quota                 string                `json:"quota" yaml:"quota"`
+	RunningSecurityGroups []securityGroupOutput `json:"running_security_groups" yaml:"running_security_groups"`
+	StagingSecurityGroups []securityGroupOutput `json:"staging_security_groups" yaml:"staging_security_groups"`
+	Labels                labelsOutput          `json:"labels,omitempty" yaml:"labels,omitempty"`
+}
+
+type securityGroupOutput struct {
+	Name     string                       `json:"name" yaml:"name"`
+	Rules    []securityGroupRuleOutput    `json:"rules,omitempty" yaml:"rules,omitempty"`
+	Bindings []securityGroupBindingOutput `json:"bindings,omitempty" yaml:"bindings,omitempty"`
+}
+
+type securityGroupRuleOutput struct {
+	Protocol    string `json:"protocol" yaml:"protocol"`
+	Destination string `json:"destination" yaml:"destination"`
+	Ports       string `json:"ports,omitempty" yaml:"ports,omitempty"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+type securityGroupBindingOutput struct {
+	Org       string `json:"org" yaml:"org"`
+	Space     string `json:"space" yaml:"space"`
+	Lifecycle string `json:"lifecycle" yaml:"lifecycle"`
+}
+
+type serviceInstanceDetailsOutput struct {
+	Name             string                 `json:"name" yaml:"name"`
+	GUID             string                 `json:"guid" yaml:"guid"`
+	Type             string                 `json:"type" yaml:"type"`
+	Broker           string                 `json:"broker,omitempty" yaml:"broker,omitempty"`
+	Offering         string                 `json:"offering,omitempty" yaml:"offering,omitempty"`
+	Plan             string                 `json:"plan,omitempty" yaml:"plan,omitempty"`
+	Tags             []string               `json:"tags" yaml:"tags"`
+	OfferingTags     []string               `json:"offering_tags,omitempty" yaml:"offering_tags,omitempty"`
+	Description      string                 `json:"description,omitempty" yaml:"description,omitempty"`
+	Documentation    string                 `json:"documentation,omitempty" yaml:"documentation,omitempty"`
+	DashboardURL     string                 `json:"dashboard_url,omitempty" yaml:"dashboard_url,omitempty"`
+	RouteServiceURL  string                 `json:"route_service_url,omitempty" yaml:"route_service_url,omitempty"`
+	SyslogDrainURL   string                 `json:"syslog_drain_url,omitempty" yaml:"syslog_drain_url,omitempty"`
+	LastOperation    *lastOperationOutput   `json:"last_operation,omitempty" yaml:"last_operation,omitempty"`
+	BoundApps        []serviceBindingOutput `json:"bound_apps" yaml:"bound_apps"`
+	SharedFrom       *sharedFromOutput      `json:"shared_from,omitempty" yaml:"shared_from,omitempty"`
+	SharedWith       []sharedWithOutput     `json:"shared_with,omitempty" yaml:"shared_with,omitempty"`
+	UpgradeAvailable *bool                  `json:"upgrade_available,omitempty" yaml:"upgrade_available,omitempty"`
+	Labels           labelsOutput           `json:"labels,omitempty" yaml:"labels,omitempty"`
+}
+
+type lastOperationOutput struct {
+	Type        string `json:"type" yaml:"type"`
+	State       string `json:"state" yaml:"state"`
+	Description string `json:"description" yaml:"description"`
+	CreatedAt   string `json:"created_at" yaml:"created_at"`
+	UpdatedAt   string `json:"updated_at" yaml:"updated_at"`
+}
+
+type serviceBindingOutput struct {
+	App           string               `json:"app" yaml:"app"`
+	Name          string               `json:"name" yaml:"name"`
+	LastOperation *lastOperationOutput `json:"last_operation,omitempty" yaml:"last_operation,omitempty"`
+}
+
+type sharedFromOutput struct {
+	Org   string `json:"org" yaml:"org"`
+	Space string `json:"space" yaml:"space"`
+}
+
+type sharedWithOutput struct {
+	Org       string `json:"org" yaml:"org"`
+	Space     string `json:"space" yaml:"space"`
+	BoundApps int    `json:"bound_apps" yaml:"bound_apps"`
+}
+
+type serviceKeyOutput struct {
+	Name            string                 `json:"name" yaml:"name"`
+	ServiceInstance string                 `json:"service_instance" yaml:"service_instance"`
+	Credentials     map[string]interface{} `json:"credentials" yaml:"credentials"`
+}
+
+type serviceOfferingOutput struct {
+	Name        string              `json:"name" yaml:"name"`
+	GUID        string              `json:"guid" yaml:"guid"`
+	Description string              `json:"description" yaml:"description"`
+	Broker      string              `json:"broker" yaml:"broker"`
+	Plans       []servicePlanOutput `json:"plans,omitempty" yaml:"plans,omitempty"`
+}
+
+type servicePlanOutput struct {
+	Name        string                  `json:"name" yaml:"name"`
+	GUID        string                  `json:"guid" yaml:"guid"`
+	Description string                  `json:"description" yaml:"description"`
+	Free        bool                    `json:"free" yaml:"free"`
+	Costs       []servicePlanCostOutput `json:"costs,omitempty" yaml:"costs,omitempty"`
+	Available   bool                    `json:"available" yaml:"available"`
+}
+
+type servicePlanCostOutput struct {
+	Amount   float64 `json:"amount" yaml:"amount"`
+	Currency string  `json:"currency" yaml:"currency"`
+	Unit     string  `json:"unit" yaml:"unit"`
+}
+
+type envOutput struct {
+	System       map[string]interface{} `json:"system" yaml:"system"`
+	Application  map[string]interface{} `json:"application" yaml:"application"`
+	UserProvided map[string]interface{} `json:"user_provided" yaml:"user_provided"`
+	Running      map[string]interface{} `json:"running" yaml:"running"`
+	Staging      map[string]interface{} `json:"staging" yaml:"staging"`
+}
+
+// quotaOutput renders unlimited values as null.
+type quotaOutput struct {
+	Name               string `json:"name" yaml:"name"`
+	GUID               string `json:"guid" yaml:"guid"`
+	TotalMemoryInMB    *int   `json:"total_memory_in_mb" yaml:"total_memory_in_mb"`
+	InstanceMemoryInMB *int   `json:"instance_memory_in_mb" yaml:"instance_memory_in_mb"`
+	Routes             *int   `json:"routes" yaml:"routes"`
+	ServiceInstances   *int   `json:"service_instances" yaml:"service_instances"`
+	PaidServicePlans   bool   `json:"paid_service_plans" yaml:"paid_service_plans"`
+	AppInstances       *int   `json:"app_instances" yaml:"app_instances"`
+	RoutePorts         *int   `json:"route_ports" yaml:"route_ports"`
+}
+
+type serviceBrokerOutput struct {
+	Name string `json:"name" yaml:"name"`
+	GUID string `json:"guid" yaml:"guid"`
+	URL  string `json:"url" yaml:"url"`
+}
+
+type featureFlagOutput struct {
+	Name    string `json:"name" yaml:"name"`
+	Enabled bool   `json:"enabled" yaml:"enabled"`
+}
+
+type userOutput struct {
+	GUID             string `json:"guid" yaml:"guid"`
+	Username         string `json:"username" yaml:"username"`
+	PresentationName string `json:"presentation_name" yaml:"presentation_name"`
+	Origin           string `json:"origin" yaml:"origin"`
+}
+
+type networkPolicyOutput struct {
+	Source           string `json:"source" yaml:"source"`
+	Destination      string `json:"destination" yaml:"destination"`
+	Protocol         string `json:"protocol" yaml:"protocol"`
+	StartPort        int    `json:"start_port" yaml:"start_port"`
+	EndPort          int    `json:"end_port" yaml:"end_port"`
+	DestinationSpace string `json:"destination_space" yaml:"destination_space"`
+	DestinationOrg   string `json:"destination_org" yaml:"destination_org"`
+}
+
+type routerGroupOutput struct {
+	Name            string `json:"name" yaml:"name"`
+	GUID            string `json:"guid" yaml:"guid"`
+	Type            string `json:"type" yaml:"type"`
+	ReservablePorts string `json:"reservable_ports,omitempty" yaml:"reservable_ports,omitempty"`
+}
+
+type isolationSegmentOutput struct {
+	Name string   `json:"name" yaml:"name"`
+	Orgs []string `json:"orgs" yaml:"orgs"`
+}
+
+type dropletOutput struct {
+	GUID      string `json:"guid" yaml:"guid"`
+	State     string `json:"state" yaml:"state"`
+	Current   bool   `json:"current" yaml:"current"`
+	CreatedAt string `json:"created_at" yaml:"created_at"`
+}
+
+type packageOutput struct {
+	GUID      string `json:"guid" yaml:"guid"`
+	State     string `json:"state" yaml:"state"`
+	CreatedAt string `json:"created_at" yaml:"created_at"`
+}
+
+type revisionOutput struct {
+	Version     int    `json:"version" yaml:"version"`
+	GUID        string `json:"guid" yaml:"guid"`
+	Description string `json:"description" yaml:"description"`
+	Deployable  bool   `json:"deployable" yaml:"deployable"`
+	Deployed    bool   `json:"deployed" yaml:"deployed"`
+	CreatedAt   string `json:"created_at" yaml:"created_at"`
+}
+
+type serviceKeySummaryOutput struct {
+	Name          string               `json:"name" yaml:"name"`
+	LastOperation *lastOperationOutput `json:"last_operation,omitempty" yaml:"last_operation,omitempty"`
+}
+
+// servicePlanAccessOutput lists the orgs a plan is visible in, or the space
+// for plans only visible in one space.
+type servicePlanAccessOutput struct {
+	Broker   string   `json:"broker" yaml:"broker"`
+	Offering string   `json:"offering" yaml:"offering"`
+	Plan     string   `json:"plan" yaml:"plan"`
+	Access   string   `json:"access" yaml:"access"`
+	Orgs     []string `json:"orgs,omitempty" yaml:"orgs,omitempty"`
+	Space    string   `json:"space,omitempty" yaml:"space,omitempty"`
+}
+
+func newLabelsOutput(metadata *resources.Metadata) labelsOutput {
+	if metadata == nil || len(metadata.Labels) == 0 {
+		return nil
+	}
+
+	labels := labelsOutput{}
+	for key, value := range metadata.Labels {
+		if value.IsSet {
+			labels[key] = value.Value
+		}
+	}
+	return labels
+}
+
+func newAppOutput(summary v7action.ApplicationSummary) appOutput {
+	output := appOutput{
+		Name:       summary.Name,
+		GUID:       summary.GUID,
+		State:      string(summary.State),
+		Stack:      summary.StackName,
+		Buildpacks: summary.LifecycleBuildpacks,
+		Lifecycle:  string(summary.LifecycleType),
+		Processes:  []processOutput{},
+		Routes:     []string{},
+		Labels:     newLabelsOutput(summary.Metadata),
+	}
+
+	for _, processSummary := range summary.ProcessSummaries {
+		output.Processes = append(output.Processes, newProcessOutput(processSummary))
+	}
+
+	for _, route := range summary.Routes {
+		output.Routes = append(output.Routes, route.URL)
+	}
+
+	return output
+}
+
+func newDetailedAppOutput(summary v7action.DetailedApplicationSummary) appOutput {
+	output := newAppOutput(summary.ApplicationSummary)
+	output.Droplet = summary.CurrentDroplet.GUID
+
+	for i, processSummary := range summary.ProcessSummaries {
+		for _, instance := range processSummary.InstanceDetails {
+			output.Processes[i].InstanceDetails = append(output.Processes[i].InstanceDetails, instanceOutput{
+				Index:       instance.Index,
+				State:       string(instance.State),
+				CPU:         instance.CPU,
+				MemoryUsage: instance.MemoryUsage,
+				MemoryQuota: instance.MemoryQuota,
+				DiskUsage:   instance.DiskUsage,
+				DiskQuota:   instance.DiskQuota,
+				Uptime:      int64(instance.Uptime.Seconds()),
+				Details:     instance.Details,
+			})
+		}
+	}
+
+	return output
+}
+
+func newProcessOutput(summary v7action.ProcessSummary) processOutput {
+	return processOutput{
+		Type:             summary.Type,
+		Instances:        summary.Instances.Value,
+		RunningInstances: summary.HealthyInstanceCount(),
+		MemoryInMB:       summary.MemoryInMB.Value,
+		DiskInMB:         summary.DiskInMB.Value,
+		HealthCheckType:  string(summary.HealthCheckType),
+	}
+}
+
+func newRouteOutput(summary v7action.RouteSummary) routeOutput {
+	output := routeOutput{
+		GUID:                summary.GUID,
+		URL:                 summary.URL,
+		Space:               summary.SpaceName,
+		Host:                summary.Host,
+		Domain:              summary.DomainName,
+		Port:                summary.Port,
+		Path:                summary.Path,
+		Protocol:            summary.Protocol,
+		Apps:                summary.AppNames,
+		AppProtocols:        summary.AppProtocols,
+		Destinations:        newRouteDestinationsOutput(summary.Destinations, summary.AppNames),
+		ServiceInstanceName: summary.ServiceInstanceName,
+	}
+	if output.Apps == nil {
+		output.Apps = []string{}
+	}
+	if output.AppProtocols == nil {
+		output.AppProtocols = []string{}
+	}
+	return output
+}
+
+func newServiceInstanceOutput(instance v7action.ServiceInstance) serviceInstanceOutput {
+	output := serviceInstanceOutput{
+		Name:          instance.Name,
+		Type:          string(instance.Type),
+		Offering:      serviceOfferingName(instance),
+		Plan:          instance.ServicePlanName,
+		Broker:        instance.ServiceBrokerName,
+		BoundApps:     instance.BoundApps,
+		LastOperation: instance.LastOperation,
+	}
+	if output.BoundApps == nil {
+		output.BoundApps = []string{}
+	}
+	if instance.UpgradeAvailable.IsSet {
+		upgradeAvailable := instance.UpgradeAvailable.Value
+		output.UpgradeAvailable = &upgradeAvailable
+	}
+	return output
+}
+
+func newBuildpackOutput(buildpack resources.Buildpack) buildpackOutput {
+	return buildpackOutput{
+		Name:     buildpack.Name,
+		GUID:     buildpack.GUID,
+		Position: buildpack.Position.Value,
+		Stack:    buildpack.Stack,
+		Enabled:  buildpack.Enabled.Value,
+		Locked:   buildpack.Locked.Value,
+		Filename: buildpack.Filename,
+	}
+}
+
+func newDomainOutput(domain resources.Domain) domainOutput {
+	output := domainOutput{
+		Name:      domain.Name,
+		GUID:      domain.GUID,
+		Shared:    domain.Shared(),
+		Internal:  domain.Internal.IsSet && domain.Internal.Value,
+		Protocols: domain.Protocols,
+	}
+	if output.Protocols == nil {
+		output.Protocols = []string{}
+	}
+	return output
+}
+
+func newTaskOutput(task resources.Task) taskOutput {
+	return taskOutput{
+		ID:         task.SequenceID,
+		GUID:       task.GUID,
+		Name:       task.Name,
+		State:      string(task.State),
+		Command:    task.Command,
+		MemoryInMB: task.MemoryInMB,
+		DiskInMB:   task.DiskInMB,
+		CreatedAt:  task.CreatedAt,
+	}
+}
+
+func newSidecarOutput(sidecar resources.Sidecar) sidecarOutput {
+	output := sidecarOutput{
+		GUID:         sidecar.GUID,
+		Name:         sidecar.Name,
+		ProcessTypes: sidecar.ProcessTypes,
+		Command:      sidecar.Command.Value,
+	}
+	if sidecar.MemoryInMB.IsSet {
+		memory := sidecar.MemoryInMB.Value
+		output.MemoryInMB = &memory
+	}
+	if output.ProcessTypes == nil {
+		output.ProcessTypes = []string{}
+	}
+	return output
+}
+
+func newStackOutput(stack resources.Stack) stackOutput {
+	return stackOutput{
+		Name:        stack.Name,
+		GUID:        stack.GUID,
+		Description: stack.Description,
+		Labels:      newLabelsOutput(stack.Metadata),
+	}
+}
+
+func newEventOutput(event v7action.Event) eventOutput {
+	return eventOutput{
+		GUID: event.GUID,
+		Time: event.Time.UTC().Format(time.RFC3339),
+		Type: event.Type,
+		Actor: eventResourceOutput{
+			GUID: event.ActorGUID,
+			Type: event.ActorType,
+			Name: event.ActorName,
+		},
+		Target: eventResourceOutput{
+			GUID: event.TargetGUID,
+			Type: event.TargetType,
+			Name: event.TargetName,
+		},
+		SpaceGUID:        event.SpaceGUID,
+		OrganizationGUID: event.OrganizationGUID,
+		Data:             event.Data,
+	}
+}
+
+func newDiffOutput(appName string, source diffSourceOutput, diffs []resources.Diff) diffOutput {
+	output := diffOutput{
+		App:         appName,
+		ComparedTo:  source,
+		Differences: []diffEntryOutput{},
+	}
+	for _, diff := range diffs {
+		output.Differences = append(output.Differences, diffEntryOutput{
+			Op:    string(diff.Op),
+			Path:  diff.Path,
+			Was:   diff.Was,
+			Value: diff.Value,
+		})
+	}
+	return output
+}
+
+func emptyIfNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+func newOrgOutput(summary v7action.OrganizationSummary, isolationSegments []resources.IsolationSegment) orgOutput {
+	output := orgOutput{
+		Name:              summary.Name,
+		GUID:              summary.GUID,
+		Domains:           emptyIfNil(summary.DomainNames),
+		Quota:             summary.QuotaName,
+		Spaces:            emptyIfNil(summary.SpaceNames),
+		IsolationSegments: []string{},
+		Labels:            newLabelsOutput(summary.Metadata),
+	}
+	for _, isolationSegment := range isolationSegments {
+		output.IsolationSegments = append(output.IsolationSegments, isolationSegment.Name)
+		if isolationSegment.GUID == summary.DefaultIsolationSegmentGUID {
+			output.DefaultIsolationSegment = isolationSegment.Name
+		}
+	}
+	sort.Strings(output.IsolationSegments)
+	return output
+}
+
+func newSpaceOutput(summary v7action.SpaceSummary, withRules bool) spaceOutput {
+	output := spaceOutput{
+		Name:                  summary.Name,
+		GUID:                  summary.Space.GUID,
+		Org:                   summary.OrgName,
+		Apps:                  emptyIfNil(summary.AppNames),
+		Services:              emptyIfNil(summary.ServiceInstanceNames),
+		IsolationSegment:      summary.IsolationSegmentName,
+		Quota:                 summary.QuotaName,
+		RunningSecurityGroups: []securityGroupOutput{},
+		StagingSecurityGroups: []securityGroupOutput{},
+		Labels:                newLabelsOutput(summary.Space.Metadata),
+	}
+	for _, group := range summary.RunningSecurityGroups {
+		output.RunningSecurityGroups = append(output.RunningSecurityGroups, newSecurityGroupOutput(group.Name, group.Rules, withRules))
+	}
+	for _, group := range summary.StagingSecurityGroups {
+		output.StagingSecurityGroups = append(output.StagingSecurityGroups, newSecurityGroupOutput(group.Name, group.Rules, withRules))
+	}
+	return output
+}
+
+func newSecurityGroupOutput(name string, rules []resources.Rule, withRules bool) securityGroupOutput {
+	output := securityGroupOutput{Name: name}
+	if !withRules {
+		return output
+	}
+
+	output.Rules = []securityGroupRuleOutput{}
+	for _, rule := range rules {
+		output.Rules = append(output.Rules, securityGroupRuleOutput{
+			Protocol:    rule.Protocol,
+			Destination: rule.Destination,
+			Ports:       nilStringPointer(rule.Ports),
+			Description: nilStringPointer(rule.Description),
+		})
+	}
+	return output
+}
+
+func newSecurityGroupSummaryOutput(summary v7action.SecurityGroupSummary) securityGroupOutput {
+	output := newSecurityGroupOutput(summary.Name, summary.Rules, true)
+	output.Bindings = []securityGroupBindingOutput{}
+	for _, space := range summary.SecurityGroupSpaces {
+		output.Bindings = append(output.Bindings, securityGroupBindingOutput{
+			Org:       space.OrgName,
+			Space:     space.SpaceName,
+			Lifecycle: space.Lifecycle,
+		})
+	}
+	return output
+}
+
+func newLastOperationOutput(lastOperation resources.LastOperation) *lastOperationOutput {
+	if lastOperation == (resources.LastOperation{}) {
+		return nil
+	}
+	return &lastOperationOutput{
+		Type:        string(lastOperation.Type),
+		State:       string(lastOperation.State),
+		Description: lastOperation.Description,
+		CreatedAt:   lastOperation.CreatedAt,
+		UpdatedAt:   lastOperation.UpdatedAt,
+	}
+}
+
+func newServiceInstanceDetailsOutput(details v7action.ServiceInstanceDetails) serviceInstanceDetailsOutput {
+	output := serviceInstanceDetailsOutput{
+		Name:            details.Name,
+		GUID:            details.GUID,
+		Type:            string(details.Type),
+		Tags:            emptyIfNil(details.Tags.Value),
+		DashboardURL:    details.DashboardURL.Value,
+		RouteServiceURL: details.RouteServiceURL.Value,
+		SyslogDrainURL:  details.SyslogDrainURL.Value,
+		LastOperation:   newLastOperationOutput(details.LastOperation),
+		BoundApps:       []serviceBindingOutput{},
+		Labels:          newLabelsOutput(details.Metadata),
+	}
+
+	for _, binding := range details.BoundApps {
+		output.BoundApps = append(output.BoundApps, serviceBindingOutput{
+			App:           binding.AppName,
+			Name:          binding.Name,
+			LastOperation: newLastOperationOutput(binding.LastOperation),
+		})
+	}
+
+	if details.Type == resources.UserProvidedServiceInstance {
+		return output
+	}
+
+	output.Broker = details.ServiceBrokerName
+	output.Offering = details.ServiceOffering.Name
+	output.Plan = details.ServicePlan.Name
+	output.OfferingTags = details.ServiceOffering.Tags.Value
+	output.Description = details.ServiceOffering.Description
+	output.Documentation = details.ServiceOffering.DocumentationURL
+
+	if details.SharedStatus.IsSharedFromOriginalSpace {
+		output.SharedFrom = &sharedFromOutput{Org: details.OrganizationName, Space: details.SpaceName}
+	}
+	for _, usage := range details.SharedStatus.UsageSummary {
+		output.SharedWith = append(output.SharedWith, sharedWithOutput{
+			Org:       usage.OrganizationName,
+			Space:     usage.SpaceName,
+			BoundApps: usage.BoundAppCount,
+		})
+	}
+
+	switch details.UpgradeStatus.State {
+	case v7action.ServiceInstanceUpgradeAvailable, v7action.ServiceInstanceUpgradeNotAvailable:
+		upgradeAvailable := details.UpgradeStatus.State == v7action.ServiceInstanceUpgradeAvailable
+		output.UpgradeAvailable = &upgradeAvailable
+	}
+
+	return output
+}
+
+func newServiceOfferingOutput(offering v7action.ServiceOfferingWithPlans, withPlans bool) serviceOfferingOutput {
+	output := serviceOfferingOutput{
+		Name:        offering.Name,
+		GUID:        offering.GUID,
+		Description: offering.Description,
+		Broker:      offering.ServiceBrokerName,
+	}
+	if !withPlans {
+		return output
+	}
+
+	output.Plans = []servicePlanOutput{}
+	for _, plan := range offering.Plans {
+		planOutput := servicePlanOutput{
+			Name:        plan.Name,
+			GUID:        plan.GUID,
+			Description: plan.Description,
+			Free:        plan.Free,
+			Available:   plan.Available,
+		}
+		for _, cost := range plan.Costs {
+			planOutput.Costs = append(planOutput.Costs, servicePlanCostOutput(cost))
+		}
+		output.Plans = append(output.Plans, planOutput)
+	}
+	return output
+}
+
+func emptyMapIfNil(values map[string]interface{}) map[string]interface{} {
+	if values == nil {
+		return map[string]interface{}{}
+	}
+	return values
+}
+
+func newEnvOutput(envGroups v7action.EnvironmentVariableGroups) envOutput {
+	return envOutput{
+		System:       emptyMapIfNil(envGroups.System),
+		Application:  emptyMapIfNil(envGroups.Application),
+		UserProvided: emptyMapIfNil(envGroups.EnvironmentVariables),
+		Running:      emptyMapIfNil(envGroups.Running),
+		Staging:      emptyMapIfNil(envGroups.Staging),
+	}
+}
+
+func quotaLimit(limit *types.NullInt) *int {
+	if limit == nil || !limit.IsSet {
+		return nil
+	}
+	value := limit.Value
+	return &value
+}
+
+func newQuotaOutput(quota resources.Quota) quotaOutput {
+	return quotaOutput{
+		Name:               quota.Name,
+		GUID:               quota.GUID,
+		TotalMemoryInMB:    quotaLimit(quota.Apps.TotalMemory),
+		InstanceMemoryInMB: quotaLimit(quota.Apps.InstanceMemory),
+		Routes:             quotaLimit(quota.Routes.TotalRoutes),
+		ServiceInstances:   quotaLimit(quota.Services.TotalServiceInstances),
+		PaidServicePlans:   quota.Services.PaidServicePlans != nil && *quota.Services.PaidServicePlans,
+		AppInstances:       quotaLimit(quota.Apps.TotalAppInstances),
+		RoutePorts:         quotaLimit(quota.Routes.TotalReservedPorts),
+	}
+}
+
+func newQuotasOutput(quotas []resources.Quota) []quotaOutput {
+	output := []quotaOutput{}
+	for _, quota := range quotas {
+		output = append(output, newQuotaOutput(quota))
+	}
+	return output
+}
+
+// newUsersByRoleOutput renders users grouped by role, keyed by role label
+// (e.g. "SPACE MANAGER" becomes "space_manager").
+func newUsersByRoleOutput(groups map[string][]resources.User) map[string][]userOutput {
+	output := map[string][]userOutput{}
+	for label, users := range groups {
+		key := strings.ReplaceAll(strings.ToLower(label), " ", "_")
+		output[key] = []userOutput{}
+
+		v7action.SortUsers(users)
+		for _, user := range users {
+			output[key] = append(output[key], userOutput{
+				GUID:             user.GUID,
+				Username:         user.Username,
+				PresentationName: user.PresentationName,
+				Origin:           v7action.GetHumanReadableOrigin(user),
+			})
+		}
+	}
+	return output
+}
+
+func newNetworkPolicyOutput(policy cfnetworkingaction.Policy) networkPolicyOutput {
+	return networkPolicyOutput{
+		Source:           policy.SourceName,
+		Destination:      policy.DestinationName,
+		Protocol:         policy.Protocol,
+		StartPort:        policy.StartPort,
+		EndPort:          policy.EndPort,
+		DestinationSpace: policy.DestinationSpaceName,
+		DestinationOrg:   policy.DestinationOrgName,
+	}
+}
+
+// newRouteDestinationsOutput takes the app name of each destination, in the
+// same order as destinations.
+func newRouteDestinationsOutput(destinations []resources.RouteDestination, appNames []string) []routeDestinationOutput {
+	output := []routeDestinationOutput{}
+	for i, destination := range destinations {
+		appName := ""
+		if i < len(appNames) {
+			appName = appNames[i]
+		}
+		output = append(output, routeDestinationOutput{
+			App:         appName,
+			ProcessType: destination.App.Process.Type,
+			Port:        destination.Port,
+			Protocol:    destination.Protocol,
+			Weight:      destination.Weight,
+		})
+	}
+	return output
+}
+
+func newRevisionOutput(revision resources.Revision, deployedRevisions []resources.Revision) revisionOutput {
+	output := revisionOutput{
+		Version:     revision.Version,
+		GUID:        revision.GUID,
+		Description: revision.Description,
+		Deployable:  revision.Deployable,
+		CreatedAt:   revision.CreatedAt,
+	}
+	for _, deployed := range deployedRevisions {
+		if deployed.GUID == revision.GUID {
+			output.Deployed = true
+		}
+	}
+	return output
+}
+
+func newServicePlanAccessOutput(plan v7action.ServicePlanAccess) servicePlanAccessOutput {
+	output := servicePlanAccessOutput{
+		Broker:   plan.BrokerName,
+		Offering: plan.ServiceOfferingName,
+		Plan:     plan.ServicePlanName,
+		Access:   accessFromVisibilityType(string(plan.VisibilityType)),
+	}
+	if string(plan.VisibilityType) == "space" {
+		output.Space = strings.Join(plan.VisibilityDetails, ",")
+	} else {
+		output.Orgs = plan.VisibilityDetails
+	}
+	return output
+}
+
+func newLabelValuesOutput(labels map[string]types.NullString) labelsOutput {
+	output := labelsOutput{}
+	for key, value := range labels {
+		output[key] = value.Value
+	}
+	return output
+}
+
+func newEnvironmentVariableGroupOutput(envVars v7action.EnvironmentVariableGroup) map[string]string {
+	output := map[string]string{}
+	for name, value := range envVars {
+		output[name] = value.Value
+	}
+	return output
+}
@@ -0,0 +1,45 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type SwitchContextCommand struct {
+	UI              command.UI
+	Config          command.Config
+	RequiredArgs    flag.ContextName `positional-args:"yes"`
+	usage           interface{}      `usage:"CF_NAME switch-context CONTEXT_NAME"`
+	relatedCommands interface{}      `related_commands:"contexts, create-context, target"`
+}
+
+func (cmd *SwitchContextCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+
+	return nil
+}
+
+func (cmd SwitchContextCommand) Execute(args []string) error {
+	contextName := cmd.RequiredArgs.Context
+
+	cmd.UI.DisplayTextWithFlavor("Switching to context {{.ContextName}}...", map[string]interface{}{
+		"ContextName": contextName,
+	})
+
+	err := cmd.Config.SwitchContext(contextName)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("API endpoint:"), cmd.Config.Target()},
+		{cmd.UI.TranslateText("org:"), cmd.Config.TargetedOrganizationName()},
+		{cmd.UI.TranslateText("space:"), cmd.Config.TargetedSpace().Name},
+	}, 3)
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("switch-context Command", func() {
+	var (
+		cmd        SwitchContextCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = SwitchContextCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+		cmd.RequiredArgs.Context = "dev"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the context exists", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("https://api.dev.com")
+			fakeConfig.TargetedOrganizationNameReturns("dev-org")
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "dev-space"})
+		})
+
+		It("switches to the context and displays the new target", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeConfig.SwitchContextCallCount()).To(Equal(1))
+			Expect(fakeConfig.SwitchContextArgsForCall(0)).To(Equal("dev"))
+
+			Expect(testUI.Out).To(Say("Switching to context dev..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`API endpoint:\s+https://api.dev.com`))
+			Expect(testUI.Out).To(Say(`org:\s+dev-org`))
+			Expect(testUI.Out).To(Say(`space:\s+dev-space`))
+		})
+	})
+
+	When("the context does not exist", func() {
+		BeforeEach(func() {
+			fakeConfig.SwitchContextReturns(translatableerror.ContextNotFoundError{Name: "dev"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ContextNotFoundError{Name: "dev"}))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+})
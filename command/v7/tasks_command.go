@@ -50,6 +50,14 @@ func (cmd TasksCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		output := []taskOutput{}
+		for _, task := range tasks {
+			output = append(output, newTaskOutput(task))
+		}
+		return cmd.UI.DisplayStructuredOutput(output)
+	}
+
 	if len(tasks) == 0 {
 		cmd.UI.DisplayText("No tasks found for application.")
 		return nil
@@ -87,3 +95,7 @@ func (cmd TasksCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (TasksCommand) SupportsStructuredOutput() bool {
+	return true
+}
@@ -304,4 +304,48 @@ var _ = Describe("tasks Command", func() {
 			})
 		})
 	})
+
+	When("structured output is requested", func() {
+		var out *Buffer
+
+		BeforeEach(func() {
+			out = NewBuffer()
+			testUI = ui.NewTestUI(nil, out, NewBuffer())
+			testUI.SetOutputFormat(ui.OutputFormatJSON)
+			cmd.UI = testUI
+
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{GUID: "some-app-guid"}, nil, nil)
+			fakeActor.GetApplicationTasksReturns(
+				[]resources.Task{
+					{
+						GUID:       "task-1-guid",
+						SequenceID: 1,
+						Name:       "task-1",
+						State:      constant.TaskSucceeded,
+						CreatedAt:  "2016-11-08T22:26:02Z",
+						MemoryInMB: 256,
+						DiskInMB:   512,
+					},
+				},
+				v7action.Warnings{"get-tasks-warning"},
+				nil)
+		})
+
+		It("renders the tasks as JSON", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(string(out.Contents())).To(MatchJSON(`[
+				{
+					"id": 1,
+					"guid": "task-1-guid",
+					"name": "task-1",
+					"state": "SUCCEEDED",
+					"memory_in_mb": 256,
+					"disk_in_mb": 512,
+					"created_at": "2016-11-08T22:26:02Z"
+				}
+			]`))
+			Expect(testUI.Err).To(Say("get-tasks-warning"))
+		})
+	})
 })
@@ -0,0 +1,152 @@
+package v7
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+// nearQuotaRatio is the fraction of an instance's memory or disk quota above
+// which its usage is highlighted.
+const nearQuotaRatio = 0.9
+
+type TopCommand struct {
+	BaseCommand
+
+	OptionalArgs    flag.OptionalAppName   `positional-args:"yes"`
+	SortBy          flag.InstanceSortField `long:"sort" choice:"cpu" choice:"memory" choice:"crashes" default:"cpu" description:"Order instances by CPU usage, memory usage, or crashes (crashed and down instances first, then the most recently restarted)"`
+	Iterations      flag.PositiveInteger   `long:"iterations" short:"n" description:"Exit after refreshing this many times"`
+	usage           interface{}            `usage:"CF_NAME top [APP_NAME] [--sort cpu|memory|crashes] [-n ITERATIONS]\n\n   Refreshes the view every few seconds until interrupted with Ctrl-C.\n   Without APP_NAME, shows the instances of every started app in the targeted space.\n\nEXAMPLES:\n   CF_NAME top\n   CF_NAME top my-app --sort memory\n   CF_NAME top --sort crashes -n 1"`
+	relatedCommands interface{}            `related_commands:"app, apps, logs, scale"`
+}
+
+func (cmd TopCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	for iteration := int64(1); ; iteration++ {
+		metrics, warnings, err := cmd.Actor.GetInstanceMetrics(cmd.OptionalArgs.AppName, cmd.Config.TargetedSpace().GUID)
+		if err != nil {
+			cmd.UI.DisplayWarnings(warnings)
+			return err
+		}
+
+		cmd.UI.ClearScreen()
+		cmd.displayFlavorText(user.Name)
+		cmd.UI.DisplayWarnings(warnings)
+		cmd.displayMetrics(metrics)
+
+		if cmd.Iterations.Value > 0 && iteration >= cmd.Iterations.Value {
+			return nil
+		}
+
+		select {
+		case <-interrupt:
+			return nil
+		case <-time.After(cmd.Config.PollingInterval()):
+		}
+	}
+}
+
+func (cmd TopCommand) displayFlavorText(username string) {
+	templateValues := map[string]interface{}{
+		"AppName":   cmd.OptionalArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  username,
+		"Time":      cmd.UI.UserFriendlyDate(time.Now()),
+	}
+
+	if cmd.OptionalArgs.AppName == "" {
+		cmd.UI.DisplayTextWithFlavor("Instance metrics for apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}} at {{.Time}}...", templateValues)
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Instance metrics for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}} at {{.Time}}...", templateValues)
+	}
+	cmd.UI.DisplayNewline()
+}
+
+func (cmd TopCommand) displayMetrics(metrics []v7action.InstanceMetrics) {
+	if len(metrics) == 0 {
+		cmd.UI.DisplayText("No instances found.")
+		return
+	}
+
+	sortInstanceMetrics(metrics, cmd.SortBy)
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("app"),
+			cmd.UI.TranslateText("process"),
+			cmd.UI.TranslateText("instance"),
+			cmd.UI.TranslateText("state"),
+			cmd.UI.TranslateText("cpu"),
+			cmd.UI.TranslateText("memory"),
+			cmd.UI.TranslateText("disk"),
+			cmd.UI.TranslateText("uptime"),
+		},
+	}
+	highlighted := [][]bool{nil}
+
+	for _, instance := range metrics {
+		table = append(table, []string{
+			instance.AppName,
+			instance.Type,
+			fmt.Sprintf("#%d", instance.Index),
+			cmd.UI.TranslateText(strings.ToLower(string(instance.State))),
+			fmt.Sprintf("%.1f%%", instance.CPU*100),
+			usageOfQuota(instance.MemoryUsage, instance.MemoryQuota),
+			usageOfQuota(instance.DiskUsage, instance.DiskQuota),
+			instance.Uptime.Round(time.Second).String(),
+		})
+		highlighted = append(highlighted, []bool{
+			false, false, false, false, false,
+			instance.MemoryUsageRatio() >= nearQuotaRatio,
+			instance.DiskUsageRatio() >= nearQuotaRatio,
+			false,
+		})
+	}
+
+	cmd.UI.DisplayInstancesTableForTop(table, highlighted)
+}
+
+func usageOfQuota(usage uint64, quota uint64) string {
+	if quota == 0 {
+		return bytefmt.ByteSize(usage)
+	}
+	return fmt.Sprintf("%s of %s (%.0f%%)", bytefmt.ByteSize(usage), bytefmt.ByteSize(quota), float64(usage)*100/float64(quota))
+}
+
+func sortInstanceMetrics(metrics []v7action.InstanceMetrics, sortBy flag.InstanceSortField) {
+	sort.SliceStable(metrics, func(i, j int) bool {
+		switch sortBy {
+		case flag.InstanceSortByMemory:
+			return metrics[i].MemoryUsage > metrics[j].MemoryUsage
+		case flag.InstanceSortByCrashes:
+			iUnhealthy, jUnhealthy := !metrics[i].Running(), !metrics[j].Running()
+			if iUnhealthy != jUnhealthy {
+				return iUnhealthy
+			}
+			return metrics[i].Uptime < metrics[j].Uptime
+		default:
+			return metrics[i].CPU > metrics[j].CPU
+		}
+	})
+}
@@ -0,0 +1,193 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("top Command", func() {
+	var (
+		cmd             TopCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+		binaryName      string
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = TopCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			SortBy:     flag.InstanceSortByCPU,
+			Iterations: flag.PositiveInteger{Value: 1},
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.PollingIntervalReturns(time.Millisecond)
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		fakeActor.GetInstanceMetricsReturns([]v7action.InstanceMetrics{
+			{
+				AppName: "app-1",
+				ProcessInstance: v7action.ProcessInstance{
+					Type: "web", Index: 0, State: constant.ProcessInstanceRunning, CPU: 0.1,
+					MemoryUsage: 960 * 1024 * 1024, MemoryQuota: 1024 * 1024 * 1024,
+					DiskUsage: 100 * 1024 * 1024, DiskQuota: 1024 * 1024 * 1024,
+					Uptime: 90 * time.Second,
+				},
+			},
+			{
+				AppName: "app-2",
+				ProcessInstance: v7action.ProcessInstance{
+					Type: "worker", Index: 1, State: constant.ProcessInstanceRunning, CPU: 0.5,
+					MemoryUsage: 10 * 1024 * 1024, MemoryQuota: 1024 * 1024 * 1024,
+					DiskUsage: 10 * 1024 * 1024, DiskQuota: 1024 * 1024 * 1024,
+					Uptime: 30 * time.Second,
+				},
+			},
+			{
+				AppName: "app-3",
+				ProcessInstance: v7action.ProcessInstance{
+					Type: "web", Index: 0, State: constant.ProcessInstanceCrashed,
+				},
+			},
+		}, v7action.Warnings{"some-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+			Expect(fakeActor.GetInstanceMetricsCallCount()).To(Equal(0))
+		})
+	})
+
+	When("no app name is given", func() {
+		It("displays the instances of the space sorted by CPU usage", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetInstanceMetricsCallCount()).To(Equal(1))
+			appName, spaceGUID := fakeActor.GetInstanceMetricsArgsForCall(0)
+			Expect(appName).To(BeEmpty())
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(testUI.Out).To(Say(`Instance metrics for apps in org some-org / space some-space as steve at .+\.\.\.`))
+			Expect(testUI.Out).To(Say(`app\s+process\s+instance\s+state\s+cpu\s+memory\s+disk\s+uptime`))
+			Expect(testUI.Out).To(Say(`app-2\s+worker\s+#1\s+running\s+50\.0%\s+10M of 1G \(1%\)\s+10M of 1G \(1%\)\s+30s`))
+			Expect(testUI.Out).To(Say(`app-1\s+web\s+#0\s+running\s+10\.0%\s+960M of 1G \(94%\)\s+100M of 1G \(10%\)\s+1m30s`))
+			Expect(testUI.Out).To(Say(`app-3\s+web\s+#0\s+crashed\s+0\.0%`))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+
+	When("an app name is given", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.AppName = "app-1"
+		})
+
+		It("displays the instances of that app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			appName, _ := fakeActor.GetInstanceMetricsArgsForCall(0)
+			Expect(appName).To(Equal("app-1"))
+			Expect(testUI.Out).To(Say(`Instance metrics for app app-1 in org some-org / space some-space as steve at .+\.\.\.`))
+		})
+	})
+
+	When("sorting by memory", func() {
+		BeforeEach(func() {
+			cmd.SortBy = flag.InstanceSortByMemory
+		})
+
+		It("orders the instances by memory usage", func() {
+			Expect(testUI.Out).To(Say(`app-1\s+web`))
+			Expect(testUI.Out).To(Say(`app-2\s+worker`))
+			Expect(testUI.Out).To(Say(`app-3\s+web`))
+		})
+	})
+
+	When("sorting by crashes", func() {
+		BeforeEach(func() {
+			cmd.SortBy = flag.InstanceSortByCrashes
+		})
+
+		It("orders unhealthy instances first, then by most recent restart", func() {
+			Expect(testUI.Out).To(Say(`app-3\s+web`))
+			Expect(testUI.Out).To(Say(`app-2\s+worker`))
+			Expect(testUI.Out).To(Say(`app-1\s+web`))
+		})
+	})
+
+	When("there are no instances", func() {
+		BeforeEach(func() {
+			fakeActor.GetInstanceMetricsReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No instances found."))
+		})
+	})
+
+	When("several iterations are requested", func() {
+		BeforeEach(func() {
+			cmd.Iterations = flag.PositiveInteger{Value: 3}
+		})
+
+		It("polls once per iteration, waiting the polling interval in between", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetInstanceMetricsCallCount()).To(Equal(3))
+			Expect(fakeConfig.PollingIntervalCallCount()).To(Equal(2))
+		})
+	})
+
+	When("getting the metrics fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetInstanceMetricsReturns(nil, v7action.Warnings{"some-warning"}, errors.New("some-error"))
+		})
+
+		It("displays the warnings and returns the error", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+})
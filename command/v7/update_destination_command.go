@@ -5,15 +5,17 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/resources"
 )
 
 type UpdateDestinationCommand struct {
 	BaseCommand
 
-	RequiredArgs flag.AppDomain   `positional-args:"yes"`
-	Hostname     string           `long:"hostname" short:"n" description:"Hostname for the HTTP route (required for shared domains)"`
-	AppProtocol  string           `long:"app-protocol" description:"New Protocol for the route destination (http1 or http2). Only applied to HTTP routes"`
-	Path         flag.V7RoutePath `long:"path" description:"Path for the HTTP route"`
+	RequiredArgs flag.AppDomain       `positional-args:"yes"`
+	Hostname     string               `long:"hostname" short:"n" description:"Hostname for the HTTP route (required for shared domains)"`
+	AppProtocol  string               `long:"app-protocol" description:"New Protocol for the route destination (http1 or http2). Only applied to HTTP routes"`
+	Path         flag.V7RoutePath     `long:"path" description:"Path for the HTTP route"`
+	Weight       flag.PositiveInteger `long:"weight" description:"New percentage (1-100) of the route's traffic to send to the app; the rest is divided among the route's other destinations"`
 
 	relatedCommands interface{} `related_commands:"routes, map-route, create-route, unmap-route"`
 }
@@ -21,13 +23,14 @@ type UpdateDestinationCommand struct {
 func (cmd UpdateDestinationCommand) Usage() string {
 	return `
 Edit an existing HTTP route:
-   CF_NAME update-destination APP_NAME DOMAIN [--hostname HOSTNAME] [--app-protocol PROTOCOL] [--path PATH]`
+   CF_NAME update-destination APP_NAME DOMAIN [--hostname HOSTNAME] [--app-protocol PROTOCOL] [--path PATH] [--weight WEIGHT]`
 }
 
 func (cmd UpdateDestinationCommand) Examples() string {
 	return `
 CF_NAME update-destination my-app example.com --hostname myhost --app-protocol http2                   # myhost.example.com
-CF_NAME update destination my-app example.com --hostname myhost --path foo --app-protocol http2        # myhost.example.com/foo`
+CF_NAME update destination my-app example.com --hostname myhost --path foo --app-protocol http2        # myhost.example.com/foo
+CF_NAME update-destination my-app-v2 example.com --hostname myhost --weight 50                         # myhost.example.com, 50% of traffic to my-app-v2`
 }
 
 func (cmd UpdateDestinationCommand) Execute(args []string) error {
@@ -74,6 +77,14 @@ func (cmd UpdateDestinationCommand) Execute(args []string) error {
 		}
 	}
 
+	if cmd.Weight.Value != 0 && cmd.AppProtocol == "" {
+		if err != nil {
+			cmd.UI.DisplayText("Route's destination to be updated does not exist.")
+			return err
+		}
+		return cmd.updateWeight(route, app, url, user.Name)
+	}
+
 	if cmd.AppProtocol == "" {
 		cmd.AppProtocol = "http1"
 	}
@@ -83,6 +94,9 @@ func (cmd UpdateDestinationCommand) Execute(args []string) error {
 	}
 
 	if dest.Protocol == cmd.AppProtocol {
+		if cmd.Weight.Value != 0 {
+			return cmd.updateWeight(route, app, url, user.Name)
+		}
 		cmd.UI.DisplayText(" App '{{ .AppName }}' is already using '{{ .AppProtocol }}'. Nothing has been updated", map[string]interface{}{
 			"AppName":     cmd.RequiredArgs.App,
 			"AppProtocol": cmd.AppProtocol,
@@ -113,5 +127,35 @@ func (cmd UpdateDestinationCommand) Execute(args []string) error {
 	}
 	cmd.UI.DisplayOK()
 
+	if cmd.Weight.Value != 0 {
+		route, warnings, err = cmd.Actor.GetRouteByAttributes(domain, cmd.Hostname, path, 0)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		return cmd.updateWeight(route, app, url, user.Name)
+	}
+
+	return nil
+}
+
+func (cmd UpdateDestinationCommand) updateWeight(route resources.Route, app resources.Application, url string, username string) error {
+	cmd.UI.DisplayTextWithFlavor("Updating destination weight to {{.Weight}} for app {{.AppName}} on route {{.URL}} in org {{.OrgName}} / space {{.SpaceName}} as {{.User}}...",
+		map[string]interface{}{
+			"Weight":    cmd.Weight.Value,
+			"AppName":   cmd.RequiredArgs.App,
+			"URL":       url,
+			"User":      username,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+		})
+
+	warnings, err := cmd.Actor.SetRouteDestinationWeights(route, map[string]int{app.GUID: int(cmd.Weight.Value)}, "")
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayOK()
+
 	return nil
 }
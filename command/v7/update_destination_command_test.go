@@ -373,6 +373,64 @@ var _ = Describe("update Command", func() {
 							})
 						})
 					})
+					When("only a weight is given", func() {
+						BeforeEach(func() {
+							cmd.AppProtocol = ""
+							cmd.Weight = flag.PositiveInteger{Value: 50}
+							fakeActor.GetRouteDestinationByAppGUIDReturns(
+								resources.RouteDestination{GUID: "route-dst-guid", Protocol: "http1"},
+								nil,
+							)
+							fakeActor.SetRouteDestinationWeightsReturns(v7action.Warnings{"set-weights-warnings"}, nil)
+						})
+
+						It("updates the weight without changing the protocol", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Out).To(Say(`Updating destination weight to 50 for app super-app on route hostname\.some-domain\.com`))
+							Expect(testUI.Out).To(Say("OK"))
+							Expect(testUI.Err).To(Say("set-weights-warnings"))
+
+							Expect(fakeActor.UpdateDestinationCallCount()).To(Equal(0))
+							Expect(fakeActor.SetRouteDestinationWeightsCallCount()).To(Equal(1))
+							actualRoute, actualWeights, _ := fakeActor.SetRouteDestinationWeightsArgsForCall(0)
+							Expect(actualRoute.GUID).To(Equal("route-guid"))
+							Expect(actualWeights).To(Equal(map[string]int{"app-guid": 50}))
+						})
+
+						When("the app is not a destination of the route", func() {
+							BeforeEach(func() {
+								fakeActor.GetRouteDestinationByAppGUIDReturns(
+									resources.RouteDestination{},
+									actionerror.RouteDestinationNotFoundError{AppGUID: "app-guid", RouteGUID: "route-guid"},
+								)
+							})
+
+							It("returns the error without mapping the app", func() {
+								Expect(executeErr).To(MatchError(actionerror.RouteDestinationNotFoundError{AppGUID: "app-guid", RouteGUID: "route-guid"}))
+								Expect(fakeActor.SetRouteDestinationWeightsCallCount()).To(Equal(0))
+							})
+						})
+					})
+
+					When("a protocol and a weight are given", func() {
+						BeforeEach(func() {
+							cmd.Weight = flag.PositiveInteger{Value: 50}
+							fakeActor.GetRouteDestinationByAppGUIDReturns(
+								resources.RouteDestination{GUID: "route-dst-guid", Protocol: "http1"},
+								nil,
+							)
+							fakeActor.UpdateDestinationReturns(v7action.Warnings{"update-dest-warnings"}, nil)
+						})
+
+						It("updates the protocol and then the weight of the refreshed route", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(fakeActor.UpdateDestinationCallCount()).To(Equal(1))
+							Expect(fakeActor.GetRouteByAttributesCallCount()).To(Equal(2))
+							Expect(fakeActor.SetRouteDestinationWeightsCallCount()).To(Equal(1))
+							_, actualWeights, _ := fakeActor.SetRouteDestinationWeightsArgsForCall(0)
+							Expect(actualWeights).To(Equal(map[string]int{"app-guid": 50}))
+						})
+					})
 				})
 			})
 		})
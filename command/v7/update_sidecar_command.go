@@ -0,0 +1,78 @@
+package v7
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+)
+
+type UpdateSidecarCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppSidecar `positional-args:"yes"`
+	Command         string          `long:"command" short:"c" description:"Command the sidecar runs"`
+	ProcessTypes    string          `long:"process-types" description:"Comma-separated types of the app's processes that the sidecar runs alongside"`
+	Memory          flag.Megabytes  `short:"m" description:"Memory reserved for the sidecar out of the memory of each process it runs alongside (e.g. 64M, 1G)"`
+	usage           interface{}     `usage:"CF_NAME update-sidecar APP_NAME SIDECAR_NAME [-c COMMAND] [--process-types TYPES] [-m MEMORY]"`
+	relatedCommands interface{}     `related_commands:"create-sidecar, delete-sidecar, restart, sidecars"`
+}
+
+func (cmd UpdateSidecarCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Updating sidecar {{.SidecarName}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"SidecarName": cmd.RequiredArgs.SidecarName,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"CurrentUser": user.Name,
+	})
+
+	if cmd.Command == "" && cmd.ProcessTypes == "" && !cmd.Memory.IsSet {
+		cmd.UI.DisplayText("No flags specified. No changes were made.")
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	sidecar := resources.Sidecar{
+		ProcessTypes: splitProcessTypes(cmd.ProcessTypes),
+		MemoryInMB:   cmd.Memory.NullUint64,
+	}
+	if cmd.Command != "" {
+		sidecar.Command = types.FilteredString{IsSet: true, Value: cmd.Command}
+	}
+
+	_, warnings, err := cmd.Actor.UpdateApplicationSidecar(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.RequiredArgs.SidecarName, sidecar)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("TIP: Use 'cf restart {{.AppName}}' for the changes to take effect.", map[string]interface{}{
+		"AppName": cmd.RequiredArgs.AppName,
+	})
+
+	return nil
+}
+
+func splitProcessTypes(processTypes string) []string {
+	var types []string
+	for _, processType := range strings.Split(processTypes, ",") {
+		processType = strings.TrimSpace(processType)
+		if processType != "" {
+			types = append(types, processType)
+		}
+	}
+	return types
+}
@@ -0,0 +1,129 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-sidecar Command", func() {
+	var (
+		cmd             UpdateSidecarCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = UpdateSidecarCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs: flag.AppSidecar{AppName: "some-app", SidecarName: "auth-proxy"},
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+		})
+	})
+
+	When("no flags are provided", func() {
+		It("makes no changes", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeActor.UpdateApplicationSidecarCallCount()).To(Equal(0))
+			Expect(testUI.Out).To(Say(`Updating sidecar auth-proxy for app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`No flags specified\. No changes were made\.`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("flags are provided", func() {
+		BeforeEach(func() {
+			cmd.Command = "./new-proxy"
+			cmd.ProcessTypes = "worker"
+			cmd.Memory = flag.Megabytes{NullUint64: types.NullUint64{IsSet: true, Value: 128}}
+			fakeActor.UpdateApplicationSidecarReturns(resources.Sidecar{}, v7action.Warnings{"some-warning"}, nil)
+		})
+
+		It("updates the sidecar", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeActor.UpdateApplicationSidecarCallCount()).To(Equal(1))
+			appName, spaceGUID, sidecarName, sidecar := fakeActor.UpdateApplicationSidecarArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(sidecarName).To(Equal("auth-proxy"))
+			Expect(sidecar).To(Equal(resources.Sidecar{
+				Command:      types.FilteredString{IsSet: true, Value: "./new-proxy"},
+				ProcessTypes: []string{"worker"},
+				MemoryInMB:   types.NullUint64{IsSet: true, Value: 128},
+			}))
+
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`TIP: Use 'cf restart some-app' for the changes to take effect\.`))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+
+		When("updating the sidecar fails", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateApplicationSidecarReturns(resources.Sidecar{}, v7action.Warnings{"some-warning"}, actionerror.SidecarNotFoundError{Name: "auth-proxy", AppName: "some-app"})
+			})
+
+			It("displays the warnings and returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.SidecarNotFoundError{Name: "auth-proxy", AppName: "some-app"}))
+				Expect(testUI.Err).To(Say("some-warning"))
+			})
+		})
+	})
+
+	When("only the memory is provided", func() {
+		BeforeEach(func() {
+			cmd.Memory = flag.Megabytes{NullUint64: types.NullUint64{IsSet: true, Value: 32}}
+			fakeActor.UpdateApplicationSidecarReturns(resources.Sidecar{}, nil, errors.New("some-error"))
+		})
+
+		It("leaves the command and process types unset", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			_, _, _, sidecar := fakeActor.UpdateApplicationSidecarArgsForCall(0)
+			Expect(sidecar.Command.IsSet).To(BeFalse())
+			Expect(sidecar.ProcessTypes).To(BeNil())
+		})
+	})
+})
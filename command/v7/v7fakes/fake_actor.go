@@ -78,6 +78,17 @@ type FakeActor struct {
 	authenticateReturnsOnCall map[int]struct {
 		result1 error
 	}
+	AuthenticateWithDeviceCodeStub        func(v7action.DeviceAuthorization) error
+	authenticateWithDeviceCodeMutex       sync.RWMutex
+	authenticateWithDeviceCodeArgsForCall []struct {
+		arg1 v7action.DeviceAuthorization
+	}
+	authenticateWithDeviceCodeReturns struct {
+		result1 error
+	}
+	authenticateWithDeviceCodeReturnsOnCall map[int]struct {
+		result1 error
+	}
 	BindSecurityGroupToSpacesStub        func(string, []resources.Space, constanta.SecurityGroupLifecycle) (v7action.Warnings, error)
 	bindSecurityGroupToSpacesMutex       sync.RWMutex
 	bindSecurityGroupToSpacesArgsForCall []struct {
@@ -128,6 +139,19 @@ type FakeActor struct {
 	clearTargetMutex       sync.RWMutex
 	clearTargetArgsForCall []struct {
 	}
+	ContinueDeploymentStub        func(string) (v7action.Warnings, error)
+	continueDeploymentMutex       sync.RWMutex
+	continueDeploymentArgsForCall []struct {
+		arg1 string
+	}
+	continueDeploymentReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	continueDeploymentReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	CopyPackageStub        func(resources.Application, resources.Application) (resources.Package, v7action.Warnings, error)
 	copyPackageMutex       sync.RWMutex
 	copyPackageArgsForCall []struct {
@@ -192,6 +216,23 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	CreateApplicationSidecarStub        func(string, string, resources.Sidecar) (resources.Sidecar, v7action.Warnings, error)
+	createApplicationSidecarMutex       sync.RWMutex
+	createApplicationSidecarArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 resources.Sidecar
+	}
+	createApplicationSidecarReturns struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
+	createApplicationSidecarReturnsOnCall map[int]struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
 	CreateBitsPackageByApplicationStub        func(string) (resources.Package, v7action.Warnings, error)
 	createBitsPackageByApplicationMutex       sync.RWMutex
 	createBitsPackageByApplicationArgsForCall []struct {
@@ -222,34 +263,17 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
-	CreateDeploymentByApplicationAndDropletStub        func(string, string) (string, v7action.Warnings, error)
-	createDeploymentByApplicationAndDropletMutex       sync.RWMutex
-	createDeploymentByApplicationAndDropletArgsForCall []struct {
-		arg1 string
-		arg2 string
-	}
-	createDeploymentByApplicationAndDropletReturns struct {
-		result1 string
-		result2 v7action.Warnings
-		result3 error
-	}
-	createDeploymentByApplicationAndDropletReturnsOnCall map[int]struct {
-		result1 string
-		result2 v7action.Warnings
-		result3 error
-	}
-	CreateDeploymentByApplicationAndRevisionStub        func(string, string) (string, v7action.Warnings, error)
-	createDeploymentByApplicationAndRevisionMutex       sync.RWMutex
-	createDeploymentByApplicationAndRevisionArgsForCall []struct {
-		arg1 string
-		arg2 string
+	CreateDeploymentStub        func(resources.Deployment) (string, v7action.Warnings, error)
+	createDeploymentMutex       sync.RWMutex
+	createDeploymentArgsForCall []struct {
+		arg1 resources.Deployment
 	}
-	createDeploymentByApplicationAndRevisionReturns struct {
+	createDeploymentReturns struct {
 		result1 string
 		result2 v7action.Warnings
 		result3 error
 	}
-	createDeploymentByApplicationAndRevisionReturnsOnCall map[int]struct {
+	createDeploymentReturnsOnCall map[int]struct {
 		result1 string
 		result2 v7action.Warnings
 		result3 error
@@ -575,6 +599,21 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	DeleteApplicationSidecarStub        func(string, string, string) (v7action.Warnings, error)
+	deleteApplicationSidecarMutex       sync.RWMutex
+	deleteApplicationSidecarArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	deleteApplicationSidecarReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	deleteApplicationSidecarReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	DeleteBuildpackByNameAndStackStub        func(string, string) (v7action.Warnings, error)
 	deleteBuildpackByNameAndStackMutex       sync.RWMutex
 	deleteBuildpackByNameAndStackArgsForCall []struct {
@@ -1148,6 +1187,55 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetApplicationSidecarsByNameAndSpaceStub        func(string, string) ([]resources.Sidecar, v7action.Warnings, error)
+	getApplicationSidecarsByNameAndSpaceMutex       sync.RWMutex
+	getApplicationSidecarsByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getApplicationSidecarsByNameAndSpaceReturns struct {
+		result1 []resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationSidecarsByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 []resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetApplicationStateByNameAndSpaceStub        func(string, string) (v7action.ApplicationState, v7action.Warnings, error)
+	getApplicationStateByNameAndSpaceMutex       sync.RWMutex
+	getApplicationStateByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getApplicationStateByNameAndSpaceReturns struct {
+		result1 v7action.ApplicationState
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationStateByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v7action.ApplicationState
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetApplicationStateByRevisionStub        func(string, string, int) (v7action.ApplicationState, v7action.Warnings, error)
+	getApplicationStateByRevisionMutex       sync.RWMutex
+	getApplicationStateByRevisionArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}
+	getApplicationStateByRevisionReturns struct {
+		result1 v7action.ApplicationState
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationStateByRevisionReturnsOnCall map[int]struct {
+		result1 v7action.ApplicationState
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetApplicationTasksStub        func(string, v7action.SortOrder) ([]resources.Task, v7action.Warnings, error)
 	getApplicationTasksMutex       sync.RWMutex
 	getApplicationTasksArgsForCall []struct {
@@ -1180,6 +1268,36 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetApplicationsBySpaceStub        func(string) ([]resources.Application, v7action.Warnings, error)
+	getApplicationsBySpaceMutex       sync.RWMutex
+	getApplicationsBySpaceArgsForCall []struct {
+		arg1 string
+	}
+	getApplicationsBySpaceReturns struct {
+		result1 []resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationsBySpaceReturnsOnCall map[int]struct {
+		result1 []resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetAuthorizationCodeURLStub        func(string, string, string) (string, error)
+	getAuthorizationCodeURLMutex       sync.RWMutex
+	getAuthorizationCodeURLArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	getAuthorizationCodeURLReturns struct {
+		result1 string
+		result2 error
+	}
+	getAuthorizationCodeURLReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	GetBuildpackLabelsStub        func(string, string) (map[string]types.NullString, v7action.Warnings, error)
 	getBuildpackLabelsMutex       sync.RWMutex
 	getBuildpackLabelsArgsForCall []struct {
@@ -1255,6 +1373,18 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetDeviceAuthorizationStub        func() (v7action.DeviceAuthorization, error)
+	getDeviceAuthorizationMutex       sync.RWMutex
+	getDeviceAuthorizationArgsForCall []struct {
+	}
+	getDeviceAuthorizationReturns struct {
+		result1 v7action.DeviceAuthorization
+		result2 error
+	}
+	getDeviceAuthorizationReturnsOnCall map[int]struct {
+		result1 v7action.DeviceAuthorization
+		result2 error
+	}
 	GetDomainStub        func(string) (resources.Domain, v7action.Warnings, error)
 	getDomainMutex       sync.RWMutex
 	getDomainArgsForCall []struct {
@@ -1347,6 +1477,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetEventsStub        func(v7action.EventQuery) ([]v7action.Event, v7action.Warnings, error)
+	getEventsMutex       sync.RWMutex
+	getEventsArgsForCall []struct {
+		arg1 v7action.EventQuery
+	}
+	getEventsReturns struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}
+	getEventsReturnsOnCall map[int]struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetFeatureFlagByNameStub        func(string) (resources.FeatureFlag, v7action.Warnings, error)
 	getFeatureFlagByNameMutex       sync.RWMutex
 	getFeatureFlagByNameArgsForCall []struct {
@@ -1376,6 +1521,46 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetFilteredRecentLogsForApplicationByNameAndSpaceStub        func(string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) ([]sharedaction.LogMessage, v7action.Warnings, error)
+	getFilteredRecentLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
+	getFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 sharedaction.LogCacheClient
+		arg4 sharedaction.LogFilter
+	}
+	getFilteredRecentLogsForApplicationByNameAndSpaceReturns struct {
+		result1 []sharedaction.LogMessage
+		result2 v7action.Warnings
+		result3 error
+	}
+	getFilteredRecentLogsForApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 []sharedaction.LogMessage
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetFilteredStreamingLogsForApplicationByNameAndSpaceStub        func(string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)
+	getFilteredStreamingLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
+	getFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 sharedaction.LogCacheClient
+		arg4 sharedaction.LogFilter
+	}
+	getFilteredStreamingLogsForApplicationByNameAndSpaceReturns struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 v7action.Warnings
+		result5 error
+	}
+	getFilteredStreamingLogsForApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 v7action.Warnings
+		result5 error
+	}
 	GetGlobalRunningSecurityGroupsStub        func() ([]resources.SecurityGroup, v7action.Warnings, error)
 	getGlobalRunningSecurityGroupsMutex       sync.RWMutex
 	getGlobalRunningSecurityGroupsArgsForCall []struct {
@@ -1404,6 +1589,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetInstanceMetricsStub        func(string, string) ([]v7action.InstanceMetrics, v7action.Warnings, error)
+	getInstanceMetricsMutex       sync.RWMutex
+	getInstanceMetricsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getInstanceMetricsReturns struct {
+		result1 []v7action.InstanceMetrics
+		result2 v7action.Warnings
+		result3 error
+	}
+	getInstanceMetricsReturnsOnCall map[int]struct {
+		result1 []v7action.InstanceMetrics
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetIsolationSegmentByNameStub        func(string) (resources.IsolationSegment, v7action.Warnings, error)
 	getIsolationSegmentByNameMutex       sync.RWMutex
 	getIsolationSegmentByNameArgsForCall []struct {
@@ -1673,22 +1874,6 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
-	GetRecentEventsByApplicationNameAndSpaceStub        func(string, string) ([]v7action.Event, v7action.Warnings, error)
-	getRecentEventsByApplicationNameAndSpaceMutex       sync.RWMutex
-	getRecentEventsByApplicationNameAndSpaceArgsForCall []struct {
-		arg1 string
-		arg2 string
-	}
-	getRecentEventsByApplicationNameAndSpaceReturns struct {
-		result1 []v7action.Event
-		result2 v7action.Warnings
-		result3 error
-	}
-	getRecentEventsByApplicationNameAndSpaceReturnsOnCall map[int]struct {
-		result1 []v7action.Event
-		result2 v7action.Warnings
-		result3 error
-	}
 	GetRecentLogsForApplicationByNameAndSpaceStub        func(string, string, sharedaction.LogCacheClient) ([]sharedaction.LogMessage, v7action.Warnings, error)
 	getRecentLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
 	getRecentLogsForApplicationByNameAndSpaceArgsForCall []struct {
@@ -1752,6 +1937,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetRouteStub        func(string, string) (resources.Route, v7action.Warnings, error)
+	getRouteMutex       sync.RWMutex
+	getRouteArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getRouteReturns struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRouteReturnsOnCall map[int]struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetRouteByAttributesStub        func(resources.Domain, string, string, int) (resources.Route, v7action.Warnings, error)
 	getRouteByAttributesMutex       sync.RWMutex
 	getRouteByAttributesArgsForCall []struct {
@@ -1920,6 +2121,23 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetSecureShellConfigurationsForRunningInstancesStub        func(string, string, string) ([]v7action.InstanceSSHAuthentication, v7action.Warnings, error)
+	getSecureShellConfigurationsForRunningInstancesMutex       sync.RWMutex
+	getSecureShellConfigurationsForRunningInstancesArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	getSecureShellConfigurationsForRunningInstancesReturns struct {
+		result1 []v7action.InstanceSSHAuthentication
+		result2 v7action.Warnings
+		result3 error
+	}
+	getSecureShellConfigurationsForRunningInstancesReturnsOnCall map[int]struct {
+		result1 []v7action.InstanceSSHAuthentication
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetSecurityGroupStub        func(string) (resources.SecurityGroup, v7action.Warnings, error)
 	getSecurityGroupMutex       sync.RWMutex
 	getSecurityGroupArgsForCall []struct {
@@ -2564,6 +2782,23 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	PollTaskStub        func(string, int, time.Duration) (resources.Task, v7action.Warnings, error)
+	pollTaskMutex       sync.RWMutex
+	pollTaskArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 time.Duration
+	}
+	pollTaskReturns struct {
+		result1 resources.Task
+		result2 v7action.Warnings
+		result3 error
+	}
+	pollTaskReturnsOnCall map[int]struct {
+		result1 resources.Task
+		result2 v7action.Warnings
+		result3 error
+	}
 	PollUploadBuildpackJobStub        func(ccv3.JobURL) (v7action.Warnings, error)
 	pollUploadBuildpackJobMutex       sync.RWMutex
 	pollUploadBuildpackJobArgsForCall []struct {
@@ -2916,6 +3151,36 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	SetRouteDestinationWeightsStub        func(resources.Route, map[string]int, string) (v7action.Warnings, error)
+	setRouteDestinationWeightsMutex       sync.RWMutex
+	setRouteDestinationWeightsArgsForCall []struct {
+		arg1 resources.Route
+		arg2 map[string]int
+		arg3 string
+	}
+	setRouteDestinationWeightsReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	setRouteDestinationWeightsReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	SetRouteDestinationWeightsByAppNameStub        func(string, string, map[string]int) (v7action.Warnings, error)
+	setRouteDestinationWeightsByAppNameMutex       sync.RWMutex
+	setRouteDestinationWeightsByAppNameArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]int
+	}
+	setRouteDestinationWeightsByAppNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	setRouteDestinationWeightsByAppNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	SetSpaceManifestStub        func(string, []byte) (v7action.Warnings, error)
 	setSpaceManifestMutex       sync.RWMutex
 	setSpaceManifestArgsForCall []struct {
@@ -3195,6 +3460,24 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateApplicationSidecarStub        func(string, string, string, resources.Sidecar) (resources.Sidecar, v7action.Warnings, error)
+	updateApplicationSidecarMutex       sync.RWMutex
+	updateApplicationSidecarArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 resources.Sidecar
+	}
+	updateApplicationSidecarReturns struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
+	updateApplicationSidecarReturnsOnCall map[int]struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
 	UpdateBuildpackByNameAndStackStub        func(string, string, resources.Buildpack) (resources.Buildpack, v7action.Warnings, error)
 	updateBuildpackByNameAndStackMutex       sync.RWMutex
 	updateBuildpackByNameAndStackArgsForCall []struct {
@@ -3846,6 +4129,66 @@ func (fake *FakeActor) AuthenticateReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeActor) AuthenticateWithDeviceCode(arg1 v7action.DeviceAuthorization) error {
+	fake.authenticateWithDeviceCodeMutex.Lock()
+	ret, specificReturn := fake.authenticateWithDeviceCodeReturnsOnCall[len(fake.authenticateWithDeviceCodeArgsForCall)]
+	fake.authenticateWithDeviceCodeArgsForCall = append(fake.authenticateWithDeviceCodeArgsForCall, struct {
+		arg1 v7action.DeviceAuthorization
+	}{arg1})
+	fake.recordInvocation("AuthenticateWithDeviceCode", []interface{}{arg1})
+	fake.authenticateWithDeviceCodeMutex.Unlock()
+	if fake.AuthenticateWithDeviceCodeStub != nil {
+		return fake.AuthenticateWithDeviceCodeStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.authenticateWithDeviceCodeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeActor) AuthenticateWithDeviceCodeCallCount() int {
+	fake.authenticateWithDeviceCodeMutex.RLock()
+	defer fake.authenticateWithDeviceCodeMutex.RUnlock()
+	return len(fake.authenticateWithDeviceCodeArgsForCall)
+}
+
+func (fake *FakeActor) AuthenticateWithDeviceCodeCalls(stub func(v7action.DeviceAuthorization) error) {
+	fake.authenticateWithDeviceCodeMutex.Lock()
+	defer fake.authenticateWithDeviceCodeMutex.Unlock()
+	fake.AuthenticateWithDeviceCodeStub = stub
+}
+
+func (fake *FakeActor) AuthenticateWithDeviceCodeArgsForCall(i int) v7action.DeviceAuthorization {
+	fake.authenticateWithDeviceCodeMutex.RLock()
+	defer fake.authenticateWithDeviceCodeMutex.RUnlock()
+	argsForCall := fake.authenticateWithDeviceCodeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) AuthenticateWithDeviceCodeReturns(result1 error) {
+	fake.authenticateWithDeviceCodeMutex.Lock()
+	defer fake.authenticateWithDeviceCodeMutex.Unlock()
+	fake.AuthenticateWithDeviceCodeStub = nil
+	fake.authenticateWithDeviceCodeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeActor) AuthenticateWithDeviceCodeReturnsOnCall(i int, result1 error) {
+	fake.authenticateWithDeviceCodeMutex.Lock()
+	defer fake.authenticateWithDeviceCodeMutex.Unlock()
+	fake.AuthenticateWithDeviceCodeStub = nil
+	if fake.authenticateWithDeviceCodeReturnsOnCall == nil {
+		fake.authenticateWithDeviceCodeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.authenticateWithDeviceCodeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeActor) BindSecurityGroupToSpaces(arg1 string, arg2 []resources.Space, arg3 constanta.SecurityGroupLifecycle) (v7action.Warnings, error) {
 	var arg2Copy []resources.Space
 	if arg2 != nil {
@@ -4071,22 +4414,85 @@ func (fake *FakeActor) ClearTargetCalls(stub func()) {
 	fake.ClearTargetStub = stub
 }
 
-func (fake *FakeActor) CopyPackage(arg1 resources.Application, arg2 resources.Application) (resources.Package, v7action.Warnings, error) {
-	fake.copyPackageMutex.Lock()
-	ret, specificReturn := fake.copyPackageReturnsOnCall[len(fake.copyPackageArgsForCall)]
-	fake.copyPackageArgsForCall = append(fake.copyPackageArgsForCall, struct {
-		arg1 resources.Application
-		arg2 resources.Application
-	}{arg1, arg2})
-	fake.recordInvocation("CopyPackage", []interface{}{arg1, arg2})
-	fake.copyPackageMutex.Unlock()
-	if fake.CopyPackageStub != nil {
-		return fake.CopyPackageStub(arg1, arg2)
+func (fake *FakeActor) ContinueDeployment(arg1 string) (v7action.Warnings, error) {
+	fake.continueDeploymentMutex.Lock()
+	ret, specificReturn := fake.continueDeploymentReturnsOnCall[len(fake.continueDeploymentArgsForCall)]
+	fake.continueDeploymentArgsForCall = append(fake.continueDeploymentArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ContinueDeployment", []interface{}{arg1})
+	fake.continueDeploymentMutex.Unlock()
+	if fake.ContinueDeploymentStub != nil {
+		return fake.ContinueDeploymentStub(arg1)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.copyPackageReturns
+	fakeReturns := fake.continueDeploymentReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) ContinueDeploymentCallCount() int {
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
+	return len(fake.continueDeploymentArgsForCall)
+}
+
+func (fake *FakeActor) ContinueDeploymentCalls(stub func(string) (v7action.Warnings, error)) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = stub
+}
+
+func (fake *FakeActor) ContinueDeploymentArgsForCall(i int) string {
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
+	argsForCall := fake.continueDeploymentArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) ContinueDeploymentReturns(result1 v7action.Warnings, result2 error) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = nil
+	fake.continueDeploymentReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) ContinueDeploymentReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = nil
+	if fake.continueDeploymentReturnsOnCall == nil {
+		fake.continueDeploymentReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.continueDeploymentReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) CopyPackage(arg1 resources.Application, arg2 resources.Application) (resources.Package, v7action.Warnings, error) {
+	fake.copyPackageMutex.Lock()
+	ret, specificReturn := fake.copyPackageReturnsOnCall[len(fake.copyPackageArgsForCall)]
+	fake.copyPackageArgsForCall = append(fake.copyPackageArgsForCall, struct {
+		arg1 resources.Application
+		arg2 resources.Application
+	}{arg1, arg2})
+	fake.recordInvocation("CopyPackage", []interface{}{arg1, arg2})
+	fake.copyPackageMutex.Unlock()
+	if fake.CopyPackageStub != nil {
+		return fake.CopyPackageStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.copyPackageReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
@@ -4339,6 +4745,74 @@ func (fake *FakeActor) CreateApplicationInSpaceReturnsOnCall(i int, result1 reso
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) CreateApplicationSidecar(arg1 string, arg2 string, arg3 resources.Sidecar) (resources.Sidecar, v7action.Warnings, error) {
+	fake.createApplicationSidecarMutex.Lock()
+	ret, specificReturn := fake.createApplicationSidecarReturnsOnCall[len(fake.createApplicationSidecarArgsForCall)]
+	fake.createApplicationSidecarArgsForCall = append(fake.createApplicationSidecarArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 resources.Sidecar
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("CreateApplicationSidecar", []interface{}{arg1, arg2, arg3})
+	fake.createApplicationSidecarMutex.Unlock()
+	if fake.CreateApplicationSidecarStub != nil {
+		return fake.CreateApplicationSidecarStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createApplicationSidecarReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) CreateApplicationSidecarCallCount() int {
+	fake.createApplicationSidecarMutex.RLock()
+	defer fake.createApplicationSidecarMutex.RUnlock()
+	return len(fake.createApplicationSidecarArgsForCall)
+}
+
+func (fake *FakeActor) CreateApplicationSidecarCalls(stub func(string, string, resources.Sidecar) (resources.Sidecar, v7action.Warnings, error)) {
+	fake.createApplicationSidecarMutex.Lock()
+	defer fake.createApplicationSidecarMutex.Unlock()
+	fake.CreateApplicationSidecarStub = stub
+}
+
+func (fake *FakeActor) CreateApplicationSidecarArgsForCall(i int) (string, string, resources.Sidecar) {
+	fake.createApplicationSidecarMutex.RLock()
+	defer fake.createApplicationSidecarMutex.RUnlock()
+	argsForCall := fake.createApplicationSidecarArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) CreateApplicationSidecarReturns(result1 resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.createApplicationSidecarMutex.Lock()
+	defer fake.createApplicationSidecarMutex.Unlock()
+	fake.CreateApplicationSidecarStub = nil
+	fake.createApplicationSidecarReturns = struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) CreateApplicationSidecarReturnsOnCall(i int, result1 resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.createApplicationSidecarMutex.Lock()
+	defer fake.createApplicationSidecarMutex.Unlock()
+	fake.CreateApplicationSidecarStub = nil
+	if fake.createApplicationSidecarReturnsOnCall == nil {
+		fake.createApplicationSidecarReturnsOnCall = make(map[int]struct {
+			result1 resources.Sidecar
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.createApplicationSidecarReturnsOnCall[i] = struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) CreateBitsPackageByApplication(arg1 string) (resources.Package, v7action.Warnings, error) {
 	fake.createBitsPackageByApplicationMutex.Lock()
 	ret, specificReturn := fake.createBitsPackageByApplicationReturnsOnCall[len(fake.createBitsPackageByApplicationArgsForCall)]
@@ -4471,134 +4945,66 @@ func (fake *FakeActor) CreateBuildpackReturnsOnCall(i int, result1 resources.Bui
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) CreateDeploymentByApplicationAndDroplet(arg1 string, arg2 string) (string, v7action.Warnings, error) {
-	fake.createDeploymentByApplicationAndDropletMutex.Lock()
-	ret, specificReturn := fake.createDeploymentByApplicationAndDropletReturnsOnCall[len(fake.createDeploymentByApplicationAndDropletArgsForCall)]
-	fake.createDeploymentByApplicationAndDropletArgsForCall = append(fake.createDeploymentByApplicationAndDropletArgsForCall, struct {
-		arg1 string
-		arg2 string
-	}{arg1, arg2})
-	fake.recordInvocation("CreateDeploymentByApplicationAndDroplet", []interface{}{arg1, arg2})
-	fake.createDeploymentByApplicationAndDropletMutex.Unlock()
-	if fake.CreateDeploymentByApplicationAndDropletStub != nil {
-		return fake.CreateDeploymentByApplicationAndDropletStub(arg1, arg2)
-	}
-	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
-	}
-	fakeReturns := fake.createDeploymentByApplicationAndDropletReturns
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
-}
-
-func (fake *FakeActor) CreateDeploymentByApplicationAndDropletCallCount() int {
-	fake.createDeploymentByApplicationAndDropletMutex.RLock()
-	defer fake.createDeploymentByApplicationAndDropletMutex.RUnlock()
-	return len(fake.createDeploymentByApplicationAndDropletArgsForCall)
-}
-
-func (fake *FakeActor) CreateDeploymentByApplicationAndDropletCalls(stub func(string, string) (string, v7action.Warnings, error)) {
-	fake.createDeploymentByApplicationAndDropletMutex.Lock()
-	defer fake.createDeploymentByApplicationAndDropletMutex.Unlock()
-	fake.CreateDeploymentByApplicationAndDropletStub = stub
-}
-
-func (fake *FakeActor) CreateDeploymentByApplicationAndDropletArgsForCall(i int) (string, string) {
-	fake.createDeploymentByApplicationAndDropletMutex.RLock()
-	defer fake.createDeploymentByApplicationAndDropletMutex.RUnlock()
-	argsForCall := fake.createDeploymentByApplicationAndDropletArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
-}
-
-func (fake *FakeActor) CreateDeploymentByApplicationAndDropletReturns(result1 string, result2 v7action.Warnings, result3 error) {
-	fake.createDeploymentByApplicationAndDropletMutex.Lock()
-	defer fake.createDeploymentByApplicationAndDropletMutex.Unlock()
-	fake.CreateDeploymentByApplicationAndDropletStub = nil
-	fake.createDeploymentByApplicationAndDropletReturns = struct {
-		result1 string
-		result2 v7action.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
-func (fake *FakeActor) CreateDeploymentByApplicationAndDropletReturnsOnCall(i int, result1 string, result2 v7action.Warnings, result3 error) {
-	fake.createDeploymentByApplicationAndDropletMutex.Lock()
-	defer fake.createDeploymentByApplicationAndDropletMutex.Unlock()
-	fake.CreateDeploymentByApplicationAndDropletStub = nil
-	if fake.createDeploymentByApplicationAndDropletReturnsOnCall == nil {
-		fake.createDeploymentByApplicationAndDropletReturnsOnCall = make(map[int]struct {
-			result1 string
-			result2 v7action.Warnings
-			result3 error
-		})
-	}
-	fake.createDeploymentByApplicationAndDropletReturnsOnCall[i] = struct {
-		result1 string
-		result2 v7action.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
-func (fake *FakeActor) CreateDeploymentByApplicationAndRevision(arg1 string, arg2 string) (string, v7action.Warnings, error) {
-	fake.createDeploymentByApplicationAndRevisionMutex.Lock()
-	ret, specificReturn := fake.createDeploymentByApplicationAndRevisionReturnsOnCall[len(fake.createDeploymentByApplicationAndRevisionArgsForCall)]
-	fake.createDeploymentByApplicationAndRevisionArgsForCall = append(fake.createDeploymentByApplicationAndRevisionArgsForCall, struct {
-		arg1 string
-		arg2 string
-	}{arg1, arg2})
-	fake.recordInvocation("CreateDeploymentByApplicationAndRevision", []interface{}{arg1, arg2})
-	fake.createDeploymentByApplicationAndRevisionMutex.Unlock()
-	if fake.CreateDeploymentByApplicationAndRevisionStub != nil {
-		return fake.CreateDeploymentByApplicationAndRevisionStub(arg1, arg2)
+func (fake *FakeActor) CreateDeployment(arg1 resources.Deployment) (string, v7action.Warnings, error) {
+	fake.createDeploymentMutex.Lock()
+	ret, specificReturn := fake.createDeploymentReturnsOnCall[len(fake.createDeploymentArgsForCall)]
+	fake.createDeploymentArgsForCall = append(fake.createDeploymentArgsForCall, struct {
+		arg1 resources.Deployment
+	}{arg1})
+	fake.recordInvocation("CreateDeployment", []interface{}{arg1})
+	fake.createDeploymentMutex.Unlock()
+	if fake.CreateDeploymentStub != nil {
+		return fake.CreateDeploymentStub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.createDeploymentByApplicationAndRevisionReturns
+	fakeReturns := fake.createDeploymentReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeActor) CreateDeploymentByApplicationAndRevisionCallCount() int {
-	fake.createDeploymentByApplicationAndRevisionMutex.RLock()
-	defer fake.createDeploymentByApplicationAndRevisionMutex.RUnlock()
-	return len(fake.createDeploymentByApplicationAndRevisionArgsForCall)
+func (fake *FakeActor) CreateDeploymentCallCount() int {
+	fake.createDeploymentMutex.RLock()
+	defer fake.createDeploymentMutex.RUnlock()
+	return len(fake.createDeploymentArgsForCall)
 }
 
-func (fake *FakeActor) CreateDeploymentByApplicationAndRevisionCalls(stub func(string, string) (string, v7action.Warnings, error)) {
-	fake.createDeploymentByApplicationAndRevisionMutex.Lock()
-	defer fake.createDeploymentByApplicationAndRevisionMutex.Unlock()
-	fake.CreateDeploymentByApplicationAndRevisionStub = stub
+func (fake *FakeActor) CreateDeploymentCalls(stub func(resources.Deployment) (string, v7action.Warnings, error)) {
+	fake.createDeploymentMutex.Lock()
+	defer fake.createDeploymentMutex.Unlock()
+	fake.CreateDeploymentStub = stub
 }
 
-func (fake *FakeActor) CreateDeploymentByApplicationAndRevisionArgsForCall(i int) (string, string) {
-	fake.createDeploymentByApplicationAndRevisionMutex.RLock()
-	defer fake.createDeploymentByApplicationAndRevisionMutex.RUnlock()
-	argsForCall := fake.createDeploymentByApplicationAndRevisionArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeActor) CreateDeploymentArgsForCall(i int) resources.Deployment {
+	fake.createDeploymentMutex.RLock()
+	defer fake.createDeploymentMutex.RUnlock()
+	argsForCall := fake.createDeploymentArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeActor) CreateDeploymentByApplicationAndRevisionReturns(result1 string, result2 v7action.Warnings, result3 error) {
-	fake.createDeploymentByApplicationAndRevisionMutex.Lock()
-	defer fake.createDeploymentByApplicationAndRevisionMutex.Unlock()
-	fake.CreateDeploymentByApplicationAndRevisionStub = nil
-	fake.createDeploymentByApplicationAndRevisionReturns = struct {
+func (fake *FakeActor) CreateDeploymentReturns(result1 string, result2 v7action.Warnings, result3 error) {
+	fake.createDeploymentMutex.Lock()
+	defer fake.createDeploymentMutex.Unlock()
+	fake.CreateDeploymentStub = nil
+	fake.createDeploymentReturns = struct {
 		result1 string
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) CreateDeploymentByApplicationAndRevisionReturnsOnCall(i int, result1 string, result2 v7action.Warnings, result3 error) {
-	fake.createDeploymentByApplicationAndRevisionMutex.Lock()
-	defer fake.createDeploymentByApplicationAndRevisionMutex.Unlock()
-	fake.CreateDeploymentByApplicationAndRevisionStub = nil
-	if fake.createDeploymentByApplicationAndRevisionReturnsOnCall == nil {
-		fake.createDeploymentByApplicationAndRevisionReturnsOnCall = make(map[int]struct {
+func (fake *FakeActor) CreateDeploymentReturnsOnCall(i int, result1 string, result2 v7action.Warnings, result3 error) {
+	fake.createDeploymentMutex.Lock()
+	defer fake.createDeploymentMutex.Unlock()
+	fake.CreateDeploymentStub = nil
+	if fake.createDeploymentReturnsOnCall == nil {
+		fake.createDeploymentReturnsOnCall = make(map[int]struct {
 			result1 string
 			result2 v7action.Warnings
 			result3 error
 		})
 	}
-	fake.createDeploymentByApplicationAndRevisionReturnsOnCall[i] = struct {
+	fake.createDeploymentReturnsOnCall[i] = struct {
 		result1 string
 		result2 v7action.Warnings
 		result3 error
@@ -5986,6 +6392,71 @@ func (fake *FakeActor) DeleteApplicationByNameAndSpaceReturnsOnCall(i int, resul
 	}{result1, result2}
 }
 
+func (fake *FakeActor) DeleteApplicationSidecar(arg1 string, arg2 string, arg3 string) (v7action.Warnings, error) {
+	fake.deleteApplicationSidecarMutex.Lock()
+	ret, specificReturn := fake.deleteApplicationSidecarReturnsOnCall[len(fake.deleteApplicationSidecarArgsForCall)]
+	fake.deleteApplicationSidecarArgsForCall = append(fake.deleteApplicationSidecarArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("DeleteApplicationSidecar", []interface{}{arg1, arg2, arg3})
+	fake.deleteApplicationSidecarMutex.Unlock()
+	if fake.DeleteApplicationSidecarStub != nil {
+		return fake.DeleteApplicationSidecarStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteApplicationSidecarReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) DeleteApplicationSidecarCallCount() int {
+	fake.deleteApplicationSidecarMutex.RLock()
+	defer fake.deleteApplicationSidecarMutex.RUnlock()
+	return len(fake.deleteApplicationSidecarArgsForCall)
+}
+
+func (fake *FakeActor) DeleteApplicationSidecarCalls(stub func(string, string, string) (v7action.Warnings, error)) {
+	fake.deleteApplicationSidecarMutex.Lock()
+	defer fake.deleteApplicationSidecarMutex.Unlock()
+	fake.DeleteApplicationSidecarStub = stub
+}
+
+func (fake *FakeActor) DeleteApplicationSidecarArgsForCall(i int) (string, string, string) {
+	fake.deleteApplicationSidecarMutex.RLock()
+	defer fake.deleteApplicationSidecarMutex.RUnlock()
+	argsForCall := fake.deleteApplicationSidecarArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) DeleteApplicationSidecarReturns(result1 v7action.Warnings, result2 error) {
+	fake.deleteApplicationSidecarMutex.Lock()
+	defer fake.deleteApplicationSidecarMutex.Unlock()
+	fake.DeleteApplicationSidecarStub = nil
+	fake.deleteApplicationSidecarReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) DeleteApplicationSidecarReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.deleteApplicationSidecarMutex.Lock()
+	defer fake.deleteApplicationSidecarMutex.Unlock()
+	fake.DeleteApplicationSidecarStub = nil
+	if fake.deleteApplicationSidecarReturnsOnCall == nil {
+		fake.deleteApplicationSidecarReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteApplicationSidecarReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) DeleteBuildpackByNameAndStack(arg1 string, arg2 string) (v7action.Warnings, error) {
 	fake.deleteBuildpackByNameAndStackMutex.Lock()
 	ret, specificReturn := fake.deleteBuildpackByNameAndStackReturnsOnCall[len(fake.deleteBuildpackByNameAndStackArgsForCall)]
@@ -8484,6 +8955,208 @@ func (fake *FakeActor) GetApplicationRoutesReturnsOnCall(i int, result1 []resour
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetApplicationSidecarsByNameAndSpace(arg1 string, arg2 string) ([]resources.Sidecar, v7action.Warnings, error) {
+	fake.getApplicationSidecarsByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationSidecarsByNameAndSpaceReturnsOnCall[len(fake.getApplicationSidecarsByNameAndSpaceArgsForCall)]
+	fake.getApplicationSidecarsByNameAndSpaceArgsForCall = append(fake.getApplicationSidecarsByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetApplicationSidecarsByNameAndSpace", []interface{}{arg1, arg2})
+	fake.getApplicationSidecarsByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationSidecarsByNameAndSpaceStub != nil {
+		return fake.GetApplicationSidecarsByNameAndSpaceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationSidecarsByNameAndSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetApplicationSidecarsByNameAndSpaceCallCount() int {
+	fake.getApplicationSidecarsByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationSidecarsByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationSidecarsByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetApplicationSidecarsByNameAndSpaceCalls(stub func(string, string) ([]resources.Sidecar, v7action.Warnings, error)) {
+	fake.getApplicationSidecarsByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationSidecarsByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationSidecarsByNameAndSpaceStub = stub
+}
+
+func (fake *FakeActor) GetApplicationSidecarsByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationSidecarsByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationSidecarsByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getApplicationSidecarsByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetApplicationSidecarsByNameAndSpaceReturns(result1 []resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationSidecarsByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationSidecarsByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationSidecarsByNameAndSpaceStub = nil
+	fake.getApplicationSidecarsByNameAndSpaceReturns = struct {
+		result1 []resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationSidecarsByNameAndSpaceReturnsOnCall(i int, result1 []resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationSidecarsByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationSidecarsByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationSidecarsByNameAndSpaceStub = nil
+	if fake.getApplicationSidecarsByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationSidecarsByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 []resources.Sidecar
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationSidecarsByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 []resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationStateByNameAndSpace(arg1 string, arg2 string) (v7action.ApplicationState, v7action.Warnings, error) {
+	fake.getApplicationStateByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationStateByNameAndSpaceReturnsOnCall[len(fake.getApplicationStateByNameAndSpaceArgsForCall)]
+	fake.getApplicationStateByNameAndSpaceArgsForCall = append(fake.getApplicationStateByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetApplicationStateByNameAndSpace", []interface{}{arg1, arg2})
+	fake.getApplicationStateByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationStateByNameAndSpaceStub != nil {
+		return fake.GetApplicationStateByNameAndSpaceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationStateByNameAndSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetApplicationStateByNameAndSpaceCallCount() int {
+	fake.getApplicationStateByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationStateByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationStateByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetApplicationStateByNameAndSpaceCalls(stub func(string, string) (v7action.ApplicationState, v7action.Warnings, error)) {
+	fake.getApplicationStateByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationStateByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationStateByNameAndSpaceStub = stub
+}
+
+func (fake *FakeActor) GetApplicationStateByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationStateByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationStateByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getApplicationStateByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetApplicationStateByNameAndSpaceReturns(result1 v7action.ApplicationState, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationStateByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationStateByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationStateByNameAndSpaceStub = nil
+	fake.getApplicationStateByNameAndSpaceReturns = struct {
+		result1 v7action.ApplicationState
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationStateByNameAndSpaceReturnsOnCall(i int, result1 v7action.ApplicationState, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationStateByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationStateByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationStateByNameAndSpaceStub = nil
+	if fake.getApplicationStateByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationStateByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v7action.ApplicationState
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationStateByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v7action.ApplicationState
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationStateByRevision(arg1 string, arg2 string, arg3 int) (v7action.ApplicationState, v7action.Warnings, error) {
+	fake.getApplicationStateByRevisionMutex.Lock()
+	ret, specificReturn := fake.getApplicationStateByRevisionReturnsOnCall[len(fake.getApplicationStateByRevisionArgsForCall)]
+	fake.getApplicationStateByRevisionArgsForCall = append(fake.getApplicationStateByRevisionArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetApplicationStateByRevision", []interface{}{arg1, arg2, arg3})
+	fake.getApplicationStateByRevisionMutex.Unlock()
+	if fake.GetApplicationStateByRevisionStub != nil {
+		return fake.GetApplicationStateByRevisionStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationStateByRevisionReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetApplicationStateByRevisionCallCount() int {
+	fake.getApplicationStateByRevisionMutex.RLock()
+	defer fake.getApplicationStateByRevisionMutex.RUnlock()
+	return len(fake.getApplicationStateByRevisionArgsForCall)
+}
+
+func (fake *FakeActor) GetApplicationStateByRevisionCalls(stub func(string, string, int) (v7action.ApplicationState, v7action.Warnings, error)) {
+	fake.getApplicationStateByRevisionMutex.Lock()
+	defer fake.getApplicationStateByRevisionMutex.Unlock()
+	fake.GetApplicationStateByRevisionStub = stub
+}
+
+func (fake *FakeActor) GetApplicationStateByRevisionArgsForCall(i int) (string, string, int) {
+	fake.getApplicationStateByRevisionMutex.RLock()
+	defer fake.getApplicationStateByRevisionMutex.RUnlock()
+	argsForCall := fake.getApplicationStateByRevisionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetApplicationStateByRevisionReturns(result1 v7action.ApplicationState, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationStateByRevisionMutex.Lock()
+	defer fake.getApplicationStateByRevisionMutex.Unlock()
+	fake.GetApplicationStateByRevisionStub = nil
+	fake.getApplicationStateByRevisionReturns = struct {
+		result1 v7action.ApplicationState
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationStateByRevisionReturnsOnCall(i int, result1 v7action.ApplicationState, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationStateByRevisionMutex.Lock()
+	defer fake.getApplicationStateByRevisionMutex.Unlock()
+	fake.GetApplicationStateByRevisionStub = nil
+	if fake.getApplicationStateByRevisionReturnsOnCall == nil {
+		fake.getApplicationStateByRevisionReturnsOnCall = make(map[int]struct {
+			result1 v7action.ApplicationState
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationStateByRevisionReturnsOnCall[i] = struct {
+		result1 v7action.ApplicationState
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetApplicationTasks(arg1 string, arg2 v7action.SortOrder) ([]resources.Task, v7action.Warnings, error) {
 	fake.getApplicationTasksMutex.Lock()
 	ret, specificReturn := fake.getApplicationTasksReturnsOnCall[len(fake.getApplicationTasksArgsForCall)]
@@ -8623,6 +9296,137 @@ func (fake *FakeActor) GetApplicationsByNamesAndSpaceReturnsOnCall(i int, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetApplicationsBySpace(arg1 string) ([]resources.Application, v7action.Warnings, error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationsBySpaceReturnsOnCall[len(fake.getApplicationsBySpaceArgsForCall)]
+	fake.getApplicationsBySpaceArgsForCall = append(fake.getApplicationsBySpaceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetApplicationsBySpace", []interface{}{arg1})
+	fake.getApplicationsBySpaceMutex.Unlock()
+	if fake.GetApplicationsBySpaceStub != nil {
+		return fake.GetApplicationsBySpaceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationsBySpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetApplicationsBySpaceCallCount() int {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	return len(fake.getApplicationsBySpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetApplicationsBySpaceCalls(stub func(string) ([]resources.Application, v7action.Warnings, error)) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	defer fake.getApplicationsBySpaceMutex.Unlock()
+	fake.GetApplicationsBySpaceStub = stub
+}
+
+func (fake *FakeActor) GetApplicationsBySpaceArgsForCall(i int) string {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	argsForCall := fake.getApplicationsBySpaceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetApplicationsBySpaceReturns(result1 []resources.Application, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	defer fake.getApplicationsBySpaceMutex.Unlock()
+	fake.GetApplicationsBySpaceStub = nil
+	fake.getApplicationsBySpaceReturns = struct {
+		result1 []resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationsBySpaceReturnsOnCall(i int, result1 []resources.Application, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	defer fake.getApplicationsBySpaceMutex.Unlock()
+	fake.GetApplicationsBySpaceStub = nil
+	if fake.getApplicationsBySpaceReturnsOnCall == nil {
+		fake.getApplicationsBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []resources.Application
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationsBySpaceReturnsOnCall[i] = struct {
+		result1 []resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetAuthorizationCodeURL(arg1 string, arg2 string, arg3 string) (string, error) {
+	fake.getAuthorizationCodeURLMutex.Lock()
+	ret, specificReturn := fake.getAuthorizationCodeURLReturnsOnCall[len(fake.getAuthorizationCodeURLArgsForCall)]
+	fake.getAuthorizationCodeURLArgsForCall = append(fake.getAuthorizationCodeURLArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetAuthorizationCodeURL", []interface{}{arg1, arg2, arg3})
+	fake.getAuthorizationCodeURLMutex.Unlock()
+	if fake.GetAuthorizationCodeURLStub != nil {
+		return fake.GetAuthorizationCodeURLStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getAuthorizationCodeURLReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) GetAuthorizationCodeURLCallCount() int {
+	fake.getAuthorizationCodeURLMutex.RLock()
+	defer fake.getAuthorizationCodeURLMutex.RUnlock()
+	return len(fake.getAuthorizationCodeURLArgsForCall)
+}
+
+func (fake *FakeActor) GetAuthorizationCodeURLCalls(stub func(string, string, string) (string, error)) {
+	fake.getAuthorizationCodeURLMutex.Lock()
+	defer fake.getAuthorizationCodeURLMutex.Unlock()
+	fake.GetAuthorizationCodeURLStub = stub
+}
+
+func (fake *FakeActor) GetAuthorizationCodeURLArgsForCall(i int) (string, string, string) {
+	fake.getAuthorizationCodeURLMutex.RLock()
+	defer fake.getAuthorizationCodeURLMutex.RUnlock()
+	argsForCall := fake.getAuthorizationCodeURLArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetAuthorizationCodeURLReturns(result1 string, result2 error) {
+	fake.getAuthorizationCodeURLMutex.Lock()
+	defer fake.getAuthorizationCodeURLMutex.Unlock()
+	fake.GetAuthorizationCodeURLStub = nil
+	fake.getAuthorizationCodeURLReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) GetAuthorizationCodeURLReturnsOnCall(i int, result1 string, result2 error) {
+	fake.getAuthorizationCodeURLMutex.Lock()
+	defer fake.getAuthorizationCodeURLMutex.Unlock()
+	fake.GetAuthorizationCodeURLStub = nil
+	if fake.getAuthorizationCodeURLReturnsOnCall == nil {
+		fake.getAuthorizationCodeURLReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getAuthorizationCodeURLReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) GetBuildpackLabels(arg1 string, arg2 string) (map[string]types.NullString, v7action.Warnings, error) {
 	fake.getBuildpackLabelsMutex.Lock()
 	ret, specificReturn := fake.getBuildpackLabelsReturnsOnCall[len(fake.getBuildpackLabelsArgsForCall)]
@@ -8945,6 +9749,61 @@ func (fake *FakeActor) GetDetailedAppSummaryReturnsOnCall(i int, result1 v7actio
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetDeviceAuthorization() (v7action.DeviceAuthorization, error) {
+	fake.getDeviceAuthorizationMutex.Lock()
+	ret, specificReturn := fake.getDeviceAuthorizationReturnsOnCall[len(fake.getDeviceAuthorizationArgsForCall)]
+	fake.getDeviceAuthorizationArgsForCall = append(fake.getDeviceAuthorizationArgsForCall, struct {
+	}{})
+	fake.recordInvocation("GetDeviceAuthorization", []interface{}{})
+	fake.getDeviceAuthorizationMutex.Unlock()
+	if fake.GetDeviceAuthorizationStub != nil {
+		return fake.GetDeviceAuthorizationStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getDeviceAuthorizationReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) GetDeviceAuthorizationCallCount() int {
+	fake.getDeviceAuthorizationMutex.RLock()
+	defer fake.getDeviceAuthorizationMutex.RUnlock()
+	return len(fake.getDeviceAuthorizationArgsForCall)
+}
+
+func (fake *FakeActor) GetDeviceAuthorizationCalls(stub func() (v7action.DeviceAuthorization, error)) {
+	fake.getDeviceAuthorizationMutex.Lock()
+	defer fake.getDeviceAuthorizationMutex.Unlock()
+	fake.GetDeviceAuthorizationStub = stub
+}
+
+func (fake *FakeActor) GetDeviceAuthorizationReturns(result1 v7action.DeviceAuthorization, result2 error) {
+	fake.getDeviceAuthorizationMutex.Lock()
+	defer fake.getDeviceAuthorizationMutex.Unlock()
+	fake.GetDeviceAuthorizationStub = nil
+	fake.getDeviceAuthorizationReturns = struct {
+		result1 v7action.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) GetDeviceAuthorizationReturnsOnCall(i int, result1 v7action.DeviceAuthorization, result2 error) {
+	fake.getDeviceAuthorizationMutex.Lock()
+	defer fake.getDeviceAuthorizationMutex.Unlock()
+	fake.GetDeviceAuthorizationStub = nil
+	if fake.getDeviceAuthorizationReturnsOnCall == nil {
+		fake.getDeviceAuthorizationReturnsOnCall = make(map[int]struct {
+			result1 v7action.DeviceAuthorization
+			result2 error
+		})
+	}
+	fake.getDeviceAuthorizationReturnsOnCall[i] = struct {
+		result1 v7action.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) GetDomain(arg1 string) (resources.Domain, v7action.Warnings, error) {
 	fake.getDomainMutex.Lock()
 	ret, specificReturn := fake.getDomainReturnsOnCall[len(fake.getDomainArgsForCall)]
@@ -9343,6 +10202,72 @@ func (fake *FakeActor) GetEnvironmentVariablesByApplicationNameAndSpaceReturnsOn
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetEvents(arg1 v7action.EventQuery) ([]v7action.Event, v7action.Warnings, error) {
+	fake.getEventsMutex.Lock()
+	ret, specificReturn := fake.getEventsReturnsOnCall[len(fake.getEventsArgsForCall)]
+	fake.getEventsArgsForCall = append(fake.getEventsArgsForCall, struct {
+		arg1 v7action.EventQuery
+	}{arg1})
+	fake.recordInvocation("GetEvents", []interface{}{arg1})
+	fake.getEventsMutex.Unlock()
+	if fake.GetEventsStub != nil {
+		return fake.GetEventsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getEventsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetEventsCallCount() int {
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	return len(fake.getEventsArgsForCall)
+}
+
+func (fake *FakeActor) GetEventsCalls(stub func(v7action.EventQuery) ([]v7action.Event, v7action.Warnings, error)) {
+	fake.getEventsMutex.Lock()
+	defer fake.getEventsMutex.Unlock()
+	fake.GetEventsStub = stub
+}
+
+func (fake *FakeActor) GetEventsArgsForCall(i int) v7action.EventQuery {
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	argsForCall := fake.getEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetEventsReturns(result1 []v7action.Event, result2 v7action.Warnings, result3 error) {
+	fake.getEventsMutex.Lock()
+	defer fake.getEventsMutex.Unlock()
+	fake.GetEventsStub = nil
+	fake.getEventsReturns = struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetEventsReturnsOnCall(i int, result1 []v7action.Event, result2 v7action.Warnings, result3 error) {
+	fake.getEventsMutex.Lock()
+	defer fake.getEventsMutex.Unlock()
+	fake.GetEventsStub = nil
+	if fake.getEventsReturnsOnCall == nil {
+		fake.getEventsReturnsOnCall = make(map[int]struct {
+			result1 []v7action.Event
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getEventsReturnsOnCall[i] = struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetFeatureFlagByName(arg1 string) (resources.FeatureFlag, v7action.Warnings, error) {
 	fake.getFeatureFlagByNameMutex.Lock()
 	ret, specificReturn := fake.getFeatureFlagByNameReturnsOnCall[len(fake.getFeatureFlagByNameArgsForCall)]
@@ -9467,6 +10392,150 @@ func (fake *FakeActor) GetFeatureFlagsReturnsOnCall(i int, result1 []resources.F
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetFilteredRecentLogsForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 sharedaction.LogCacheClient, arg4 sharedaction.LogFilter) ([]sharedaction.LogMessage, v7action.Warnings, error) {
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getFilteredRecentLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall)]
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall = append(fake.getFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 sharedaction.LogCacheClient
+		arg4 sharedaction.LogFilter
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("GetFilteredRecentLogsForApplicationByNameAndSpace", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetFilteredRecentLogsForApplicationByNameAndSpaceStub != nil {
+		return fake.GetFilteredRecentLogsForApplicationByNameAndSpaceStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getFilteredRecentLogsForApplicationByNameAndSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetFilteredRecentLogsForApplicationByNameAndSpaceCallCount() int {
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetFilteredRecentLogsForApplicationByNameAndSpaceCalls(stub func(string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) ([]sharedaction.LogMessage, v7action.Warnings, error)) {
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetFilteredRecentLogsForApplicationByNameAndSpaceStub = stub
+}
+
+func (fake *FakeActor) GetFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) {
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) GetFilteredRecentLogsForApplicationByNameAndSpaceReturns(result1 []sharedaction.LogMessage, result2 v7action.Warnings, result3 error) {
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetFilteredRecentLogsForApplicationByNameAndSpaceStub = nil
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceReturns = struct {
+		result1 []sharedaction.LogMessage
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetFilteredRecentLogsForApplicationByNameAndSpaceReturnsOnCall(i int, result1 []sharedaction.LogMessage, result2 v7action.Warnings, result3 error) {
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetFilteredRecentLogsForApplicationByNameAndSpaceStub = nil
+	if fake.getFilteredRecentLogsForApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getFilteredRecentLogsForApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 []sharedaction.LogMessage
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 []sharedaction.LogMessage
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetFilteredStreamingLogsForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 sharedaction.LogCacheClient, arg4 sharedaction.LogFilter) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error) {
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getFilteredStreamingLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall)]
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall = append(fake.getFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 sharedaction.LogCacheClient
+		arg4 sharedaction.LogFilter
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("GetFilteredStreamingLogsForApplicationByNameAndSpace", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub != nil {
+		return fake.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4, ret.result5
+	}
+	fakeReturns := fake.getFilteredStreamingLogsForApplicationByNameAndSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4, fakeReturns.result5
+}
+
+func (fake *FakeActor) GetFilteredStreamingLogsForApplicationByNameAndSpaceCallCount() int {
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetFilteredStreamingLogsForApplicationByNameAndSpaceCalls(stub func(string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)) {
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub = stub
+}
+
+func (fake *FakeActor) GetFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) {
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) GetFilteredStreamingLogsForApplicationByNameAndSpaceReturns(result1 <-chan sharedaction.LogMessage, result2 <-chan error, result3 context.CancelFunc, result4 v7action.Warnings, result5 error) {
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub = nil
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceReturns = struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 v7action.Warnings
+		result5 error
+	}{result1, result2, result3, result4, result5}
+}
+
+func (fake *FakeActor) GetFilteredStreamingLogsForApplicationByNameAndSpaceReturnsOnCall(i int, result1 <-chan sharedaction.LogMessage, result2 <-chan error, result3 context.CancelFunc, result4 v7action.Warnings, result5 error) {
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub = nil
+	if fake.getFilteredStreamingLogsForApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getFilteredStreamingLogsForApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 <-chan sharedaction.LogMessage
+			result2 <-chan error
+			result3 context.CancelFunc
+			result4 v7action.Warnings
+			result5 error
+		})
+	}
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 v7action.Warnings
+		result5 error
+	}{result1, result2, result3, result4, result5}
+}
+
 func (fake *FakeActor) GetGlobalRunningSecurityGroups() ([]resources.SecurityGroup, v7action.Warnings, error) {
 	fake.getGlobalRunningSecurityGroupsMutex.Lock()
 	ret, specificReturn := fake.getGlobalRunningSecurityGroupsReturnsOnCall[len(fake.getGlobalRunningSecurityGroupsArgsForCall)]
@@ -9538,46 +10607,113 @@ func (fake *FakeActor) GetGlobalStagingSecurityGroups() ([]resources.SecurityGro
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.getGlobalStagingSecurityGroupsReturns
+	fakeReturns := fake.getGlobalStagingSecurityGroupsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetGlobalStagingSecurityGroupsCallCount() int {
+	fake.getGlobalStagingSecurityGroupsMutex.RLock()
+	defer fake.getGlobalStagingSecurityGroupsMutex.RUnlock()
+	return len(fake.getGlobalStagingSecurityGroupsArgsForCall)
+}
+
+func (fake *FakeActor) GetGlobalStagingSecurityGroupsCalls(stub func() ([]resources.SecurityGroup, v7action.Warnings, error)) {
+	fake.getGlobalStagingSecurityGroupsMutex.Lock()
+	defer fake.getGlobalStagingSecurityGroupsMutex.Unlock()
+	fake.GetGlobalStagingSecurityGroupsStub = stub
+}
+
+func (fake *FakeActor) GetGlobalStagingSecurityGroupsReturns(result1 []resources.SecurityGroup, result2 v7action.Warnings, result3 error) {
+	fake.getGlobalStagingSecurityGroupsMutex.Lock()
+	defer fake.getGlobalStagingSecurityGroupsMutex.Unlock()
+	fake.GetGlobalStagingSecurityGroupsStub = nil
+	fake.getGlobalStagingSecurityGroupsReturns = struct {
+		result1 []resources.SecurityGroup
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetGlobalStagingSecurityGroupsReturnsOnCall(i int, result1 []resources.SecurityGroup, result2 v7action.Warnings, result3 error) {
+	fake.getGlobalStagingSecurityGroupsMutex.Lock()
+	defer fake.getGlobalStagingSecurityGroupsMutex.Unlock()
+	fake.GetGlobalStagingSecurityGroupsStub = nil
+	if fake.getGlobalStagingSecurityGroupsReturnsOnCall == nil {
+		fake.getGlobalStagingSecurityGroupsReturnsOnCall = make(map[int]struct {
+			result1 []resources.SecurityGroup
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getGlobalStagingSecurityGroupsReturnsOnCall[i] = struct {
+		result1 []resources.SecurityGroup
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetInstanceMetrics(arg1 string, arg2 string) ([]v7action.InstanceMetrics, v7action.Warnings, error) {
+	fake.getInstanceMetricsMutex.Lock()
+	ret, specificReturn := fake.getInstanceMetricsReturnsOnCall[len(fake.getInstanceMetricsArgsForCall)]
+	fake.getInstanceMetricsArgsForCall = append(fake.getInstanceMetricsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetInstanceMetrics", []interface{}{arg1, arg2})
+	fake.getInstanceMetricsMutex.Unlock()
+	if fake.GetInstanceMetricsStub != nil {
+		return fake.GetInstanceMetricsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getInstanceMetricsReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeActor) GetGlobalStagingSecurityGroupsCallCount() int {
-	fake.getGlobalStagingSecurityGroupsMutex.RLock()
-	defer fake.getGlobalStagingSecurityGroupsMutex.RUnlock()
-	return len(fake.getGlobalStagingSecurityGroupsArgsForCall)
+func (fake *FakeActor) GetInstanceMetricsCallCount() int {
+	fake.getInstanceMetricsMutex.RLock()
+	defer fake.getInstanceMetricsMutex.RUnlock()
+	return len(fake.getInstanceMetricsArgsForCall)
 }
 
-func (fake *FakeActor) GetGlobalStagingSecurityGroupsCalls(stub func() ([]resources.SecurityGroup, v7action.Warnings, error)) {
-	fake.getGlobalStagingSecurityGroupsMutex.Lock()
-	defer fake.getGlobalStagingSecurityGroupsMutex.Unlock()
-	fake.GetGlobalStagingSecurityGroupsStub = stub
+func (fake *FakeActor) GetInstanceMetricsCalls(stub func(string, string) ([]v7action.InstanceMetrics, v7action.Warnings, error)) {
+	fake.getInstanceMetricsMutex.Lock()
+	defer fake.getInstanceMetricsMutex.Unlock()
+	fake.GetInstanceMetricsStub = stub
 }
 
-func (fake *FakeActor) GetGlobalStagingSecurityGroupsReturns(result1 []resources.SecurityGroup, result2 v7action.Warnings, result3 error) {
-	fake.getGlobalStagingSecurityGroupsMutex.Lock()
-	defer fake.getGlobalStagingSecurityGroupsMutex.Unlock()
-	fake.GetGlobalStagingSecurityGroupsStub = nil
-	fake.getGlobalStagingSecurityGroupsReturns = struct {
-		result1 []resources.SecurityGroup
+func (fake *FakeActor) GetInstanceMetricsArgsForCall(i int) (string, string) {
+	fake.getInstanceMetricsMutex.RLock()
+	defer fake.getInstanceMetricsMutex.RUnlock()
+	argsForCall := fake.getInstanceMetricsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetInstanceMetricsReturns(result1 []v7action.InstanceMetrics, result2 v7action.Warnings, result3 error) {
+	fake.getInstanceMetricsMutex.Lock()
+	defer fake.getInstanceMetricsMutex.Unlock()
+	fake.GetInstanceMetricsStub = nil
+	fake.getInstanceMetricsReturns = struct {
+		result1 []v7action.InstanceMetrics
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetGlobalStagingSecurityGroupsReturnsOnCall(i int, result1 []resources.SecurityGroup, result2 v7action.Warnings, result3 error) {
-	fake.getGlobalStagingSecurityGroupsMutex.Lock()
-	defer fake.getGlobalStagingSecurityGroupsMutex.Unlock()
-	fake.GetGlobalStagingSecurityGroupsStub = nil
-	if fake.getGlobalStagingSecurityGroupsReturnsOnCall == nil {
-		fake.getGlobalStagingSecurityGroupsReturnsOnCall = make(map[int]struct {
-			result1 []resources.SecurityGroup
+func (fake *FakeActor) GetInstanceMetricsReturnsOnCall(i int, result1 []v7action.InstanceMetrics, result2 v7action.Warnings, result3 error) {
+	fake.getInstanceMetricsMutex.Lock()
+	defer fake.getInstanceMetricsMutex.Unlock()
+	fake.GetInstanceMetricsStub = nil
+	if fake.getInstanceMetricsReturnsOnCall == nil {
+		fake.getInstanceMetricsReturnsOnCall = make(map[int]struct {
+			result1 []v7action.InstanceMetrics
 			result2 v7action.Warnings
 			result3 error
 		})
 	}
-	fake.getGlobalStagingSecurityGroupsReturnsOnCall[i] = struct {
-		result1 []resources.SecurityGroup
+	fake.getInstanceMetricsReturnsOnCall[i] = struct {
+		result1 []v7action.InstanceMetrics
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
@@ -10748,73 +11884,6 @@ func (fake *FakeActor) GetRawApplicationManifestByNameAndSpaceReturnsOnCall(i in
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetRecentEventsByApplicationNameAndSpace(arg1 string, arg2 string) ([]v7action.Event, v7action.Warnings, error) {
-	fake.getRecentEventsByApplicationNameAndSpaceMutex.Lock()
-	ret, specificReturn := fake.getRecentEventsByApplicationNameAndSpaceReturnsOnCall[len(fake.getRecentEventsByApplicationNameAndSpaceArgsForCall)]
-	fake.getRecentEventsByApplicationNameAndSpaceArgsForCall = append(fake.getRecentEventsByApplicationNameAndSpaceArgsForCall, struct {
-		arg1 string
-		arg2 string
-	}{arg1, arg2})
-	fake.recordInvocation("GetRecentEventsByApplicationNameAndSpace", []interface{}{arg1, arg2})
-	fake.getRecentEventsByApplicationNameAndSpaceMutex.Unlock()
-	if fake.GetRecentEventsByApplicationNameAndSpaceStub != nil {
-		return fake.GetRecentEventsByApplicationNameAndSpaceStub(arg1, arg2)
-	}
-	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
-	}
-	fakeReturns := fake.getRecentEventsByApplicationNameAndSpaceReturns
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
-}
-
-func (fake *FakeActor) GetRecentEventsByApplicationNameAndSpaceCallCount() int {
-	fake.getRecentEventsByApplicationNameAndSpaceMutex.RLock()
-	defer fake.getRecentEventsByApplicationNameAndSpaceMutex.RUnlock()
-	return len(fake.getRecentEventsByApplicationNameAndSpaceArgsForCall)
-}
-
-func (fake *FakeActor) GetRecentEventsByApplicationNameAndSpaceCalls(stub func(string, string) ([]v7action.Event, v7action.Warnings, error)) {
-	fake.getRecentEventsByApplicationNameAndSpaceMutex.Lock()
-	defer fake.getRecentEventsByApplicationNameAndSpaceMutex.Unlock()
-	fake.GetRecentEventsByApplicationNameAndSpaceStub = stub
-}
-
-func (fake *FakeActor) GetRecentEventsByApplicationNameAndSpaceArgsForCall(i int) (string, string) {
-	fake.getRecentEventsByApplicationNameAndSpaceMutex.RLock()
-	defer fake.getRecentEventsByApplicationNameAndSpaceMutex.RUnlock()
-	argsForCall := fake.getRecentEventsByApplicationNameAndSpaceArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
-}
-
-func (fake *FakeActor) GetRecentEventsByApplicationNameAndSpaceReturns(result1 []v7action.Event, result2 v7action.Warnings, result3 error) {
-	fake.getRecentEventsByApplicationNameAndSpaceMutex.Lock()
-	defer fake.getRecentEventsByApplicationNameAndSpaceMutex.Unlock()
-	fake.GetRecentEventsByApplicationNameAndSpaceStub = nil
-	fake.getRecentEventsByApplicationNameAndSpaceReturns = struct {
-		result1 []v7action.Event
-		result2 v7action.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
-func (fake *FakeActor) GetRecentEventsByApplicationNameAndSpaceReturnsOnCall(i int, result1 []v7action.Event, result2 v7action.Warnings, result3 error) {
-	fake.getRecentEventsByApplicationNameAndSpaceMutex.Lock()
-	defer fake.getRecentEventsByApplicationNameAndSpaceMutex.Unlock()
-	fake.GetRecentEventsByApplicationNameAndSpaceStub = nil
-	if fake.getRecentEventsByApplicationNameAndSpaceReturnsOnCall == nil {
-		fake.getRecentEventsByApplicationNameAndSpaceReturnsOnCall = make(map[int]struct {
-			result1 []v7action.Event
-			result2 v7action.Warnings
-			result3 error
-		})
-	}
-	fake.getRecentEventsByApplicationNameAndSpaceReturnsOnCall[i] = struct {
-		result1 []v7action.Event
-		result2 v7action.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
 func (fake *FakeActor) GetRecentLogsForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 sharedaction.LogCacheClient) ([]sharedaction.LogMessage, v7action.Warnings, error) {
 	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getRecentLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall)]
@@ -11075,6 +12144,73 @@ func (fake *FakeActor) GetRootResponseReturnsOnCall(i int, result1 v7action.Info
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetRoute(arg1 string, arg2 string) (resources.Route, v7action.Warnings, error) {
+	fake.getRouteMutex.Lock()
+	ret, specificReturn := fake.getRouteReturnsOnCall[len(fake.getRouteArgsForCall)]
+	fake.getRouteArgsForCall = append(fake.getRouteArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetRoute", []interface{}{arg1, arg2})
+	fake.getRouteMutex.Unlock()
+	if fake.GetRouteStub != nil {
+		return fake.GetRouteStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getRouteReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetRouteCallCount() int {
+	fake.getRouteMutex.RLock()
+	defer fake.getRouteMutex.RUnlock()
+	return len(fake.getRouteArgsForCall)
+}
+
+func (fake *FakeActor) GetRouteCalls(stub func(string, string) (resources.Route, v7action.Warnings, error)) {
+	fake.getRouteMutex.Lock()
+	defer fake.getRouteMutex.Unlock()
+	fake.GetRouteStub = stub
+}
+
+func (fake *FakeActor) GetRouteArgsForCall(i int) (string, string) {
+	fake.getRouteMutex.RLock()
+	defer fake.getRouteMutex.RUnlock()
+	argsForCall := fake.getRouteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetRouteReturns(result1 resources.Route, result2 v7action.Warnings, result3 error) {
+	fake.getRouteMutex.Lock()
+	defer fake.getRouteMutex.Unlock()
+	fake.GetRouteStub = nil
+	fake.getRouteReturns = struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRouteReturnsOnCall(i int, result1 resources.Route, result2 v7action.Warnings, result3 error) {
+	fake.getRouteMutex.Lock()
+	defer fake.getRouteMutex.Unlock()
+	fake.GetRouteStub = nil
+	if fake.getRouteReturnsOnCall == nil {
+		fake.getRouteReturnsOnCall = make(map[int]struct {
+			result1 resources.Route
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteReturnsOnCall[i] = struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetRouteByAttributes(arg1 resources.Domain, arg2 string, arg3 string, arg4 int) (resources.Route, v7action.Warnings, error) {
 	fake.getRouteByAttributesMutex.Lock()
 	ret, specificReturn := fake.getRouteByAttributesReturnsOnCall[len(fake.getRouteByAttributesArgsForCall)]
@@ -11792,6 +12928,74 @@ func (fake *FakeActor) GetSecureShellConfigurationByApplicationNameSpaceProcessT
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetSecureShellConfigurationsForRunningInstances(arg1 string, arg2 string, arg3 string) ([]v7action.InstanceSSHAuthentication, v7action.Warnings, error) {
+	fake.getSecureShellConfigurationsForRunningInstancesMutex.Lock()
+	ret, specificReturn := fake.getSecureShellConfigurationsForRunningInstancesReturnsOnCall[len(fake.getSecureShellConfigurationsForRunningInstancesArgsForCall)]
+	fake.getSecureShellConfigurationsForRunningInstancesArgsForCall = append(fake.getSecureShellConfigurationsForRunningInstancesArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetSecureShellConfigurationsForRunningInstances", []interface{}{arg1, arg2, arg3})
+	fake.getSecureShellConfigurationsForRunningInstancesMutex.Unlock()
+	if fake.GetSecureShellConfigurationsForRunningInstancesStub != nil {
+		return fake.GetSecureShellConfigurationsForRunningInstancesStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getSecureShellConfigurationsForRunningInstancesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetSecureShellConfigurationsForRunningInstancesCallCount() int {
+	fake.getSecureShellConfigurationsForRunningInstancesMutex.RLock()
+	defer fake.getSecureShellConfigurationsForRunningInstancesMutex.RUnlock()
+	return len(fake.getSecureShellConfigurationsForRunningInstancesArgsForCall)
+}
+
+func (fake *FakeActor) GetSecureShellConfigurationsForRunningInstancesCalls(stub func(string, string, string) ([]v7action.InstanceSSHAuthentication, v7action.Warnings, error)) {
+	fake.getSecureShellConfigurationsForRunningInstancesMutex.Lock()
+	defer fake.getSecureShellConfigurationsForRunningInstancesMutex.Unlock()
+	fake.GetSecureShellConfigurationsForRunningInstancesStub = stub
+}
+
+func (fake *FakeActor) GetSecureShellConfigurationsForRunningInstancesArgsForCall(i int) (string, string, string) {
+	fake.getSecureShellConfigurationsForRunningInstancesMutex.RLock()
+	defer fake.getSecureShellConfigurationsForRunningInstancesMutex.RUnlock()
+	argsForCall := fake.getSecureShellConfigurationsForRunningInstancesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetSecureShellConfigurationsForRunningInstancesReturns(result1 []v7action.InstanceSSHAuthentication, result2 v7action.Warnings, result3 error) {
+	fake.getSecureShellConfigurationsForRunningInstancesMutex.Lock()
+	defer fake.getSecureShellConfigurationsForRunningInstancesMutex.Unlock()
+	fake.GetSecureShellConfigurationsForRunningInstancesStub = nil
+	fake.getSecureShellConfigurationsForRunningInstancesReturns = struct {
+		result1 []v7action.InstanceSSHAuthentication
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetSecureShellConfigurationsForRunningInstancesReturnsOnCall(i int, result1 []v7action.InstanceSSHAuthentication, result2 v7action.Warnings, result3 error) {
+	fake.getSecureShellConfigurationsForRunningInstancesMutex.Lock()
+	defer fake.getSecureShellConfigurationsForRunningInstancesMutex.Unlock()
+	fake.GetSecureShellConfigurationsForRunningInstancesStub = nil
+	if fake.getSecureShellConfigurationsForRunningInstancesReturnsOnCall == nil {
+		fake.getSecureShellConfigurationsForRunningInstancesReturnsOnCall = make(map[int]struct {
+			result1 []v7action.InstanceSSHAuthentication
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getSecureShellConfigurationsForRunningInstancesReturnsOnCall[i] = struct {
+		result1 []v7action.InstanceSSHAuthentication
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetSecurityGroup(arg1 string) (resources.SecurityGroup, v7action.Warnings, error) {
 	fake.getSecurityGroupMutex.Lock()
 	ret, specificReturn := fake.getSecurityGroupReturnsOnCall[len(fake.getSecurityGroupArgsForCall)]
@@ -14507,6 +15711,74 @@ func (fake *FakeActor) PollStartForRollingReturnsOnCall(i int, result1 v7action.
 	}{result1, result2}
 }
 
+func (fake *FakeActor) PollTask(arg1 string, arg2 int, arg3 time.Duration) (resources.Task, v7action.Warnings, error) {
+	fake.pollTaskMutex.Lock()
+	ret, specificReturn := fake.pollTaskReturnsOnCall[len(fake.pollTaskArgsForCall)]
+	fake.pollTaskArgsForCall = append(fake.pollTaskArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 time.Duration
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("PollTask", []interface{}{arg1, arg2, arg3})
+	fake.pollTaskMutex.Unlock()
+	if fake.PollTaskStub != nil {
+		return fake.PollTaskStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.pollTaskReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) PollTaskCallCount() int {
+	fake.pollTaskMutex.RLock()
+	defer fake.pollTaskMutex.RUnlock()
+	return len(fake.pollTaskArgsForCall)
+}
+
+func (fake *FakeActor) PollTaskCalls(stub func(string, int, time.Duration) (resources.Task, v7action.Warnings, error)) {
+	fake.pollTaskMutex.Lock()
+	defer fake.pollTaskMutex.Unlock()
+	fake.PollTaskStub = stub
+}
+
+func (fake *FakeActor) PollTaskArgsForCall(i int) (string, int, time.Duration) {
+	fake.pollTaskMutex.RLock()
+	defer fake.pollTaskMutex.RUnlock()
+	argsForCall := fake.pollTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) PollTaskReturns(result1 resources.Task, result2 v7action.Warnings, result3 error) {
+	fake.pollTaskMutex.Lock()
+	defer fake.pollTaskMutex.Unlock()
+	fake.PollTaskStub = nil
+	fake.pollTaskReturns = struct {
+		result1 resources.Task
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) PollTaskReturnsOnCall(i int, result1 resources.Task, result2 v7action.Warnings, result3 error) {
+	fake.pollTaskMutex.Lock()
+	defer fake.pollTaskMutex.Unlock()
+	fake.PollTaskStub = nil
+	if fake.pollTaskReturnsOnCall == nil {
+		fake.pollTaskReturnsOnCall = make(map[int]struct {
+			result1 resources.Task
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.pollTaskReturnsOnCall[i] = struct {
+		result1 resources.Task
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) PollUploadBuildpackJob(arg1 ccv3.JobURL) (v7action.Warnings, error) {
 	fake.pollUploadBuildpackJobMutex.Lock()
 	ret, specificReturn := fake.pollUploadBuildpackJobReturnsOnCall[len(fake.pollUploadBuildpackJobArgsForCall)]
@@ -16061,6 +17333,136 @@ func (fake *FakeActor) SetOrganizationDefaultIsolationSegmentReturnsOnCall(i int
 	}{result1, result2}
 }
 
+func (fake *FakeActor) SetRouteDestinationWeights(arg1 resources.Route, arg2 map[string]int, arg3 string) (v7action.Warnings, error) {
+	fake.setRouteDestinationWeightsMutex.Lock()
+	ret, specificReturn := fake.setRouteDestinationWeightsReturnsOnCall[len(fake.setRouteDestinationWeightsArgsForCall)]
+	fake.setRouteDestinationWeightsArgsForCall = append(fake.setRouteDestinationWeightsArgsForCall, struct {
+		arg1 resources.Route
+		arg2 map[string]int
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("SetRouteDestinationWeights", []interface{}{arg1, arg2, arg3})
+	fake.setRouteDestinationWeightsMutex.Unlock()
+	if fake.SetRouteDestinationWeightsStub != nil {
+		return fake.SetRouteDestinationWeightsStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.setRouteDestinationWeightsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) SetRouteDestinationWeightsCallCount() int {
+	fake.setRouteDestinationWeightsMutex.RLock()
+	defer fake.setRouteDestinationWeightsMutex.RUnlock()
+	return len(fake.setRouteDestinationWeightsArgsForCall)
+}
+
+func (fake *FakeActor) SetRouteDestinationWeightsCalls(stub func(resources.Route, map[string]int, string) (v7action.Warnings, error)) {
+	fake.setRouteDestinationWeightsMutex.Lock()
+	defer fake.setRouteDestinationWeightsMutex.Unlock()
+	fake.SetRouteDestinationWeightsStub = stub
+}
+
+func (fake *FakeActor) SetRouteDestinationWeightsArgsForCall(i int) (resources.Route, map[string]int, string) {
+	fake.setRouteDestinationWeightsMutex.RLock()
+	defer fake.setRouteDestinationWeightsMutex.RUnlock()
+	argsForCall := fake.setRouteDestinationWeightsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) SetRouteDestinationWeightsReturns(result1 v7action.Warnings, result2 error) {
+	fake.setRouteDestinationWeightsMutex.Lock()
+	defer fake.setRouteDestinationWeightsMutex.Unlock()
+	fake.SetRouteDestinationWeightsStub = nil
+	fake.setRouteDestinationWeightsReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) SetRouteDestinationWeightsReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.setRouteDestinationWeightsMutex.Lock()
+	defer fake.setRouteDestinationWeightsMutex.Unlock()
+	fake.SetRouteDestinationWeightsStub = nil
+	if fake.setRouteDestinationWeightsReturnsOnCall == nil {
+		fake.setRouteDestinationWeightsReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.setRouteDestinationWeightsReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) SetRouteDestinationWeightsByAppName(arg1 string, arg2 string, arg3 map[string]int) (v7action.Warnings, error) {
+	fake.setRouteDestinationWeightsByAppNameMutex.Lock()
+	ret, specificReturn := fake.setRouteDestinationWeightsByAppNameReturnsOnCall[len(fake.setRouteDestinationWeightsByAppNameArgsForCall)]
+	fake.setRouteDestinationWeightsByAppNameArgsForCall = append(fake.setRouteDestinationWeightsByAppNameArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]int
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("SetRouteDestinationWeightsByAppName", []interface{}{arg1, arg2, arg3})
+	fake.setRouteDestinationWeightsByAppNameMutex.Unlock()
+	if fake.SetRouteDestinationWeightsByAppNameStub != nil {
+		return fake.SetRouteDestinationWeightsByAppNameStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.setRouteDestinationWeightsByAppNameReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) SetRouteDestinationWeightsByAppNameCallCount() int {
+	fake.setRouteDestinationWeightsByAppNameMutex.RLock()
+	defer fake.setRouteDestinationWeightsByAppNameMutex.RUnlock()
+	return len(fake.setRouteDestinationWeightsByAppNameArgsForCall)
+}
+
+func (fake *FakeActor) SetRouteDestinationWeightsByAppNameCalls(stub func(string, string, map[string]int) (v7action.Warnings, error)) {
+	fake.setRouteDestinationWeightsByAppNameMutex.Lock()
+	defer fake.setRouteDestinationWeightsByAppNameMutex.Unlock()
+	fake.SetRouteDestinationWeightsByAppNameStub = stub
+}
+
+func (fake *FakeActor) SetRouteDestinationWeightsByAppNameArgsForCall(i int) (string, string, map[string]int) {
+	fake.setRouteDestinationWeightsByAppNameMutex.RLock()
+	defer fake.setRouteDestinationWeightsByAppNameMutex.RUnlock()
+	argsForCall := fake.setRouteDestinationWeightsByAppNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) SetRouteDestinationWeightsByAppNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.setRouteDestinationWeightsByAppNameMutex.Lock()
+	defer fake.setRouteDestinationWeightsByAppNameMutex.Unlock()
+	fake.SetRouteDestinationWeightsByAppNameStub = nil
+	fake.setRouteDestinationWeightsByAppNameReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) SetRouteDestinationWeightsByAppNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.setRouteDestinationWeightsByAppNameMutex.Lock()
+	defer fake.setRouteDestinationWeightsByAppNameMutex.Unlock()
+	fake.SetRouteDestinationWeightsByAppNameStub = nil
+	if fake.setRouteDestinationWeightsByAppNameReturnsOnCall == nil {
+		fake.setRouteDestinationWeightsByAppNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.setRouteDestinationWeightsByAppNameReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) SetSpaceManifest(arg1 string, arg2 []byte) (v7action.Warnings, error) {
 	var arg2Copy []byte
 	if arg2 != nil {
@@ -17299,6 +18701,75 @@ func (fake *FakeActor) UpdateApplicationLabelsByApplicationNameReturnsOnCall(i i
 	}{result1, result2}
 }
 
+func (fake *FakeActor) UpdateApplicationSidecar(arg1 string, arg2 string, arg3 string, arg4 resources.Sidecar) (resources.Sidecar, v7action.Warnings, error) {
+	fake.updateApplicationSidecarMutex.Lock()
+	ret, specificReturn := fake.updateApplicationSidecarReturnsOnCall[len(fake.updateApplicationSidecarArgsForCall)]
+	fake.updateApplicationSidecarArgsForCall = append(fake.updateApplicationSidecarArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 resources.Sidecar
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("UpdateApplicationSidecar", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateApplicationSidecarMutex.Unlock()
+	if fake.UpdateApplicationSidecarStub != nil {
+		return fake.UpdateApplicationSidecarStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateApplicationSidecarReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) UpdateApplicationSidecarCallCount() int {
+	fake.updateApplicationSidecarMutex.RLock()
+	defer fake.updateApplicationSidecarMutex.RUnlock()
+	return len(fake.updateApplicationSidecarArgsForCall)
+}
+
+func (fake *FakeActor) UpdateApplicationSidecarCalls(stub func(string, string, string, resources.Sidecar) (resources.Sidecar, v7action.Warnings, error)) {
+	fake.updateApplicationSidecarMutex.Lock()
+	defer fake.updateApplicationSidecarMutex.Unlock()
+	fake.UpdateApplicationSidecarStub = stub
+}
+
+func (fake *FakeActor) UpdateApplicationSidecarArgsForCall(i int) (string, string, string, resources.Sidecar) {
+	fake.updateApplicationSidecarMutex.RLock()
+	defer fake.updateApplicationSidecarMutex.RUnlock()
+	argsForCall := fake.updateApplicationSidecarArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) UpdateApplicationSidecarReturns(result1 resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.updateApplicationSidecarMutex.Lock()
+	defer fake.updateApplicationSidecarMutex.Unlock()
+	fake.UpdateApplicationSidecarStub = nil
+	fake.updateApplicationSidecarReturns = struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) UpdateApplicationSidecarReturnsOnCall(i int, result1 resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.updateApplicationSidecarMutex.Lock()
+	defer fake.updateApplicationSidecarMutex.Unlock()
+	fake.UpdateApplicationSidecarStub = nil
+	if fake.updateApplicationSidecarReturnsOnCall == nil {
+		fake.updateApplicationSidecarReturnsOnCall = make(map[int]struct {
+			result1 resources.Sidecar
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.updateApplicationSidecarReturnsOnCall[i] = struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) UpdateBuildpackByNameAndStack(arg1 string, arg2 string, arg3 resources.Buildpack) (resources.Buildpack, v7action.Warnings, error) {
 	fake.updateBuildpackByNameAndStackMutex.Lock()
 	ret, specificReturn := fake.updateBuildpackByNameAndStackReturnsOnCall[len(fake.updateBuildpackByNameAndStackArgsForCall)]
@@ -19010,6 +20481,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.RUnlock()
 	fake.authenticateMutex.RLock()
 	defer fake.authenticateMutex.RUnlock()
+	fake.authenticateWithDeviceCodeMutex.RLock()
+	defer fake.authenticateWithDeviceCodeMutex.RUnlock()
 	fake.bindSecurityGroupToSpacesMutex.RLock()
 	defer fake.bindSecurityGroupToSpacesMutex.RUnlock()
 	fake.cancelDeploymentMutex.RLock()
@@ -19018,6 +20491,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.checkRouteMutex.RUnlock()
 	fake.clearTargetMutex.RLock()
 	defer fake.clearTargetMutex.RUnlock()
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
 	fake.copyPackageMutex.RLock()
 	defer fake.copyPackageMutex.RUnlock()
 	fake.createAndUploadBitsPackageByApplicationNameAndSpaceMutex.RLock()
@@ -19026,14 +20501,14 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.createApplicationDropletMutex.RUnlock()
 	fake.createApplicationInSpaceMutex.RLock()
 	defer fake.createApplicationInSpaceMutex.RUnlock()
+	fake.createApplicationSidecarMutex.RLock()
+	defer fake.createApplicationSidecarMutex.RUnlock()
 	fake.createBitsPackageByApplicationMutex.RLock()
 	defer fake.createBitsPackageByApplicationMutex.RUnlock()
 	fake.createBuildpackMutex.RLock()
 	defer fake.createBuildpackMutex.RUnlock()
-	fake.createDeploymentByApplicationAndDropletMutex.RLock()
-	defer fake.createDeploymentByApplicationAndDropletMutex.RUnlock()
-	fake.createDeploymentByApplicationAndRevisionMutex.RLock()
-	defer fake.createDeploymentByApplicationAndRevisionMutex.RUnlock()
+	fake.createDeploymentMutex.RLock()
+	defer fake.createDeploymentMutex.RUnlock()
 	fake.createDockerPackageByApplicationMutex.RLock()
 	defer fake.createDockerPackageByApplicationMutex.RUnlock()
 	fake.createDockerPackageByApplicationNameAndSpaceMutex.RLock()
@@ -19076,6 +20551,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.createUserProvidedServiceInstanceMutex.RUnlock()
 	fake.deleteApplicationByNameAndSpaceMutex.RLock()
 	defer fake.deleteApplicationByNameAndSpaceMutex.RUnlock()
+	fake.deleteApplicationSidecarMutex.RLock()
+	defer fake.deleteApplicationSidecarMutex.RUnlock()
 	fake.deleteBuildpackByNameAndStackMutex.RLock()
 	defer fake.deleteBuildpackByNameAndStackMutex.RUnlock()
 	fake.deleteDomainMutex.RLock()
@@ -19152,10 +20629,20 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getApplicationRevisionsDeployedMutex.RUnlock()
 	fake.getApplicationRoutesMutex.RLock()
 	defer fake.getApplicationRoutesMutex.RUnlock()
+	fake.getApplicationSidecarsByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationSidecarsByNameAndSpaceMutex.RUnlock()
+	fake.getApplicationStateByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationStateByNameAndSpaceMutex.RUnlock()
+	fake.getApplicationStateByRevisionMutex.RLock()
+	defer fake.getApplicationStateByRevisionMutex.RUnlock()
 	fake.getApplicationTasksMutex.RLock()
 	defer fake.getApplicationTasksMutex.RUnlock()
 	fake.getApplicationsByNamesAndSpaceMutex.RLock()
 	defer fake.getApplicationsByNamesAndSpaceMutex.RUnlock()
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	fake.getAuthorizationCodeURLMutex.RLock()
+	defer fake.getAuthorizationCodeURLMutex.RUnlock()
 	fake.getBuildpackLabelsMutex.RLock()
 	defer fake.getBuildpackLabelsMutex.RUnlock()
 	fake.getBuildpacksMutex.RLock()
@@ -19166,6 +20653,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getDefaultDomainMutex.RUnlock()
 	fake.getDetailedAppSummaryMutex.RLock()
 	defer fake.getDetailedAppSummaryMutex.RUnlock()
+	fake.getDeviceAuthorizationMutex.RLock()
+	defer fake.getDeviceAuthorizationMutex.RUnlock()
 	fake.getDomainMutex.RLock()
 	defer fake.getDomainMutex.RUnlock()
 	fake.getDomainByNameMutex.RLock()
@@ -19178,14 +20667,22 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getEnvironmentVariableGroupMutex.RUnlock()
 	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RLock()
 	defer fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RUnlock()
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
 	fake.getFeatureFlagByNameMutex.RLock()
 	defer fake.getFeatureFlagByNameMutex.RUnlock()
 	fake.getFeatureFlagsMutex.RLock()
 	defer fake.getFeatureFlagsMutex.RUnlock()
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getGlobalRunningSecurityGroupsMutex.RLock()
 	defer fake.getGlobalRunningSecurityGroupsMutex.RUnlock()
 	fake.getGlobalStagingSecurityGroupsMutex.RLock()
 	defer fake.getGlobalStagingSecurityGroupsMutex.RUnlock()
+	fake.getInstanceMetricsMutex.RLock()
+	defer fake.getInstanceMetricsMutex.RUnlock()
 	fake.getIsolationSegmentByNameMutex.RLock()
 	defer fake.getIsolationSegmentByNameMutex.RUnlock()
 	fake.getIsolationSegmentSummariesMutex.RLock()
@@ -19222,8 +20719,6 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getProcessByTypeAndApplicationMutex.RUnlock()
 	fake.getRawApplicationManifestByNameAndSpaceMutex.RLock()
 	defer fake.getRawApplicationManifestByNameAndSpaceMutex.RUnlock()
-	fake.getRecentEventsByApplicationNameAndSpaceMutex.RLock()
-	defer fake.getRecentEventsByApplicationNameAndSpaceMutex.RUnlock()
 	fake.getRecentLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getRevisionByApplicationAndVersionMutex.RLock()
@@ -19232,6 +20727,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getRevisionsByApplicationNameAndSpaceMutex.RUnlock()
 	fake.getRootResponseMutex.RLock()
 	defer fake.getRootResponseMutex.RUnlock()
+	fake.getRouteMutex.RLock()
+	defer fake.getRouteMutex.RUnlock()
 	fake.getRouteByAttributesMutex.RLock()
 	defer fake.getRouteByAttributesMutex.RUnlock()
 	fake.getRouteDestinationByAppGUIDMutex.RLock()
@@ -19254,6 +20751,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getSSHPasscodeMutex.RUnlock()
 	fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.RLock()
 	defer fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.RUnlock()
+	fake.getSecureShellConfigurationsForRunningInstancesMutex.RLock()
+	defer fake.getSecureShellConfigurationsForRunningInstancesMutex.RUnlock()
 	fake.getSecurityGroupMutex.RLock()
 	defer fake.getSecurityGroupMutex.RUnlock()
 	fake.getSecurityGroupSummaryMutex.RLock()
@@ -19336,6 +20835,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.pollStartMutex.RUnlock()
 	fake.pollStartForRollingMutex.RLock()
 	defer fake.pollStartForRollingMutex.RUnlock()
+	fake.pollTaskMutex.RLock()
+	defer fake.pollTaskMutex.RUnlock()
 	fake.pollUploadBuildpackJobMutex.RLock()
 	defer fake.pollUploadBuildpackJobMutex.RUnlock()
 	fake.prepareBuildpackBitsMutex.RLock()
@@ -19384,6 +20885,10 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.setEnvironmentVariableGroupMutex.RUnlock()
 	fake.setOrganizationDefaultIsolationSegmentMutex.RLock()
 	defer fake.setOrganizationDefaultIsolationSegmentMutex.RUnlock()
+	fake.setRouteDestinationWeightsMutex.RLock()
+	defer fake.setRouteDestinationWeightsMutex.RUnlock()
+	fake.setRouteDestinationWeightsByAppNameMutex.RLock()
+	defer fake.setRouteDestinationWeightsByAppNameMutex.RUnlock()
 	fake.setSpaceManifestMutex.RLock()
 	defer fake.setSpaceManifestMutex.RUnlock()
 	fake.setTargetMutex.RLock()
@@ -19422,6 +20927,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.updateApplicationMutex.RUnlock()
 	fake.updateApplicationLabelsByApplicationNameMutex.RLock()
 	defer fake.updateApplicationLabelsByApplicationNameMutex.RUnlock()
+	fake.updateApplicationSidecarMutex.RLock()
+	defer fake.updateApplicationSidecarMutex.RUnlock()
 	fake.updateBuildpackByNameAndStackMutex.RLock()
 	defer fake.updateBuildpackByNameAndStackMutex.RUnlock()
 	fake.updateBuildpackLabelsByBuildpackNameAndStackMutex.RLock()
@@ -23,6 +23,19 @@ type FakePushActor struct {
 	actualizeReturnsOnCall map[int]struct {
 		result1 <-chan *v7pushaction.PushEvent
 	}
+	ActualizeAllStub        func([]v7pushaction.PushPlan, int, v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent
+	actualizeAllMutex       sync.RWMutex
+	actualizeAllArgsForCall []struct {
+		arg1 []v7pushaction.PushPlan
+		arg2 int
+		arg3 v7pushaction.ProgressBar
+	}
+	actualizeAllReturns struct {
+		result1 <-chan *v7pushaction.PushEvent
+	}
+	actualizeAllReturnsOnCall map[int]struct {
+		result1 <-chan *v7pushaction.PushEvent
+	}
 	CreatePushPlansStub        func(string, string, manifestparser.Manifest, v7pushaction.FlagOverrides) ([]v7pushaction.PushPlan, v7action.Warnings, error)
 	createPushPlansMutex       sync.RWMutex
 	createPushPlansArgsForCall []struct {
@@ -120,6 +133,73 @@ func (fake *FakePushActor) ActualizeReturnsOnCall(i int, result1 <-chan *v7pusha
 	}{result1}
 }
 
+func (fake *FakePushActor) ActualizeAll(arg1 []v7pushaction.PushPlan, arg2 int, arg3 v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+	var arg1Copy []v7pushaction.PushPlan
+	if arg1 != nil {
+		arg1Copy = make([]v7pushaction.PushPlan, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.actualizeAllMutex.Lock()
+	ret, specificReturn := fake.actualizeAllReturnsOnCall[len(fake.actualizeAllArgsForCall)]
+	fake.actualizeAllArgsForCall = append(fake.actualizeAllArgsForCall, struct {
+		arg1 []v7pushaction.PushPlan
+		arg2 int
+		arg3 v7pushaction.ProgressBar
+	}{arg1Copy, arg2, arg3})
+	fake.recordInvocation("ActualizeAll", []interface{}{arg1Copy, arg2, arg3})
+	fake.actualizeAllMutex.Unlock()
+	if fake.ActualizeAllStub != nil {
+		return fake.ActualizeAllStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.actualizeAllReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePushActor) ActualizeAllCallCount() int {
+	fake.actualizeAllMutex.RLock()
+	defer fake.actualizeAllMutex.RUnlock()
+	return len(fake.actualizeAllArgsForCall)
+}
+
+func (fake *FakePushActor) ActualizeAllCalls(stub func([]v7pushaction.PushPlan, int, v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent) {
+	fake.actualizeAllMutex.Lock()
+	defer fake.actualizeAllMutex.Unlock()
+	fake.ActualizeAllStub = stub
+}
+
+func (fake *FakePushActor) ActualizeAllArgsForCall(i int) ([]v7pushaction.PushPlan, int, v7pushaction.ProgressBar) {
+	fake.actualizeAllMutex.RLock()
+	defer fake.actualizeAllMutex.RUnlock()
+	argsForCall := fake.actualizeAllArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePushActor) ActualizeAllReturns(result1 <-chan *v7pushaction.PushEvent) {
+	fake.actualizeAllMutex.Lock()
+	defer fake.actualizeAllMutex.Unlock()
+	fake.ActualizeAllStub = nil
+	fake.actualizeAllReturns = struct {
+		result1 <-chan *v7pushaction.PushEvent
+	}{result1}
+}
+
+func (fake *FakePushActor) ActualizeAllReturnsOnCall(i int, result1 <-chan *v7pushaction.PushEvent) {
+	fake.actualizeAllMutex.Lock()
+	defer fake.actualizeAllMutex.Unlock()
+	fake.ActualizeAllStub = nil
+	if fake.actualizeAllReturnsOnCall == nil {
+		fake.actualizeAllReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v7pushaction.PushEvent
+		})
+	}
+	fake.actualizeAllReturnsOnCall[i] = struct {
+		result1 <-chan *v7pushaction.PushEvent
+	}{result1}
+}
+
 func (fake *FakePushActor) CreatePushPlans(arg1 string, arg2 string, arg3 manifestparser.Manifest, arg4 v7pushaction.FlagOverrides) ([]v7pushaction.PushPlan, v7action.Warnings, error) {
 	fake.createPushPlansMutex.Lock()
 	ret, specificReturn := fake.createPushPlansReturnsOnCall[len(fake.createPushPlansArgsForCall)]
@@ -258,6 +338,8 @@ func (fake *FakePushActor) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.actualizeMutex.RLock()
 	defer fake.actualizeMutex.RUnlock()
+	fake.actualizeAllMutex.RLock()
+	defer fake.actualizeAllMutex.RUnlock()
 	fake.createPushPlansMutex.RLock()
 	defer fake.createPushPlansMutex.RUnlock()
 	fake.handleFlagOverridesMutex.RLock()
@@ -6,9 +6,23 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/util/clissh"
 )
 
 type FakeSharedSSHActor struct {
+	ExecuteSecureCopyStub        func(sharedaction.SecureShellClient, sharedaction.SecureCopyOptions, clissh.ProgressBar) error
+	executeSecureCopyMutex       sync.RWMutex
+	executeSecureCopyArgsForCall []struct {
+		arg1 sharedaction.SecureShellClient
+		arg2 sharedaction.SecureCopyOptions
+		arg3 clissh.ProgressBar
+	}
+	executeSecureCopyReturns struct {
+		result1 error
+	}
+	executeSecureCopyReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ExecuteSecureShellStub        func(sharedaction.SecureShellClient, sharedaction.SSHOptions) error
 	executeSecureShellMutex       sync.RWMutex
 	executeSecureShellArgsForCall []struct {
@@ -21,10 +35,84 @@ type FakeSharedSSHActor struct {
 	executeSecureShellReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ExecuteSecureShellOnInstancesStub        func(func() sharedaction.SecureShellClient, sharedaction.MultiInstanceSSHOptions) []sharedaction.InstanceCommandResult
+	executeSecureShellOnInstancesMutex       sync.RWMutex
+	executeSecureShellOnInstancesArgsForCall []struct {
+		arg1 func() sharedaction.SecureShellClient
+		arg2 sharedaction.MultiInstanceSSHOptions
+	}
+	executeSecureShellOnInstancesReturns struct {
+		result1 []sharedaction.InstanceCommandResult
+	}
+	executeSecureShellOnInstancesReturnsOnCall map[int]struct {
+		result1 []sharedaction.InstanceCommandResult
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeSharedSSHActor) ExecuteSecureCopy(arg1 sharedaction.SecureShellClient, arg2 sharedaction.SecureCopyOptions, arg3 clissh.ProgressBar) error {
+	fake.executeSecureCopyMutex.Lock()
+	ret, specificReturn := fake.executeSecureCopyReturnsOnCall[len(fake.executeSecureCopyArgsForCall)]
+	fake.executeSecureCopyArgsForCall = append(fake.executeSecureCopyArgsForCall, struct {
+		arg1 sharedaction.SecureShellClient
+		arg2 sharedaction.SecureCopyOptions
+		arg3 clissh.ProgressBar
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("ExecuteSecureCopy", []interface{}{arg1, arg2, arg3})
+	fake.executeSecureCopyMutex.Unlock()
+	if fake.ExecuteSecureCopyStub != nil {
+		return fake.ExecuteSecureCopyStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.executeSecureCopyReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureCopyCallCount() int {
+	fake.executeSecureCopyMutex.RLock()
+	defer fake.executeSecureCopyMutex.RUnlock()
+	return len(fake.executeSecureCopyArgsForCall)
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureCopyCalls(stub func(sharedaction.SecureShellClient, sharedaction.SecureCopyOptions, clissh.ProgressBar) error) {
+	fake.executeSecureCopyMutex.Lock()
+	defer fake.executeSecureCopyMutex.Unlock()
+	fake.ExecuteSecureCopyStub = stub
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureCopyArgsForCall(i int) (sharedaction.SecureShellClient, sharedaction.SecureCopyOptions, clissh.ProgressBar) {
+	fake.executeSecureCopyMutex.RLock()
+	defer fake.executeSecureCopyMutex.RUnlock()
+	argsForCall := fake.executeSecureCopyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureCopyReturns(result1 error) {
+	fake.executeSecureCopyMutex.Lock()
+	defer fake.executeSecureCopyMutex.Unlock()
+	fake.ExecuteSecureCopyStub = nil
+	fake.executeSecureCopyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureCopyReturnsOnCall(i int, result1 error) {
+	fake.executeSecureCopyMutex.Lock()
+	defer fake.executeSecureCopyMutex.Unlock()
+	fake.ExecuteSecureCopyStub = nil
+	if fake.executeSecureCopyReturnsOnCall == nil {
+		fake.executeSecureCopyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.executeSecureCopyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSharedSSHActor) ExecuteSecureShell(arg1 sharedaction.SecureShellClient, arg2 sharedaction.SSHOptions) error {
 	fake.executeSecureShellMutex.Lock()
 	ret, specificReturn := fake.executeSecureShellReturnsOnCall[len(fake.executeSecureShellArgsForCall)]
@@ -86,11 +174,76 @@ func (fake *FakeSharedSSHActor) ExecuteSecureShellReturnsOnCall(i int, result1 e
 	}{result1}
 }
 
+func (fake *FakeSharedSSHActor) ExecuteSecureShellOnInstances(arg1 func() sharedaction.SecureShellClient, arg2 sharedaction.MultiInstanceSSHOptions) []sharedaction.InstanceCommandResult {
+	fake.executeSecureShellOnInstancesMutex.Lock()
+	ret, specificReturn := fake.executeSecureShellOnInstancesReturnsOnCall[len(fake.executeSecureShellOnInstancesArgsForCall)]
+	fake.executeSecureShellOnInstancesArgsForCall = append(fake.executeSecureShellOnInstancesArgsForCall, struct {
+		arg1 func() sharedaction.SecureShellClient
+		arg2 sharedaction.MultiInstanceSSHOptions
+	}{arg1, arg2})
+	fake.recordInvocation("ExecuteSecureShellOnInstances", []interface{}{arg1, arg2})
+	fake.executeSecureShellOnInstancesMutex.Unlock()
+	if fake.ExecuteSecureShellOnInstancesStub != nil {
+		return fake.ExecuteSecureShellOnInstancesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.executeSecureShellOnInstancesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellOnInstancesCallCount() int {
+	fake.executeSecureShellOnInstancesMutex.RLock()
+	defer fake.executeSecureShellOnInstancesMutex.RUnlock()
+	return len(fake.executeSecureShellOnInstancesArgsForCall)
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellOnInstancesCalls(stub func(func() sharedaction.SecureShellClient, sharedaction.MultiInstanceSSHOptions) []sharedaction.InstanceCommandResult) {
+	fake.executeSecureShellOnInstancesMutex.Lock()
+	defer fake.executeSecureShellOnInstancesMutex.Unlock()
+	fake.ExecuteSecureShellOnInstancesStub = stub
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellOnInstancesArgsForCall(i int) (func() sharedaction.SecureShellClient, sharedaction.MultiInstanceSSHOptions) {
+	fake.executeSecureShellOnInstancesMutex.RLock()
+	defer fake.executeSecureShellOnInstancesMutex.RUnlock()
+	argsForCall := fake.executeSecureShellOnInstancesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellOnInstancesReturns(result1 []sharedaction.InstanceCommandResult) {
+	fake.executeSecureShellOnInstancesMutex.Lock()
+	defer fake.executeSecureShellOnInstancesMutex.Unlock()
+	fake.ExecuteSecureShellOnInstancesStub = nil
+	fake.executeSecureShellOnInstancesReturns = struct {
+		result1 []sharedaction.InstanceCommandResult
+	}{result1}
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellOnInstancesReturnsOnCall(i int, result1 []sharedaction.InstanceCommandResult) {
+	fake.executeSecureShellOnInstancesMutex.Lock()
+	defer fake.executeSecureShellOnInstancesMutex.Unlock()
+	fake.ExecuteSecureShellOnInstancesStub = nil
+	if fake.executeSecureShellOnInstancesReturnsOnCall == nil {
+		fake.executeSecureShellOnInstancesReturnsOnCall = make(map[int]struct {
+			result1 []sharedaction.InstanceCommandResult
+		})
+	}
+	fake.executeSecureShellOnInstancesReturnsOnCall[i] = struct {
+		result1 []sharedaction.InstanceCommandResult
+	}{result1}
+}
+
 func (fake *FakeSharedSSHActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.executeSecureCopyMutex.RLock()
+	defer fake.executeSecureCopyMutex.RUnlock()
 	fake.executeSecureShellMutex.RLock()
 	defer fake.executeSecureShellMutex.RUnlock()
+	fake.executeSecureShellOnInstancesMutex.RLock()
+	defer fake.executeSecureShellOnInstancesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -80,6 +80,21 @@ type FakeV7ActorForPush struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	SetRouteDestinationWeightsByAppNameStub        func(string, string, map[string]int) (v7action.Warnings, error)
+	setRouteDestinationWeightsByAppNameMutex       sync.RWMutex
+	setRouteDestinationWeightsByAppNameArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]int
+	}
+	setRouteDestinationWeightsByAppNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	setRouteDestinationWeightsByAppNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	SetSpaceManifestStub        func(string, []byte) (v7action.Warnings, error)
 	setSpaceManifestMutex       sync.RWMutex
 	setSpaceManifestArgsForCall []struct {
@@ -371,6 +386,71 @@ func (fake *FakeV7ActorForPush) RestartApplicationReturnsOnCall(i int, result1 v
 	}{result1, result2}
 }
 
+func (fake *FakeV7ActorForPush) SetRouteDestinationWeightsByAppName(arg1 string, arg2 string, arg3 map[string]int) (v7action.Warnings, error) {
+	fake.setRouteDestinationWeightsByAppNameMutex.Lock()
+	ret, specificReturn := fake.setRouteDestinationWeightsByAppNameReturnsOnCall[len(fake.setRouteDestinationWeightsByAppNameArgsForCall)]
+	fake.setRouteDestinationWeightsByAppNameArgsForCall = append(fake.setRouteDestinationWeightsByAppNameArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]int
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("SetRouteDestinationWeightsByAppName", []interface{}{arg1, arg2, arg3})
+	fake.setRouteDestinationWeightsByAppNameMutex.Unlock()
+	if fake.SetRouteDestinationWeightsByAppNameStub != nil {
+		return fake.SetRouteDestinationWeightsByAppNameStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.setRouteDestinationWeightsByAppNameReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeV7ActorForPush) SetRouteDestinationWeightsByAppNameCallCount() int {
+	fake.setRouteDestinationWeightsByAppNameMutex.RLock()
+	defer fake.setRouteDestinationWeightsByAppNameMutex.RUnlock()
+	return len(fake.setRouteDestinationWeightsByAppNameArgsForCall)
+}
+
+func (fake *FakeV7ActorForPush) SetRouteDestinationWeightsByAppNameCalls(stub func(string, string, map[string]int) (v7action.Warnings, error)) {
+	fake.setRouteDestinationWeightsByAppNameMutex.Lock()
+	defer fake.setRouteDestinationWeightsByAppNameMutex.Unlock()
+	fake.SetRouteDestinationWeightsByAppNameStub = stub
+}
+
+func (fake *FakeV7ActorForPush) SetRouteDestinationWeightsByAppNameArgsForCall(i int) (string, string, map[string]int) {
+	fake.setRouteDestinationWeightsByAppNameMutex.RLock()
+	defer fake.setRouteDestinationWeightsByAppNameMutex.RUnlock()
+	argsForCall := fake.setRouteDestinationWeightsByAppNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeV7ActorForPush) SetRouteDestinationWeightsByAppNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.setRouteDestinationWeightsByAppNameMutex.Lock()
+	defer fake.setRouteDestinationWeightsByAppNameMutex.Unlock()
+	fake.SetRouteDestinationWeightsByAppNameStub = nil
+	fake.setRouteDestinationWeightsByAppNameReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV7ActorForPush) SetRouteDestinationWeightsByAppNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.setRouteDestinationWeightsByAppNameMutex.Lock()
+	defer fake.setRouteDestinationWeightsByAppNameMutex.Unlock()
+	fake.SetRouteDestinationWeightsByAppNameStub = nil
+	if fake.setRouteDestinationWeightsByAppNameReturnsOnCall == nil {
+		fake.setRouteDestinationWeightsByAppNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.setRouteDestinationWeightsByAppNameReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV7ActorForPush) SetSpaceManifest(arg1 string, arg2 []byte) (v7action.Warnings, error) {
 	var arg2Copy []byte
 	if arg2 != nil {
@@ -451,6 +531,8 @@ func (fake *FakeV7ActorForPush) Invocations() map[string][][]interface{} {
 	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
 	fake.restartApplicationMutex.RLock()
 	defer fake.restartApplicationMutex.RUnlock()
+	fake.setRouteDestinationWeightsByAppNameMutex.RLock()
+	defer fake.setRouteDestinationWeightsByAppNameMutex.RUnlock()
 	fake.setSpaceManifestMutex.RLock()
 	defer fake.setSpaceManifestMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -27,6 +27,8 @@ var _ = Describe("add-plugin-repo command", func() {
 				Eventually(session).Should(Say("cf add-plugin-repo REPO_NAME URL"))
 				Eventually(session).Should(Say("EXAMPLES"))
 				Eventually(session).Should(Say(`cf add-plugin-repo ExampleRepo https://example\.com/repo`))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`--public-key\s+Base64-encoded ed25519 public key; plugins installed from this repository must be signed with the matching private key`))
 				Eventually(session).Should(Say("SEE ALSO:"))
 				Eventually(session).Should(Say("install-plugin, list-plugin-repos"))
 				Eventually(session).Should(Exit(0))
@@ -45,7 +45,7 @@ var _ = Describe("install-plugin command", func() {
 				Eventually(session).Should(Say("NAME:"))
 				Eventually(session).Should(Say("install-plugin - Install CLI plugin"))
 				Eventually(session).Should(Say("USAGE:"))
-				Eventually(session).Should(Say(`cf install-plugin PLUGIN_NAME \[-r REPO_NAME\] \[-f\]`))
+				Eventually(session).Should(Say(`cf install-plugin PLUGIN_NAME \[-r REPO_NAME\] \[-f\] \[--skip-signature-verification\]`))
 				Eventually(session).Should(Say(`cf install-plugin LOCAL-PATH/TO/PLUGIN | URL \[-f\]`))
 				Eventually(session).Should(Say(""))
 				Eventually(session).Should(Say("WARNING:"))
@@ -59,6 +59,7 @@ var _ = Describe("install-plugin command", func() {
 				Eventually(session).Should(Say("OPTIONS:"))
 				Eventually(session).Should(Say(`-f\s+Force install of plugin without confirmation`))
 				Eventually(session).Should(Say(`-r\s+Restrict search for plugin to this registered repository`))
+				Eventually(session).Should(Say(`--skip-signature-verification\s+Install the plugin even if its signature is missing or does not match the public key of its repository`))
 				Eventually(session).Should(Say("SEE ALSO:"))
 				Eventually(session).Should(Say("add-plugin-repo, list-plugin-repos, plugins"))
 
@@ -405,9 +405,10 @@ func helpText(session *Session) {
 	Eventually(session).Should(Say("NAME:"))
 	Eventually(session).Should(Say("copy-source - Copies the source code of an application to another existing application and restages that application"))
 	Eventually(session).Should(Say("USAGE:"))
-	Eventually(session).Should(Say(`cf copy-source SOURCE_APP DESTINATION_APP \[-s TARGET_SPACE \[-o TARGET_ORG\]\] \[--no-restart\] \[--strategy STRATEGY\] \[--no-wait\]`))
+	Eventually(session).Should(Say(`cf copy-source SOURCE_APP DESTINATION_APP \[-s TARGET_SPACE \[-o TARGET_ORG\]\] \[--no-restart\] \[--strategy STRATEGY\] \[--instance-steps STEPS\] \[--no-wait\]`))
 	Eventually(session).Should(Say("OPTIONS:"))
-	Eventually(session).Should(Say(`--strategy\s+Deployment strategy, either rolling or null`))
+	Eventually(session).Should(Say(`--strategy\s+Deployment strategy can be canary, rolling or null`))
+	Eventually(session).Should(Say(`--instance-steps\s+An array of percentage steps to deploy when using deployment strategy canary. \(e.g. 20,40,60\)`))
 	Eventually(session).Should(Say(`--no-wait\s+ Exit when the first instance of the web process is healthy`))
 	Eventually(session).Should(Say(`--no-restart\s+Do not restage the destination application`))
 	Eventually(session).Should(Say(`--organization, -o\s+Org that contains the destination application`))
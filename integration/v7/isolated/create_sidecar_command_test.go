@@ -0,0 +1,38 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/cf/util/testhelpers/matchers"
+
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("create-sidecar command", func() {
+	When("--help flag is set", func() {
+		It("appears in cf help -a", func() {
+			session := helpers.CF("help", "-a")
+			Eventually(session).Should(Exit(0))
+			Expect(session).To(HaveCommandInCategoryWithDescription("create-sidecar", "APPS", "Add a sidecar process to an app"))
+		})
+
+		It("displays command usage to output", func() {
+			session := helpers.CF("create-sidecar", "--help")
+
+			Eventually(session).Should(Say(`NAME:`))
+			Eventually(session).Should(Say(`create-sidecar - Add a sidecar process to an app`))
+			Eventually(session).Should(Say(`USAGE:`))
+			Eventually(session).Should(Say(`cf create-sidecar APP_NAME SIDECAR_NAME -c COMMAND \[--process-types TYPES\] \[-m MEMORY\]`))
+			Eventually(session).Should(Say(`EXAMPLES:`))
+			Eventually(session).Should(Say(`OPTIONS:`))
+			Eventually(session).Should(Say(`--command, -c\s+Command the sidecar runs`))
+			Eventually(session).Should(Say(`--process-types\s+Comma-separated types of the app's processes`))
+			Eventually(session).Should(Say(`-m\s+Memory reserved for the sidecar`))
+			Eventually(session).Should(Say(`SEE ALSO:`))
+			Eventually(session).Should(Say(`delete-sidecar, restart, sidecars, update-sidecar`))
+			Eventually(session).Should(Exit(0))
+		})
+	})
+})
@@ -27,16 +27,24 @@ var _ = Describe("events command", func() {
 			It("appears in cf help -a", func() {
 				session := helpers.CF("help", "-a")
 				Eventually(session).Should(Exit(0))
-				Expect(session).To(HaveCommandInCategoryWithDescription("events", "APPS", "Show recent app events"))
+				Expect(session).To(HaveCommandInCategoryWithDescription("events", "APPS", "Show recent events for an app, space, org or other resource"))
 			})
 
 			It("Displays command usage to output", func() {
 				session := helpers.CF("events", "--help")
 
 				Eventually(session).Should(Say("NAME:"))
-				Eventually(session).Should(Say("events - Show recent app events"))
+				Eventually(session).Should(Say("events - Show recent events for an app, space, org or other resource"))
 				Eventually(session).Should(Say("USAGE:"))
-				Eventually(session).Should(Say("cf events APP_NAME"))
+				Eventually(session).Should(Say(`cf events APP_NAME \[--type EVENT_TYPE\]\.\.\. \[--actor ACTOR\] \[--since TIME\] \[--until TIME\] \[--all\]`))
+				Eventually(session).Should(Say(`cf events \(--space \| --org \| --target-guid GUID\)`))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`--space\s+Show events for every resource in the targeted space`))
+				Eventually(session).Should(Say(`--org\s+Show events for every resource in the targeted org`))
+				Eventually(session).Should(Say(`--target-guid\s+Show events for the resource with this GUID`))
+				Eventually(session).Should(Say(`--type\s+Only show events of this type`))
+				Eventually(session).Should(Say(`--actor\s+Only show events caused by the user or client with this name or GUID`))
+				Eventually(session).Should(Say(`--all\s+Show the complete event history`))
 				Eventually(session).Should(Say("SEE ALSO:"))
 				Eventually(session).Should(Say("app, logs, map-route, unmap-route"))
 
@@ -53,6 +53,8 @@ var _ = Describe("help command", func() {
 			Eventually(session).Should(Say("Global options:"))
 			Eventually(session).Should(Say("  --help, -h                         Show help"))
 			Eventually(session).Should(Say("  -v                                 Print API request diagnostics to stdout"))
+			Eventually(session).Should(Say("  --output                           Render the results of read commands as json or yaml"))
+			Eventually(session).Should(Say("  --context                          Use the named context for this command only"))
 
 			Eventually(session).Should(Say(`TIP: Use 'cf help -a' to see all commands\.`))
 			Eventually(session).Should(Exit(0))
@@ -32,6 +32,13 @@ var _ = Describe("logs Command", func() {
 				Eventually(session).Should(Say("cf logs APP_NAME"))
 				Eventually(session).Should(Say("OPTIONS:"))
 				Eventually(session).Should(Say(`--recent\s+Dump recent logs instead of tailing`))
+				Eventually(session).Should(Say(`--since\s+Only show logs at or after this time`))
+				Eventually(session).Should(Say(`--until\s+Only show logs before this time`))
+				Eventually(session).Should(Say(`--process\s+Only show logs from instances of this process type`))
+				Eventually(session).Should(Say(`--instance\s+Only show logs from the app instance with this index`))
+				Eventually(session).Should(Say(`--source-type\s+Only show logs from this source`))
+				Eventually(session).Should(Say(`--match\s+Only show log messages matching this regular expression`))
+				Eventually(session).Should(Say(`--max-lines\s+Show at most this many log messages`))
 				Eventually(session).Should(Say("SEE ALSO:"))
 				Eventually(session).Should(Say("app, apps, ssh"))
 				Eventually(session).Should(Exit(0))
@@ -72,6 +79,13 @@ var _ = Describe("logs Command", func() {
 					Eventually(session).Should(Say("cf logs APP_NAME"))
 					Eventually(session).Should(Say("OPTIONS:"))
 					Eventually(session).Should(Say(`--recent\s+Dump recent logs instead of tailing`))
+					Eventually(session).Should(Say(`--since\s+Only show logs at or after this time`))
+					Eventually(session).Should(Say(`--until\s+Only show logs before this time`))
+					Eventually(session).Should(Say(`--process\s+Only show logs from instances of this process type`))
+					Eventually(session).Should(Say(`--instance\s+Only show logs from the app instance with this index`))
+					Eventually(session).Should(Say(`--source-type\s+Only show logs from this source`))
+					Eventually(session).Should(Say(`--match\s+Only show log messages matching this regular expression`))
+					Eventually(session).Should(Say(`--max-lines\s+Show at most this many log messages`))
 					Eventually(session).Should(Say("SEE ALSO:"))
 					Eventually(session).Should(Say("app, apps, ssh"))
 					Eventually(session).Should(Exit(1))
@@ -29,7 +29,7 @@ var _ = Describe("map-route command", func() {
 
 			Eventually(session).Should(Say(`USAGE:`))
 			Eventually(session).Should(Say(`Map an HTTP route:\n`))
-			Eventually(session).Should(Say(`cf map-route APP_NAME DOMAIN \[--hostname HOSTNAME\] \[--path PATH\] \[--app-protocol PROTOCOL\]\n`))
+			Eventually(session).Should(Say(`cf map-route APP_NAME DOMAIN \[--hostname HOSTNAME\] \[--path PATH\] \[--app-protocol PROTOCOL\] \[--weight WEIGHT\]\n`))
 			Eventually(session).Should(Say(`Map a TCP route:\n`))
 			Eventually(session).Should(Say(`cf map-route APP_NAME DOMAIN \[--port PORT]\n`))
 			Eventually(session).Should(Say(`\n`))
@@ -39,6 +39,7 @@ var _ = Describe("map-route command", func() {
 			Eventually(session).Should(Say(`cf map-route my-app example.com --hostname myhost                              # myhost.example.com`))
 			Eventually(session).Should(Say(`cf map-route my-app example.com --hostname myhost --path foo                   # myhost.example.com/foo`))
 			Eventually(session).Should(Say(`cf map-route my-app example.com --hostname myhost --app-protocol http2 # myhost.example.com`))
+			Eventually(session).Should(Say(`cf map-route my-app-v2 example.com --hostname myhost --weight 10               # myhost.example.com, 10% of traffic to my-app-v2`))
 			Eventually(session).Should(Say(`cf map-route my-app example.com --port 5000                                    # example.com:5000`))
 			Eventually(session).Should(Say(`\n`))
 
@@ -47,6 +48,7 @@ var _ = Describe("map-route command", func() {
 			Eventually(session).Should(Say(`--path\s+Path for the HTTP route`))
 			Eventually(session).Should(Say(`--port\s+Port for the TCP route \(default: random port\)`))
 			Eventually(session).Should(Say(`--app-protocol\s+\[Beta flag, subject to change\] Protocol for the route destination \(default: http1\). Only applied to HTTP routes`))
+			Eventually(session).Should(Say(`--weight\s+Percentage \(1-100\) of the route's traffic to send to the app; the rest is divided among the route's other destinations`))
 
 			Eventually(session).Should(Say(`\n`))
 
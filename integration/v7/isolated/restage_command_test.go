@@ -24,16 +24,18 @@ var _ = Describe("restage command", func() {
 				Eventually(session).ShouldNot(Say(`This action will cause app downtime.`))
 				Eventually(session).Should(Say("USAGE:"))
 				Eventually(session).Should(Say("cf restage APP_NAME"))
-				Eventually(session).Should(Say("This command will cause downtime unless you use '--strategy rolling'."))
+				Eventually(session).Should(Say("This command will cause downtime unless you use '--strategy rolling' or '--strategy canary'."))
 				Eventually(session).Should(Say("EXAMPLES:"))
 				Eventually(session).Should(Say("cf restage APP_NAME"))
 				Eventually(session).Should(Say("cf restage APP_NAME --strategy rolling"))
 				Eventually(session).Should(Say("cf restage APP_NAME --strategy rolling --no-wait"))
+				Eventually(session).Should(Say("cf restage APP_NAME --strategy canary --instance-steps 20,50"))
 				Eventually(session).Should(Say("ALIAS:"))
 				Eventually(session).Should(Say("rg"))
 				Eventually(session).Should(Say("OPTIONS:"))
-				Eventually(session).Should(Say("--strategy      Deployment strategy, either rolling or null"))
-				Eventually(session).Should(Say("--no-wait       Exit when the first instance of the web process is healthy"))
+				Eventually(session).Should(Say(`--strategy\s+Deployment strategy can be canary, rolling or null`))
+				Eventually(session).Should(Say(`--instance-steps\s+An array of percentage steps to deploy when using deployment strategy canary. \(e.g. 20,40,60\)`))
+				Eventually(session).Should(Say(`--no-wait\s+Exit when the first instance of the web process is healthy`))
 				Eventually(session).Should(Say("ENVIRONMENT:"))
 				Eventually(session).Should(Say(`CF_STAGING_TIMEOUT=15\s+Max wait time for staging, in minutes`))
 				Eventually(session).Should(Say(`CF_STARTUP_TIMEOUT=5\s+Max wait time for app instance startup, in minutes`))
@@ -39,14 +39,15 @@ var _ = Describe("restart command", func() {
 				Eventually(session).Should(Say(`restart - Stop all instances of the app, then start them again\.`))
 				Eventually(session).Should(Say("USAGE:"))
 				Eventually(session).Should(Say("cf restart APP_NAME"))
-				Eventually(session).Should(Say("This command will cause downtime unless you use '--strategy rolling'."))
+				Eventually(session).Should(Say("This command will cause downtime unless you use '--strategy rolling' or '--strategy canary'."))
 				Eventually(session).Should(Say("If the app's most recent package is unstaged, restarting the app will stage and run that package."))
 				Eventually(session).Should(Say("Otherwise, the app's current droplet will be run."))
 				Eventually(session).Should(Say("ALIAS:"))
 				Eventually(session).Should(Say("rs"))
 				Eventually(session).Should(Say("OPTIONS:"))
-				Eventually(session).Should(Say("--strategy      Deployment strategy, either rolling or null"))
-				Eventually(session).Should(Say("--no-wait       Exit when the first instance of the web process is healthy"))
+				Eventually(session).Should(Say(`--strategy\s+Deployment strategy can be canary, rolling or null`))
+				Eventually(session).Should(Say(`--instance-steps\s+An array of percentage steps to deploy when using deployment strategy canary. \(e.g. 20,40,60\)`))
+				Eventually(session).Should(Say(`--no-wait\s+Exit when the first instance of the web process is healthy`))
 				Eventually(session).Should(Say("ENVIRONMENT:"))
 				Eventually(session).Should(Say(`CF_STAGING_TIMEOUT=15\s+Max wait time for staging, in minutes`))
 				Eventually(session).Should(Say(`CF_STARTUP_TIMEOUT=5\s+Max wait time for app instance startup, in minutes`))
@@ -0,0 +1,41 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/cf/util/testhelpers/matchers"
+
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("scp command", func() {
+	When("--help flag is set", func() {
+		It("appears in cf help -a", func() {
+			session := helpers.CF("help", "-a")
+			Eventually(session).Should(Exit(0))
+			Expect(session).To(HaveCommandInCategoryWithDescription("scp", "APPS", "Copy files to or from an application container instance"))
+		})
+
+		It("displays command usage to output", func() {
+			session := helpers.CF("scp", "--help")
+
+			Eventually(session).Should(Say(`NAME:`))
+			Eventually(session).Should(Say(`scp - Copy files to or from an application container instance`))
+			Eventually(session).Should(Say(`USAGE:`))
+			Eventually(session).Should(Say(`cf scp \[--process PROCESS\] \[-i INDEX\] \[-r\] APP_NAME:REMOTE_PATH LOCAL_PATH`))
+			Eventually(session).Should(Say(`cf scp \[--process PROCESS\] \[-i INDEX\] \[-r\] LOCAL_PATH APP_NAME:REMOTE_PATH`))
+			Eventually(session).Should(Say(`EXAMPLES:`))
+			Eventually(session).Should(Say(`cf scp my-app:/tmp/heapdump.hprof \.`))
+			Eventually(session).Should(Say(`OPTIONS:`))
+			Eventually(session).Should(Say(`--app-instance-index, -i\s+App process instance index \(Default: 0\)`))
+			Eventually(session).Should(Say(`--process\s+App process name \(Default: web\)`))
+			Eventually(session).Should(Say(`--recursive, -r\s+Copy directories recursively`))
+			Eventually(session).Should(Say(`--skip-host-validation, -k\s+Skip host key validation\. Not recommended!`))
+			Eventually(session).Should(Say(`SEE ALSO:`))
+			Eventually(session).Should(Say(`enable-ssh, ssh, ssh-code`))
+			Eventually(session).Should(Exit(0))
+		})
+	})
+})
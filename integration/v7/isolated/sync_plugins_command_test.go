@@ -0,0 +1,41 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/cf/util/testhelpers/matchers"
+
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("sync-plugins command", func() {
+	When("--help flag is set", func() {
+		It("appears in cf help -a", func() {
+			session := helpers.CF("help", "-a")
+			Eventually(session).Should(Exit(0))
+			Expect(session).To(HaveCommandInCategoryWithDescription("sync-plugins", "ADD/REMOVE PLUGIN", "Install, update and uninstall plugins to match a plugin set file"))
+		})
+
+		It("displays command usage to output", func() {
+			session := helpers.CF("sync-plugins", "--help")
+
+			Eventually(session).Should(Say(`NAME:`))
+			Eventually(session).Should(Say(`sync-plugins - Install, update and uninstall plugins to match a plugin set file`))
+			Eventually(session).Should(Say(`USAGE:`))
+			Eventually(session).Should(Say(`cf sync-plugins \[PLUGIN_SET_FILE\] \[--check\] \[-f\] \[--skip-signature-verification\]`))
+			Eventually(session).Should(Say(`\(default: cf-plugins\.yml\)`))
+			Eventually(session).Should(Say(`WARNING:`))
+			Eventually(session).Should(Say(`EXAMPLES:`))
+			Eventually(session).Should(Say(`cf sync-plugins --check`))
+			Eventually(session).Should(Say(`OPTIONS:`))
+			Eventually(session).Should(Say(`--check\s+Report differences from the plugin set file without changing anything; exits with an error if there are any`))
+			Eventually(session).Should(Say(`-f\s+Force changes to installed plugins without confirmation`))
+			Eventually(session).Should(Say(`--skip-signature-verification\s+Install and update plugins even if their signature is missing or does not match the public key of their repository`))
+			Eventually(session).Should(Say(`SEE ALSO:`))
+			Eventually(session).Should(Say(`install-plugin, plugins, uninstall-plugin, update-plugin`))
+			Eventually(session).Should(Exit(0))
+		})
+	})
+})
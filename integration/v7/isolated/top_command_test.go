@@ -0,0 +1,38 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/cf/util/testhelpers/matchers"
+
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("top command", func() {
+	When("--help flag is set", func() {
+		It("appears in cf help -a", func() {
+			session := helpers.CF("help", "-a")
+			Eventually(session).Should(Exit(0))
+			Expect(session).To(HaveCommandInCategoryWithDescription("top", "APPS", "Show live CPU, memory and disk usage of app instances"))
+		})
+
+		It("displays command usage to output", func() {
+			session := helpers.CF("top", "--help")
+
+			Eventually(session).Should(Say(`NAME:`))
+			Eventually(session).Should(Say(`top - Show live CPU, memory and disk usage of app instances`))
+			Eventually(session).Should(Say(`USAGE:`))
+			Eventually(session).Should(Say(`cf top \[APP_NAME\] \[--sort cpu\|memory\|crashes\] \[-n ITERATIONS\]`))
+			Eventually(session).Should(Say(`EXAMPLES:`))
+			Eventually(session).Should(Say(`cf top my-app --sort memory`))
+			Eventually(session).Should(Say(`OPTIONS:`))
+			Eventually(session).Should(Say(`--sort\s+Order instances by CPU usage, memory usage, or crashes`))
+			Eventually(session).Should(Say(`--iterations, -n\s+Exit after refreshing this many times`))
+			Eventually(session).Should(Say(`SEE ALSO:`))
+			Eventually(session).Should(Say(`app, apps, logs, scale`))
+			Eventually(session).Should(Exit(0))
+		})
+	})
+})
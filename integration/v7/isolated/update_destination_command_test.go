@@ -27,18 +27,20 @@ var _ = Describe("update destination command", func() {
 
 			Eventually(session).Should(Say(`USAGE:`))
 			Eventually(session).Should(Say(`Edit an existing HTTP route`))
-			Eventually(session).Should(Say(`cf update-destination APP_NAME DOMAIN \[--hostname HOSTNAME\] \[--app-protocol PROTOCOL\] \[--path PATH\]\n`))
+			Eventually(session).Should(Say(`cf update-destination APP_NAME DOMAIN \[--hostname HOSTNAME\] \[--app-protocol PROTOCOL\] \[--path PATH\] \[--weight WEIGHT\]\n`))
 			Eventually(session).Should(Say(`\n`))
 
 			Eventually(session).Should(Say(`EXAMPLES:`))
 			Eventually(session).Should(Say(`cf update-destination my-app example.com --hostname myhost --app-protocol http2                   # myhost.example.com`))
 			Eventually(session).Should(Say(`cf update destination my-app example.com --hostname myhost --path foo --app-protocol http2        # myhost.example.com/foo`))
+			Eventually(session).Should(Say(`cf update-destination my-app-v2 example.com --hostname myhost --weight 50                         # myhost.example.com, 50% of traffic to my-app-v2`))
 			Eventually(session).Should(Say(`\n`))
 
 			Eventually(session).Should(Say(`OPTIONS:`))
 			Eventually(session).Should(Say(`--hostname, -n\s+Hostname for the HTTP route \(required for shared domains\)`))
 			Eventually(session).Should(Say(`--app-protocol\s+New Protocol for the route destination \(http1 or http2\). Only applied to HTTP routes`))
 			Eventually(session).Should(Say(`--path\s+Path for the HTTP route`))
+			Eventually(session).Should(Say(`--weight\s+New percentage \(1-100\) of the route's traffic to send to the app; the rest is divided among the route's other destinations`))
 			Eventually(session).Should(Say(`\n`))
 
 			Eventually(session).Should(Say(`SEE ALSO:`))
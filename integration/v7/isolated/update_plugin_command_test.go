@@ -0,0 +1,44 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/cf/util/testhelpers/matchers"
+
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("update-plugin command", func() {
+	When("--help flag is set", func() {
+		It("appears in cf help -a", func() {
+			session := helpers.CF("help", "-a")
+			Eventually(session).Should(Exit(0))
+			Expect(session).To(HaveCommandInCategoryWithDescription("update-plugin", "ADD/REMOVE PLUGIN", "Update installed CLI plugins to the latest versions in the registered plugin repositories"))
+		})
+
+		It("displays command usage to output", func() {
+			session := helpers.CF("update-plugin", "--help")
+
+			Eventually(session).Should(Say(`NAME:`))
+			Eventually(session).Should(Say(`update-plugin - Update installed CLI plugins to the latest versions in the registered plugin repositories`))
+			Eventually(session).Should(Say(`USAGE:`))
+			Eventually(session).Should(Say(`cf update-plugin PLUGIN_NAME \[-r REPO_NAME\] \[-f\] \[--skip-signature-verification\]`))
+			Eventually(session).Should(Say(`cf update-plugin --all \[-r REPO_NAME\] \[-f\] \[--skip-signature-verification\]`))
+			Eventually(session).Should(Say(`WARNING:`))
+			Eventually(session).Should(Say(`Plugins are binaries written by potentially untrusted authors\.`))
+			Eventually(session).Should(Say(`EXAMPLES:`))
+			Eventually(session).Should(Say(`cf update-plugin plugin-echo`))
+			Eventually(session).Should(Say(`cf update-plugin --all -f`))
+			Eventually(session).Should(Say(`OPTIONS:`))
+			Eventually(session).Should(Say(`--all\s+Update all installed plugins that have a newer version in the registered repositories`))
+			Eventually(session).Should(Say(`-f\s+Force update of plugins without confirmation`))
+			Eventually(session).Should(Say(`-r\s+Restrict search for newer versions to this registered repository`))
+			Eventually(session).Should(Say(`--skip-signature-verification\s+Update plugins even if their signature is missing or does not match the public key of their repository`))
+			Eventually(session).Should(Say(`SEE ALSO:`))
+			Eventually(session).Should(Say(`install-plugin, plugins, repo-plugins`))
+			Eventually(session).Should(Exit(0))
+		})
+	})
+})
@@ -28,6 +28,7 @@ var _ = Describe("help", func() {
 				"[-f MANIFEST_PATH | --no-manifest]",
 				"[--no-start]",
 				"[--no-wait]",
+				"[--parallel NUM_APPS]",
 				"[-i NUM_INSTANCES]",
 				"[-k DISK]",
 				"[-m MEMORY]",
@@ -52,6 +53,7 @@ var _ = Describe("help", func() {
 				"[-f MANIFEST_PATH | --no-manifest]",
 				"[--no-start]",
 				"[--no-wait]",
+				"[--parallel NUM_APPS]",
 				"[-i NUM_INSTANCES]",
 				"[-k DISK]",
 				"[-m MEMORY]",
@@ -77,12 +79,14 @@ var _ = Describe("help", func() {
 			Eventually(session).Should(Say(`--endpoint`))
 			Eventually(session).Should(Say(`--health-check-type, -u`))
 			Eventually(session).Should(Say(`--instances, -i`))
+			Eventually(session).Should(Say(`--instance-steps`))
 			Eventually(session).Should(Say(`--manifest, -f`))
 			Eventually(session).Should(Say(`--memory, -m`))
 			Eventually(session).Should(Say(`--no-manifest`))
 			Eventually(session).Should(Say(`--no-route`))
 			Eventually(session).Should(Say(`--no-start`))
 			Eventually(session).Should(Say(`--no-wait`))
+			Eventually(session).Should(Say(`--parallel`))
 			Eventually(session).Should(Say(`--path, -p`))
 			Eventually(session).Should(Say(`--random-route`))
 			Eventually(session).Should(Say(`--stack, -s`))
@@ -0,0 +1,215 @@
+package plugin
+
+import (
+	"errors"
+	"net/rpc"
+	"sync"
+
+	plugin_models "code.cloudfoundry.org/cli/plugin/models"
+)
+
+// APIV2MinCliVersion is the first CLI version that serves the v2 plugin API.
+const APIV2MinCliVersion = "8.1.0"
+
+var ErrAPIV2NotSupported = errors.New("This version of the cf CLI does not support the v2 plugin API; " + APIV2MinCliVersion + " or later is required")
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . CliConnectionV2
+/**
+	List of v2 plugin API calls, which return v3 resources. Use NewCliConnectionV2 to get one.
+**/
+type CliConnectionV2 interface {
+	// GetApps returns the apps in the targeted space with their processes and
+	// routes. An empty labelSelector returns every app.
+	GetApps(labelSelector string) ([]plugin_models.App, error)
+	// GetApp returns an app in the targeted space with its process instances
+	// and current droplet.
+	GetApp(appName string) (plugin_models.App, error)
+	// GetRoutes returns the routes in the targeted space with their
+	// destinations. An empty labelSelector returns every route.
+	GetRoutes(labelSelector string) ([]plugin_models.Route, error)
+	GetServiceAppBindings(appName string) ([]plugin_models.ServiceCredentialBinding, error)
+	GetServiceKeys(serviceInstanceName string) ([]plugin_models.ServiceCredentialBinding, error)
+	// GetDeployment returns the active deployment of an app.
+	GetDeployment(appName string) (plugin_models.Deployment, error)
+	// GetLabels returns the labels of a resource; see
+	// plugin_models.LabelsRequest for the supported resource types.
+	GetLabels(resourceType string, resourceName string) (map[string]string, error)
+	// StreamLogs tails the logs of an app in the targeted space until stop is
+	// called or the stream ends, after which both channels are closed. Errors
+	// on the error channel do not end the stream.
+	StreamLogs(appName string) (messages <-chan plugin_models.LogMessage, errs <-chan error, stop func(), err error)
+}
+
+type cliConnectionV2 struct {
+	*cliConnection
+}
+
+// NewCliConnectionV2 returns the v2 plugin API for the connection passed to
+// Plugin.Run. It returns ErrAPIV2NotSupported when the running CLI is older
+// than APIV2MinCliVersion.
+func NewCliConnectionV2(connection CliConnection) (CliConnectionV2, error) {
+	c, ok := connection.(*cliConnection)
+	if !ok {
+		return nil, errors.New("NewCliConnectionV2 requires the CliConnection passed to Run")
+	}
+
+	var supported bool
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.IsMinCliVersion", APIV2MinCliVersion, &supported)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !supported {
+		return nil, ErrAPIV2NotSupported
+	}
+
+	return &cliConnectionV2{cliConnection: c}, nil
+}
+
+func (c *cliConnectionV2) GetApps(labelSelector string) ([]plugin_models.App, error) {
+	var result []plugin_models.App
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmdV2.GetApps", labelSelector, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnectionV2) GetApp(appName string) (plugin_models.App, error) {
+	var result plugin_models.App
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmdV2.GetApp", appName, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnectionV2) GetRoutes(labelSelector string) ([]plugin_models.Route, error) {
+	var result []plugin_models.Route
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmdV2.GetRoutes", labelSelector, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnectionV2) GetServiceAppBindings(appName string) ([]plugin_models.ServiceCredentialBinding, error) {
+	var result []plugin_models.ServiceCredentialBinding
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmdV2.GetServiceAppBindings", appName, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnectionV2) GetServiceKeys(serviceInstanceName string) ([]plugin_models.ServiceCredentialBinding, error) {
+	var result []plugin_models.ServiceCredentialBinding
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmdV2.GetServiceKeys", serviceInstanceName, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnectionV2) GetDeployment(appName string) (plugin_models.Deployment, error) {
+	var result plugin_models.Deployment
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmdV2.GetDeployment", appName, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnectionV2) GetLabels(resourceType string, resourceName string) (map[string]string, error) {
+	var result map[string]string
+
+	request := plugin_models.LabelsRequest{ResourceType: resourceType, ResourceName: resourceName}
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmdV2.GetLabels", request, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnectionV2) StreamLogs(appName string) (<-chan plugin_models.LogMessage, <-chan error, func(), error) {
+	var id string
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmdV2.StartLogStream", appName, &id)
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	messages := make(chan plugin_models.LogMessage)
+	errs := make(chan error)
+	stopped := make(chan struct{})
+
+	var once sync.Once
+	stop := func() {
+		once.Do(func() { close(stopped) })
+	}
+
+	go func() {
+		defer close(messages)
+		defer close(errs)
+
+		err := c.withClientDo(func(client *rpc.Client) error {
+			for {
+				var batch plugin_models.LogBatch
+				err := client.Call("CliRpcCmdV2.ReadLogStream", id, &batch)
+				if err != nil {
+					return err
+				}
+
+				for _, message := range batch.Messages {
+					select {
+					case messages <- message:
+					case <-stopped:
+						return stopLogStream(client, id)
+					}
+				}
+
+				for _, logErr := range batch.Errors {
+					select {
+					case errs <- errors.New(logErr):
+					case <-stopped:
+						return stopLogStream(client, id)
+					}
+				}
+
+				if batch.Done {
+					return nil
+				}
+
+				select {
+				case <-stopped:
+					return stopLogStream(client, id)
+				default:
+				}
+			}
+		})
+
+		if err != nil {
+			select {
+			case errs <- err:
+			case <-stopped:
+			}
+		}
+	}()
+
+	return messages, errs, stop, nil
+}
+
+func stopLogStream(client *rpc.Client, id string) error {
+	var success bool
+	return client.Call("CliRpcCmdV2.StopLogStream", id, &success)
+}
@@ -0,0 +1,159 @@
+package plugin_test
+
+import (
+	"net"
+	"net/rpc"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/plugin"
+	cliRpc "code.cloudfoundry.org/cli/plugin/rpc"
+	"code.cloudfoundry.org/cli/plugin/rpc/rpcfakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CliConnectionV2", func() {
+	var (
+		rpcService *cliRpc.CliRpcService
+		fakeActor  *rpcfakes.FakeV2Actor
+		connection plugin.CliConnectionV2
+	)
+
+	BeforeEach(func() {
+		fakeActor = new(rpcfakes.FakeV2Actor)
+		fakeConfig := new(commandfakes.FakeConfig)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid"})
+
+		var err error
+		rpcService, err = cliRpc.NewRpcService(nil, nil, nil, api.RepositoryLocator{}, nil, nil, nil, rpc.NewServer())
+		Expect(err).ToNot(HaveOccurred())
+		rpcService.RpcCmdV2.NewDependencies = func() (cliRpc.V2Dependencies, error) {
+			return cliRpc.V2Dependencies{
+				Actor:       fakeActor,
+				Config:      fakeConfig,
+				SharedActor: new(commandfakes.FakeSharedActor),
+			}, nil
+		}
+		Expect(rpcService.Start()).To(Succeed())
+
+		connection, err = plugin.NewCliConnectionV2(plugin.NewCliConnection(rpcService.Port()))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		rpcService.Stop()
+	})
+
+	It("returns an error for connections it did not create", func() {
+		_, err := plugin.NewCliConnectionV2(nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	When("the CLI is older than APIV2MinCliVersion", func() {
+		var (
+			listener net.Listener
+			olderCli *olderCliRpcCmd
+		)
+
+		BeforeEach(func() {
+			olderCli = new(olderCliRpcCmd)
+			server := rpc.NewServer()
+			Expect(server.RegisterName("CliRpcCmd", olderCli)).To(Succeed())
+
+			var err error
+			listener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).ToNot(HaveOccurred())
+			go server.Accept(listener)
+		})
+
+		AfterEach(func() {
+			listener.Close()
+		})
+
+		It("returns ErrAPIV2NotSupported", func() {
+			_, port, err := net.SplitHostPort(listener.Addr().String())
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = plugin.NewCliConnectionV2(plugin.NewCliConnection(port))
+			Expect(err).To(MatchError(plugin.ErrAPIV2NotSupported))
+			Expect(olderCli.requestedVersion).To(Equal(plugin.APIV2MinCliVersion))
+		})
+	})
+
+	It("gets resources from the CLI", func() {
+		fakeActor.GetAppSummariesForSpaceReturns([]v7action.ApplicationSummary{
+			{Application: resources.Application{GUID: "app-guid", Name: "some-app"}},
+		}, nil, nil)
+		fakeActor.GetApplicationLabelsReturns(map[string]types.NullString{"env": types.NewNullString("prod")}, nil, nil)
+
+		apps, err := connection.GetApps("")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(apps).To(HaveLen(1))
+		Expect(apps[0].Name).To(Equal("some-app"))
+
+		labels, err := connection.GetLabels("app", "some-app")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(labels).To(Equal(map[string]string{"env": "prod"}))
+	})
+
+	It("streams logs until the stream ends", func() {
+		logMessages := make(chan sharedaction.LogMessage, 2)
+		logErrs := make(chan error)
+		fakeActor.GetStreamingLogsForApplicationByNameAndSpaceReturns(logMessages, logErrs, func() {}, nil, nil)
+
+		messages, errs, stop, err := connection.StreamLogs("some-app")
+		Expect(err).ToNot(HaveOccurred())
+		defer stop()
+
+		logMessages <- *sharedaction.NewLogMessage("message-1", "OUT", time.Unix(0, 0), "APP/PROC/WEB", "0")
+		logMessages <- *sharedaction.NewLogMessage("message-2", "OUT", time.Unix(0, 0), "APP/PROC/WEB", "0")
+		close(logMessages)
+		close(logErrs)
+
+		var received []string
+		for message := range messages {
+			received = append(received, message.Message)
+		}
+		Expect(received).To(Equal([]string{"message-1", "message-2"}))
+		Eventually(errs).Should(BeClosed())
+	})
+
+	It("stops streaming logs when asked", func() {
+		logStopped := make(chan bool, 1)
+		fakeActor.GetStreamingLogsForApplicationByNameAndSpaceReturns(make(chan sharedaction.LogMessage), make(chan error), func() { logStopped <- true }, nil, nil)
+
+		messages, _, stop, err := connection.StreamLogs("some-app")
+		Expect(err).ToNot(HaveOccurred())
+
+		stop()
+		Eventually(messages, 3*time.Second).Should(BeClosed())
+		Eventually(logStopped).Should(Receive())
+	})
+
+	It("passes on errors from the CLI", func() {
+		fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{}, nil, actionerror.ApplicationNotFoundError{Name: "some-app"})
+
+		_, err := connection.GetDeployment("some-app")
+		Expect(err).To(MatchError("App 'some-app' not found."))
+	})
+})
+
+// olderCliRpcCmd stands in for the RPC service of a CLI that predates the v2
+// plugin API.
+type olderCliRpcCmd struct {
+	requestedVersion string
+}
+
+func (cmd *olderCliRpcCmd) IsMinCliVersion(version string, retVal *bool) error {
+	cmd.requestedVersion = version
+	*retVal = false
+	return nil
+}
@@ -0,0 +1,44 @@
+package plugin_models
+
+import "time"
+
+// App is an application as returned by the v2 plugin API, including its
+// processes and, for GetAppV2, their instances.
+type App struct {
+	Guid          string
+	Name          string
+	State         string
+	SpaceGuid     string
+	LifecycleType string
+	Buildpacks    []string
+	Stack         string
+	DropletGuid   string
+	Labels        map[string]string
+	Processes     []Process
+	Routes        []Route
+}
+
+type Process struct {
+	Guid                string
+	Type                string
+	Command             string
+	Instances           int
+	RunningInstances    int
+	MemoryInMB          uint64
+	DiskInMB            uint64
+	HealthCheckType     string
+	HealthCheckEndpoint string
+	InstanceDetails     []ProcessInstance
+}
+
+type ProcessInstance struct {
+	Index       int64
+	State       string
+	Uptime      time.Duration
+	CPU         float64 // fraction of one core
+	MemoryUsage uint64  // in bytes
+	MemoryQuota uint64  // in bytes
+	DiskUsage   uint64  // in bytes
+	DiskQuota   uint64  // in bytes
+	Details     string
+}
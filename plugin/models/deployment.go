@@ -0,0 +1,13 @@
+package plugin_models
+
+type Deployment struct {
+	Guid         string
+	State        string
+	StatusValue  string
+	StatusReason string
+	Strategy     string
+	DropletGuid  string
+	RevisionGuid string
+	CreatedAt    string
+	UpdatedAt    string
+}
@@ -0,0 +1,10 @@
+package plugin_models
+
+// LabelsRequest names the resource whose labels GetLabels returns.
+// ResourceType is one of app, domain, org, route, service-instance, space or
+// stack; apps, routes and service instances are looked up in the targeted
+// space, and spaces in the targeted org.
+type LabelsRequest struct {
+	ResourceType string
+	ResourceName string
+}
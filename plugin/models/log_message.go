@@ -0,0 +1,20 @@
+package plugin_models
+
+import "time"
+
+type LogMessage struct {
+	Message        string
+	MessageType    string // OUT or ERR
+	Timestamp      time.Time
+	SourceType     string
+	SourceInstance string
+}
+
+// LogBatch is one poll of a log subscription. Errors are non-fatal errors
+// reported by Log Cache. Done is set once the stream has ended, after which
+// the subscription is gone.
+type LogBatch struct {
+	Messages []LogMessage
+	Errors   []string
+	Done     bool
+}
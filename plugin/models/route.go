@@ -0,0 +1,23 @@
+package plugin_models
+
+type Route struct {
+	Guid         string
+	SpaceGuid    string
+	DomainGuid   string
+	Host         string
+	Path         string
+	Port         int
+	Protocol     string
+	URL          string
+	Destinations []RouteDestination
+	Labels       map[string]string
+}
+
+type RouteDestination struct {
+	Guid        string
+	AppGuid     string
+	ProcessType string
+	Port        int
+	Protocol    string
+	Weight      int // 0 unless the route uses weighted routing
+}
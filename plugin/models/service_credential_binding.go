@@ -0,0 +1,16 @@
+package plugin_models
+
+type ServiceCredentialBinding struct {
+	Guid                string
+	Name                string
+	Type                string // "app" or "key"
+	AppGuid             string
+	ServiceInstanceGuid string
+	LastOperation       ServiceCredentialBinding_LastOperation
+}
+
+type ServiceCredentialBinding_LastOperation struct {
+	Type        string
+	State       string
+	Description string
+}
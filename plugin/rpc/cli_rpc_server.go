@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"bufio"
 	"os"
 	"strings"
 
@@ -33,6 +34,7 @@ type CliRpcService struct {
 	stopCh   chan struct{}
 	Pinged   bool
 	RpcCmd   *CliRpcCmd
+	RpcCmdV2 *CliRpcCmdV2
 	Server   *rpc.Server
 }
 
@@ -85,6 +87,7 @@ func NewRpcService(
 			outputBucket:         &bytes.Buffer{},
 			stdout:               w,
 		},
+		RpcCmdV2: NewCliRpcCmdV2(NewV2Dependencies, logger),
 	}
 
 	err := rpcService.Server.Register(rpcService.RpcCmd)
@@ -92,12 +95,18 @@ func NewRpcService(
 		return nil, err
 	}
 
+	err = rpcService.Server.Register(rpcService.RpcCmdV2)
+	if err != nil {
+		return nil, err
+	}
+
 	return rpcService, nil
 }
 
 func (cli *CliRpcService) Stop() {
 	close(cli.stopCh)
 	cli.listener.Close()
+	cli.RpcCmdV2.StopLogStreams()
 }
 
 func (cli *CliRpcService) Port() string {
@@ -125,7 +134,7 @@ func (cli *CliRpcService) Start() error {
 					fmt.Println(err)
 				}
 			} else {
-				go cli.Server.ServeConn(conn)
+				go cli.serveConn(conn)
 			}
 		}
 	}()
@@ -133,6 +142,33 @@ func (cli *CliRpcService) Start() error {
 	return nil
 }
 
+// serveConn serves JSON-RPC 2.0 to plugins whose first byte opens a JSON
+// object and gob to everything else; a gob client never starts with '{'.
+func (cli *CliRpcService) serveConn(conn net.Conn) {
+	reader := bufio.NewReader(conn)
+	first, err := reader.Peek(1)
+	if err != nil {
+		conn.Close()
+		return
+	}
+
+	buffered := bufferedConn{Conn: conn, reader: reader}
+	if first[0] == '{' {
+		cli.Server.ServeCodec(NewJSONServerCodec(buffered))
+	} else {
+		cli.Server.ServeConn(buffered)
+	}
+}
+
+type bufferedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (c bufferedConn) Read(p []byte) (int, error) {
+	return c.reader.Read(p)
+}
+
 func (cmd *CliRpcCmd) IsMinCliVersion(passedVersion string, retVal *bool) error {
 	if version.VersionString() == version.DefaultVersion {
 		*retVal = true
@@ -0,0 +1,567 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/cf/trace"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	plugin_models "code.cloudfoundry.org/cli/plugin/models"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+const (
+	logBatchSize    = 100
+	logBatchMaxWait = time.Second
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . V2Actor
+
+type V2Actor interface {
+	GetAppSummariesForSpace(spaceGUID string, labelSelector string) ([]v7action.ApplicationSummary, v7action.Warnings, error)
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (resources.Application, v7action.Warnings, error)
+	GetApplicationLabels(appName string, spaceGUID string) (map[string]types.NullString, v7action.Warnings, error)
+	GetDetailedAppSummary(appName string, spaceGUID string, withObfuscatedValues bool) (v7action.DetailedApplicationSummary, v7action.Warnings, error)
+	GetDomainLabels(domainName string) (map[string]types.NullString, v7action.Warnings, error)
+	GetLatestActiveDeploymentForApp(appGUID string) (resources.Deployment, v7action.Warnings, error)
+	GetOrganizationLabels(orgName string) (map[string]types.NullString, v7action.Warnings, error)
+	GetRouteLabels(routeName string, spaceGUID string) (map[string]types.NullString, v7action.Warnings, error)
+	GetRoutesBySpace(spaceGUID string, labelSelector string) ([]resources.Route, v7action.Warnings, error)
+	GetServiceAppBindingsForApp(appName string, spaceGUID string) ([]resources.ServiceCredentialBinding, v7action.Warnings, error)
+	GetServiceInstanceLabels(serviceInstanceName string, spaceGUID string) (map[string]types.NullString, v7action.Warnings, error)
+	GetServiceKeysByServiceInstance(serviceInstanceName string, spaceGUID string) ([]resources.ServiceCredentialBinding, v7action.Warnings, error)
+	GetSpaceLabels(spaceName string, orgGUID string) (map[string]types.NullString, v7action.Warnings, error)
+	GetStackLabels(stackName string) (map[string]types.NullString, v7action.Warnings, error)
+	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)
+}
+
+// V2Dependencies are the clients CliRpcCmdV2 needs. They are built on the
+// first call, so plugins that only use the v1 API never connect to the v3 API.
+type V2Dependencies struct {
+	Actor          V2Actor
+	Config         command.Config
+	SharedActor    command.SharedActor
+	LogCacheClient sharedaction.LogCacheClient
+}
+
+// CliRpcCmdV2 serves the v2 plugin API, which exposes v3 resources through
+// v7action. CLIs older than plugin.APIV2MinCliVersion do not register it.
+type CliRpcCmdV2 struct {
+	NewDependencies func() (V2Dependencies, error)
+
+	logger trace.Printer
+
+	depsMutex sync.Mutex
+	deps      *V2Dependencies
+
+	logsMutex       sync.Mutex
+	logStreams      map[string]*logSubscription
+	nextLogStreamID int
+}
+
+type defaultLocale struct{}
+
+func (defaultLocale) Locale() string { return "" }
+
+type logSubscription struct {
+	mutex    sync.Mutex
+	messages <-chan sharedaction.LogMessage
+	errs     <-chan error
+	stop     context.CancelFunc
+}
+
+func NewCliRpcCmdV2(newDependencies func() (V2Dependencies, error), logger trace.Printer) *CliRpcCmdV2 {
+	return &CliRpcCmdV2{
+		NewDependencies: newDependencies,
+		logger:          logger,
+		logStreams:      map[string]*logSubscription{},
+	}
+}
+
+func (cmd *CliRpcCmdV2) GetApps(labelSelector string, retVal *[]plugin_models.App) error {
+	deps, spaceGUID, err := cmd.targetedSpace()
+	if err != nil {
+		return cmd.renderError(err)
+	}
+
+	summaries, warnings, err := deps.Actor.GetAppSummariesForSpace(spaceGUID, labelSelector)
+	cmd.logWarnings(warnings)
+	if err != nil {
+		return cmd.renderError(err)
+	}
+
+	apps := make([]plugin_models.App, 0, len(summaries))
+	for _, summary := range summaries {
+		apps = append(apps, convertAppSummary(summary))
+	}
+	*retVal = apps
+
+	return nil
+}
+
+func (cmd *CliRpcCmdV2) GetApp(appName string, retVal *plugin_models.App) error {
+	deps, spaceGUID, err := cmd.targetedSpace()
+	if err != nil {
+		return cmd.renderError(err)
+	}
+
+	summary, warnings, err := deps.Actor.GetDetailedAppSummary(appName, spaceGUID, true)
+	cmd.logWarnings(warnings)
+	if err != nil {
+		return cmd.renderError(err)
+	}
+
+	*retVal = convertAppSummary(summary.ApplicationSummary)
+	retVal.DropletGuid = summary.CurrentDroplet.GUID
+
+	return nil
+}
+
+func (cmd *CliRpcCmdV2) GetRoutes(labelSelector string, retVal *[]plugin_models.Route) error {
+	deps, spaceGUID, err := cmd.targetedSpace()
+	if err != nil {
+		return cmd.renderError(err)
+	}
+
+	routes, warnings, err := deps.Actor.GetRoutesBySpace(spaceGUID, labelSelector)
+	cmd.logWarnings(warnings)
+	if err != nil {
+		return cmd.renderError(err)
+	}
+
+	*retVal = convertRoutes(routes)
+
+	return nil
+}
+
+func (cmd *CliRpcCmdV2) GetServiceAppBindings(appName string, retVal *[]plugin_models.ServiceCredentialBinding) error {
+	deps, spaceGUID, err := cmd.targetedSpace()
+	if err != nil {
+		return cmd.renderError(err)
+	}
+
+	bindings, warnings, err := deps.Actor.GetServiceAppBindingsForApp(appName, spaceGUID)
+	cmd.logWarnings(warnings)
+	if err != nil {
+		return cmd.renderError(err)
+	}
+
+	*retVal = convertServiceCredentialBindings(bindings)
+
+	return nil
+}
+
+func (cmd *CliRpcCmdV2) GetServiceKeys(serviceInstanceName string, retVal *[]plugin_models.ServiceCredentialBinding) error {
+	deps, spaceGUID, err := cmd.targetedSpace()
+	if err != nil {
+		return cmd.renderError(err)
+	}
+
+	keys, warnings, err := deps.Actor.GetServiceKeysByServiceInstance(serviceInstanceName, spaceGUID)
+	cmd.logWarnings(warnings)
+	if err != nil {
+		return cmd.renderError(err)
+	}
+
+	*retVal = convertServiceCredentialBindings(keys)
+
+	return nil
+}
+
+func (cmd *CliRpcCmdV2) GetDeployment(appName string, retVal *plugin_models.Deployment) error {
+	deps, spaceGUID, err := cmd.targetedSpace()
+	if err != nil {
+		return cmd.renderError(err)
+	}
+
+	app, warnings, err := deps.Actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	cmd.logWarnings(warnings)
+	if err != nil {
+		return cmd.renderError(err)
+	}
+
+	deployment, warnings, err := deps.Actor.GetLatestActiveDeploymentForApp(app.GUID)
+	cmd.logWarnings(warnings)
+	if err != nil {
+		return cmd.renderError(err)
+	}
+
+	*retVal = plugin_models.Deployment{
+		Guid:         deployment.GUID,
+		State:        string(deployment.State),
+		StatusValue:  string(deployment.StatusValue),
+		StatusReason: string(deployment.StatusReason),
+		Strategy:     string(deployment.Strategy),
+		DropletGuid:  deployment.DropletGUID,
+		RevisionGuid: deployment.RevisionGUID,
+		CreatedAt:    deployment.CreatedAt,
+		UpdatedAt:    deployment.UpdatedAt,
+	}
+
+	return nil
+}
+
+func (cmd *CliRpcCmdV2) GetLabels(request plugin_models.LabelsRequest, retVal *map[string]string) error {
+	deps, err := cmd.dependencies()
+	if err != nil {
+		return cmd.renderError(err)
+	}
+
+	resourceType := strings.ToLower(request.ResourceType)
+	switch resourceType {
+	case "domain", "org", "stack":
+		err = deps.SharedActor.CheckTarget(false, false)
+	case "space":
+		err = deps.SharedActor.CheckTarget(true, false)
+	default:
+		err = deps.SharedActor.CheckTarget(true, true)
+	}
+	if err != nil {
+		return cmd.renderError(err)
+	}
+
+	var (
+		labels   map[string]types.NullString
+		warnings v7action.Warnings
+	)
+	switch resourceType {
+	case "app":
+		labels, warnings, err = deps.Actor.GetApplicationLabels(request.ResourceName, deps.Config.TargetedSpace().GUID)
+	case "domain":
+		labels, warnings, err = deps.Actor.GetDomainLabels(request.ResourceName)
+	case "org":
+		labels, warnings, err = deps.Actor.GetOrganizationLabels(request.ResourceName)
+	case "route":
+		labels, warnings, err = deps.Actor.GetRouteLabels(request.ResourceName, deps.Config.TargetedSpace().GUID)
+	case "service-instance":
+		labels, warnings, err = deps.Actor.GetServiceInstanceLabels(request.ResourceName, deps.Config.TargetedSpace().GUID)
+	case "space":
+		labels, warnings, err = deps.Actor.GetSpaceLabels(request.ResourceName, deps.Config.TargetedOrganization().GUID)
+	case "stack":
+		labels, warnings, err = deps.Actor.GetStackLabels(request.ResourceName)
+	default:
+		err = fmt.Errorf("Unsupported resource type of '%s'", request.ResourceType)
+	}
+	cmd.logWarnings(warnings)
+	if err != nil {
+		return cmd.renderError(err)
+	}
+
+	*retVal = convertLabels(labels)
+
+	return nil
+}
+
+// StartLogStream subscribes to the logs of an app in the targeted space and
+// returns the subscription ID to pass to ReadLogStream and StopLogStream.
+func (cmd *CliRpcCmdV2) StartLogStream(appName string, retVal *string) error {
+	deps, spaceGUID, err := cmd.targetedSpace()
+	if err != nil {
+		return cmd.renderError(err)
+	}
+
+	messages, errs, stop, warnings, err := deps.Actor.GetStreamingLogsForApplicationByNameAndSpace(appName, spaceGUID, deps.LogCacheClient)
+	cmd.logWarnings(warnings)
+	if err != nil {
+		return cmd.renderError(err)
+	}
+
+	cmd.logsMutex.Lock()
+	defer cmd.logsMutex.Unlock()
+
+	cmd.nextLogStreamID++
+	id := strconv.Itoa(cmd.nextLogStreamID)
+	cmd.logStreams[id] = &logSubscription{messages: messages, errs: errs, stop: stop}
+	*retVal = id
+
+	return nil
+}
+
+// ReadLogStream waits up to a second for log messages and returns those that
+// have arrived. Once the stream ends it returns a batch with Done set and
+// forgets the subscription.
+func (cmd *CliRpcCmdV2) ReadLogStream(id string, retVal *plugin_models.LogBatch) error {
+	subscription, err := cmd.logStream(id)
+	if err != nil {
+		return cmd.renderError(err)
+	}
+
+	subscription.read(logBatchMaxWait, retVal)
+	if retVal.Done {
+		cmd.removeLogStream(id)
+	}
+
+	return nil
+}
+
+func (cmd *CliRpcCmdV2) StopLogStream(id string, retVal *bool) error {
+	if _, err := cmd.logStream(id); err != nil {
+		return cmd.renderError(err)
+	}
+
+	cmd.removeLogStream(id)
+	*retVal = true
+
+	return nil
+}
+
+// StopLogStreams stops every open log subscription. The RPC service calls it
+// when the plugin exits.
+func (cmd *CliRpcCmdV2) StopLogStreams() {
+	cmd.logsMutex.Lock()
+	defer cmd.logsMutex.Unlock()
+
+	for id, subscription := range cmd.logStreams {
+		subscription.stop()
+		delete(cmd.logStreams, id)
+	}
+}
+
+func (cmd *CliRpcCmdV2) logStream(id string) (*logSubscription, error) {
+	cmd.logsMutex.Lock()
+	defer cmd.logsMutex.Unlock()
+
+	subscription, ok := cmd.logStreams[id]
+	if !ok {
+		return nil, fmt.Errorf("Log stream '%s' not found", id)
+	}
+	return subscription, nil
+}
+
+func (cmd *CliRpcCmdV2) removeLogStream(id string) {
+	cmd.logsMutex.Lock()
+	defer cmd.logsMutex.Unlock()
+
+	if subscription, ok := cmd.logStreams[id]; ok {
+		subscription.stop()
+		delete(cmd.logStreams, id)
+	}
+}
+
+func (cmd *CliRpcCmdV2) dependencies() (V2Dependencies, error) {
+	cmd.depsMutex.Lock()
+	defer cmd.depsMutex.Unlock()
+
+	if cmd.deps == nil {
+		deps, err := cmd.NewDependencies()
+		if err != nil {
+			return V2Dependencies{}, err
+		}
+		cmd.deps = &deps
+	}
+
+	return *cmd.deps, nil
+}
+
+func (cmd *CliRpcCmdV2) targetedSpace() (V2Dependencies, string, error) {
+	deps, err := cmd.dependencies()
+	if err != nil {
+		return V2Dependencies{}, "", err
+	}
+
+	err = deps.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return V2Dependencies{}, "", err
+	}
+
+	return deps, deps.Config.TargetedSpace().GUID, nil
+}
+
+// renderError turns CLI errors into the message a command would display,
+// since net/rpc only passes the error string on to the plugin and many
+// action errors leave that string to their translatable counterpart.
+func (cmd *CliRpcCmdV2) renderError(err error) error {
+	translatable, ok := translatableerror.ConvertToTranslatableError(err).(translatableerror.TranslatableError)
+	if !ok {
+		return err
+	}
+
+	var localeReader ui.LocaleReader = defaultLocale{}
+	cmd.depsMutex.Lock()
+	if cmd.deps != nil && cmd.deps.Config != nil {
+		localeReader = cmd.deps.Config
+	}
+	cmd.depsMutex.Unlock()
+
+	translate, translateErr := ui.GetTranslationFunc(localeReader)
+	if translateErr != nil {
+		return err
+	}
+
+	return errors.New(translatable.Translate(translate))
+}
+
+func (cmd *CliRpcCmdV2) logWarnings(warnings v7action.Warnings) {
+	if cmd.logger == nil {
+		return
+	}
+	for _, warning := range warnings {
+		cmd.logger.Printf("WARNING: %s\n", warning)
+	}
+}
+
+func (subscription *logSubscription) read(maxWait time.Duration, batch *plugin_models.LogBatch) {
+	subscription.mutex.Lock()
+	defer subscription.mutex.Unlock()
+
+	deadline := time.After(maxWait)
+	for len(batch.Messages) < logBatchSize {
+		if subscription.messages == nil && subscription.errs == nil {
+			batch.Done = true
+			return
+		}
+
+		// Once something has arrived, only take what is already waiting.
+		if len(batch.Messages) > 0 || len(batch.Errors) > 0 {
+			deadline = expired
+		}
+
+		select {
+		case message, ok := <-subscription.messages:
+			if !ok {
+				subscription.messages = nil
+				continue
+			}
+			batch.Messages = append(batch.Messages, plugin_models.LogMessage{
+				Message:        message.Message(),
+				MessageType:    message.Type(),
+				Timestamp:      message.Timestamp(),
+				SourceType:     message.SourceType(),
+				SourceInstance: message.SourceInstance(),
+			})
+		case err, ok := <-subscription.errs:
+			if !ok {
+				subscription.errs = nil
+				continue
+			}
+			batch.Errors = append(batch.Errors, err.Error())
+		case <-deadline:
+			return
+		}
+	}
+}
+
+var expired = func() <-chan time.Time {
+	c := make(chan time.Time)
+	close(c)
+	return c
+}()
+
+func convertAppSummary(summary v7action.ApplicationSummary) plugin_models.App {
+	app := plugin_models.App{
+		Guid:          summary.GUID,
+		Name:          summary.Name,
+		State:         string(summary.State),
+		SpaceGuid:     summary.SpaceGUID,
+		LifecycleType: string(summary.LifecycleType),
+		Buildpacks:    summary.LifecycleBuildpacks,
+		Stack:         summary.StackName,
+		Routes:        convertRoutes(summary.Routes),
+	}
+	if summary.Metadata != nil {
+		app.Labels = convertLabels(summary.Metadata.Labels)
+	}
+
+	for _, process := range summary.ProcessSummaries {
+		converted := plugin_models.Process{
+			Guid:                process.GUID,
+			Type:                process.Type,
+			Command:             process.Command.Value,
+			Instances:           process.Instances.Value,
+			RunningInstances:    process.HealthyInstanceCount(),
+			MemoryInMB:          process.MemoryInMB.Value,
+			DiskInMB:            process.DiskInMB.Value,
+			HealthCheckType:     string(process.HealthCheckType),
+			HealthCheckEndpoint: process.HealthCheckEndpoint,
+		}
+		for _, instance := range process.InstanceDetails {
+			converted.InstanceDetails = append(converted.InstanceDetails, plugin_models.ProcessInstance{
+				Index:       instance.Index,
+				State:       string(instance.State),
+				Uptime:      instance.Uptime,
+				CPU:         instance.CPU,
+				MemoryUsage: instance.MemoryUsage,
+				MemoryQuota: instance.MemoryQuota,
+				DiskUsage:   instance.DiskUsage,
+				DiskQuota:   instance.DiskQuota,
+				Details:     instance.Details,
+			})
+		}
+		app.Processes = append(app.Processes, converted)
+	}
+
+	return app
+}
+
+func convertRoutes(routes []resources.Route) []plugin_models.Route {
+	converted := make([]plugin_models.Route, 0, len(routes))
+	for _, route := range routes {
+		model := plugin_models.Route{
+			Guid:       route.GUID,
+			SpaceGuid:  route.SpaceGUID,
+			DomainGuid: route.DomainGUID,
+			Host:       route.Host,
+			Path:       route.Path,
+			Port:       route.Port,
+			Protocol:   route.Protocol,
+			URL:        route.URL,
+		}
+		if route.Metadata != nil {
+			model.Labels = convertLabels(route.Metadata.Labels)
+		}
+		for _, destination := range route.Destinations {
+			converted := plugin_models.RouteDestination{
+				Guid:        destination.GUID,
+				AppGuid:     destination.App.GUID,
+				ProcessType: destination.App.Process.Type,
+				Port:        destination.Port,
+				Protocol:    destination.Protocol,
+			}
+			if destination.Weight != nil {
+				converted.Weight = *destination.Weight
+			}
+			model.Destinations = append(model.Destinations, converted)
+		}
+		converted = append(converted, model)
+	}
+	return converted
+}
+
+func convertServiceCredentialBindings(bindings []resources.ServiceCredentialBinding) []plugin_models.ServiceCredentialBinding {
+	converted := make([]plugin_models.ServiceCredentialBinding, 0, len(bindings))
+	for _, binding := range bindings {
+		converted = append(converted, plugin_models.ServiceCredentialBinding{
+			Guid:                binding.GUID,
+			Name:                binding.Name,
+			Type:                string(binding.Type),
+			AppGuid:             binding.AppGUID,
+			ServiceInstanceGuid: binding.ServiceInstanceGUID,
+			LastOperation: plugin_models.ServiceCredentialBinding_LastOperation{
+				Type:        string(binding.LastOperation.Type),
+				State:       string(binding.LastOperation.State),
+				Description: binding.LastOperation.Description,
+			},
+		})
+	}
+	return converted
+}
+
+func convertLabels(labels map[string]types.NullString) map[string]string {
+	converted := map[string]string{}
+	for key, value := range labels {
+		if value.IsSet {
+			converted[key] = value.Value
+		}
+	}
+	return converted
+}
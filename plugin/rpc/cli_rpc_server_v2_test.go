@@ -0,0 +1,517 @@
+package rpc_test
+
+import (
+	"errors"
+	"net/rpc"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	plugin_models "code.cloudfoundry.org/cli/plugin/models"
+	. "code.cloudfoundry.org/cli/plugin/rpc"
+	"code.cloudfoundry.org/cli/plugin/rpc/rpcfakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plugin API v2", func() {
+	var (
+		err             error
+		client          *rpc.Client
+		rpcService      *CliRpcService
+		fakeActor       *rpcfakes.FakeV2Actor
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeLogCache    *sharedactionfakes.FakeLogCacheClient
+		newDepsCalls    int
+	)
+
+	BeforeEach(func() {
+		rpc.DefaultServer = rpc.NewServer()
+
+		fakeActor = new(rpcfakes.FakeV2Actor)
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeLogCache = new(sharedactionfakes.FakeLogCacheClient)
+		newDepsCalls = 0
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+
+		rpcService, err = NewRpcService(nil, nil, nil, api.RepositoryLocator{}, nil, nil, nil, rpc.DefaultServer)
+		Expect(err).ToNot(HaveOccurred())
+
+		rpcService.RpcCmdV2.NewDependencies = func() (V2Dependencies, error) {
+			newDepsCalls++
+			return V2Dependencies{
+				Actor:          fakeActor,
+				Config:         fakeConfig,
+				SharedActor:    fakeSharedActor,
+				LogCacheClient: fakeLogCache,
+			}, nil
+		}
+
+		err = rpcService.Start()
+		Expect(err).ToNot(HaveOccurred())
+
+		pingCli(rpcService.Port())
+
+		client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		client.Close()
+		rpcService.Stop()
+
+		//give time for server to stop
+		time.Sleep(50 * time.Millisecond)
+	})
+
+	Describe("GetApps", func() {
+		BeforeEach(func() {
+			fakeActor.GetAppSummariesForSpaceReturns([]v7action.ApplicationSummary{
+				{
+					Application: resources.Application{
+						GUID:                "app-guid",
+						Name:                "some-app",
+						State:               constant.ApplicationStarted,
+						SpaceGUID:           "some-space-guid",
+						LifecycleType:       constant.AppLifecycleTypeBuildpack,
+						LifecycleBuildpacks: []string{"ruby_buildpack"},
+						StackName:           "cflinuxfs3",
+						Metadata: &resources.Metadata{Labels: map[string]types.NullString{
+							"env":     types.NewNullString("prod"),
+							"removed": types.NewNullString(),
+						}},
+					},
+					ProcessSummaries: v7action.ProcessSummaries{
+						{
+							Process: resources.Process{
+								GUID:       "process-guid",
+								Type:       constant.ProcessTypeWeb,
+								Instances:  types.NullInt{Value: 2, IsSet: true},
+								MemoryInMB: types.NullUint64{Value: 256, IsSet: true},
+							},
+							InstanceDetails: []v7action.ProcessInstance{
+								{Index: 0, State: constant.ProcessInstanceRunning},
+								{Index: 1, State: constant.ProcessInstanceStarting},
+							},
+						},
+					},
+					Routes: []resources.Route{{GUID: "route-guid", URL: "some-app.example.com"}},
+				},
+			}, v7action.Warnings{"some-warning"}, nil)
+		})
+
+		It("returns the apps in the targeted space with their processes", func() {
+			var apps []plugin_models.App
+			err = client.Call("CliRpcCmdV2.GetApps", "env=prod", &apps)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			orgRequired, spaceRequired := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(orgRequired).To(BeTrue())
+			Expect(spaceRequired).To(BeTrue())
+
+			spaceGUID, labelSelector := fakeActor.GetAppSummariesForSpaceArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(labelSelector).To(Equal("env=prod"))
+
+			Expect(apps).To(HaveLen(1))
+			Expect(apps[0].Guid).To(Equal("app-guid"))
+			Expect(apps[0].Name).To(Equal("some-app"))
+			Expect(apps[0].State).To(Equal("STARTED"))
+			Expect(apps[0].LifecycleType).To(Equal("buildpack"))
+			Expect(apps[0].Buildpacks).To(Equal([]string{"ruby_buildpack"}))
+			Expect(apps[0].Stack).To(Equal("cflinuxfs3"))
+			Expect(apps[0].Labels).To(Equal(map[string]string{"env": "prod"}))
+			Expect(apps[0].Routes).To(HaveLen(1))
+			Expect(apps[0].Routes[0].URL).To(Equal("some-app.example.com"))
+
+			Expect(apps[0].Processes).To(HaveLen(1))
+			process := apps[0].Processes[0]
+			Expect(process.Guid).To(Equal("process-guid"))
+			Expect(process.Type).To(Equal("web"))
+			Expect(process.Instances).To(Equal(2))
+			Expect(process.RunningInstances).To(Equal(1))
+			Expect(process.MemoryInMB).To(Equal(uint64(256)))
+			Expect(process.InstanceDetails).To(HaveLen(2))
+			Expect(process.InstanceDetails[1].State).To(Equal("STARTING"))
+		})
+
+		It("builds the dependencies only once", func() {
+			var apps []plugin_models.App
+			Expect(client.Call("CliRpcCmdV2.GetApps", "", &apps)).To(Succeed())
+			Expect(client.Call("CliRpcCmdV2.GetApps", "", &apps)).To(Succeed())
+			Expect(newDepsCalls).To(Equal(1))
+		})
+
+		When("no space is targeted", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(actionerror.NoSpaceTargetedError{BinaryName: "cf"})
+			})
+
+			It("returns the rendered error without calling the actor", func() {
+				var apps []plugin_models.App
+				err = client.Call("CliRpcCmdV2.GetApps", "", &apps)
+				Expect(err).To(MatchError("No space targeted, use 'cf target -s SPACE' to target a space."))
+				Expect(fakeActor.GetAppSummariesForSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the dependencies cannot be built", func() {
+			BeforeEach(func() {
+				rpcService.RpcCmdV2.NewDependencies = func() (V2Dependencies, error) {
+					return V2Dependencies{}, errors.New("not logged in")
+				}
+			})
+
+			It("returns the error", func() {
+				var apps []plugin_models.App
+				err = client.Call("CliRpcCmdV2.GetApps", "", &apps)
+				Expect(err).To(MatchError("not logged in"))
+			})
+		})
+	})
+
+	Describe("GetApp", func() {
+		BeforeEach(func() {
+			fakeActor.GetDetailedAppSummaryReturns(v7action.DetailedApplicationSummary{
+				ApplicationSummary: v7action.ApplicationSummary{
+					Application: resources.Application{GUID: "app-guid", Name: "some-app"},
+					ProcessSummaries: v7action.ProcessSummaries{
+						{
+							Process: resources.Process{Type: constant.ProcessTypeWeb},
+							InstanceDetails: []v7action.ProcessInstance{
+								{Index: 0, State: constant.ProcessInstanceRunning, CPU: 0.5, MemoryUsage: 1024, Uptime: time.Minute},
+							},
+						},
+					},
+				},
+				CurrentDroplet: resources.Droplet{GUID: "droplet-guid"},
+			}, nil, nil)
+		})
+
+		It("returns the app with its instances and droplet", func() {
+			var app plugin_models.App
+			err = client.Call("CliRpcCmdV2.GetApp", "some-app", &app)
+			Expect(err).ToNot(HaveOccurred())
+
+			appName, spaceGUID, _ := fakeActor.GetDetailedAppSummaryArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(app.Guid).To(Equal("app-guid"))
+			Expect(app.DropletGuid).To(Equal("droplet-guid"))
+			Expect(app.Processes[0].InstanceDetails).To(Equal([]plugin_models.ProcessInstance{
+				{Index: 0, State: "RUNNING", CPU: 0.5, MemoryUsage: 1024, Uptime: time.Minute},
+			}))
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetDetailedAppSummaryReturns(v7action.DetailedApplicationSummary{}, nil, actionerror.ApplicationNotFoundError{Name: "some-app"})
+			})
+
+			It("returns the error", func() {
+				var app plugin_models.App
+				err = client.Call("CliRpcCmdV2.GetApp", "some-app", &app)
+				Expect(err).To(MatchError("App 'some-app' not found."))
+			})
+		})
+	})
+
+	Describe("GetRoutes", func() {
+		BeforeEach(func() {
+			weight := 80
+			fakeActor.GetRoutesBySpaceReturns([]resources.Route{
+				{
+					GUID:       "route-guid",
+					SpaceGUID:  "some-space-guid",
+					DomainGUID: "domain-guid",
+					Host:       "some-host",
+					Path:       "/path",
+					URL:        "some-host.example.com/path",
+					Destinations: []resources.RouteDestination{
+						{GUID: "destination-guid", App: resources.RouteDestinationApp{GUID: "app-guid"}, Port: 8080, Weight: &weight},
+					},
+				},
+			}, nil, nil)
+		})
+
+		It("returns the routes in the targeted space with their destinations", func() {
+			var routes []plugin_models.Route
+			err = client.Call("CliRpcCmdV2.GetRoutes", "", &routes)
+			Expect(err).ToNot(HaveOccurred())
+
+			spaceGUID, labelSelector := fakeActor.GetRoutesBySpaceArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(labelSelector).To(BeEmpty())
+
+			Expect(routes).To(Equal([]plugin_models.Route{
+				{
+					Guid:       "route-guid",
+					SpaceGuid:  "some-space-guid",
+					DomainGuid: "domain-guid",
+					Host:       "some-host",
+					Path:       "/path",
+					URL:        "some-host.example.com/path",
+					Destinations: []plugin_models.RouteDestination{
+						{Guid: "destination-guid", AppGuid: "app-guid", Port: 8080, Weight: 80},
+					},
+				},
+			}))
+		})
+	})
+
+	Describe("service credential bindings", func() {
+		var binding resources.ServiceCredentialBinding
+
+		BeforeEach(func() {
+			binding = resources.ServiceCredentialBinding{
+				GUID:                "binding-guid",
+				Name:                "some-binding",
+				Type:                resources.AppBinding,
+				AppGUID:             "app-guid",
+				ServiceInstanceGUID: "instance-guid",
+				LastOperation:       resources.LastOperation{Type: resources.CreateOperation, State: resources.OperationSucceeded},
+			}
+			fakeActor.GetServiceAppBindingsForAppReturns([]resources.ServiceCredentialBinding{binding}, nil, nil)
+			fakeActor.GetServiceKeysByServiceInstanceReturns([]resources.ServiceCredentialBinding{{GUID: "key-guid", Name: "some-key", Type: resources.KeyBinding}}, nil, nil)
+		})
+
+		It("returns the app's bindings", func() {
+			var bindings []plugin_models.ServiceCredentialBinding
+			err = client.Call("CliRpcCmdV2.GetServiceAppBindings", "some-app", &bindings)
+			Expect(err).ToNot(HaveOccurred())
+
+			appName, spaceGUID := fakeActor.GetServiceAppBindingsForAppArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(bindings).To(Equal([]plugin_models.ServiceCredentialBinding{
+				{
+					Guid:                "binding-guid",
+					Name:                "some-binding",
+					Type:                "app",
+					AppGuid:             "app-guid",
+					ServiceInstanceGuid: "instance-guid",
+					LastOperation:       plugin_models.ServiceCredentialBinding_LastOperation{Type: "create", State: "succeeded"},
+				},
+			}))
+		})
+
+		It("returns the service instance's keys", func() {
+			var keys []plugin_models.ServiceCredentialBinding
+			err = client.Call("CliRpcCmdV2.GetServiceKeys", "some-instance", &keys)
+			Expect(err).ToNot(HaveOccurred())
+
+			instanceName, spaceGUID := fakeActor.GetServiceKeysByServiceInstanceArgsForCall(0)
+			Expect(instanceName).To(Equal("some-instance"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(keys).To(HaveLen(1))
+			Expect(keys[0].Name).To(Equal("some-key"))
+			Expect(keys[0].Type).To(Equal("key"))
+		})
+	})
+
+	Describe("GetDeployment", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{GUID: "app-guid"}, nil, nil)
+			fakeActor.GetLatestActiveDeploymentForAppReturns(resources.Deployment{
+				GUID:        "deployment-guid",
+				State:       constant.DeploymentDeploying,
+				StatusValue: constant.DeploymentStatusValueActive,
+				Strategy:    constant.DeploymentStrategyRolling,
+				DropletGUID: "droplet-guid",
+			}, nil, nil)
+		})
+
+		It("returns the app's active deployment", func() {
+			var deployment plugin_models.Deployment
+			err = client.Call("CliRpcCmdV2.GetDeployment", "some-app", &deployment)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetLatestActiveDeploymentForAppArgsForCall(0)).To(Equal("app-guid"))
+			Expect(deployment).To(Equal(plugin_models.Deployment{
+				Guid:        "deployment-guid",
+				State:       "DEPLOYING",
+				StatusValue: "ACTIVE",
+				Strategy:    "rolling",
+				DropletGuid: "droplet-guid",
+			}))
+		})
+
+		When("the app has no active deployment", func() {
+			BeforeEach(func() {
+				fakeActor.GetLatestActiveDeploymentForAppReturns(resources.Deployment{}, nil, actionerror.ActiveDeploymentNotFoundError{})
+			})
+
+			It("returns the error", func() {
+				var deployment plugin_models.Deployment
+				err = client.Call("CliRpcCmdV2.GetDeployment", "some-app", &deployment)
+				Expect(err).To(MatchError(actionerror.ActiveDeploymentNotFoundError{}.Error()))
+			})
+		})
+	})
+
+	Describe("GetLabels", func() {
+		BeforeEach(func() {
+			labels := map[string]types.NullString{"env": types.NewNullString("prod")}
+			fakeActor.GetApplicationLabelsReturns(labels, nil, nil)
+			fakeActor.GetSpaceLabelsReturns(labels, nil, nil)
+			fakeActor.GetStackLabelsReturns(labels, nil, nil)
+		})
+
+		It("returns app labels from the targeted space", func() {
+			var labels map[string]string
+			err = client.Call("CliRpcCmdV2.GetLabels", plugin_models.LabelsRequest{ResourceType: "app", ResourceName: "some-app"}, &labels)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(labels).To(Equal(map[string]string{"env": "prod"}))
+
+			appName, spaceGUID := fakeActor.GetApplicationLabelsArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+
+		It("returns space labels from the targeted org without requiring a targeted space", func() {
+			var labels map[string]string
+			err = client.Call("CliRpcCmdV2.GetLabels", plugin_models.LabelsRequest{ResourceType: "Space", ResourceName: "some-space"}, &labels)
+			Expect(err).ToNot(HaveOccurred())
+
+			orgRequired, spaceRequired := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(orgRequired).To(BeTrue())
+			Expect(spaceRequired).To(BeFalse())
+
+			spaceName, orgGUID := fakeActor.GetSpaceLabelsArgsForCall(0)
+			Expect(spaceName).To(Equal("some-space"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+		})
+
+		It("returns stack labels without requiring a target", func() {
+			var labels map[string]string
+			err = client.Call("CliRpcCmdV2.GetLabels", plugin_models.LabelsRequest{ResourceType: "stack", ResourceName: "cflinuxfs3"}, &labels)
+			Expect(err).ToNot(HaveOccurred())
+
+			orgRequired, spaceRequired := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(orgRequired).To(BeFalse())
+			Expect(spaceRequired).To(BeFalse())
+			Expect(fakeActor.GetStackLabelsArgsForCall(0)).To(Equal("cflinuxfs3"))
+		})
+
+		It("returns an error for unsupported resource types", func() {
+			var labels map[string]string
+			err = client.Call("CliRpcCmdV2.GetLabels", plugin_models.LabelsRequest{ResourceType: "buildpack", ResourceName: "some-buildpack"}, &labels)
+			Expect(err).To(MatchError("Unsupported resource type of 'buildpack'"))
+		})
+	})
+
+	Describe("log streams", func() {
+		var (
+			messages chan sharedaction.LogMessage
+			logErrs  chan error
+			stopped  bool
+		)
+
+		BeforeEach(func() {
+			messages = make(chan sharedaction.LogMessage, 3)
+			logErrs = make(chan error, 1)
+			stopped = false
+
+			fakeActor.GetStreamingLogsForApplicationByNameAndSpaceReturns(messages, logErrs, func() { stopped = true }, nil, nil)
+		})
+
+		readAll := func(id string) plugin_models.LogBatch {
+			var all plugin_models.LogBatch
+			for !all.Done {
+				var batch plugin_models.LogBatch
+				Expect(client.Call("CliRpcCmdV2.ReadLogStream", id, &batch)).To(Succeed())
+				all.Messages = append(all.Messages, batch.Messages...)
+				all.Errors = append(all.Errors, batch.Errors...)
+				all.Done = batch.Done
+			}
+			return all
+		}
+
+		It("streams the app's logs until the stream ends", func() {
+			var id string
+			err = client.Call("CliRpcCmdV2.StartLogStream", "some-app", &id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(id).ToNot(BeEmpty())
+
+			appName, spaceGUID, logCacheClient := fakeActor.GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(logCacheClient).To(Equal(fakeLogCache))
+
+			timestamp := time.Unix(1600000000, 0)
+			messages <- *sharedaction.NewLogMessage("message-1", "OUT", timestamp, "APP/PROC/WEB", "0")
+			messages <- *sharedaction.NewLogMessage("message-2", "ERR", timestamp, "APP/PROC/WEB", "1")
+			logErrs <- errors.New("some-log-error")
+			close(messages)
+			close(logErrs)
+
+			batch := readAll(id)
+			Expect(batch.Messages).To(Equal([]plugin_models.LogMessage{
+				{Message: "message-1", MessageType: "OUT", Timestamp: timestamp, SourceType: "APP/PROC/WEB", SourceInstance: "0"},
+				{Message: "message-2", MessageType: "ERR", Timestamp: timestamp, SourceType: "APP/PROC/WEB", SourceInstance: "1"},
+			}))
+			Expect(batch.Errors).To(Equal([]string{"some-log-error"}))
+			Expect(stopped).To(BeTrue())
+
+			var batchAfterDone plugin_models.LogBatch
+			err = client.Call("CliRpcCmdV2.ReadLogStream", id, &batchAfterDone)
+			Expect(err).To(MatchError("Log stream '" + id + "' not found"))
+		})
+
+		It("returns an empty batch when no logs arrive in time", func() {
+			var id string
+			Expect(client.Call("CliRpcCmdV2.StartLogStream", "some-app", &id)).To(Succeed())
+
+			var batch plugin_models.LogBatch
+			Expect(client.Call("CliRpcCmdV2.ReadLogStream", id, &batch)).To(Succeed())
+			Expect(batch.Messages).To(BeEmpty())
+			Expect(batch.Done).To(BeFalse())
+		})
+
+		It("stops a stream on request", func() {
+			var id string
+			Expect(client.Call("CliRpcCmdV2.StartLogStream", "some-app", &id)).To(Succeed())
+
+			var success bool
+			Expect(client.Call("CliRpcCmdV2.StopLogStream", id, &success)).To(Succeed())
+			Expect(success).To(BeTrue())
+			Expect(stopped).To(BeTrue())
+		})
+
+		It("stops open streams when the service stops", func() {
+			var id string
+			Expect(client.Call("CliRpcCmdV2.StartLogStream", "some-app", &id)).To(Succeed())
+
+			rpcService.RpcCmdV2.StopLogStreams()
+			Expect(stopped).To(BeTrue())
+		})
+
+		When("the app cannot be found", func() {
+			BeforeEach(func() {
+				fakeActor.GetStreamingLogsForApplicationByNameAndSpaceReturns(nil, nil, nil, v7action.Warnings{"some-warning"}, actionerror.ApplicationNotFoundError{Name: "some-app"})
+			})
+
+			It("returns the error", func() {
+				var id string
+				err = client.Call("CliRpcCmdV2.StartLogStream", "some-app", &id)
+				Expect(err).To(MatchError("App 'some-app' not found."))
+			})
+		})
+	})
+})
@@ -0,0 +1,172 @@
+package rpc
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/rpc"
+	"strings"
+	"sync"
+)
+
+// JSON-RPC 2.0 error codes returned to plugins.
+const (
+	JSONRPCParseError     = -32700
+	JSONRPCMethodNotFound = -32601
+	JSONRPCInvalidParams  = -32602
+	JSONRPCServerError    = -32000
+)
+
+const invalidParamsPrefix = "invalid params: "
+
+// jsonRPCRequest keeps its ID raw, so a request without an id, which is a
+// notification, can be told apart from one with "id": null.
+type jsonRPCRequest struct {
+	Version string           `json:"jsonrpc"`
+	Method  string           `json:"method"`
+	Params  *json.RawMessage `json:"params"`
+	ID      json.RawMessage  `json:"id"`
+}
+
+type jsonRPCResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *JSONRPCError   `json:"error,omitempty"`
+}
+
+type JSONRPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type jsonServerCodec struct {
+	decoder *json.Decoder
+	encoder *json.Encoder
+	closer  io.Closer
+
+	request jsonRPCRequest
+
+	// encoderMutex serializes writes, since parse errors are written by the
+	// reading goroutine while responses are written by the method calls.
+	encoderMutex sync.Mutex
+
+	mutex   sync.Mutex
+	seq     uint64
+	pending map[uint64]json.RawMessage
+}
+
+// NewJSONServerCodec returns a net/rpc codec speaking JSON-RPC 2.0, so plugins
+// can be written in languages without a gob implementation. See
+// plugin/plugin_examples/JSON_RPC.md for the protocol.
+func NewJSONServerCodec(conn io.ReadWriteCloser) rpc.ServerCodec {
+	return &jsonServerCodec{
+		decoder: json.NewDecoder(conn),
+		encoder: json.NewEncoder(conn),
+		closer:  conn,
+		pending: map[uint64]json.RawMessage{},
+	}
+}
+
+func (c *jsonServerCodec) ReadRequestHeader(r *rpc.Request) error {
+	c.request = jsonRPCRequest{}
+	err := c.decoder.Decode(&c.request)
+	if err != nil {
+		if err != io.EOF && err != io.ErrUnexpectedEOF {
+			c.writeError(nil, JSONRPCParseError, err.Error())
+		}
+		return err
+	}
+
+	r.ServiceMethod = c.request.Method
+
+	c.mutex.Lock()
+	c.seq++
+	c.pending[c.seq] = c.request.ID
+	r.Seq = c.seq
+	c.mutex.Unlock()
+
+	return nil
+}
+
+// ReadRequestBody accepts the single argument of a net/rpc method either on
+// its own or as the only element of a params array.
+func (c *jsonServerCodec) ReadRequestBody(x interface{}) error {
+	if x == nil || c.request.Params == nil {
+		return nil
+	}
+
+	params := []byte(*c.request.Params)
+	if strings.HasPrefix(strings.TrimSpace(string(params)), "[") {
+		var positional []json.RawMessage
+		if err := json.Unmarshal(params, &positional); err != nil {
+			return errors.New(invalidParamsPrefix + err.Error())
+		}
+		if len(positional) != 1 {
+			return errors.New(invalidParamsPrefix + "expected exactly one argument")
+		}
+		params = positional[0]
+	}
+
+	if err := json.Unmarshal(params, x); err != nil {
+		return errors.New(invalidParamsPrefix + err.Error())
+	}
+
+	return nil
+}
+
+func (c *jsonServerCodec) WriteResponse(r *rpc.Response, x interface{}) error {
+	c.mutex.Lock()
+	id, ok := c.pending[r.Seq]
+	if !ok {
+		c.mutex.Unlock()
+		return errors.New("invalid sequence number in response")
+	}
+	delete(c.pending, r.Seq)
+	c.mutex.Unlock()
+
+	// Requests without an id are notifications, which get no response.
+	if len(id) == 0 {
+		return nil
+	}
+
+	if r.Error != "" {
+		return c.writeError(id, errorCode(r.Error), strings.TrimPrefix(r.Error, invalidParamsPrefix))
+	}
+
+	return c.encode(jsonRPCResponse{Version: "2.0", ID: id, Result: x})
+}
+
+func (c *jsonServerCodec) Close() error {
+	return c.closer.Close()
+}
+
+func (c *jsonServerCodec) writeError(id json.RawMessage, code int, message string) error {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+
+	return c.encode(jsonRPCResponse{
+		Version: "2.0",
+		ID:      id,
+		Error:   &JSONRPCError{Code: code, Message: message},
+	})
+}
+
+func (c *jsonServerCodec) encode(response jsonRPCResponse) error {
+	c.encoderMutex.Lock()
+	defer c.encoderMutex.Unlock()
+
+	return c.encoder.Encode(response)
+}
+
+func errorCode(message string) int {
+	switch {
+	case strings.HasPrefix(message, invalidParamsPrefix):
+		return JSONRPCInvalidParams
+	case strings.HasPrefix(message, "rpc: can't find service"), strings.HasPrefix(message, "rpc: can't find method"):
+		return JSONRPCMethodNotFound
+	default:
+		return JSONRPCServerError
+	}
+}
@@ -0,0 +1,231 @@
+package rpc_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"net"
+	"net/rpc"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/models"
+	testconfig "code.cloudfoundry.org/cli/cf/util/testhelpers/configuration"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/plugin/rpc"
+	"code.cloudfoundry.org/cli/plugin/rpc/rpcfakes"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type jsonRPCTestResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result"`
+	Error   *JSONRPCError   `json:"error"`
+}
+
+var _ = Describe("JSON-RPC transport", func() {
+	var (
+		rpcService *CliRpcService
+		fakeActor  *rpcfakes.FakeV2Actor
+		conn       net.Conn
+		responses  *bufio.Reader
+	)
+
+	send := func(request string) {
+		_, err := conn.Write([]byte(request + "\n"))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	receive := func() jsonRPCTestResponse {
+		Expect(conn.SetReadDeadline(time.Now().Add(2 * time.Second))).To(Succeed())
+		line, err := responses.ReadBytes('\n')
+		Expect(err).ToNot(HaveOccurred())
+
+		var response jsonRPCTestResponse
+		Expect(json.Unmarshal(line, &response)).To(Succeed())
+		Expect(response.Version).To(Equal("2.0"))
+		return response
+	}
+
+	BeforeEach(func() {
+		config := testconfig.NewRepositoryWithDefaults()
+		config.SetOrganizationFields(models.OrganizationFields{GUID: "test-guid", Name: "test-org"})
+
+		var err error
+		rpcService, err = NewRpcService(nil, nil, config, api.RepositoryLocator{}, nil, nil, nil, rpc.NewServer())
+		Expect(err).ToNot(HaveOccurred())
+
+		fakeActor = new(rpcfakes.FakeV2Actor)
+		rpcService.RpcCmdV2.NewDependencies = func() (V2Dependencies, error) {
+			return V2Dependencies{
+				Actor:       fakeActor,
+				Config:      new(commandfakes.FakeConfig),
+				SharedActor: new(commandfakes.FakeSharedActor),
+			}, nil
+		}
+		Expect(rpcService.Start()).To(Succeed())
+
+		pingCli(rpcService.Port())
+
+		conn, err = net.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+		Expect(err).ToNot(HaveOccurred())
+		responses = bufio.NewReader(conn)
+	})
+
+	AfterEach(func() {
+		conn.Close()
+		rpcService.Stop()
+	})
+
+	It("answers requests with the result of the method", func() {
+		send(`{"jsonrpc":"2.0","method":"CliRpcCmd.GetCurrentOrg","params":[""],"id":1}`)
+
+		response := receive()
+		Expect(string(response.ID)).To(Equal("1"))
+		Expect(response.Error).To(BeNil())
+
+		var org map[string]interface{}
+		Expect(json.Unmarshal(response.Result, &org)).To(Succeed())
+		Expect(org).To(HaveKeyWithValue("Name", "test-org"))
+		Expect(org).To(HaveKeyWithValue("Guid", "test-guid"))
+	})
+
+	It("accepts the method argument without a params array", func() {
+		send(`{"jsonrpc":"2.0","method":"CliRpcCmd.SetPluginMetadata","params":{"Name":"py-plugin","Commands":[{"Name":"hello","HelpText":"says hello"}]},"id":"metadata"}`)
+
+		response := receive()
+		Expect(string(response.ID)).To(Equal(`"metadata"`))
+		Expect(string(response.Result)).To(Equal("true"))
+		Expect(rpcService.RpcCmd.PluginMetadata.Name).To(Equal("py-plugin"))
+		Expect(rpcService.RpcCmd.PluginMetadata.Commands[0].Name).To(Equal("hello"))
+	})
+
+	It("serves the v2 plugin API", func() {
+		fakeActor.GetOrganizationLabelsReturns(map[string]types.NullString{"env": types.NewNullString("prod")}, nil, nil)
+
+		send(`{"jsonrpc":"2.0","method":"CliRpcCmdV2.GetLabels","params":[{"ResourceType":"org","ResourceName":"some-org"}],"id":2}`)
+
+		response := receive()
+		Expect(response.Error).To(BeNil())
+		Expect(string(response.Result)).To(MatchJSON(`{"env":"prod"}`))
+	})
+
+	It("answers every request on one connection", func() {
+		send(`{"jsonrpc":"2.0","method":"CliRpcCmd.IsMinCliVersion","params":["6.0.0"],"id":1}`)
+		send(`{"jsonrpc":"2.0","method":"CliRpcCmd.IsMinCliVersion","params":["6.0.0"],"id":2}`)
+
+		ids := []string{string(receive().ID), string(receive().ID)}
+		Expect(ids).To(ConsistOf("1", "2"))
+	})
+
+	It("answers a request that finishes first before the requests sent earlier", func() {
+		fakeActor.GetDetailedAppSummaryStub = func(string, string, bool) (v7action.DetailedApplicationSummary, v7action.Warnings, error) {
+			time.Sleep(100 * time.Millisecond)
+			return v7action.DetailedApplicationSummary{}, nil, actionerror.ApplicationNotFoundError{Name: "some-app"}
+		}
+
+		send(`{"jsonrpc":"2.0","method":"CliRpcCmdV2.GetApp","params":["some-app"],"id":1}`)
+		send(`{"jsonrpc":"2.0","method":"CliRpcCmd.IsMinCliVersion","params":["6.0.0"],"id":2}`)
+
+		ids := []string{string(receive().ID), string(receive().ID)}
+		Expect(ids).To(Equal([]string{"2", "1"}))
+	})
+
+	It("does not answer notifications", func() {
+		send(`{"jsonrpc":"2.0","method":"CliRpcCmd.IsMinCliVersion","params":["6.0.0"]}`)
+		send(`{"jsonrpc":"2.0","method":"CliRpcCmd.IsMinCliVersion","params":["6.0.0"],"id":3}`)
+
+		Expect(string(receive().ID)).To(Equal("3"))
+	})
+
+	It("answers requests with a null id", func() {
+		send(`{"jsonrpc":"2.0","method":"CliRpcCmd.IsMinCliVersion","params":["6.0.0"],"id":null}`)
+
+		response := receive()
+		Expect(string(response.ID)).To(Equal("null"))
+		Expect(string(response.Result)).To(Equal("true"))
+	})
+
+	It("returns method not found for unknown methods", func() {
+		send(`{"jsonrpc":"2.0","method":"CliRpcCmd.DoesNotExist","params":[""],"id":4}`)
+
+		response := receive()
+		Expect(string(response.ID)).To(Equal("4"))
+		Expect(response.Error.Code).To(Equal(JSONRPCMethodNotFound))
+	})
+
+	It("returns invalid params when the argument does not decode", func() {
+		send(`{"jsonrpc":"2.0","method":"CliRpcCmd.GetApp","params":["one","two"],"id":5}`)
+
+		response := receive()
+		Expect(response.Error.Code).To(Equal(JSONRPCInvalidParams))
+		Expect(response.Error.Message).To(Equal("expected exactly one argument"))
+
+		send(`{"jsonrpc":"2.0","method":"CliRpcCmd.GetApp","params":[42],"id":6}`)
+
+		response = receive()
+		Expect(response.Error.Code).To(Equal(JSONRPCInvalidParams))
+	})
+
+	It("returns errors from the method", func() {
+		fakeActor.GetDetailedAppSummaryReturns(v7action.DetailedApplicationSummary{}, nil, actionerror.ApplicationNotFoundError{Name: "some-app"})
+
+		send(`{"jsonrpc":"2.0","method":"CliRpcCmdV2.GetApp","params":["some-app"],"id":7}`)
+
+		response := receive()
+		Expect(response.Error.Code).To(Equal(JSONRPCServerError))
+		Expect(response.Error.Message).To(Equal("App 'some-app' not found."))
+		Expect(response.Result).To(BeNil())
+	})
+
+	It("returns a parse error and closes the connection on malformed JSON", func() {
+		send(`{"jsonrpc":"2.0","method":`)
+		send(`}`)
+
+		response := receive()
+		Expect(string(response.ID)).To(Equal("null"))
+		Expect(response.Error.Code).To(Equal(JSONRPCParseError))
+
+		_, err := responses.ReadBytes('\n')
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("returns a parse error for batch requests", func() {
+		send(`{"jsonrpc":"2.0","method":"CliRpcCmd.IsMinCliVersion","params":["6.0.0"],"id":1}`)
+		Expect(string(receive().ID)).To(Equal("1"))
+
+		send(`[{"jsonrpc":"2.0","method":"CliRpcCmd.IsMinCliVersion","params":["6.0.0"],"id":2}]`)
+
+		response := receive()
+		Expect(string(response.ID)).To(Equal("null"))
+		Expect(response.Error.Code).To(Equal(JSONRPCParseError))
+	})
+
+	It("still answers the requests that are running when it returns a parse error", func() {
+		fakeActor.GetDetailedAppSummaryStub = func(string, string, bool) (v7action.DetailedApplicationSummary, v7action.Warnings, error) {
+			time.Sleep(50 * time.Millisecond)
+			return v7action.DetailedApplicationSummary{}, nil, actionerror.ApplicationNotFoundError{Name: "some-app"}
+		}
+
+		send(`{"jsonrpc":"2.0","method":"CliRpcCmdV2.GetApp","params":["some-app"],"id":8}`)
+		send(`{"jsonrpc":"2.0","method":`)
+		send(`}`)
+
+		ids := []string{string(receive().ID), string(receive().ID)}
+		Expect(ids).To(ConsistOf("null", "8"))
+	})
+
+	It("still serves gob clients", func() {
+		client, err := rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+		Expect(err).ToNot(HaveOccurred())
+		defer client.Close()
+
+		var result bool
+		Expect(client.Call("CliRpcCmd.IsMinCliVersion", "6.0.0", &result)).To(Succeed())
+		Expect(result).To(BeTrue())
+	})
+})
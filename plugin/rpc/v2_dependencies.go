@@ -0,0 +1,47 @@
+package rpc
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/clock"
+)
+
+// NewV2Dependencies loads the CLI config and connects to the targeted API the
+// same way v7 commands do.
+func NewV2Dependencies() (V2Dependencies, error) {
+	config, err := configv3.LoadConfig()
+	if err != nil {
+		if _, ok := err.(translatableerror.EmptyConfigError); !ok {
+			return V2Dependencies{}, err
+		}
+	}
+
+	commandUI, err := ui.NewUI(config)
+	if err != nil {
+		return V2Dependencies{}, err
+	}
+
+	sharedActor := sharedaction.NewActor(config)
+
+	ccClient, uaaClient, routingClient, err := shared.GetNewClientsAndConnectToCF(config, commandUI, "")
+	if err != nil {
+		return V2Dependencies{}, err
+	}
+
+	logCacheClient, err := logcache.NewClient(config.LogCacheEndpoint(), config, commandUI, v7action.NewDefaultKubernetesConfigGetter())
+	if err != nil {
+		return V2Dependencies{}, err
+	}
+
+	return V2Dependencies{
+		Actor:          v7action.NewActor(ccClient, config, sharedActor, uaaClient, routingClient, clock.NewClock()),
+		Config:         config,
+		SharedActor:    sharedActor,
+		LogCacheClient: logCacheClient,
+	}, nil
+}
@@ -18,6 +18,30 @@ type Deployment struct {
 	UpdatedAt     string
 	Relationships Relationships
 	NewProcesses  []Process
+	Strategy      constant.DeploymentStrategy
+	CanarySteps   []CanaryStep
+	CanaryStatus  CanaryStatus
+}
+
+// CanaryStep is a step of a canary deployment. The deployment pauses after
+// InstanceWeight percent of the instances have been replaced.
+type CanaryStep struct {
+	InstanceWeight int64 `json:"instance_weight"`
+}
+
+// NewCanarySteps returns a canary step for each of the instance weights.
+func NewCanarySteps(instanceWeights []int64) []CanaryStep {
+	var steps []CanaryStep
+	for _, weight := range instanceWeights {
+		steps = append(steps, CanaryStep{InstanceWeight: weight})
+	}
+	return steps
+}
+
+// CanaryStatus describes the progress of a canary deployment.
+type CanaryStatus struct {
+	CurrentStep int
+	TotalSteps  int
 }
 
 // MarshalJSON converts a Deployment into a Cloud Controller Deployment.
@@ -29,10 +53,19 @@ func (d Deployment) MarshalJSON() ([]byte, error) {
 		GUID string `json:"guid,omitempty"`
 	}
 
+	type Canary struct {
+		Steps []CanaryStep `json:"steps"`
+	}
+	type Options struct {
+		Canary *Canary `json:"canary,omitempty"`
+	}
+
 	var ccDeployment struct {
-		Droplet       *Droplet      `json:"droplet,omitempty"`
-		Revision      *Revision     `json:"revision,omitempty"`
-		Relationships Relationships `json:"relationships,omitempty"`
+		Droplet       *Droplet                    `json:"droplet,omitempty"`
+		Revision      *Revision                   `json:"revision,omitempty"`
+		Strategy      constant.DeploymentStrategy `json:"strategy,omitempty"`
+		Options       *Options                    `json:"options,omitempty"`
+		Relationships Relationships               `json:"relationships,omitempty"`
 	}
 
 	if d.DropletGUID != "" {
@@ -43,6 +76,12 @@ func (d Deployment) MarshalJSON() ([]byte, error) {
 		ccDeployment.Revision = &Revision{d.RevisionGUID}
 	}
 
+	ccDeployment.Strategy = d.Strategy
+
+	if d.Strategy == constant.DeploymentStrategyCanary && len(d.CanarySteps) > 0 {
+		ccDeployment.Options = &Options{Canary: &Canary{Steps: d.CanarySteps}}
+	}
+
 	ccDeployment.Relationships = d.Relationships
 
 	return json.Marshal(ccDeployment)
@@ -58,7 +97,19 @@ func (d *Deployment) UnmarshalJSON(data []byte) error {
 		Status        struct {
 			Value  constant.DeploymentStatusValue  `json:"value"`
 			Reason constant.DeploymentStatusReason `json:"reason"`
+			Canary struct {
+				Steps struct {
+					Current int `json:"current"`
+					Total   int `json:"total"`
+				} `json:"steps"`
+			} `json:"canary"`
 		} `json:"status"`
+		Strategy constant.DeploymentStrategy `json:"strategy,omitempty"`
+		Options  struct {
+			Canary struct {
+				Steps []CanaryStep `json:"steps"`
+			} `json:"canary"`
+		} `json:"options"`
 		Droplet      Droplet   `json:"droplet,omitempty"`
 		NewProcesses []Process `json:"new_processes,omitempty"`
 	}
@@ -75,6 +126,12 @@ func (d *Deployment) UnmarshalJSON(data []byte) error {
 	d.StatusReason = ccDeployment.Status.Reason
 	d.DropletGUID = ccDeployment.Droplet.GUID
 	d.NewProcesses = ccDeployment.NewProcesses
+	d.Strategy = ccDeployment.Strategy
+	d.CanarySteps = ccDeployment.Options.Canary.Steps
+	d.CanaryStatus = CanaryStatus{
+		CurrentStep: ccDeployment.Status.Canary.Steps.Current,
+		TotalSteps:  ccDeployment.Status.Canary.Steps.Total,
+	}
 
 	return nil
 }
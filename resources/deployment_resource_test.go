@@ -0,0 +1,20 @@
+package resources_test
+
+import (
+	. "code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewCanarySteps", func() {
+	It("converts the instance weights into canary steps", func() {
+		Expect(NewCanarySteps([]int64{20, 60})).To(Equal([]CanaryStep{
+			{InstanceWeight: 20},
+			{InstanceWeight: 60},
+		}))
+	})
+
+	It("returns nil when no weights are provided", func() {
+		Expect(NewCanarySteps(nil)).To(BeNil())
+	})
+})
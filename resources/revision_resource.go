@@ -1,11 +1,18 @@
 package resources
 
 type Revision struct {
-	GUID        string  `json:"guid"`
-	Version     int     `json:"version"`
-	Deployable  bool    `json:"deployable"`
-	Description string  `json:"description"`
-	Droplet     Droplet `json:"droplet"`
-	CreatedAt   string  `json:"created_at"`
-	UpdatedAt   string  `json:"updated_at"`
+	GUID        string                     `json:"guid"`
+	Version     int                        `json:"version"`
+	Deployable  bool                       `json:"deployable"`
+	Description string                     `json:"description"`
+	Droplet     Droplet                    `json:"droplet"`
+	Processes   map[string]RevisionProcess `json:"processes,omitempty"`
+	CreatedAt   string                     `json:"created_at"`
+	UpdatedAt   string                     `json:"updated_at"`
+}
+
+// RevisionProcess is the configuration of a process type recorded in a
+// revision.
+type RevisionProcess struct {
+	Command string `json:"command"`
 }
@@ -18,6 +18,9 @@ type RouteDestination struct {
 	App      RouteDestinationApp
 	Port     int
 	Protocol string
+	// Weight is the percentage of the route's traffic sent to the
+	// destination. It is nil unless the route uses weighted routing.
+	Weight *int
 }
 
 type Route struct {
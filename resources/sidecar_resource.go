@@ -1,9 +1,42 @@
 package resources
 
-import "code.cloudfoundry.org/cli/types"
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/types"
+)
 
 type Sidecar struct {
 	GUID    string               `json:"guid"`
 	Name    string               `json:"name"`
 	Command types.FilteredString `json:"command"`
+	// ProcessTypes are the types of the app's processes that the sidecar runs
+	// alongside.
+	ProcessTypes []string `json:"process_types"`
+	// MemoryInMB is the memory reserved for the sidecar out of the memory of
+	// the processes it runs alongside.
+	MemoryInMB types.NullUint64 `json:"memory_in_mb"`
+}
+
+// MarshalJSON converts a Sidecar into a Cloud Controller Sidecar, leaving out
+// the fields that are not set.
+func (sidecar Sidecar) MarshalJSON() ([]byte, error) {
+	ccSidecar := struct {
+		Name         string   `json:"name,omitempty"`
+		Command      *string  `json:"command,omitempty"`
+		ProcessTypes []string `json:"process_types,omitempty"`
+		MemoryInMB   *uint64  `json:"memory_in_mb,omitempty"`
+	}{
+		Name:         sidecar.Name,
+		ProcessTypes: sidecar.ProcessTypes,
+	}
+
+	if sidecar.Command.IsSet {
+		ccSidecar.Command = &sidecar.Command.Value
+	}
+	if sidecar.MemoryInMB.IsSet {
+		ccSidecar.MemoryInMB = &sidecar.MemoryInMB.Value
+	}
+
+	return json.Marshal(ccSidecar)
 }
@@ -24,6 +24,8 @@ type Task struct {
 	SequenceID int64 `json:"sequence_id,omitempty"`
 	// State represents the task state.
 	State constant.TaskState `json:"state,omitempty"`
+	// Result holds the outcome of a finished task.
+	Result *TaskResult `json:"result,omitempty"`
 	// Tasks can use a process as a template to fill in
 	// command, memory, disk values
 	//
@@ -32,6 +34,12 @@ type Task struct {
 	Template *TaskTemplate `json:"template,omitempty"`
 }
 
+type TaskResult struct {
+	// FailureReason describes why a failed task failed, e.g. "Exited with
+	// status 1".
+	FailureReason string `json:"failure_reason,omitempty"`
+}
+
 type TaskTemplate struct {
 	Process TaskProcessTemplate `json:"process,omitempty"`
 }
@@ -0,0 +1,13 @@
+package authcode_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestAuthcode(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Authcode Suite")
+}
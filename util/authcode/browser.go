@@ -0,0 +1,29 @@
+package authcode
+
+import (
+	"os/exec"
+	"runtime"
+)
+
+// Browser opens URLs in the user's default web browser.
+type Browser struct{}
+
+// Open starts the default web browser with url. It does not wait for the
+// browser to exit.
+func (Browser) Open(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	err := cmd.Start()
+	if err != nil {
+		return err
+	}
+	go cmd.Wait() //nolint:errcheck
+	return nil
+}
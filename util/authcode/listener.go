@@ -0,0 +1,135 @@
+// Package authcode receives OAuth authorization codes on a loopback address,
+// as described in RFC 8252, so that users can log in through a web browser.
+package authcode
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+const callbackPath = "/callback"
+
+// Listener is a local HTTP server that the authorization server redirects the
+// user's web browser to once they have logged in.
+type Listener struct {
+	timeout  time.Duration
+	state    string
+	listener net.Listener
+	server   *http.Server
+	results  chan result
+}
+
+type result struct {
+	code string
+	err  error
+}
+
+// NewListener returns a Listener that waits up to timeout for the redirect.
+func NewListener(timeout time.Duration) *Listener {
+	return &Listener{
+		timeout: timeout,
+		results: make(chan result, 1),
+	}
+}
+
+// Listen starts listening on a random loopback port. It returns the redirect
+// URI to send to the authorization server and the state value the redirect
+// must carry.
+func (l *Listener) Listen() (string, string, error) {
+	state, err := randomState()
+	if err != nil {
+		return "", "", err
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", "", err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(callbackPath, l.handleCallback)
+
+	l.state = state
+	l.listener = listener
+	l.server = &http.Server{Handler: mux}
+	go l.server.Serve(listener) //nolint:errcheck
+
+	return fmt.Sprintf("http://%s%s", listener.Addr().String(), callbackPath), state, nil
+}
+
+// WaitForCode blocks until the authorization server redirects to the
+// listener, and returns the authorization code it provided.
+func (l *Listener) WaitForCode() (string, error) {
+	if l.server == nil {
+		return "", errors.New("listener has not been started")
+	}
+
+	select {
+	case result := <-l.results:
+		return result.code, result.err
+	case <-time.After(l.timeout):
+		return "", fmt.Errorf("Timed out after %s waiting for the web browser login to complete.", l.timeout)
+	}
+}
+
+// Close stops the listener.
+func (l *Listener) Close() error {
+	if l.server == nil {
+		return nil
+	}
+	return l.server.Close()
+}
+
+func (l *Listener) handleCallback(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	// A request without the expected state was not sent by the authorization
+	// server on behalf of this login, so it must not end the wait.
+	if query.Get("state") != l.state {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintln(w, "The web browser login returned an unexpected state.")
+		return
+	}
+
+	var res result
+	switch {
+	case query.Get("error") != "":
+		description := query.Get("error_description")
+		if description == "" {
+			description = query.Get("error")
+		}
+		res.err = fmt.Errorf("The web browser login failed: %s", description)
+	case query.Get("code") == "":
+		res.err = errors.New("The web browser login did not return an authorization code.")
+	default:
+		res.code = query.Get("code")
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if res.err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintln(w, res.err.Error())
+	} else {
+		fmt.Fprintln(w, "Login complete. You can close this window and return to the command line.")
+	}
+
+	select {
+	case l.results <- res:
+	default:
+	}
+}
+
+func randomState() (string, error) {
+	bytes := make([]byte, 16)
+	_, err := rand.Read(bytes)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
@@ -0,0 +1,111 @@
+package authcode_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/authcode"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Listener", func() {
+	var (
+		listener    *Listener
+		redirectURI string
+		state       string
+	)
+
+	BeforeEach(func() {
+		listener = NewListener(time.Second)
+
+		var err error
+		redirectURI, state, err = listener.Listen()
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(listener.Close()).To(Succeed())
+	})
+
+	redirect := func(query url.Values) (int, string) {
+		response, err := http.Get(redirectURI + "?" + query.Encode())
+		Expect(err).NotTo(HaveOccurred())
+		defer response.Body.Close()
+
+		body, err := ioutil.ReadAll(response.Body)
+		Expect(err).NotTo(HaveOccurred())
+		return response.StatusCode, string(body)
+	}
+
+	It("listens on a loopback address", func() {
+		Expect(redirectURI).To(MatchRegexp(`^http://127\.0\.0\.1:\d+/callback$`))
+		Expect(state).NotTo(BeEmpty())
+	})
+
+	When("the redirect carries a code and the expected state", func() {
+		It("returns the code and tells the user they can close the browser", func() {
+			status, body := redirect(url.Values{"code": {"some-code"}, "state": {state}})
+			Expect(status).To(Equal(http.StatusOK))
+			Expect(body).To(ContainSubstring("Login complete."))
+
+			code, err := listener.WaitForCode()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(code).To(Equal("some-code"))
+		})
+	})
+
+	When("the redirect carries a different state", func() {
+		It("rejects the request and keeps waiting for the real redirect", func() {
+			status, _ := redirect(url.Values{"code": {"some-code"}, "state": {"some-other-state"}})
+			Expect(status).To(Equal(http.StatusBadRequest))
+
+			status, _ = redirect(url.Values{"code": {"forged-code"}})
+			Expect(status).To(Equal(http.StatusBadRequest))
+
+			status, _ = redirect(url.Values{"code": {"some-code"}, "state": {state}})
+			Expect(status).To(Equal(http.StatusOK))
+
+			code, err := listener.WaitForCode()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(code).To(Equal("some-code"))
+		})
+
+		It("times out when the real redirect never arrives", func() {
+			status, _ := redirect(url.Values{"code": {"some-code"}, "state": {"some-other-state"}})
+			Expect(status).To(Equal(http.StatusBadRequest))
+
+			_, err := listener.WaitForCode()
+			Expect(err).To(MatchError("Timed out after 1s waiting for the web browser login to complete."))
+		})
+	})
+
+	When("the redirect carries an error", func() {
+		It("returns the error description", func() {
+			status, body := redirect(url.Values{"error": {"access_denied"}, "error_description": {"User denied access"}, "state": {state}})
+			Expect(status).To(Equal(http.StatusBadRequest))
+			Expect(body).To(ContainSubstring("User denied access"))
+
+			_, err := listener.WaitForCode()
+			Expect(err).To(MatchError("The web browser login failed: User denied access"))
+		})
+	})
+
+	When("the redirect carries no code", func() {
+		It("returns an error", func() {
+			redirect(url.Values{"state": {state}})
+
+			_, err := listener.WaitForCode()
+			Expect(err).To(MatchError("The web browser login did not return an authorization code."))
+		})
+	})
+
+	When("no redirect arrives in time", func() {
+		It("returns a timeout error", func() {
+			_, err := listener.WaitForCode()
+			Expect(err).To(MatchError("Timed out after 1s waiting for the web browser login to complete."))
+		})
+	})
+})
@@ -0,0 +1,399 @@
+package clissh
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"code.cloudfoundry.org/cli/util/clissh/ssherror"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ProgressBar
+
+// ProgressBar displays the progress of the file contents being copied. It is
+// only used when the size of the files is known.
+type ProgressBar interface {
+	NewProgressBarWrapper(reader io.Reader, sizeOfFile int64) io.Reader
+}
+
+// Download copies remotePath on the app instance to localPath. If localPath
+// is an existing directory, the copy is placed inside it. Directories are only
+// copied when recursive is true. Files are streamed as a tar archive, so the
+// app instance needs a tar binary; only regular files and directories are
+// copied.
+func (c *SecureShell) Download(remotePath string, localPath string, recursive bool, progressBar ProgressBar) error {
+	remoteDir, rootName := splitRemotePath(remotePath)
+
+	var sizeOutput bytes.Buffer
+	err := c.runCommand(downloadCheckCommand(remotePath, recursive), nil, func(output io.Reader) error {
+		_, err := io.Copy(&sizeOutput, output)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	size, _ := strconv.ParseInt(strings.TrimSpace(sizeOutput.String()), 10, 64)
+
+	destination := localPath
+	if info, err := os.Stat(localPath); err == nil && info.IsDir() && rootName != "." {
+		destination = filepath.Join(localPath, rootName)
+	}
+
+	command := fmt.Sprintf("cd -- %s && tar -cf - %s", shellQuote(remoteDir), shellQuote("./"+rootName))
+	return c.runCommand(command, nil, func(output io.Reader) error {
+		tarReader := tar.NewReader(output)
+		err := extractTar(tarReader, withProgress(progressBar, tarReader, size), path.Clean(rootName), destination)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(ioutil.Discard, output)
+		return err
+	})
+}
+
+// Upload copies localPath to remotePath on the app instance. If remotePath is
+// an existing directory, the copy is placed inside it. Directories are only
+// copied when recursive is true. Files are streamed as a tar archive, so the
+// app instance needs a tar binary; only regular files and directories are
+// copied.
+func (c *SecureShell) Upload(localPath string, remotePath string, recursive bool, progressBar ProgressBar) error {
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return err
+	}
+	if info.IsDir() && !recursive {
+		return ssherror.FileTransferError{Message: fmt.Sprintf("%s: Is a directory", localPath)}
+	}
+
+	entries, size, err := collectUploadEntries(localPath, info)
+	if err != nil {
+		return err
+	}
+
+	var isDirOutput bytes.Buffer
+	err = c.runCommand(fmt.Sprintf("if [ -d %s ]; then echo directory; fi", shellQuote(remotePath)), nil, func(output io.Reader) error {
+		_, err := io.Copy(&isDirOutput, output)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	remoteDir, rootName := splitRemotePath(remotePath)
+	if strings.TrimSpace(isDirOutput.String()) == "directory" {
+		remoteDir, rootName = remotePath, filepath.Base(filepath.Clean(localPath))
+	}
+
+	contents := withProgress(progressBar, &fileContentReader{entries: entries}, size)
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(writeTar(writer, entries, rootName, contents))
+	}()
+
+	return c.runCommand(fmt.Sprintf("cd -- %s && tar -xf -", shellQuote(remoteDir)), reader, nil)
+}
+
+// runCommand runs command on the app instance without a terminal. input, if
+// given, is copied to the command's stdin and handleOutput, if given, reads
+// its stdout. When the command fails, its stderr is returned as a
+// FileTransferError.
+func (c *SecureShell) runCommand(command string, input io.Reader, handleOutput func(io.Reader) error) error {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+	defer session.Close()
+
+	inPipe, err := session.StdinPipe()
+	if err != nil {
+		return err
+	}
+
+	outPipe, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	errPipe, err := session.StderrPipe()
+	if err != nil {
+		return err
+	}
+
+	err = session.Start(command)
+	if err != nil {
+		return err
+	}
+
+	var stderr bytes.Buffer
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go copyAndDone(wg, &stderr, errPipe)
+
+	inputErr := make(chan error, 1)
+	go func() {
+		var err error
+		if input != nil {
+			_, err = io.Copy(inPipe, input)
+		}
+		_ = inPipe.Close()
+		inputErr <- err
+	}()
+
+	if handleOutput == nil {
+		handleOutput = func(output io.Reader) error {
+			_, err := io.Copy(ioutil.Discard, output)
+			return err
+		}
+	}
+	err = handleOutput(outPipe)
+	if err != nil {
+		// Closing the session stops the remote command, which would otherwise
+		// block writing output that is no longer read.
+		_ = session.Close()
+		return err
+	}
+
+	wg.Wait()
+	err = session.Wait()
+	if copyErr := <-inputErr; copyErr != nil {
+		return copyErr
+	}
+	if err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return ssherror.FileTransferError{Message: message}
+		}
+		return err
+	}
+
+	return nil
+}
+
+type uploadEntry struct {
+	path         string
+	relativePath string
+	info         os.FileInfo
+}
+
+// collectUploadEntries lists the directories and regular files in localPath
+// and returns the total size of the files.
+func collectUploadEntries(localPath string, info os.FileInfo) ([]uploadEntry, int64, error) {
+	if !info.IsDir() {
+		return []uploadEntry{{path: localPath, info: info}}, info.Size(), nil
+	}
+
+	var (
+		entries []uploadEntry
+		size    int64
+	)
+	err := filepath.Walk(localPath, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && !info.Mode().IsRegular() {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(localPath, filePath)
+		if err != nil {
+			return err
+		}
+		if relativePath == "." {
+			relativePath = ""
+		}
+
+		entries = append(entries, uploadEntry{path: filePath, relativePath: filepath.ToSlash(relativePath), info: info})
+		if info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+
+	return entries, size, err
+}
+
+// writeTar writes entries to writer as a tar archive rooted at rootName. The
+// contents of the regular files are read, in order, from contents.
+func writeTar(writer io.Writer, entries []uploadEntry, rootName string, contents io.Reader) error {
+	tarWriter := tar.NewWriter(writer)
+
+	for _, entry := range entries {
+		header, err := tar.FileInfoHeader(entry.info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = path.Join(rootName, entry.relativePath)
+		if entry.info.IsDir() {
+			header.Name += "/"
+		}
+
+		err = tarWriter.WriteHeader(header)
+		if err != nil {
+			return err
+		}
+
+		if entry.info.Mode().IsRegular() {
+			_, err = io.CopyN(tarWriter, contents, entry.info.Size())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return tarWriter.Close()
+}
+
+// extractTar extracts the archive read by tarReader, whose entries are rooted
+// at rootName, to destination. The contents of the regular files are read
+// from contents, which reads from tarReader.
+func extractTar(tarReader *tar.Reader, contents io.Reader, rootName string, destination string) error {
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		relativePath, ok := relativeArchivePath(header.Name, rootName)
+		if !ok {
+			return ssherror.FileTransferError{Message: fmt.Sprintf("unexpected path %s in archive", header.Name)}
+		}
+		target := filepath.Join(destination, filepath.FromSlash(relativePath))
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = os.MkdirAll(target, header.FileInfo().Mode().Perm()|0700)
+		case tar.TypeReg:
+			err = writeFile(target, contents, header.FileInfo().Mode().Perm())
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func writeFile(target string, contents io.Reader, mode os.FileMode) error {
+	file, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(file, contents)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// relativeArchivePath returns name relative to rootName, rejecting names
+// outside of it.
+func relativeArchivePath(name string, rootName string) (string, bool) {
+	name = path.Clean(name)
+	if name == ".." || strings.HasPrefix(name, "../") || path.IsAbs(name) {
+		return "", false
+	}
+
+	switch {
+	case rootName == ".":
+		if name == "." {
+			return "", true
+		}
+		return name, true
+	case name == rootName:
+		return "", true
+	case strings.HasPrefix(name, rootName+"/"):
+		return strings.TrimPrefix(name, rootName+"/"), true
+	default:
+		return "", false
+	}
+}
+
+// fileContentReader reads the contents of the regular files in entries one
+// after another. Reading fails if a file is shorter than when it was listed.
+type fileContentReader struct {
+	entries   []uploadEntry
+	file      *os.File
+	remaining int64
+}
+
+func (r *fileContentReader) Read(p []byte) (int, error) {
+	for {
+		if r.file == nil {
+			for len(r.entries) > 0 && !r.entries[0].info.Mode().IsRegular() {
+				r.entries = r.entries[1:]
+			}
+			if len(r.entries) == 0 {
+				return 0, io.EOF
+			}
+
+			file, err := os.Open(r.entries[0].path)
+			if err != nil {
+				return 0, err
+			}
+			r.file, r.remaining = file, r.entries[0].info.Size()
+			r.entries = r.entries[1:]
+		}
+
+		if r.remaining == 0 {
+			_ = r.file.Close()
+			r.file = nil
+			continue
+		}
+
+		if int64(len(p)) > r.remaining {
+			p = p[:r.remaining]
+		}
+		n, err := r.file.Read(p)
+		r.remaining -= int64(n)
+		if err == io.EOF {
+			if r.remaining > 0 {
+				return n, fmt.Errorf("%s changed while it was being copied", r.file.Name())
+			}
+			err = nil
+		}
+		return n, err
+	}
+}
+
+// withProgress displays the progress of reading size bytes from reader. The
+// progress is not displayed when the size is unknown.
+func withProgress(progressBar ProgressBar, reader io.Reader, size int64) io.Reader {
+	if size <= 0 {
+		return reader
+	}
+	return progressBar.NewProgressBarWrapper(reader, size)
+}
+
+// downloadCheckCommand fails when remotePath cannot be downloaded and
+// otherwise prints the total size of the regular files in it. Only POSIX
+// utilities are used, since app instances do not necessarily have GNU ones.
+func downloadCheckCommand(remotePath string, recursive bool) string {
+	command := fmt.Sprintf(`p=%s; if [ ! -e "$p" ]; then echo "$p: No such file or directory" >&2; exit 1; fi; `, shellQuote(remotePath))
+	if !recursive {
+		command += `if [ -d "$p" ]; then echo "$p: Is a directory" >&2; exit 1; fi; `
+	}
+	return command + `case "$p" in -*) p="./$p";; esac; find "$p" -type f -exec ls -ln {} + 2>/dev/null | awk '{ s += $5 } END { print s + 0 }'`
+}
+
+// splitRemotePath returns the directory containing remotePath and its name.
+func splitRemotePath(remotePath string) (string, string) {
+	cleanPath := path.Clean(remotePath)
+	if cleanPath == "/" {
+		return "/", "."
+	}
+	return path.Dir(cleanPath), path.Base(cleanPath)
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
@@ -0,0 +1,355 @@
+package clissh_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/clissh/clisshfakes"
+	"code.cloudfoundry.org/cli/util/clissh/ssherror"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type closeBuffer struct {
+	bytes.Buffer
+}
+
+func (*closeBuffer) Close() error {
+	return nil
+}
+
+type readerFunc func(p []byte) (int, error)
+
+func (f readerFunc) Read(p []byte) (int, error) {
+	return f(p)
+}
+
+var _ = Describe("file transfers", func() {
+	var (
+		fakeSecureDialer  *clisshfakes.FakeSecureDialer
+		fakeSecureClient  *clisshfakes.FakeSecureClient
+		fakeProgressBar   *clisshfakes.FakeProgressBar
+		sessions          []*clisshfakes.FakeSecureSession
+		stdins            []*closeBuffer
+		secureShell       *SecureShell
+		localDir          string
+		transferErr       error
+		transferInvoker   func() error
+		addSessionInvoker func(stdout string, stderr string, waitErr error)
+	)
+
+	BeforeEach(func() {
+		fakeSecureDialer = new(clisshfakes.FakeSecureDialer)
+		fakeSecureClient = new(clisshfakes.FakeSecureClient)
+		fakeSecureDialer.DialReturns(fakeSecureClient, nil)
+
+		fakeProgressBar = new(clisshfakes.FakeProgressBar)
+		fakeProgressBar.NewProgressBarWrapperStub = func(reader io.Reader, _ int64) io.Reader {
+			return reader
+		}
+
+		sessions = nil
+		stdins = nil
+		addSessionInvoker = func(stdout string, stderr string, waitErr error) {
+			session := new(clisshfakes.FakeSecureSession)
+			stdin := new(closeBuffer)
+			session.StdinPipeReturns(stdin, nil)
+			session.StdoutPipeReturns(strings.NewReader(stdout), nil)
+			session.StderrPipeReturns(strings.NewReader(stderr), nil)
+			session.WaitReturns(waitErr)
+			sessions = append(sessions, session)
+			stdins = append(stdins, stdin)
+		}
+		fakeSecureClient.NewSessionStub = func() (SecureSession, error) {
+			return sessions[fakeSecureClient.NewSessionCallCount()-1], nil
+		}
+
+		var err error
+		localDir, err = ioutil.TempDir("", "cli-file-transfer")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	JustBeforeEach(func() {
+		secureShell = NewSecureShell(fakeSecureDialer, new(clisshfakes.FakeTerminalHelper), new(clisshfakes.FakeListenerFactory), DefaultKeepAliveInterval)
+		Expect(secureShell.Connect("some-user", "some-passcode", "some-endpoint", "", true)).To(Succeed())
+
+		transferErr = transferInvoker()
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(localDir)).To(Succeed())
+	})
+
+	Describe("Download", func() {
+		var (
+			localPath string
+			recursive bool
+		)
+
+		buildArchive := func(files map[string]string) string {
+			var buffer bytes.Buffer
+			tarWriter := tar.NewWriter(&buffer)
+			for _, name := range []string{"./logs/", "./logs/a.txt", "./logs/sub/", "./logs/sub/b.txt", "../escape.txt"} {
+				contents, ok := files[name]
+				if !ok {
+					continue
+				}
+				header := &tar.Header{Name: name, Mode: 0644, Size: int64(len(contents)), Typeflag: tar.TypeReg}
+				if strings.HasSuffix(name, "/") {
+					header = &tar.Header{Name: name, Mode: 0755, Typeflag: tar.TypeDir}
+				}
+				Expect(tarWriter.WriteHeader(header)).To(Succeed())
+				_, err := tarWriter.Write([]byte(contents))
+				Expect(err).NotTo(HaveOccurred())
+			}
+			Expect(tarWriter.Close()).To(Succeed())
+			return buffer.String()
+		}
+
+		BeforeEach(func() {
+			localPath = localDir
+			recursive = true
+
+			addSessionInvoker("26\n", "", nil)
+			addSessionInvoker(buildArchive(map[string]string{
+				"./logs/":          "",
+				"./logs/a.txt":     "some-contents",
+				"./logs/sub/":      "",
+				"./logs/sub/b.txt": "more-contents",
+			}), "", nil)
+
+			transferInvoker = func() error {
+				return secureShell.Download("/home/vcap/logs", localPath, recursive, fakeProgressBar)
+			}
+		})
+
+		It("checks the remote path and streams it as a tar archive", func() {
+			Expect(transferErr).NotTo(HaveOccurred())
+			Expect(fakeSecureClient.NewSessionCallCount()).To(Equal(2))
+
+			checkCommand := sessions[0].StartArgsForCall(0)
+			Expect(checkCommand).To(HavePrefix(`p='/home/vcap/logs'; `))
+			Expect(checkCommand).To(ContainSubstring("No such file or directory"))
+			Expect(checkCommand).NotTo(ContainSubstring("Is a directory"))
+			Expect(checkCommand).To(ContainSubstring(`find "$p" -type f -exec ls -ln {} +`))
+			Expect(checkCommand).NotTo(ContainSubstring("du "))
+			Expect(sessions[1].StartArgsForCall(0)).To(Equal(`cd -- '/home/vcap' && tar -cf - './logs'`))
+
+			Expect(fakeProgressBar.NewProgressBarWrapperCallCount()).To(Equal(1))
+			_, size := fakeProgressBar.NewProgressBarWrapperArgsForCall(0)
+			Expect(size).To(BeEquivalentTo(26))
+		})
+
+		When("the progress of the download is displayed", func() {
+			var progressBytes int
+
+			BeforeEach(func() {
+				progressBytes = 0
+				fakeProgressBar.NewProgressBarWrapperStub = func(reader io.Reader, _ int64) io.Reader {
+					return readerFunc(func(p []byte) (int, error) {
+						n, err := reader.Read(p)
+						progressBytes += n
+						return n, err
+					})
+				}
+			})
+
+			It("counts only the contents of the files", func() {
+				Expect(transferErr).NotTo(HaveOccurred())
+				Expect(progressBytes).To(Equal(len("some-contents") + len("more-contents")))
+			})
+		})
+
+		When("the size of the remote files is unknown", func() {
+			BeforeEach(func() {
+				sessions, stdins = nil, nil
+				addSessionInvoker("\n", "", nil)
+				addSessionInvoker(buildArchive(map[string]string{
+					"./logs/":      "",
+					"./logs/a.txt": "some-contents",
+				}), "", nil)
+			})
+
+			It("copies the files without displaying progress", func() {
+				Expect(transferErr).NotTo(HaveOccurred())
+				Expect(fakeProgressBar.NewProgressBarWrapperCallCount()).To(Equal(0))
+
+				contents, err := ioutil.ReadFile(filepath.Join(localDir, "logs", "a.txt"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("some-contents"))
+			})
+		})
+
+		It("copies the directory into an existing local directory", func() {
+			Expect(transferErr).NotTo(HaveOccurred())
+
+			contents, err := ioutil.ReadFile(filepath.Join(localDir, "logs", "a.txt"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-contents"))
+
+			contents, err = ioutil.ReadFile(filepath.Join(localDir, "logs", "sub", "b.txt"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("more-contents"))
+		})
+
+		When("the local path does not exist", func() {
+			BeforeEach(func() {
+				localPath = filepath.Join(localDir, "copied-logs")
+			})
+
+			It("copies the directory to the local path", func() {
+				Expect(transferErr).NotTo(HaveOccurred())
+
+				contents, err := ioutil.ReadFile(filepath.Join(localDir, "copied-logs", "a.txt"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("some-contents"))
+			})
+		})
+
+		When("not copying recursively", func() {
+			BeforeEach(func() {
+				recursive = false
+			})
+
+			It("fails on directories", func() {
+				Expect(sessions[0].StartArgsForCall(0)).To(ContainSubstring("Is a directory"))
+			})
+		})
+
+		When("the remote path cannot be copied", func() {
+			BeforeEach(func() {
+				sessions, stdins = nil, nil
+				addSessionInvoker("", "/home/vcap/logs: No such file or directory\n", errors.New("exit status 1"))
+			})
+
+			It("returns the remote error", func() {
+				Expect(transferErr).To(MatchError(ssherror.FileTransferError{Message: "/home/vcap/logs: No such file or directory"}))
+				Expect(fakeSecureClient.NewSessionCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the archive contains paths outside the remote path", func() {
+			BeforeEach(func() {
+				sessions, stdins = sessions[:1], stdins[:1]
+				addSessionInvoker(buildArchive(map[string]string{"../escape.txt": "gotcha"}), "", nil)
+			})
+
+			It("returns an error without writing the file", func() {
+				Expect(transferErr).To(MatchError(ssherror.FileTransferError{Message: "unexpected path ../escape.txt in archive"}))
+				Expect(filepath.Join(filepath.Dir(localDir), "escape.txt")).NotTo(BeAnExistingFile())
+				Expect(sessions[1].CloseCallCount()).To(BeNumerically(">=", 1))
+			})
+		})
+	})
+
+	Describe("Upload", func() {
+		var (
+			localPath string
+			recursive bool
+		)
+
+		archiveEntries := func(archive []byte) map[string]string {
+			entries := map[string]string{}
+			tarReader := tar.NewReader(bytes.NewReader(archive))
+			for {
+				header, err := tarReader.Next()
+				if err == io.EOF {
+					return entries
+				}
+				Expect(err).NotTo(HaveOccurred())
+				contents, err := ioutil.ReadAll(tarReader)
+				Expect(err).NotTo(HaveOccurred())
+				entries[header.Name] = string(contents)
+			}
+		}
+
+		BeforeEach(func() {
+			localPath = filepath.Join(localDir, "config")
+			Expect(os.MkdirAll(filepath.Join(localPath, "nested"), 0755)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(localPath, "app.yml"), []byte("some-config"), 0644)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(localPath, "nested", "db.yml"), []byte("db-config"), 0600)).To(Succeed())
+			recursive = true
+
+			addSessionInvoker("directory\n", "", nil)
+			addSessionInvoker("", "", nil)
+
+			transferInvoker = func() error {
+				return secureShell.Upload(localPath, "/home/vcap/app", recursive, fakeProgressBar)
+			}
+		})
+
+		It("streams the local directory into the remote directory", func() {
+			Expect(transferErr).NotTo(HaveOccurred())
+			Expect(fakeSecureClient.NewSessionCallCount()).To(Equal(2))
+			Expect(sessions[0].StartArgsForCall(0)).To(Equal(`if [ -d '/home/vcap/app' ]; then echo directory; fi`))
+			Expect(sessions[1].StartArgsForCall(0)).To(Equal(`cd -- '/home/vcap/app' && tar -xf -`))
+
+			Expect(archiveEntries(stdins[1].Bytes())).To(Equal(map[string]string{
+				"config/":              "",
+				"config/app.yml":       "some-config",
+				"config/nested/":       "",
+				"config/nested/db.yml": "db-config",
+			}))
+
+			Expect(fakeProgressBar.NewProgressBarWrapperCallCount()).To(Equal(1))
+			_, size := fakeProgressBar.NewProgressBarWrapperArgsForCall(0)
+			Expect(size).To(BeEquivalentTo(len("some-config") + len("db-config")))
+		})
+
+		When("the remote path is not a directory", func() {
+			BeforeEach(func() {
+				sessions, stdins = nil, nil
+				addSessionInvoker("", "", nil)
+				addSessionInvoker("", "", nil)
+			})
+
+			It("copies the directory to the remote path", func() {
+				Expect(transferErr).NotTo(HaveOccurred())
+				Expect(sessions[1].StartArgsForCall(0)).To(Equal(`cd -- '/home/vcap' && tar -xf -`))
+				Expect(archiveEntries(stdins[1].Bytes())).To(HaveKey("app/nested/db.yml"))
+			})
+		})
+
+		When("not copying recursively", func() {
+			BeforeEach(func() {
+				recursive = false
+			})
+
+			It("fails on directories", func() {
+				Expect(transferErr).To(MatchError(ssherror.FileTransferError{Message: localPath + ": Is a directory"}))
+				Expect(fakeSecureClient.NewSessionCallCount()).To(Equal(0))
+			})
+
+			When("copying a file", func() {
+				BeforeEach(func() {
+					localPath = filepath.Join(localPath, "app.yml")
+				})
+
+				It("streams the file", func() {
+					Expect(transferErr).NotTo(HaveOccurred())
+					Expect(archiveEntries(stdins[1].Bytes())).To(Equal(map[string]string{
+						"app.yml": "some-config",
+					}))
+				})
+			})
+		})
+
+		When("extracting the archive fails", func() {
+			BeforeEach(func() {
+				sessions, stdins = sessions[:1], stdins[:1]
+				addSessionInvoker("", "sh: cd: can't cd to /home/vcap/app\n", errors.New("exit status 2"))
+			})
+
+			It("returns the remote error", func() {
+				Expect(transferErr).To(MatchError(ssherror.FileTransferError{Message: "sh: cd: can't cd to /home/vcap/app"}))
+			})
+		})
+	})
+})
@@ -0,0 +1,62 @@
+package clissh
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// PrefixWriter writes each line written to it to an underlying writer with a
+// prefix. Lines are only written once complete, so PrefixWriters sharing a
+// lock can write to the same writer without interleaving their lines.
+type PrefixWriter struct {
+	writer io.Writer
+	prefix []byte
+	lock   sync.Locker
+
+	buffer []byte
+}
+
+func NewPrefixWriter(writer io.Writer, prefix string, lock sync.Locker) *PrefixWriter {
+	return &PrefixWriter{
+		writer: writer,
+		prefix: []byte(prefix),
+		lock:   lock,
+	}
+}
+
+func (w *PrefixWriter) Write(p []byte) (int, error) {
+	w.buffer = append(w.buffer, p...)
+
+	for {
+		i := bytes.IndexByte(w.buffer, '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+
+		err := w.writeLine(w.buffer[:i+1])
+		w.buffer = w.buffer[i+1:]
+		if err != nil {
+			return len(p), err
+		}
+	}
+}
+
+// Flush writes any incomplete last line, terminated with a newline.
+func (w *PrefixWriter) Flush() error {
+	if len(w.buffer) == 0 {
+		return nil
+	}
+
+	line := append(w.buffer, '\n')
+	w.buffer = nil
+	return w.writeLine(line)
+}
+
+func (w *PrefixWriter) writeLine(line []byte) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	_, err := w.writer.Write(append(append([]byte{}, w.prefix...), line...))
+	return err
+}
@@ -0,0 +1,69 @@
+package clissh_test
+
+import (
+	"bytes"
+	"sync"
+
+	. "code.cloudfoundry.org/cli/util/clissh"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PrefixWriter", func() {
+	var (
+		output *bytes.Buffer
+		lock   *sync.Mutex
+		writer *PrefixWriter
+	)
+
+	BeforeEach(func() {
+		output = new(bytes.Buffer)
+		lock = new(sync.Mutex)
+		writer = NewPrefixWriter(output, "[0] ", lock)
+	})
+
+	It("prefixes every complete line", func() {
+		n, err := writer.Write([]byte("first\nsecond\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(n).To(Equal(13))
+		Expect(output.String()).To(Equal("[0] first\n[0] second\n"))
+	})
+
+	It("holds back a line until it is complete", func() {
+		_, err := writer.Write([]byte("par"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output.String()).To(BeEmpty())
+
+		_, err = writer.Write([]byte("tial\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output.String()).To(Equal("[0] partial\n"))
+	})
+
+	It("does not interleave lines of writers sharing a lock", func() {
+		other := NewPrefixWriter(output, "[1] ", lock)
+
+		_, err := writer.Write([]byte("from "))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = other.Write([]byte("other\n"))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = writer.Write([]byte("zero\n"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(output.String()).To(Equal("[1] other\n[0] from zero\n"))
+	})
+
+	Describe("Flush", func() {
+		It("writes the incomplete last line with a newline", func() {
+			_, err := writer.Write([]byte("no newline"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(writer.Flush()).To(Succeed())
+			Expect(output.String()).To(Equal("[0] no newline\n"))
+		})
+
+		It("writes nothing when there is no incomplete line", func() {
+			Expect(writer.Flush()).To(Succeed())
+			Expect(output.String()).To(BeEmpty())
+		})
+	})
+})
@@ -37,6 +37,15 @@ type LocalPortForward struct {
 	RemoteAddress string
 }
 
+type RemotePortForward struct {
+	RemoteAddress string
+	LocalAddress  string
+}
+
+type DynamicPortForward struct {
+	LocalAddress string
+}
+
 type SecureShell struct {
 	secureDialer    SecureDialer
 	secureClient    SecureClient
@@ -44,6 +53,7 @@ type SecureShell struct {
 	listenerFactory ListenerFactory
 
 	localListeners    []net.Listener
+	remoteListeners   []net.Listener
 	keepAliveInterval time.Duration
 }
 
@@ -79,6 +89,9 @@ func (c *SecureShell) Close() error {
 	for _, listener := range c.localListeners {
 		listener.Close()
 	}
+	for _, listener := range c.remoteListeners {
+		listener.Close()
+	}
 	return c.secureClient.Close()
 }
 
@@ -103,6 +116,52 @@ func (c *SecureShell) Connect(username string, passcode string, appSSHEndpoint s
 	return nil
 }
 
+// ExecuteCommand runs commands on the app instance without a terminal and
+// copies their output to stdout and stderr. A non-zero exit status is
+// returned as an *ssh.ExitError.
+func (c *SecureShell) ExecuteCommand(commands []string, stdout io.Writer, stderr io.Writer) error {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+	defer session.Close()
+
+	inPipe, err := session.StdinPipe()
+	if err != nil {
+		return err
+	}
+
+	outPipe, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	errPipe, err := session.StderrPipe()
+	if err != nil {
+		return err
+	}
+
+	err = session.Start(strings.Join(commands, " "))
+	if err != nil {
+		return err
+	}
+	_ = inPipe.Close()
+
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+
+	go copyAndDone(wg, stdout, outPipe)
+	go copyAndDone(wg, stderr, errPipe)
+
+	keepaliveStopCh := make(chan struct{})
+	defer close(keepaliveStopCh)
+
+	go keepalive(c.secureClient.Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+
+	wg.Wait()
+	return session.Wait()
+}
+
 func (c *SecureShell) InteractiveSession(commands []string, terminalRequest TTYRequest) error {
 	session, err := c.secureClient.NewSession()
 	if err != nil {
@@ -213,7 +272,46 @@ func (c *SecureShell) LocalPortForward(localPortForwardSpecs []LocalPortForward)
 		}
 		c.localListeners = append(c.localListeners, listener)
 
-		go c.localForwardAcceptLoop(listener, spec.RemoteAddress)
+		remoteAddress := spec.RemoteAddress
+		go c.forwardAcceptLoop(listener, func(conn net.Conn) {
+			c.handleForwardConnection(conn, c.secureClient.Dial, remoteAddress)
+		})
+	}
+
+	return nil
+}
+
+// RemotePortForward asks the SSH server to listen on each spec's remote
+// address and forwards the connections it accepts to the spec's local
+// address.
+func (c *SecureShell) RemotePortForward(remotePortForwardSpecs []RemotePortForward) error {
+	for _, spec := range remotePortForwardSpecs {
+		listener, err := c.secureClient.Listen("tcp", spec.RemoteAddress)
+		if err != nil {
+			return err
+		}
+		c.remoteListeners = append(c.remoteListeners, listener)
+
+		localAddress := spec.LocalAddress
+		go c.forwardAcceptLoop(listener, func(conn net.Conn) {
+			c.handleForwardConnection(conn, net.Dial, localAddress)
+		})
+	}
+
+	return nil
+}
+
+// DynamicPortForward runs a SOCKS5 proxy on each spec's local address; the
+// connections it proxies are made from the SSH server.
+func (c *SecureShell) DynamicPortForward(dynamicPortForwardSpecs []DynamicPortForward) error {
+	for _, spec := range dynamicPortForwardSpecs {
+		listener, err := c.listenerFactory.Listen("tcp", spec.LocalAddress)
+		if err != nil {
+			return err
+		}
+		c.localListeners = append(c.localListeners, listener)
+
+		go c.forwardAcceptLoop(listener, c.handleDynamicForwardConnection)
 	}
 
 	return nil
@@ -240,25 +338,45 @@ func (c *SecureShell) getWindowDimensions(terminalFd uintptr) (width int, height
 	return int(winSize.Width), int(winSize.Height)
 }
 
-func (c *SecureShell) handleForwardConnection(conn net.Conn, targetAddr string) {
+func (c *SecureShell) handleDynamicForwardConnection(conn net.Conn) {
 	defer conn.Close()
 
+	targetAddr, err := socksHandshake(conn)
+	if err != nil {
+		log.Errorln("socks handshake:", err)
+		return
+	}
+
 	target, err := c.secureClient.Dial("tcp", targetAddr)
 	if err != nil {
 		fmt.Printf("connect to %s failed: %s\n", targetAddr, err.Error())
+		_ = socksReply(conn, socksReplyGeneralFailure)
 		return
 	}
 	defer target.Close()
 
-	wg := &sync.WaitGroup{}
-	wg.Add(2)
+	err = socksReply(conn, socksReplySucceeded)
+	if err != nil {
+		return
+	}
 
-	go copyAndClose(wg, conn, target)
-	go copyAndClose(wg, target, conn)
-	wg.Wait()
+	copyConnections(conn, target)
+}
+
+func (c *SecureShell) handleForwardConnection(conn net.Conn, dial func(network, address string) (net.Conn, error), targetAddr string) {
+	defer conn.Close()
+
+	target, err := dial("tcp", targetAddr)
+	if err != nil {
+		fmt.Printf("connect to %s failed: %s\n", targetAddr, err.Error())
+		return
+	}
+	defer target.Close()
+
+	copyConnections(conn, target)
 }
 
-func (c *SecureShell) localForwardAcceptLoop(listener net.Listener, addr string) {
+func (c *SecureShell) forwardAcceptLoop(listener net.Listener, handle func(net.Conn)) {
 	defer listener.Close()
 
 	for {
@@ -271,7 +389,7 @@ func (c *SecureShell) localForwardAcceptLoop(listener net.Listener, addr string)
 			return
 		}
 
-		go c.handleForwardConnection(conn, addr)
+		go handle(conn)
 	}
 }
 
@@ -346,6 +464,15 @@ func copyAndClose(wg *sync.WaitGroup, dest io.WriteCloser, src io.Reader) {
 	}
 }
 
+func copyConnections(conn net.Conn, target net.Conn) {
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+
+	go copyAndClose(wg, conn, target)
+	go copyAndClose(wg, target, conn)
+	wg.Wait()
+}
+
 func copyAndDone(wg *sync.WaitGroup, dest io.Writer, src io.Reader) {
 	_, err := io.Copy(dest, src)
 	if err != nil {
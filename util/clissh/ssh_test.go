@@ -8,11 +8,13 @@
 package clissh_test
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
 	"net"
 	"os"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -50,6 +52,29 @@ func BlockAcceptOnClose(fake *fake_net.FakeListener) {
 	}
 }
 
+func serveEcho(listener net.Listener) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			io.Copy(conn, conn) //nolint:errcheck
+			conn.Close()
+		}()
+	}
+}
+
+func expectEcho(conn net.Conn, msg string) {
+	_, err := conn.Write([]byte(msg))
+	Expect(err).NotTo(HaveOccurred())
+
+	response := make([]byte, len(msg))
+	_, err = io.ReadFull(conn, response)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(string(response)).To(Equal(msg))
+}
+
 var _ = Describe("CLI SSH", func() {
 	var (
 		fakeSecureDialer    *clisshfakes.FakeSecureDialer
@@ -186,6 +211,7 @@ var _ = Describe("CLI SSH", func() {
 
 		BeforeEach(func() {
 			stdin = new(fake_io.FakeReadCloser)
+			stdin.ReadReturns(0, io.EOF)
 			stdout = new(fake_io.FakeWriter)
 			stderr = new(fake_io.FakeWriter)
 
@@ -1149,6 +1175,269 @@ var _ = Describe("CLI SSH", func() {
 		})
 	})
 
+	Describe("RemotePortForward", func() {
+		var (
+			forwardErr error
+
+			echoListener   net.Listener
+			remoteListener net.Listener
+			forwardSpecs   []RemotePortForward
+		)
+
+		BeforeEach(func() {
+			var err error
+			echoListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			go serveEcho(echoListener)
+
+			remoteListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			fakeSecureClient.ListenReturns(remoteListener, nil)
+
+			forwardSpecs = []RemotePortForward{{
+				RemoteAddress: "localhost:9000",
+				LocalAddress:  echoListener.Addr().String(),
+			}}
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			forwardErr = secureShell.RemotePortForward(forwardSpecs)
+		})
+
+		AfterEach(func() {
+			Expect(secureShell.Close()).To(Succeed())
+			echoListener.Close()
+			remoteListener.Close()
+		})
+
+		It("asks the server to listen on the remote address", func() {
+			Expect(forwardErr).NotTo(HaveOccurred())
+			Expect(fakeSecureClient.ListenCallCount()).To(Equal(1))
+			network, addr := fakeSecureClient.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:9000"))
+		})
+
+		It("copies data between the remote connections and the local address", func() {
+			conn, err := net.Dial("tcp", remoteListener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+
+			expectEcho(conn, "Hello from the app instance\n")
+		})
+
+		When("listening on the remote address fails", func() {
+			BeforeEach(func() {
+				fakeSecureClient.ListenReturns(nil, errors.New("tcpip-forward request denied by peer"))
+			})
+
+			It("returns the error", func() {
+				Expect(forwardErr).To(MatchError("tcpip-forward request denied by peer"))
+			})
+		})
+
+		When("the client is closed", func() {
+			var fakeRemoteListener *fake_net.FakeListener
+
+			BeforeEach(func() {
+				fakeRemoteListener = new(fake_net.FakeListener)
+				BlockAcceptOnClose(fakeRemoteListener)
+				fakeSecureClient.ListenReturns(fakeRemoteListener, nil)
+			})
+
+			It("closes the remote listener", func() {
+				Eventually(fakeRemoteListener.AcceptCallCount).Should(Equal(1))
+
+				Expect(secureShell.Close()).To(Succeed())
+				Eventually(fakeRemoteListener.CloseCallCount).Should(Equal(2))
+			})
+		})
+	})
+
+	Describe("DynamicPortForward", func() {
+		var (
+			forwardErr error
+
+			echoListener  net.Listener
+			echoPort      int
+			localListener net.Listener
+			conn          net.Conn
+		)
+
+		socksConnect := func(request []byte) []byte {
+			_, err := conn.Write([]byte{0x05, 0x01, 0x00})
+			Expect(err).NotTo(HaveOccurred())
+
+			method := make([]byte, 2)
+			_, err = io.ReadFull(conn, method)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(method).To(Equal([]byte{0x05, 0x00}))
+
+			_, err = conn.Write(append(request, byte(echoPort>>8), byte(echoPort)))
+			Expect(err).NotTo(HaveOccurred())
+
+			reply := make([]byte, 10)
+			_, err = io.ReadFull(conn, reply)
+			Expect(err).NotTo(HaveOccurred())
+			return reply
+		}
+
+		BeforeEach(func() {
+			var err error
+			echoListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			echoPort = echoListener.Addr().(*net.TCPAddr).Port
+			go serveEcho(echoListener)
+
+			localListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			fakeListenerFactory.ListenReturns(localListener, nil)
+
+			fakeSecureClient.DialStub = net.Dial
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			forwardErr = secureShell.DynamicPortForward([]DynamicPortForward{{LocalAddress: "localhost:1080"}})
+			Expect(forwardErr).NotTo(HaveOccurred())
+
+			var err error
+			conn, err = net.Dial("tcp", localListener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			conn.Close()
+			Expect(secureShell.Close()).To(Succeed())
+			echoListener.Close()
+			localListener.Close()
+		})
+
+		It("listens on the local address", func() {
+			Expect(fakeListenerFactory.ListenCallCount()).To(Equal(1))
+			network, addr := fakeListenerFactory.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:1080"))
+		})
+
+		It("connects to IPv4 addresses through the secure client", func() {
+			reply := socksConnect([]byte{0x05, 0x01, 0x00, 0x01, 127, 0, 0, 1})
+			Expect(reply[:2]).To(Equal([]byte{0x05, 0x00}))
+
+			Expect(fakeSecureClient.DialCallCount()).To(Equal(1))
+			network, addr := fakeSecureClient.DialArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal(echoListener.Addr().String()))
+
+			expectEcho(conn, "Hello through the proxy\n")
+		})
+
+		It("connects to domain names through the secure client", func() {
+			reply := socksConnect(append([]byte{0x05, 0x01, 0x00, 0x03, byte(len("localhost"))}, "localhost"...))
+			Expect(reply[:2]).To(Equal([]byte{0x05, 0x00}))
+
+			_, addr := fakeSecureClient.DialArgsForCall(0)
+			Expect(addr).To(Equal(fmt.Sprintf("localhost:%d", echoPort)))
+		})
+
+		When("dialing the target fails", func() {
+			BeforeEach(func() {
+				fakeSecureClient.DialStub = nil
+				fakeSecureClient.DialReturns(nil, errors.New("connection refused"))
+			})
+
+			It("replies with a failure", func() {
+				reply := socksConnect([]byte{0x05, 0x01, 0x00, 0x01, 127, 0, 0, 1})
+				Expect(reply[:2]).To(Equal([]byte{0x05, 0x01}))
+			})
+		})
+
+		When("the client requires authentication", func() {
+			It("rejects the connection", func() {
+				_, err := conn.Write([]byte{0x05, 0x01, 0x02})
+				Expect(err).NotTo(HaveOccurred())
+
+				method := make([]byte, 2)
+				_, err = io.ReadFull(conn, method)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(method).To(Equal([]byte{0x05, 0xff}))
+				Expect(fakeSecureClient.DialCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("ExecuteCommand", func() {
+		var (
+			stdout, stderr *bytes.Buffer
+			executeErr     error
+		)
+
+		BeforeEach(func() {
+			stdout = new(bytes.Buffer)
+			stderr = new(bytes.Buffer)
+			commands = []string{"echo", "hello"}
+
+			stdoutPipe.ReadStub = strings.NewReader("some-output\n").Read
+			stderrPipe.ReadStub = strings.NewReader("some-error\n").Read
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			executeErr = secureShell.ExecuteCommand(commands, stdout, stderr)
+		})
+
+		It("runs the commands without a terminal and copies their output", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeSecureSession.StartCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal("echo hello"))
+			Expect(fakeSecureSession.RequestPtyCallCount()).To(Equal(0))
+			Expect(stdinPipe.CloseCallCount()).To(Equal(1))
+
+			Expect(stdout.String()).To(Equal("some-output\n"))
+			Expect(stderr.String()).To(Equal("some-error\n"))
+			Expect(fakeSecureSession.WaitCallCount()).To(Equal(1))
+		})
+
+		When("allocating the session fails", func() {
+			BeforeEach(func() {
+				fakeSecureClient.NewSessionReturns(nil, errors.New("no session"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("SSH session allocation failed: no session"))
+			})
+		})
+
+		When("starting the command fails", func() {
+			BeforeEach(func() {
+				fakeSecureSession.StartReturns(errors.New("start failed"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("start failed"))
+				Expect(fakeSecureSession.WaitCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the command fails", func() {
+			BeforeEach(func() {
+				fakeSecureSession.WaitReturns(errors.New("exit status 3"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("exit status 3"))
+			})
+		})
+	})
+
 	Describe("Wait", func() {
 		var waitErr error
 
@@ -0,0 +1,11 @@
+package ssherror
+
+// FileTransferError is returned when copying files to or from an app instance
+// fails.
+type FileTransferError struct {
+	Message string
+}
+
+func (e FileTransferError) Error() string {
+	return e.Message
+}
@@ -60,6 +60,7 @@ func (p *CommandParser) ParseCommandFromArgs(ui *ui.UI, args []string) (int, err
 func (p *CommandParser) executionWrapper(cmd flags.Commander, args []string) error {
 	cfConfig := p.Config
 	cfConfig.Flags = configv3.FlagOverride{
+		Context: common.Commands.Context,
 		Verbose: common.Commands.VerboseOrVersion,
 	}
 	defer p.UI.FlushDeferred()
@@ -74,6 +75,12 @@ func (p *CommandParser) executionWrapper(cmd flags.Commander, args []string) err
 		return p.handleError(err)
 	}
 
+	// Runs after the config is written, so that warnings from writing it are
+	// shown too.
+	defer func() {
+		p.UI.DisplayWarnings(cfConfig.TakeWarnings())
+	}()
+
 	defer func() {
 		configWriteErr := cfConfig.WriteConfig()
 		if configWriteErr != nil {
@@ -81,6 +88,23 @@ func (p *CommandParser) executionWrapper(cmd flags.Commander, args []string) err
 		}
 	}()
 
+	err = cfConfig.ApplyContextOverride()
+	p.UI.DisplayWarnings(cfConfig.TakeWarnings())
+	if err != nil {
+		return p.handleError(err)
+	}
+
+	outputFormat := common.Commands.OutputFormat.Format
+	// The command list is reused when displaying help after a failure, so the
+	// format must not leak into that invocation.
+	common.Commands.OutputFormat = flag.OutputFormat{}
+	if outputFormat != ui.OutputFormatDefault {
+		if structuredCmd, ok := cmd.(command.StructuredOutputCommander); !ok || !structuredCmd.SupportsStructuredOutput() {
+			return p.handleError(translatableerror.OutputFormatNotSupportedError{})
+		}
+		p.UI.SetOutputFormat(outputFormat)
+	}
+
 	if extendedCmd, ok := cmd.(command.ExtendedCommander); ok {
 		log.SetOutput(os.Stderr)
 		log.SetLevel(log.Level(cfConfig.LogLevel()))
@@ -126,6 +150,14 @@ func (p *CommandParser) handleError(passedErr error) error {
 	case translatableerror.CurlExit22Error:
 		p.UI.DisplayError(translatedErr)
 		return passedErr
+	case translatableerror.DiffFoundError:
+		return passedErr
+	case translatableerror.SSHInstancesFailedError:
+		p.UI.DisplayError(translatedErr)
+		return passedErr
+	case translatableerror.TaskFailedError:
+		p.UI.DisplayError(translatedErr)
+		return passedErr
 	}
 
 	p.UI.DisplayError(translatedErr)
@@ -238,6 +270,12 @@ func (p *CommandParser) parse(args []string, commandList interface{}) (int, erro
 		return exitError.ExitStatus(), nil
 	} else if curlError, ok := err.(translatableerror.CurlExit22Error); ok {
 		return 22, curlError
+	} else if _, ok := err.(translatableerror.DiffFoundError); ok {
+		return 2, nil
+	} else if sshErr, ok := err.(translatableerror.SSHInstancesFailedError); ok {
+		return sshErr.ExitStatus, nil
+	} else if taskErr, ok := err.(translatableerror.TaskFailedError); ok {
+		return taskErr.ExitStatus, nil
 	}
 
 	fmt.Fprintf(os.Stderr, "Unexpected error: %s\n", err.Error())
@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/util/command_parser"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
@@ -78,4 +79,66 @@ var _ = Describe("Command 'Parser'", func() {
 		})
 
 	})
+
+	Describe("the context flag", func() {
+		var parser command_parser.CommandParser
+
+		BeforeEach(func() {
+			common.Commands.Context = ""
+			var err error
+
+			parser, err = command_parser.NewCommandParser()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			common.Commands.Context = ""
+		})
+
+		It("sets the context flag", func() {
+			_, _ = parser.ParseCommandFromArgs(pluginUI, []string{"help", "--context", "some-context"})
+			Expect(parser.Config.Flags.Context).To(Equal("some-context"))
+		})
+
+		When("the context does not exist", func() {
+			It("does not run the command", func() {
+				exitCode, err := parser.ParseCommandFromArgs(pluginUI, []string{"version", "--context", "some-missing-context"})
+				Expect(exitCode).To(Equal(1))
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("the output flag", func() {
+		var parser command_parser.CommandParser
+
+		BeforeEach(func() {
+			common.Commands.OutputFormat = flag.OutputFormat{}
+			var err error
+
+			parser, err = command_parser.NewCommandParser()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			common.Commands.OutputFormat = flag.OutputFormat{}
+		})
+
+		When("the command does not support structured output", func() {
+			It("fails with a usage error", func() {
+				exitCode, err := parser.ParseCommandFromArgs(pluginUI, []string{"version", "--output", "json"})
+				Expect(exitCode).To(Equal(1))
+				Expect(err).To(MatchError(command_parser.ParseErr))
+				Expect(pluginUI.IsStructuredOutput()).To(BeFalse())
+			})
+		})
+
+		When("the format is not valid", func() {
+			It("does not run the command", func() {
+				exitCode, _ := parser.ParseCommandFromArgs(pluginUI, []string{"version", "--output", "xml"})
+				Expect(exitCode).To(Equal(1))
+				Expect(common.Commands.OutputFormat.Format).To(BeEmpty())
+			})
+		})
+	})
 })
@@ -32,6 +32,31 @@ type Config struct {
 
 	pluginsConfig PluginsConfig
 
+	// contextOverride is the name of the context provided by the --context
+	// flag or $CF_CONTEXT for this invocation.
+	contextOverride string
+
+	// shadowedContext is the target and session of the config file replaced
+	// by contextOverride. It is restored when the config is written.
+	shadowedContext *TargetContext
+
+	// storedCredentials are the secrets last read from or written to the
+	// credential helper, by session.
+	storedCredentials map[string]Credentials
+
+	// previousCredentialsStore is the credential helper that was configured
+	// before SetCredentialsStore was called. Its secrets are erased when the
+	// config is written.
+	previousCredentialsStore string
+
+	// erasedSessions are the sessions whose secrets are erased from the
+	// credential helper when the config is written.
+	erasedSessions []string
+
+	// warnings are the problems the config recovered from, returned by
+	// TakeWarnings.
+	warnings []string
+
 	UserConfig
 }
 
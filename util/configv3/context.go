@@ -0,0 +1,226 @@
+package configv3
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+// TargetContext contains the API target and user session of a named context.
+type TargetContext struct {
+	AccessToken              string       `json:"AccessToken"`
+	APIVersion               string       `json:"APIVersion"`
+	AuthorizationEndpoint    string       `json:"AuthorizationEndpoint"`
+	CFOnK8s                  CFOnK8s      `json:"CFOnK8s"`
+	DopplerEndpoint          string       `json:"DopplerEndPoint"`
+	LogCacheEndpoint         string       `json:"LogCacheEndPoint"`
+	MinCLIVersion            string       `json:"MinCLIVersion"`
+	MinRecommendedCLIVersion string       `json:"MinRecommendedCLIVersion"`
+	NetworkPolicyV1Endpoint  string       `json:"NetworkPolicyV1Endpoint"`
+	TargetedOrganization     Organization `json:"OrganizationFields"`
+	RefreshToken             string       `json:"RefreshToken"`
+	RoutingEndpoint          string       `json:"RoutingAPIEndpoint"`
+	TargetedSpace            Space        `json:"SpaceFields"`
+	SSHOAuthClient           string       `json:"SSHOAuthClient"`
+	SkipSSLValidation        bool         `json:"SSLDisabled"`
+	Target                   string       `json:"Target"`
+	UAAEndpoint              string       `json:"UaaEndpoint"`
+	UAAGrantType             string       `json:"UAAGrantType"`
+	UAAOAuthClient           string       `json:"UAAOAuthClient"`
+	UAAOAuthClientSecret     string       `json:"UAAOAuthClientSecret"`
+}
+
+// ContextNames returns the names of all saved contexts in alphabetical order.
+func (config *Config) ContextNames() []string {
+	names := make([]string, 0, len(config.ConfigFile.Contexts))
+	for name := range config.ConfigFile.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// CreateContext saves the current target and session as a new context with
+// the given name, and makes it the active context.
+func (config *Config) CreateContext(name string) {
+	config.saveActiveContext()
+	if config.ConfigFile.Contexts == nil {
+		config.ConfigFile.Contexts = map[string]TargetContext{}
+	}
+	config.ConfigFile.Contexts[name] = config.ConfigFile.targetContext()
+	config.ConfigFile.CurrentContext = name
+	config.contextOverride = ""
+	config.shadowedContext = nil
+}
+
+// CurrentContext returns the name of the context used by this invocation of
+// the CLI. The context provided by the --context flag or $CF_CONTEXT takes
+// precedence over the context saved in the config file. An empty string is
+// returned when no named context is in use.
+func (config *Config) CurrentContext() string {
+	if config.contextOverride != "" {
+		return config.contextOverride
+	}
+	return config.ConfigFile.CurrentContext
+}
+
+// DeleteContext removes the context with the given name. The target and
+// session of the active context are left untouched when it is deleted, but
+// they will no longer be saved under that name.
+func (config *Config) DeleteContext(name string) error {
+	if !config.HasContext(name) {
+		return translatableerror.ContextNotFoundError{Name: name}
+	}
+
+	delete(config.ConfigFile.Contexts, name)
+	config.erasedSessions = append(config.erasedSessions, name)
+	if config.ConfigFile.CurrentContext == name {
+		config.ConfigFile.CurrentContext = ""
+	}
+	if config.contextOverride == name {
+		config.contextOverride = ""
+		config.restoreShadowedContext()
+	}
+	return nil
+}
+
+// GetContext returns the target and session saved for the context with the
+// given name, and whether the context exists.
+func (config *Config) GetContext(name string) (TargetContext, bool) {
+	if !config.HasContext(name) {
+		return TargetContext{}, false
+	}
+	if name == config.CurrentContext() {
+		return config.ConfigFile.targetContext(), true
+	}
+	return config.ConfigFile.Contexts[name], true
+}
+
+// HasContext returns true if a context with the given name has been saved.
+func (config *Config) HasContext(name string) bool {
+	_, ok := config.ConfigFile.Contexts[name]
+	return ok
+}
+
+// SwitchContext saves the target and session of the active context and loads
+// the ones saved for the context with the given name.
+func (config *Config) SwitchContext(name string) error {
+	if !config.HasContext(name) {
+		return translatableerror.ContextNotFoundError{Name: name}
+	}
+
+	config.saveActiveContext()
+	config.contextOverride = ""
+	config.shadowedContext = nil
+
+	config.loadContextCredentials(name)
+	config.ConfigFile.setTargetContext(config.ConfigFile.Contexts[name])
+	config.ConfigFile.CurrentContext = name
+	return nil
+}
+
+// ApplyContextOverride loads the context provided by the --context flag or
+// $CF_CONTEXT for the duration of this invocation without changing the
+// context saved as active in the config file.
+func (config *Config) ApplyContextOverride() error {
+	name := config.Flags.Context
+	if name == "" {
+		name = config.ENV.CFContext
+	}
+	if name == "" || name == config.CurrentContext() {
+		return nil
+	}
+
+	if !config.HasContext(name) {
+		return translatableerror.ContextNotFoundError{Name: name}
+	}
+
+	config.saveActiveContext()
+	if config.shadowedContext == nil {
+		shadowed := config.ConfigFile.targetContext()
+		config.shadowedContext = &shadowed
+	}
+	config.contextOverride = name
+	config.loadContextCredentials(name)
+	config.ConfigFile.setTargetContext(config.ConfigFile.Contexts[name])
+	return nil
+}
+
+// saveActiveContext copies the current target and session into the context
+// that is in use, if any.
+func (config *Config) saveActiveContext() {
+	name := config.CurrentContext()
+	if name == "" || !config.HasContext(name) {
+		return
+	}
+	config.ConfigFile.Contexts[name] = config.ConfigFile.targetContext()
+}
+
+// persistedConfigFile returns the config that should be written to disk. The
+// active target and session are saved into their context, and the target and
+// session shadowed by a per-invocation context override are restored.
+func (config *Config) persistedConfigFile() JSONConfig {
+	config.saveActiveContext()
+
+	configFile := config.ConfigFile
+	if config.shadowedContext != nil {
+		configFile.setTargetContext(*config.shadowedContext)
+	}
+	return configFile
+}
+
+func (config *Config) restoreShadowedContext() {
+	if config.shadowedContext == nil {
+		return
+	}
+	config.ConfigFile.setTargetContext(*config.shadowedContext)
+	config.shadowedContext = nil
+}
+
+func (c JSONConfig) targetContext() TargetContext {
+	return TargetContext{
+		AccessToken:              c.AccessToken,
+		APIVersion:               c.APIVersion,
+		AuthorizationEndpoint:    c.AuthorizationEndpoint,
+		CFOnK8s:                  c.CFOnK8s,
+		DopplerEndpoint:          c.DopplerEndpoint,
+		LogCacheEndpoint:         c.LogCacheEndpoint,
+		MinCLIVersion:            c.MinCLIVersion,
+		MinRecommendedCLIVersion: c.MinRecommendedCLIVersion,
+		NetworkPolicyV1Endpoint:  c.NetworkPolicyV1Endpoint,
+		TargetedOrganization:     c.TargetedOrganization,
+		RefreshToken:             c.RefreshToken,
+		RoutingEndpoint:          c.RoutingEndpoint,
+		TargetedSpace:            c.TargetedSpace,
+		SSHOAuthClient:           c.SSHOAuthClient,
+		SkipSSLValidation:        c.SkipSSLValidation,
+		Target:                   c.Target,
+		UAAEndpoint:              c.UAAEndpoint,
+		UAAGrantType:             c.UAAGrantType,
+		UAAOAuthClient:           c.UAAOAuthClient,
+		UAAOAuthClientSecret:     c.UAAOAuthClientSecret,
+	}
+}
+
+func (c *JSONConfig) setTargetContext(context TargetContext) {
+	c.AccessToken = context.AccessToken
+	c.APIVersion = context.APIVersion
+	c.AuthorizationEndpoint = context.AuthorizationEndpoint
+	c.CFOnK8s = context.CFOnK8s
+	c.DopplerEndpoint = context.DopplerEndpoint
+	c.LogCacheEndpoint = context.LogCacheEndpoint
+	c.MinCLIVersion = context.MinCLIVersion
+	c.MinRecommendedCLIVersion = context.MinRecommendedCLIVersion
+	c.NetworkPolicyV1Endpoint = context.NetworkPolicyV1Endpoint
+	c.TargetedOrganization = context.TargetedOrganization
+	c.RefreshToken = context.RefreshToken
+	c.RoutingEndpoint = context.RoutingEndpoint
+	c.TargetedSpace = context.TargetedSpace
+	c.SSHOAuthClient = context.SSHOAuthClient
+	c.SkipSSLValidation = context.SkipSSLValidation
+	c.Target = context.Target
+	c.UAAEndpoint = context.UAAEndpoint
+	c.UAAGrantType = context.UAAGrantType
+	c.UAAOAuthClient = context.UAAOAuthClient
+	c.UAAOAuthClientSecret = context.UAAOAuthClientSecret
+}
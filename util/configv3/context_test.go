@@ -0,0 +1,167 @@
+package configv3_test
+
+import (
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Contexts", func() {
+	var (
+		homeDir string
+		config  *Config
+	)
+
+	BeforeEach(func() {
+		homeDir = setup()
+
+		config = &Config{ConfigFile: JSONConfig{ConfigVersion: CurrentConfigVersion}}
+		config.SetTargetInformation(TargetInformationArgs{Api: "https://api.dev.com"})
+		config.SetTokenInformation("dev-access-token", "dev-refresh-token", "ssh-client")
+		config.SetOrganizationInformation("dev-org-guid", "dev-org")
+		config.V7SetSpaceInformation("dev-space-guid", "dev-space")
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	Describe("CreateContext", func() {
+		It("saves the current target and session and makes the context current", func() {
+			config.CreateContext("dev")
+
+			Expect(config.CurrentContext()).To(Equal("dev"))
+			Expect(config.ContextNames()).To(Equal([]string{"dev"}))
+
+			context, ok := config.GetContext("dev")
+			Expect(ok).To(BeTrue())
+			Expect(context.Target).To(Equal("https://api.dev.com"))
+			Expect(context.AccessToken).To(Equal("dev-access-token"))
+			Expect(context.TargetedOrganization.Name).To(Equal("dev-org"))
+			Expect(context.TargetedSpace.Name).To(Equal("dev-space"))
+		})
+	})
+
+	Describe("SwitchContext", func() {
+		BeforeEach(func() {
+			config.CreateContext("dev")
+			config.CreateContext("prod")
+			config.SetTargetInformation(TargetInformationArgs{Api: "https://api.prod.com"})
+			config.SetTokenInformation("prod-access-token", "prod-refresh-token", "ssh-client")
+			config.SetOrganizationInformation("prod-org-guid", "prod-org")
+			config.V7SetSpaceInformation("prod-space-guid", "prod-space")
+		})
+
+		It("loads the target and session of the context", func() {
+			Expect(config.SwitchContext("dev")).To(Succeed())
+
+			Expect(config.CurrentContext()).To(Equal("dev"))
+			Expect(config.Target()).To(Equal("https://api.dev.com"))
+			Expect(config.AccessToken()).To(Equal("dev-access-token"))
+			Expect(config.TargetedOrganizationName()).To(Equal("dev-org"))
+			Expect(config.TargetedSpace().Name).To(Equal("dev-space"))
+		})
+
+		It("saves the target and session of the previous context", func() {
+			Expect(config.SwitchContext("dev")).To(Succeed())
+			Expect(config.SwitchContext("prod")).To(Succeed())
+
+			Expect(config.Target()).To(Equal("https://api.prod.com"))
+			Expect(config.AccessToken()).To(Equal("prod-access-token"))
+			Expect(config.TargetedSpace().Name).To(Equal("prod-space"))
+		})
+
+		When("the context does not exist", func() {
+			It("returns a ContextNotFoundError and leaves the target alone", func() {
+				Expect(config.SwitchContext("staging")).To(MatchError(translatableerror.ContextNotFoundError{Name: "staging"}))
+				Expect(config.CurrentContext()).To(Equal("prod"))
+				Expect(config.Target()).To(Equal("https://api.prod.com"))
+			})
+		})
+	})
+
+	Describe("DeleteContext", func() {
+		BeforeEach(func() {
+			config.CreateContext("dev")
+		})
+
+		It("removes the context and keeps the current target", func() {
+			Expect(config.DeleteContext("dev")).To(Succeed())
+
+			Expect(config.HasContext("dev")).To(BeFalse())
+			Expect(config.CurrentContext()).To(BeEmpty())
+			Expect(config.Target()).To(Equal("https://api.dev.com"))
+		})
+
+		When("the context does not exist", func() {
+			It("returns a ContextNotFoundError", func() {
+				Expect(config.DeleteContext("staging")).To(MatchError(translatableerror.ContextNotFoundError{Name: "staging"}))
+			})
+		})
+	})
+
+	Describe("ApplyContextOverride", func() {
+		BeforeEach(func() {
+			config.CreateContext("dev")
+			config.CreateContext("prod")
+			config.SetTargetInformation(TargetInformationArgs{Api: "https://api.prod.com"})
+			config.SetTokenInformation("prod-access-token", "prod-refresh-token", "ssh-client")
+		})
+
+		When("the --context flag is provided", func() {
+			BeforeEach(func() {
+				config.Flags = FlagOverride{Context: "dev"}
+				config.ENV = EnvOverride{CFContext: "prod"}
+			})
+
+			It("uses the context for this invocation only", func() {
+				Expect(config.ApplyContextOverride()).To(Succeed())
+
+				Expect(config.CurrentContext()).To(Equal("dev"))
+				Expect(config.Target()).To(Equal("https://api.dev.com"))
+				Expect(config.AccessToken()).To(Equal("dev-access-token"))
+			})
+
+			It("keeps the saved current context when the config is written", func() {
+				Expect(config.ApplyContextOverride()).To(Succeed())
+				config.SetTokenInformation("new-dev-access-token", "new-dev-refresh-token", "ssh-client")
+				Expect(config.WriteConfig()).To(Succeed())
+
+				loadedConfig, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(loadedConfig.CurrentContext()).To(Equal("prod"))
+				Expect(loadedConfig.Target()).To(Equal("https://api.prod.com"))
+				Expect(loadedConfig.AccessToken()).To(Equal("prod-access-token"))
+
+				Expect(loadedConfig.SwitchContext("dev")).To(Succeed())
+				Expect(loadedConfig.AccessToken()).To(Equal("new-dev-access-token"))
+			})
+		})
+
+		When("$CF_CONTEXT is set", func() {
+			BeforeEach(func() {
+				config.ENV = EnvOverride{CFContext: "dev"}
+			})
+
+			It("uses the context for this invocation only", func() {
+				Expect(config.ApplyContextOverride()).To(Succeed())
+
+				Expect(config.CurrentContext()).To(Equal("dev"))
+				Expect(config.Target()).To(Equal("https://api.dev.com"))
+			})
+		})
+
+		When("the context does not exist", func() {
+			BeforeEach(func() {
+				config.Flags = FlagOverride{Context: "staging"}
+			})
+
+			It("returns a ContextNotFoundError", func() {
+				Expect(config.ApplyContextOverride()).To(MatchError(translatableerror.ContextNotFoundError{Name: "staging"}))
+				Expect(config.Target()).To(Equal("https://api.prod.com"))
+			})
+		})
+	})
+})
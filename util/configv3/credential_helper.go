@@ -0,0 +1,140 @@
+package configv3
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os/exec"
+	"strings"
+
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+// CredentialHelperPrefix is prepended to the name of a credential store to
+// get the name of the executable that implements it.
+const CredentialHelperPrefix = "cf-credential-"
+
+// credentialsNotFoundMessage is printed by credential helpers that have no
+// secrets for a key.
+const credentialsNotFoundMessage = "credentials not found"
+
+// CredentialHelper stores secrets with an external executable, named
+// cf-credential-<name> and found in $PATH, modeled on docker credential
+// helpers. The executable is run with one of the following actions as its
+// only argument:
+//
+//   get    reads a key from stdin and writes the matching
+//          {"ServerURL": key, "Username": ..., "Secret": ...} JSON object to
+//          stdout, or prints "credentials not found" and exits non-zero
+//   store  reads a {"ServerURL": key, "Username": ..., "Secret": ...} JSON
+//          object from stdin
+//   erase  reads a key from stdin
+//
+// The secret is the JSON encoded Credentials of a session.
+type CredentialHelper struct {
+	Name string
+
+	// KeyPrefix keeps the sessions of different config files apart.
+	KeyPrefix string
+}
+
+type credentialHelperMessage struct {
+	ServerURL string `json:"ServerURL"`
+	Username  string `json:"Username"`
+	Secret    string `json:"Secret"`
+}
+
+// NewCredentialHelper returns a CredentialHelper that keeps the secrets of
+// the current config file.
+func NewCredentialHelper(name string) CredentialHelper {
+	return CredentialHelper{
+		Name:      name,
+		KeyPrefix: ConfigFilePath(),
+	}
+}
+
+// Get returns the secrets of the session. No secrets are returned when the
+// helper has none for the session.
+func (helper CredentialHelper) Get(session string) (Credentials, error) {
+	output, err := helper.run("get", strings.NewReader(helper.key(session)))
+	if err != nil {
+		if strings.Contains(string(output), credentialsNotFoundMessage) {
+			return Credentials{}, nil
+		}
+		return Credentials{}, helper.error(output, err)
+	}
+
+	var message credentialHelperMessage
+	err = json.Unmarshal(output, &message)
+	if err != nil {
+		return Credentials{}, helper.error(nil, err)
+	}
+
+	var credentials Credentials
+	err = json.Unmarshal([]byte(message.Secret), &credentials)
+	if err != nil {
+		return Credentials{}, helper.error(nil, err)
+	}
+	return credentials, nil
+}
+
+// Store saves the secrets of the session.
+func (helper CredentialHelper) Store(session string, credentials Credentials) error {
+	secret, err := json.Marshal(credentials)
+	if err != nil {
+		return err
+	}
+
+	input, err := json.Marshal(credentialHelperMessage{
+		ServerURL: helper.key(session),
+		Username:  "cf",
+		Secret:    string(secret),
+	})
+	if err != nil {
+		return err
+	}
+
+	output, err := helper.run("store", bytes.NewReader(input))
+	if err != nil {
+		return helper.error(output, err)
+	}
+	return nil
+}
+
+// Erase removes the secrets of the session. Erasing a session the helper has
+// no secrets for is not an error.
+func (helper CredentialHelper) Erase(session string) error {
+	output, err := helper.run("erase", strings.NewReader(helper.key(session)))
+	if err != nil && !strings.Contains(string(output), credentialsNotFoundMessage) {
+		return helper.error(output, err)
+	}
+	return nil
+}
+
+func (helper CredentialHelper) key(session string) string {
+	if session == "" {
+		return helper.KeyPrefix
+	}
+	return helper.KeyPrefix + "#" + session
+}
+
+func (helper CredentialHelper) run(action string, input io.Reader) ([]byte, error) {
+	cmd := exec.Command(CredentialHelperPrefix+helper.Name, action)
+	cmd.Stdin = input
+	return cmd.Output()
+}
+
+func (helper CredentialHelper) error(output []byte, err error) error {
+	message := strings.TrimSpace(string(output))
+	if exitErr, ok := err.(*exec.ExitError); ok && message == "" {
+		message = strings.TrimSpace(string(exitErr.Stderr))
+	}
+	if message == "" {
+		message = err.Error()
+	}
+
+	return translatableerror.CredentialHelperError{
+		Helper:  CredentialHelperPrefix + helper.Name,
+		Message: message,
+	}
+}
@@ -0,0 +1,327 @@
+//go:build !windows
+// +build !windows
+
+package configv3_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// fakeCredentialHelper keeps each secret in a secrets/ file named after the hex
+// encoding of its key, and logs every action it runs.
+const fakeCredentialHelper = `#!/bin/sh
+dir="$FAKE_CREDENTIAL_STORE"
+echo "$1" >> "$dir/log"
+if [ -n "$FAKE_CREDENTIAL_STORE_FAIL" ]; then
+  echo "$FAKE_CREDENTIAL_STORE_FAIL" >&2
+  exit 1
+fi
+file_for() { echo "$dir/secrets/$(printf %s "$1" | od -An -tx1 | tr -d ' \n')"; }
+case "$1" in
+  get)
+    file=$(file_for "$(cat)")
+    if [ -f "$file" ]; then cat "$file"; else echo "credentials not found in native keychain"; exit 1; fi
+    ;;
+  store)
+    input=$(cat)
+    key=$(printf %s "$input" | sed 's/.*"ServerURL":"\([^"]*\)".*/\1/')
+    printf %s "$input" > "$(file_for "$key")"
+    ;;
+  erase)
+    file=$(file_for "$(cat)")
+    if [ -f "$file" ]; then rm "$file"; else echo "credentials not found in native keychain"; exit 1; fi
+    ;;
+esac
+`
+
+var _ = Describe("credential helpers", func() {
+	var (
+		homeDir  string
+		storeDir string
+		oldPath  string
+	)
+
+	actions := func() []string {
+		raw, err := ioutil.ReadFile(filepath.Join(storeDir, "log"))
+		if os.IsNotExist(err) {
+			return nil
+		}
+		Expect(err).NotTo(HaveOccurred())
+		return strings.Fields(string(raw))
+	}
+
+	storedSecrets := func() []string {
+		files, err := filepath.Glob(filepath.Join(storeDir, "secrets", "*"))
+		Expect(err).NotTo(HaveOccurred())
+		var secrets []string
+		for _, file := range files {
+			raw, err := ioutil.ReadFile(file)
+			Expect(err).NotTo(HaveOccurred())
+			var message struct{ Secret string }
+			Expect(json.Unmarshal(raw, &message)).To(Succeed())
+			secrets = append(secrets, message.Secret)
+		}
+		return secrets
+	}
+
+	BeforeEach(func() {
+		homeDir = setup()
+
+		var err error
+		storeDir, err = ioutil.TempDir("", "cli-credential-store")
+		Expect(err).NotTo(HaveOccurred())
+		binDir := filepath.Join(storeDir, "bin")
+		Expect(os.Mkdir(binDir, 0700)).To(Succeed())
+		Expect(os.Mkdir(filepath.Join(storeDir, "secrets"), 0700)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(binDir, "cf-credential-fake"), []byte(fakeCredentialHelper), 0700)).To(Succeed())
+
+		oldPath = os.Getenv("PATH")
+		Expect(os.Setenv("PATH", binDir+string(os.PathListSeparator)+oldPath)).To(Succeed())
+		Expect(os.Setenv("FAKE_CREDENTIAL_STORE", storeDir)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.Setenv("PATH", oldPath)).To(Succeed())
+		Expect(os.Unsetenv("FAKE_CREDENTIAL_STORE")).To(Succeed())
+		Expect(os.Unsetenv("FAKE_CREDENTIAL_STORE_FAIL")).To(Succeed())
+		Expect(os.RemoveAll(storeDir)).To(Succeed())
+		teardown(homeDir)
+	})
+
+	Describe("CredentialHelper", func() {
+		var helper CredentialHelper
+
+		BeforeEach(func() {
+			helper = CredentialHelper{Name: "fake", KeyPrefix: "/some/config.json"}
+		})
+
+		It("stores, gets and erases the secrets of a session", func() {
+			credentials := Credentials{AccessToken: "some-access-token", RefreshToken: "some-refresh-token"}
+			Expect(helper.Store("staging", credentials)).To(Succeed())
+			Expect(helper.Get("staging")).To(Equal(credentials))
+			Expect(helper.Get("")).To(Equal(Credentials{}))
+
+			Expect(helper.Erase("staging")).To(Succeed())
+			Expect(helper.Get("staging")).To(Equal(Credentials{}))
+			Expect(helper.Erase("staging")).To(Succeed())
+		})
+
+		When("the helper fails", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("FAKE_CREDENTIAL_STORE_FAIL", "the keychain is locked")).To(Succeed())
+			})
+
+			It("returns a CredentialHelperError", func() {
+				_, err := helper.Get("")
+				Expect(err).To(MatchError(translatableerror.CredentialHelperError{
+					Helper:  "cf-credential-fake",
+					Message: "the keychain is locked",
+				}))
+			})
+		})
+
+		When("the helper is not installed", func() {
+			BeforeEach(func() {
+				helper.Name = "missing"
+			})
+
+			It("returns a CredentialHelperError", func() {
+				err := helper.Store("", Credentials{AccessToken: "some-access-token"})
+				Expect(err).To(BeAssignableToTypeOf(translatableerror.CredentialHelperError{}))
+				Expect(err.(translatableerror.CredentialHelperError).Message).To(ContainSubstring("executable file not found"))
+			})
+		})
+	})
+
+	Describe("Config", func() {
+		BeforeEach(func() {
+			config, err := LoadConfig()
+			Expect(err).NotTo(HaveOccurred())
+			config.SetTokenInformation("some-access-token", "some-refresh-token", "ssh-oauth-client")
+			config.SetTargetInformation(TargetInformationArgs{Api: "https://api.example.com"})
+			config.CreateContext("production")
+			config.SetCredentialsStore("fake")
+			Expect(config.WriteConfig()).To(Succeed())
+		})
+
+		It("keeps secrets out of config.json", func() {
+			raw, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(raw)).NotTo(ContainSubstring("some-access-token"))
+			Expect(string(raw)).NotTo(ContainSubstring("some-refresh-token"))
+			Expect(string(raw)).To(ContainSubstring(`"CredentialsStore": "fake"`))
+
+			Expect(storedSecrets()).To(ConsistOf(
+				`{"AccessToken":"some-access-token","RefreshToken":"some-refresh-token"}`,
+				`{"AccessToken":"some-access-token","RefreshToken":"some-refresh-token"}`,
+			))
+		})
+
+		It("reads secrets from the credential helper when loading the config", func() {
+			config, err := LoadConfig()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.AccessToken()).To(Equal("some-access-token"))
+			Expect(config.RefreshToken()).To(Equal("some-refresh-token"))
+
+			context, ok := config.GetContext("production")
+			Expect(ok).To(BeTrue())
+			Expect(context.AccessToken).To(Equal("some-access-token"))
+		})
+
+		When("there are other contexts", func() {
+			BeforeEach(func() {
+				config, err := LoadConfig()
+				Expect(err).NotTo(HaveOccurred())
+				config.CreateContext("staging")
+				config.SetTokenInformation("staging-access-token", "staging-refresh-token", "ssh-oauth-client")
+				Expect(config.SwitchContext("production")).To(Succeed())
+				Expect(config.WriteConfig()).To(Succeed())
+				Expect(os.Remove(filepath.Join(storeDir, "log"))).To(Succeed())
+			})
+
+			It("only reads the secrets of the active session when loading the config", func() {
+				_, err := LoadConfig()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actions()).To(Equal([]string{"get"}))
+			})
+
+			It("reads the secrets of a context when switching to it", func() {
+				config, err := LoadConfig()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(config.AccessToken()).To(Equal("some-access-token"))
+
+				Expect(config.SwitchContext("staging")).To(Succeed())
+				Expect(actions()).To(Equal([]string{"get", "get"}))
+				Expect(config.AccessToken()).To(Equal("staging-access-token"))
+				Expect(config.RefreshToken()).To(Equal("staging-refresh-token"))
+			})
+
+			It("reads the secrets of a context provided for one invocation", func() {
+				config, err := LoadConfig(FlagOverride{Context: "staging"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(config.ApplyContextOverride()).To(Succeed())
+				Expect(config.AccessToken()).To(Equal("staging-access-token"))
+			})
+
+			It("keeps the secrets of contexts that were not used", func() {
+				config, err := LoadConfig()
+				Expect(err).NotTo(HaveOccurred())
+				config.SetAccessToken("new-access-token")
+				Expect(config.WriteConfig()).To(Succeed())
+
+				config, err = LoadConfig()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(config.SwitchContext("staging")).To(Succeed())
+				Expect(config.AccessToken()).To(Equal("staging-access-token"))
+			})
+
+			It("moves the secrets of every context when the credentials store changes", func() {
+				config, err := LoadConfig()
+				Expect(err).NotTo(HaveOccurred())
+				config.SetCredentialsStore("")
+				Expect(config.WriteConfig()).To(Succeed())
+
+				Expect(storedSecrets()).To(BeEmpty())
+				raw, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(raw)).To(ContainSubstring("staging-access-token"))
+			})
+		})
+
+		It("only runs the credential helper for secrets that changed", func() {
+			config, err := LoadConfig()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(os.Remove(filepath.Join(storeDir, "log"))).To(Succeed())
+
+			Expect(config.WriteConfig()).To(Succeed())
+			Expect(actions()).To(BeEmpty())
+
+			config.SetAccessToken("new-access-token")
+			Expect(config.WriteConfig()).To(Succeed())
+			Expect(actions()).To(Equal([]string{"store", "store"}))
+		})
+
+		It("erases the secrets of deleted contexts", func() {
+			config, err := LoadConfig()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.DeleteContext("production")).To(Succeed())
+			Expect(config.WriteConfig()).To(Succeed())
+
+			Expect(storedSecrets()).To(HaveLen(1))
+		})
+
+		When("secrets are moved back to config.json", func() {
+			BeforeEach(func() {
+				config, err := LoadConfig()
+				Expect(err).NotTo(HaveOccurred())
+				config.SetCredentialsStore("")
+				Expect(config.WriteConfig()).To(Succeed())
+			})
+
+			It("writes them to config.json and erases them from the credential helper", func() {
+				raw, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(raw)).To(ContainSubstring("some-access-token"))
+				Expect(string(raw)).NotTo(ContainSubstring("CredentialsStore"))
+
+				Expect(storedSecrets()).To(BeEmpty())
+			})
+		})
+
+		When("the credential helper fails", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("FAKE_CREDENTIAL_STORE_FAIL", "the keychain is locked")).To(Succeed())
+			})
+
+			It("loads the config without the secrets and returns a warning", func() {
+				config, err := LoadConfig()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(config.AccessToken()).To(BeEmpty())
+				Expect(config.TakeWarnings()).To(ConsistOf(
+					"Credential helper 'cf-credential-fake' failed: the keychain is locked\nContinuing without the stored credentials; you may need to log in again.",
+				))
+				Expect(config.TakeWarnings()).To(BeEmpty())
+			})
+
+			It("does not erase the secrets it could not read when the config is written", func() {
+				config, err := LoadConfig()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(config.WriteConfig()).To(Succeed())
+
+				Expect(os.Unsetenv("FAKE_CREDENTIAL_STORE_FAIL")).To(Succeed())
+				config, err = LoadConfig()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(config.AccessToken()).To(Equal("some-access-token"))
+			})
+		})
+
+		When("the credential helper is not installed", func() {
+			BeforeEach(func() {
+				raw, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+				Expect(err).NotTo(HaveOccurred())
+				raw = []byte(strings.Replace(string(raw), `"CredentialsStore": "fake"`, `"CredentialsStore": "missing"`, 1))
+				Expect(ioutil.WriteFile(filepath.Join(homeDir, ".cf", "config.json"), raw, 0600)).To(Succeed())
+			})
+
+			It("loads the config without the secrets and returns a warning", func() {
+				config, err := LoadConfig()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(config.AccessToken()).To(BeEmpty())
+
+				warnings := config.TakeWarnings()
+				Expect(warnings).To(HaveLen(1))
+				Expect(warnings[0]).To(ContainSubstring("Credential helper 'cf-credential-missing' failed"))
+			})
+		})
+	})
+})
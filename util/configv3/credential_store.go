@@ -0,0 +1,73 @@
+package configv3
+
+// Credentials are the secrets of a CLI session.
+type Credentials struct {
+	AccessToken          string `json:"AccessToken,omitempty"`
+	RefreshToken         string `json:"RefreshToken,omitempty"`
+	UAAOAuthClientSecret string `json:"UAAOAuthClientSecret,omitempty"`
+}
+
+// IsEmpty returns true if none of the secrets are set.
+func (c Credentials) IsEmpty() bool {
+	return c == Credentials{}
+}
+
+// CredentialStore saves and retrieves the secrets of CLI sessions. A session
+// is identified by the name of its context, or by an empty string for the
+// active session.
+type CredentialStore interface {
+	Get(session string) (Credentials, error)
+	Store(session string, credentials Credentials) error
+	Erase(session string) error
+}
+
+// FileCredentialStore keeps secrets in config.json alongside the rest of the
+// session. It is used unless a credential helper has been configured.
+type FileCredentialStore struct {
+	ConfigFile *JSONConfig
+}
+
+// Get returns the secrets of the session. Sessions without a context return
+// no secrets.
+func (store FileCredentialStore) Get(session string) (Credentials, error) {
+	if session == "" {
+		return Credentials{
+			AccessToken:          store.ConfigFile.AccessToken,
+			RefreshToken:         store.ConfigFile.RefreshToken,
+			UAAOAuthClientSecret: store.ConfigFile.UAAOAuthClientSecret,
+		}, nil
+	}
+
+	context := store.ConfigFile.Contexts[session]
+	return Credentials{
+		AccessToken:          context.AccessToken,
+		RefreshToken:         context.RefreshToken,
+		UAAOAuthClientSecret: context.UAAOAuthClientSecret,
+	}, nil
+}
+
+// Store sets the secrets of the session. Secrets of sessions without a
+// context are discarded.
+func (store FileCredentialStore) Store(session string, credentials Credentials) error {
+	if session == "" {
+		store.ConfigFile.AccessToken = credentials.AccessToken
+		store.ConfigFile.RefreshToken = credentials.RefreshToken
+		store.ConfigFile.UAAOAuthClientSecret = credentials.UAAOAuthClientSecret
+		return nil
+	}
+
+	context, ok := store.ConfigFile.Contexts[session]
+	if !ok {
+		return nil
+	}
+	context.AccessToken = credentials.AccessToken
+	context.RefreshToken = credentials.RefreshToken
+	context.UAAOAuthClientSecret = credentials.UAAOAuthClientSecret
+	store.ConfigFile.Contexts[session] = context
+	return nil
+}
+
+// Erase removes the secrets of the session.
+func (store FileCredentialStore) Erase(session string) error {
+	return store.Store(session, Credentials{})
+}
@@ -0,0 +1,61 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FileCredentialStore", func() {
+	var (
+		configFile JSONConfig
+		store      FileCredentialStore
+	)
+
+	BeforeEach(func() {
+		configFile = JSONConfig{
+			AccessToken:  "some-access-token",
+			RefreshToken: "some-refresh-token",
+			Contexts: map[string]TargetContext{
+				"staging": {
+					Target:               "https://api.staging.example.com",
+					AccessToken:          "staging-access-token",
+					UAAOAuthClientSecret: "staging-secret",
+				},
+			},
+		}
+		store = FileCredentialStore{ConfigFile: &configFile}
+	})
+
+	It("reads the secrets of the active session and of contexts", func() {
+		Expect(store.Get("")).To(Equal(Credentials{
+			AccessToken:  "some-access-token",
+			RefreshToken: "some-refresh-token",
+		}))
+		Expect(store.Get("staging")).To(Equal(Credentials{
+			AccessToken:          "staging-access-token",
+			UAAOAuthClientSecret: "staging-secret",
+		}))
+		Expect(store.Get("unknown")).To(Equal(Credentials{}))
+	})
+
+	It("stores secrets in the config file", func() {
+		Expect(store.Store("", Credentials{AccessToken: "new-access-token"})).To(Succeed())
+		Expect(store.Store("staging", Credentials{RefreshToken: "new-refresh-token"})).To(Succeed())
+		Expect(store.Store("unknown", Credentials{AccessToken: "discarded"})).To(Succeed())
+
+		Expect(configFile.AccessToken).To(Equal("new-access-token"))
+		Expect(configFile.RefreshToken).To(BeEmpty())
+		Expect(configFile.Contexts["staging"].RefreshToken).To(Equal("new-refresh-token"))
+		Expect(configFile.Contexts["staging"].AccessToken).To(BeEmpty())
+		Expect(configFile.Contexts["staging"].Target).To(Equal("https://api.staging.example.com"))
+		Expect(configFile.Contexts).NotTo(HaveKey("unknown"))
+	})
+
+	It("erases secrets from the config file", func() {
+		Expect(store.Erase("staging")).To(Succeed())
+		Expect(store.Get("staging")).To(Equal(Credentials{}))
+		Expect(store.Get("")).NotTo(Equal(Credentials{}))
+	})
+})
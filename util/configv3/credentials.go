@@ -0,0 +1,179 @@
+package configv3
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+// CredentialsStore returns the name of the credential helper that stores the
+// CLI's secrets, or an empty string when they are stored in config.json.
+func (config *Config) CredentialsStore() string {
+	return config.ConfigFile.CredentialsStore
+}
+
+// SetCredentialsStore sets the credential helper that stores the CLI's
+// secrets. An empty name stores them in config.json. The secrets are moved
+// when the config is written.
+func (config *Config) SetCredentialsStore(name string) {
+	if name == config.ConfigFile.CredentialsStore {
+		return
+	}
+
+	if config.previousCredentialsStore == "" {
+		config.previousCredentialsStore = config.ConfigFile.CredentialsStore
+	}
+	config.ConfigFile.CredentialsStore = name
+}
+
+// restoreCredentials reads the secrets of the active session from the
+// configured credential helper. The secrets of other contexts are read when
+// the context is used.
+func (config *Config) restoreCredentials() {
+	if config.ConfigFile.CredentialsStore == "" {
+		return
+	}
+
+	config.loadCredentials(NewCredentialHelper(config.ConfigFile.CredentialsStore), &config.ConfigFile, "")
+
+	// The current context is saved from the active session every time the
+	// config is written, so the helper holds the same secrets for both.
+	credentials, loaded := config.storedCredentials[""]
+	if current := config.ConfigFile.CurrentContext; loaded && config.HasContext(current) {
+		config.storedCredentials[current] = credentials
+	}
+}
+
+// loadContextCredentials reads the secrets of the named context from the
+// configured credential helper, unless they have been read already.
+func (config *Config) loadContextCredentials(name string) {
+	if config.ConfigFile.CredentialsStore == "" {
+		return
+	}
+
+	config.loadCredentials(NewCredentialHelper(config.ConfigFile.CredentialsStore), &config.ConfigFile, name)
+}
+
+// loadCredentials reads the secrets of session from helper into configFile.
+// Secrets found in config.json, for example ones written by an older version
+// of the CLI, take precedence; they are moved to the credential helper the
+// next time the config is written. A helper that fails leaves the session
+// without secrets and adds a warning.
+func (config *Config) loadCredentials(helper CredentialStore, configFile *JSONConfig, session string) {
+	if _, loaded := config.storedCredentials[session]; loaded {
+		return
+	}
+
+	credentials, err := helper.Get(session)
+	if err != nil {
+		config.warnings = append(config.warnings, credentialsWarning(err))
+		return
+	}
+
+	if config.storedCredentials == nil {
+		config.storedCredentials = map[string]Credentials{}
+	}
+	config.storedCredentials[session] = credentials
+
+	var file CredentialStore = FileCredentialStore{ConfigFile: configFile}
+	fileCredentials, _ := file.Get(session)
+	if fileCredentials.IsEmpty() {
+		_ = file.Store(session, credentials)
+	}
+}
+
+// TakeWarnings returns the problems the config recovered from since it was
+// loaded or TakeWarnings was last called, such as a credential helper that
+// could not be run.
+func (config *Config) TakeWarnings() []string {
+	warnings := config.warnings
+	config.warnings = nil
+	return warnings
+}
+
+func credentialsWarning(err error) string {
+	message := err.Error()
+	if helperErr, ok := err.(translatableerror.CredentialHelperError); ok {
+		message = fmt.Sprintf("Credential helper '%s' failed: %s", helperErr.Helper, helperErr.Message)
+	}
+	return message + "\nContinuing without the stored credentials; you may need to log in again."
+}
+
+// persistCredentials moves the secrets in configFile, which is about to be
+// written to config.json, to the configured credential helper. The helper is
+// only run for secrets that have changed since they were last read or
+// written.
+func (config *Config) persistCredentials(configFile *JSONConfig) error {
+	if previous := config.previousCredentialsStore; previous != "" && previous != configFile.CredentialsStore {
+		var helper CredentialStore = NewCredentialHelper(previous)
+		for _, session := range credentialSessions(*configFile) {
+			config.loadCredentials(helper, configFile, session)
+		}
+		for session := range config.storedCredentials {
+			err := helper.Erase(session)
+			if err != nil {
+				return err
+			}
+		}
+		config.storedCredentials = nil
+	}
+	config.previousCredentialsStore = ""
+
+	if configFile.CredentialsStore == "" {
+		config.erasedSessions = nil
+		return nil
+	}
+
+	// The contexts are shared with the in-memory config, which must keep its
+	// secrets.
+	contexts := make(map[string]TargetContext, len(configFile.Contexts))
+	for name, context := range configFile.Contexts {
+		contexts[name] = context
+	}
+	configFile.Contexts = contexts
+
+	var (
+		helper CredentialStore = NewCredentialHelper(configFile.CredentialsStore)
+		file   CredentialStore = FileCredentialStore{ConfigFile: configFile}
+	)
+	if config.storedCredentials == nil {
+		config.storedCredentials = map[string]Credentials{}
+	}
+
+	for _, session := range config.erasedSessions {
+		err := helper.Erase(session)
+		if err != nil {
+			return err
+		}
+		delete(config.storedCredentials, session)
+	}
+	config.erasedSessions = nil
+
+	for _, session := range credentialSessions(*configFile) {
+		credentials, _ := file.Get(session)
+		if credentials != config.storedCredentials[session] {
+			var err error
+			if credentials.IsEmpty() {
+				err = helper.Erase(session)
+			} else {
+				err = helper.Store(session, credentials)
+			}
+			if err != nil {
+				return err
+			}
+			config.storedCredentials[session] = credentials
+		}
+		_ = file.Erase(session)
+	}
+
+	return nil
+}
+
+// credentialSessions returns the active session followed by every context.
+func credentialSessions(configFile JSONConfig) []string {
+	sessions := []string{""}
+	for name := range configFile.Contexts {
+		sessions = append(sessions, name)
+	}
+	return sessions
+}
@@ -10,6 +10,7 @@ import (
 type EnvOverride struct {
 	BinaryName       string
 	CFColor          string
+	CFContext        string
 	CFDialTimeout    string
 	CFHome           string
 	CFLogLevel       string
@@ -6,32 +6,35 @@ import (
 
 // JSONConfig represents .cf/config.json.
 type JSONConfig struct {
-	AccessToken              string             `json:"AccessToken"`
-	APIVersion               string             `json:"APIVersion"`
-	AsyncTimeout             int                `json:"AsyncTimeout"`
-	AuthorizationEndpoint    string             `json:"AuthorizationEndpoint"`
-	CFOnK8s                  CFOnK8s            `json:"CFOnK8s"`
-	ColorEnabled             string             `json:"ColorEnabled"`
-	ConfigVersion            int                `json:"ConfigVersion"`
-	DopplerEndpoint          string             `json:"DopplerEndPoint"`
-	Locale                   string             `json:"Locale"`
-	LogCacheEndpoint         string             `json:"LogCacheEndPoint"`
-	MinCLIVersion            string             `json:"MinCLIVersion"`
-	MinRecommendedCLIVersion string             `json:"MinRecommendedCLIVersion"`
-	NetworkPolicyV1Endpoint  string             `json:"NetworkPolicyV1Endpoint"`
-	TargetedOrganization     Organization       `json:"OrganizationFields"`
-	PluginRepositories       []PluginRepository `json:"PluginRepos"`
-	RefreshToken             string             `json:"RefreshToken"`
-	RoutingEndpoint          string             `json:"RoutingAPIEndpoint"`
-	TargetedSpace            Space              `json:"SpaceFields"`
-	SSHOAuthClient           string             `json:"SSHOAuthClient"`
-	SkipSSLValidation        bool               `json:"SSLDisabled"`
-	Target                   string             `json:"Target"`
-	Trace                    string             `json:"Trace"`
-	UAAEndpoint              string             `json:"UaaEndpoint"`
-	UAAGrantType             string             `json:"UAAGrantType"`
-	UAAOAuthClient           string             `json:"UAAOAuthClient"`
-	UAAOAuthClientSecret     string             `json:"UAAOAuthClientSecret"`
+	AccessToken              string                   `json:"AccessToken"`
+	APIVersion               string                   `json:"APIVersion"`
+	AsyncTimeout             int                      `json:"AsyncTimeout"`
+	AuthorizationEndpoint    string                   `json:"AuthorizationEndpoint"`
+	CFOnK8s                  CFOnK8s                  `json:"CFOnK8s"`
+	ColorEnabled             string                   `json:"ColorEnabled"`
+	ConfigVersion            int                      `json:"ConfigVersion"`
+	Contexts                 map[string]TargetContext `json:"Contexts,omitempty"`
+	CredentialsStore         string                   `json:"CredentialsStore,omitempty"`
+	CurrentContext           string                   `json:"CurrentContext,omitempty"`
+	DopplerEndpoint          string                   `json:"DopplerEndPoint"`
+	Locale                   string                   `json:"Locale"`
+	LogCacheEndpoint         string                   `json:"LogCacheEndPoint"`
+	MinCLIVersion            string                   `json:"MinCLIVersion"`
+	MinRecommendedCLIVersion string                   `json:"MinRecommendedCLIVersion"`
+	NetworkPolicyV1Endpoint  string                   `json:"NetworkPolicyV1Endpoint"`
+	TargetedOrganization     Organization             `json:"OrganizationFields"`
+	PluginRepositories       []PluginRepository       `json:"PluginRepos"`
+	RefreshToken             string                   `json:"RefreshToken"`
+	RoutingEndpoint          string                   `json:"RoutingAPIEndpoint"`
+	TargetedSpace            Space                    `json:"SpaceFields"`
+	SSHOAuthClient           string                   `json:"SSHOAuthClient"`
+	SkipSSLValidation        bool                     `json:"SSLDisabled"`
+	Target                   string                   `json:"Target"`
+	Trace                    string                   `json:"Trace"`
+	UAAEndpoint              string                   `json:"UaaEndpoint"`
+	UAAGrantType             string                   `json:"UAAGrantType"`
+	UAAOAuthClient           string                   `json:"UAAOAuthClient"`
+	UAAOAuthClientSecret     string                   `json:"UAAOAuthClientSecret"`
 }
 
 // Organization contains basic information about the targeted organization.
@@ -106,6 +106,8 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 		}
 	}
 
+	config.restoreCredentials()
+
 	if config.ConfigFile.SSHOAuthClient == "" {
 		config.ConfigFile.SSHOAuthClient = DefaultSSHOAuthClient
 	}
@@ -118,6 +120,7 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 	config.ENV = EnvOverride{
 		BinaryName:       filepath.Base(os.Args[0]),
 		CFColor:          os.Getenv("CF_COLOR"),
+		CFContext:        os.Getenv("CF_CONTEXT"),
 		CFDialTimeout:    os.Getenv("CF_DIAL_TIMEOUT"),
 		CFLogLevel:       os.Getenv("CF_LOG_LEVEL"),
 		CFPassword:       os.Getenv("CF_PASSWORD"),
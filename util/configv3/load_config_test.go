@@ -105,6 +105,7 @@ var _ = Describe("Config", func() {
 				))
 				Expect(config.Flags).To(Equal(FlagOverride{}))
 				Expect(config.PluginHome()).To(Equal(filepath.Join(homeDir, ".cf", "plugins")))
+				Expect(config.PackageCacheDir()).To(Equal(filepath.Join(homeDir, ".cf", "package-cache")))
 
 				pluginConfig := config.Plugins()
 				Expect(pluginConfig).To(BeEmpty())
@@ -0,0 +1,9 @@
+package configv3
+
+import "path/filepath"
+
+// PackageCacheDir returns the directory in which push keeps the archives it
+// builds until they have been uploaded successfully.
+func (config *Config) PackageCacheDir() string {
+	return filepath.Join(configDirectory(), "package-cache")
+}
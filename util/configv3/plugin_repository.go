@@ -17,6 +17,10 @@ const (
 type PluginRepository struct {
 	Name string `json:"Name"`
 	URL  string `json:"URL"`
+	// PublicKey is the base64-encoded ed25519 key that the binaries of the
+	// repository's plugins must be signed with. Empty when signatures are
+	// not checked.
+	PublicKey string `json:"PublicKey,omitempty"`
 }
 
 // AddPluginRepository adds an new repository to the plugin config. It does not
@@ -26,6 +30,16 @@ func (config *Config) AddPluginRepository(name string, url string) {
 		PluginRepository{Name: name, URL: url})
 }
 
+// SetPluginRepositoryPublicKey sets the public key used to verify the plugin
+// binaries of the named repository.
+func (config *Config) SetPluginRepositoryPublicKey(name string, publicKey string) {
+	for i, repo := range config.ConfigFile.PluginRepositories {
+		if strings.EqualFold(repo.Name, name) {
+			config.ConfigFile.PluginRepositories[i].PublicKey = publicKey
+		}
+	}
+}
+
 // PluginRepositories returns the currently configured plugin repositories from the
 // .cf/config.json.
 func (config *Config) PluginRepositories() []PluginRepository {
@@ -44,4 +44,23 @@ var _ = Describe("PluginRepository", func() {
 			Expect(config.PluginRepositories()).To(ContainElement(PluginRepository{Name: "some-repo", URL: "some-URL"}))
 		})
 	})
+
+	Describe("SetPluginRepositoryPublicKey", func() {
+		It("sets the public key of the repository with that name", func() {
+			config := Config{
+				ConfigFile: JSONConfig{
+					PluginRepositories: []PluginRepository{
+						{Name: "repo-1", URL: "repo1.com"},
+						{Name: "repo-2", URL: "repo2.com"},
+					},
+				},
+			}
+
+			config.SetPluginRepositoryPublicKey("REPO-2", "some-key")
+			Expect(config.PluginRepositories()).To(Equal([]PluginRepository{
+				{Name: "repo-1", URL: "repo1.com"},
+				{Name: "repo-2", URL: "repo2.com", PublicKey: "some-key"},
+			}))
+		})
+	})
 })
@@ -1,8 +1,10 @@
 package configv3
 
 import (
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -40,6 +42,23 @@ func (p Plugin) CalculateSHA1() string {
 	return fmt.Sprintf("%x", fileSHA)
 }
 
+// CalculateSHA256 returns the SHA-256 value of the plugin executable. If an
+// error is encountered calculating SHA-256, N/A is returned
+func (p Plugin) CalculateSHA256() string {
+	f, err := os.Open(p.Location)
+	if err != nil {
+		return notApplicable
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return notApplicable
+	}
+
+	return fmt.Sprintf("%x", hash.Sum(nil))
+}
+
 // PluginCommands returns the plugin's commands sorted by command name.
 func (p Plugin) PluginCommands() []PluginCommand {
 	sort.Slice(p.Commands, func(i, j int) bool {
@@ -497,6 +497,56 @@ var _ = Describe("PluginsConfig", func() {
 			})
 		})
 
+		Describe("CalculateSHA256", func() {
+			var plugin Plugin
+
+			When("no errors are encountered calculating the sha256 value", func() {
+				var file *os.File
+
+				BeforeEach(func() {
+					var err error
+					file, err = ioutil.TempFile("", "")
+					Expect(err).NotTo(HaveOccurred())
+					defer file.Close()
+
+					err = ioutil.WriteFile(file.Name(), []byte("foo"), 0600)
+					Expect(err).NotTo(HaveOccurred())
+
+					plugin.Location = file.Name()
+				})
+
+				AfterEach(func() {
+					err := os.Remove(file.Name())
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("returns the sha256 value", func() {
+					Expect(plugin.CalculateSHA256()).To(Equal("2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"))
+				})
+			})
+
+			When("an error is encountered calculating the sha256 value", func() {
+				var dirPath string
+
+				BeforeEach(func() {
+					var err error
+					dirPath, err = ioutil.TempDir("", "")
+					Expect(err).NotTo(HaveOccurred())
+
+					plugin.Location = dirPath
+				})
+
+				AfterEach(func() {
+					err := os.RemoveAll(dirPath)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("returns 'N/A'", func() {
+					Expect(plugin.CalculateSHA256()).To(Equal("N/A"))
+				})
+			})
+		})
+
 		Describe("PluginCommands", func() {
 			It("returns the plugin's commands sorted by command name", func() {
 				plugin := Plugin{
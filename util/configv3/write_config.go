@@ -13,7 +13,13 @@ import (
 // location of .cf directory is written in the same way LoadConfig reads .cf
 // directory.
 func (c *Config) WriteConfig() error {
-	rawConfig, err := json.MarshalIndent(c.ConfigFile, "", "  ")
+	configFile := c.persistedConfigFile()
+	err := c.persistCredentials(&configFile)
+	if err != nil {
+		return err
+	}
+
+	rawConfig, err := json.MarshalIndent(configFile, "", "  ")
 	if err != nil {
 		return err
 	}
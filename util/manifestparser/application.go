@@ -30,6 +30,7 @@ type Application struct {
 	RandomRoute             bool                     `yaml:"random-route,omitempty"`
 	DefaultRoute            bool                     `yaml:"default-route,omitempty"`
 	Stack                   string                   `yaml:"stack,omitempty"`
+	DependsOn               []string                 `yaml:"depends-on,omitempty"`
 	RemainingManifestFields map[string]interface{}   `yaml:"-,inline"`
 }
 
@@ -0,0 +1,14 @@
+package manifestparser
+
+import (
+	"fmt"
+	"strings"
+)
+
+type DependencyCycleError struct {
+	AppNames []string
+}
+
+func (e DependencyCycleError) Error() string {
+	return fmt.Sprintf("Apps in the manifest depend on each other in a cycle: %s", strings.Join(e.AppNames, " -> "))
+}
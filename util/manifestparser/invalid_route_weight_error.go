@@ -0,0 +1,12 @@
+package manifestparser
+
+import "fmt"
+
+type InvalidRouteWeightError struct {
+	AppName string
+	Route   string
+}
+
+func (e InvalidRouteWeightError) Error() string {
+	return fmt.Sprintf("Route '%s' of app '%s' has an invalid weight; weight must be an integer between 1 and 100.", e.Route, e.AppName)
+}
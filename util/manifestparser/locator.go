@@ -1,6 +1,7 @@
 package manifestparser
 
 import (
+	"io/ioutil"
 	"os"
 	"path/filepath"
 )
@@ -54,3 +55,33 @@ func (loc Locator) handleDir(dir string) (string, bool, error) {
 func (Locator) handleFilepath(filepath string) (string, bool, error) {
 	return filepath, true, nil
 }
+
+// SpaceManifestPaths returns the YAML files that make up a space manifest. A
+// file path is returned as is, while a directory is expanded to every .yml and
+// .yaml file directly inside it, in lexical order.
+func (loc Locator) SpaceManifestPaths(filepathOrDirectory string) ([]string, error) {
+	info, err := os.Stat(filepathOrDirectory)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return []string{filepathOrDirectory}, nil
+	}
+
+	entries, err := ioutil.ReadDir(filepathOrDirectory)
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	for _, entry := range entries {
+		extension := filepath.Ext(entry.Name())
+		if entry.IsDir() || (extension != ".yml" && extension != ".yaml") {
+			continue
+		}
+		paths = append(paths, filepath.Join(filepathOrDirectory, entry.Name()))
+	}
+
+	return paths, nil
+}
@@ -148,4 +148,63 @@ var _ = Describe("Locator", func() {
 			})
 		})
 	})
+
+	Describe("SpaceManifestPaths", func() {
+		var (
+			workingDir string
+			paths      []string
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			var err error
+			workingDir, err = ioutil.TempDir("", "space-manifest-locator-working-dir")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(ioutil.WriteFile(filepath.Join(workingDir, "routes.yaml"), nil, 0600)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(workingDir, "apps.yml"), nil, 0600)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(workingDir, "README.md"), nil, 0600)).To(Succeed())
+			Expect(os.Mkdir(filepath.Join(workingDir, "nested.yml"), 0700)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(workingDir)).ToNot(HaveOccurred())
+		})
+
+		When("given a directory", func() {
+			JustBeforeEach(func() {
+				paths, executeErr = locator.SpaceManifestPaths(workingDir)
+			})
+
+			It("returns the YAML files in the directory in lexical order", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(paths).To(Equal([]string{
+					filepath.Join(workingDir, "apps.yml"),
+					filepath.Join(workingDir, "routes.yaml"),
+				}))
+			})
+		})
+
+		When("given a file path", func() {
+			JustBeforeEach(func() {
+				paths, executeErr = locator.SpaceManifestPaths(filepath.Join(workingDir, "README.md"))
+			})
+
+			It("returns the file", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(paths).To(Equal([]string{filepath.Join(workingDir, "README.md")}))
+			})
+		})
+
+		When("the path does not exist", func() {
+			JustBeforeEach(func() {
+				paths, executeErr = locator.SpaceManifestPaths(filepath.Join(workingDir, "missing"))
+			})
+
+			It("returns the error", func() {
+				Expect(os.IsNotExist(executeErr)).To(BeTrue())
+				Expect(paths).To(BeEmpty())
+			})
+		})
+	})
 })
@@ -49,3 +49,115 @@ func (m Manifest) HasAppWithNoName() bool {
 	}
 	return false
 }
+
+// ValidateDependencies returns an error if an application depends on an
+// application that is not in the manifest, or if the depends-on relations
+// form a cycle.
+func (m Manifest) ValidateDependencies() error {
+	dependencies := make(map[string][]string, len(m.Applications))
+	for _, app := range m.Applications {
+		dependencies[app.Name] = app.DependsOn
+	}
+
+	for _, app := range m.Applications {
+		for _, dependency := range app.DependsOn {
+			if _, ok := dependencies[dependency]; !ok {
+				return UnknownDependencyError{AppName: app.Name, Dependency: dependency}
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(m.Applications))
+
+	var path []string
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			for i, pathName := range path {
+				if pathName == name {
+					return DependencyCycleError{AppNames: append(append([]string{}, path[i:]...), name)}
+				}
+			}
+		}
+
+		state[name] = visiting
+		path = append(path, name)
+		for _, dependency := range dependencies[name] {
+			if err := visit(dependency); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+		return nil
+	}
+
+	for _, app := range m.Applications {
+		if err := visit(app.Name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExtractRouteWeights removes the weight of each entry in the applications'
+// routes, which the cloud controller does not accept in a manifest, and
+// returns the weights keyed by route and then by app name.
+func (m *Manifest) ExtractRouteWeights() (map[string]map[string]int, error) {
+	weights := map[string]map[string]int{}
+	for i, app := range m.Applications {
+		routes, ok := app.RemainingManifestFields["routes"].([]interface{})
+		if !ok {
+			continue
+		}
+
+		strippedRoutes := make([]interface{}, 0, len(routes))
+		for _, route := range routes {
+			routeMap, ok := route.(map[interface{}]interface{})
+			if !ok {
+				strippedRoutes = append(strippedRoutes, route)
+				continue
+			}
+
+			strippedRoute := make(map[interface{}]interface{}, len(routeMap))
+			for key, value := range routeMap {
+				if key != "weight" {
+					strippedRoute[key] = value
+				}
+			}
+			strippedRoutes = append(strippedRoutes, strippedRoute)
+
+			rawWeight, ok := routeMap["weight"]
+			if !ok {
+				continue
+			}
+			routeURL, _ := routeMap["route"].(string)
+			weight, ok := rawWeight.(int)
+			if !ok || weight < 1 || weight > 100 {
+				return nil, InvalidRouteWeightError{AppName: app.Name, Route: routeURL}
+			}
+			if weights[routeURL] == nil {
+				weights[routeURL] = map[string]int{}
+			}
+			weights[routeURL][app.Name] = weight
+		}
+
+		fields := make(map[string]interface{}, len(app.RemainingManifestFields))
+		for key, value := range app.RemainingManifestFields {
+			fields[key] = value
+		}
+		fields["routes"] = strippedRoutes
+		m.Applications[i].RemainingManifestFields = fields
+	}
+
+	return weights, nil
+}
@@ -116,6 +116,125 @@ var _ = Describe("Manifest", func() {
 		})
 	})
 
+	Describe("ExtractRouteWeights", func() {
+		var (
+			routeWeights map[string]map[string]int
+			executeErr   error
+		)
+
+		JustBeforeEach(func() {
+			routeWeights, executeErr = manifest.ExtractRouteWeights()
+		})
+
+		When("the routes have weights", func() {
+			BeforeEach(func() {
+				err := yaml.Unmarshal([]byte(`---
+applications:
+- name: app-v1
+  routes:
+  - route: canary.example.com
+    weight: 90
+  - route: v1.example.com
+- name: app-v2
+  routes:
+  - route: canary.example.com
+    weight: 10
+    protocol: http2
+`), &manifest)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns the weights by route and app name", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(routeWeights).To(Equal(map[string]map[string]int{
+					"canary.example.com": {"app-v1": 90, "app-v2": 10},
+				}))
+			})
+
+			It("removes the weights from the routes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(manifest.Applications[0].RemainingManifestFields["routes"]).To(Equal([]interface{}{
+					map[interface{}]interface{}{"route": "canary.example.com"},
+					map[interface{}]interface{}{"route": "v1.example.com"},
+				}))
+				Expect(manifest.Applications[1].RemainingManifestFields["routes"]).To(Equal([]interface{}{
+					map[interface{}]interface{}{"route": "canary.example.com", "protocol": "http2"},
+				}))
+			})
+		})
+
+		When("no routes have weights", func() {
+			BeforeEach(func() {
+				manifest.Applications = []Application{{Name: "app-1"}}
+			})
+
+			It("returns no weights", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(routeWeights).To(BeEmpty())
+			})
+		})
+
+		When("a weight is not between 1 and 100", func() {
+			BeforeEach(func() {
+				err := yaml.Unmarshal([]byte(`---
+applications:
+- name: app-1
+  routes:
+  - route: example.com
+    weight: 0
+`), &manifest)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns an InvalidRouteWeightError", func() {
+				Expect(executeErr).To(MatchError(InvalidRouteWeightError{AppName: "app-1", Route: "example.com"}))
+			})
+		})
+	})
+
+	Describe("ValidateDependencies", func() {
+		It("returns nil when every dependency is in the manifest", func() {
+			manifest.Applications = []Application{
+				{Name: "frontend", DependsOn: []string{"backend", "worker"}},
+				{Name: "backend", DependsOn: []string{"worker"}},
+				{Name: "worker"},
+			}
+
+			Expect(manifest.ValidateDependencies()).To(Succeed())
+		})
+
+		It("returns an error when an app depends on an app that is not in the manifest", func() {
+			manifest.Applications = []Application{
+				{Name: "frontend", DependsOn: []string{"backend"}},
+			}
+
+			Expect(manifest.ValidateDependencies()).To(MatchError(UnknownDependencyError{
+				AppName:    "frontend",
+				Dependency: "backend",
+			}))
+		})
+
+		It("returns an error describing the cycle when apps depend on each other", func() {
+			manifest.Applications = []Application{
+				{Name: "frontend", DependsOn: []string{"backend"}},
+				{Name: "backend", DependsOn: []string{"worker"}},
+				{Name: "worker", DependsOn: []string{"backend"}},
+			}
+
+			err := manifest.ValidateDependencies()
+			Expect(err).To(MatchError(DependencyCycleError{AppNames: []string{"backend", "worker", "backend"}}))
+			Expect(err).To(MatchError("Apps in the manifest depend on each other in a cycle: backend -> worker -> backend"))
+		})
+
+		It("returns an error when an app depends on itself", func() {
+			manifest.Applications = []Application{
+				{Name: "frontend", DependsOn: []string{"frontend"}},
+			}
+
+			Expect(manifest.ValidateDependencies()).To(MatchError(DependencyCycleError{AppNames: []string{"frontend", "frontend"}}))
+		})
+	})
+
 	Describe("GetFirstAppWebProcess", func() {
 		BeforeEach(func() {
 			manifest.Applications = []Application{
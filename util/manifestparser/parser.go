@@ -68,6 +68,11 @@ func (m ManifestParser) ParseManifest(pathToManifest string, rawManifest []byte)
 		return Manifest{}, errors.New("Manifest must have at least one application.")
 	}
 
+	err = parsedManifest.ValidateDependencies()
+	if err != nil {
+		return Manifest{}, err
+	}
+
 	parsedManifest.PathToManifest = pathToManifest
 
 	return parsedManifest, nil
@@ -76,3 +81,25 @@ func (m ManifestParser) ParseManifest(pathToManifest string, rawManifest []byte)
 func (m ManifestParser) MarshalManifest(manifest Manifest) ([]byte, error) {
 	return yaml.Marshal(manifest)
 }
+
+// ParseSpaceManifest parses a manifest describing the resources of a space.
+// Unlike ParseManifest, the manifest does not need to contain any
+// applications.
+func (m ManifestParser) ParseSpaceManifest(pathToManifest string, rawManifest []byte) (SpaceManifest, error) {
+	var parsedManifest SpaceManifest
+	err := yaml.Unmarshal(rawManifest, &parsedManifest)
+	if err != nil {
+		return SpaceManifest{}, &yaml.TypeError{}
+	}
+
+	for i, instance := range parsedManifest.ServiceInstances {
+		parsedManifest.ServiceInstances[i].Parameters = normalizeYAMLObject(instance.Parameters)
+		parsedManifest.ServiceInstances[i].Credentials = normalizeYAMLObject(instance.Credentials)
+	}
+
+	for i, binding := range parsedManifest.ServiceBindings {
+		parsedManifest.ServiceBindings[i].Parameters = normalizeYAMLObject(binding.Parameters)
+	}
+
+	return parsedManifest, nil
+}
@@ -282,6 +282,34 @@ applications:
 			})
 		})
 
+		When("an app depends on an app that is not in the manifest", func() {
+			BeforeEach(func() {
+				rawManifest = []byte(`applications:
+- name: one
+  depends-on: [two]
+`)
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(UnknownDependencyError{AppName: "one", Dependency: "two"}))
+			})
+		})
+
+		When("apps depend on each other in a cycle", func() {
+			BeforeEach(func() {
+				rawManifest = []byte(`applications:
+- name: one
+  depends-on: [two]
+- name: two
+  depends-on: [one]
+`)
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(DependencyCycleError{AppNames: []string{"one", "two", "one"}}))
+			})
+		})
+
 		When("the manifest is valid", func() {
 			BeforeEach(func() {
 				rawManifest = []byte(`applications:
@@ -295,6 +323,23 @@ applications:
 				Expect(parsedManifest.AppNames()).To(ConsistOf("one", "two"))
 			})
 		})
+
+		When("an app depends on another app", func() {
+			BeforeEach(func() {
+				rawManifest = []byte(`applications:
+- name: frontend
+  depends-on:
+  - backend
+- name: backend
+`)
+			})
+
+			It("parses the dependencies", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(parsedManifest.Applications[0].DependsOn).To(Equal([]string{"backend"}))
+				Expect(parsedManifest.Applications[0].RemainingManifestFields).ToNot(HaveKey("depends-on"))
+			})
+		})
 	})
 
 	Describe("MarshalManifest", func() {
@@ -326,4 +371,97 @@ applications:
 `))
 		})
 	})
+
+	Describe("ParseSpaceManifest", func() {
+		var (
+			rawManifest   []byte
+			spaceManifest SpaceManifest
+			executeErr    error
+		)
+
+		JustBeforeEach(func() {
+			spaceManifest, executeErr = parser.ParseSpaceManifest("some-path", rawManifest)
+		})
+
+		When("the manifest describes several kinds of resources", func() {
+			BeforeEach(func() {
+				rawManifest = []byte(`---
+applications:
+- name: some-app
+routes:
+- host: www
+  domain: example.com
+  apps: [some-app]
+service_instances:
+- name: some-db
+  offering: some-offering
+  plan: some-plan
+  parameters:
+    nested:
+      key: value
+- name: some-ups
+  user_provided: true
+  credentials:
+    user: admin
+service_bindings:
+- app: some-app
+  service_instance: some-db
+network_policies:
+- source: some-app
+  destination: other-app
+  ports: 8080-8090
+labels:
+  team: some-team
+`)
+			})
+
+			It("parses every resource", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(spaceManifest.Applications).To(HaveLen(1))
+				Expect(spaceManifest.Applications[0].Name).To(Equal("some-app"))
+				Expect(spaceManifest.Routes).To(Equal([]SpaceRoute{
+					{Host: "www", Domain: "example.com", Apps: []string{"some-app"}},
+				}))
+				Expect(spaceManifest.ServiceInstances).To(HaveLen(2))
+				Expect(spaceManifest.ServiceInstances[1].UserProvided).To(BeTrue())
+				Expect(spaceManifest.ServiceBindings).To(Equal([]SpaceServiceBinding{
+					{App: "some-app", ServiceInstance: "some-db"},
+				}))
+				Expect(spaceManifest.NetworkPolicies).To(Equal([]SpaceNetworkPolicy{
+					{Source: "some-app", Destination: "other-app", Ports: "8080-8090"},
+				}))
+				Expect(spaceManifest.Labels).To(Equal(map[string]string{"team": "some-team"}))
+			})
+
+			It("converts nested parameters and credentials to string keyed maps", func() {
+				Expect(spaceManifest.ServiceInstances[0].Parameters).To(Equal(map[string]interface{}{
+					"nested": map[string]interface{}{"key": "value"},
+				}))
+				Expect(spaceManifest.ServiceInstances[1].Credentials).To(Equal(map[string]interface{}{
+					"user": "admin",
+				}))
+			})
+		})
+
+		When("the manifest has no applications", func() {
+			BeforeEach(func() {
+				rawManifest = []byte("labels:\n  team: some-team\n")
+			})
+
+			It("does not return an error", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(spaceManifest.Labels).To(HaveKeyWithValue("team", "some-team"))
+			})
+		})
+
+		When("the manifest is not valid YAML", func() {
+			BeforeEach(func() {
+				rawManifest = []byte("routes: {{}")
+			})
+
+			It("returns a yaml type error", func() {
+				Expect(executeErr).To(MatchError(&yaml.TypeError{}))
+			})
+		})
+	})
 })
@@ -0,0 +1,222 @@
+package manifestparser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	DefaultNetworkPolicyProtocol = "tcp"
+	DefaultNetworkPolicyPorts    = "8080"
+)
+
+// SpaceManifest describes the desired state of every resource in a space that
+// is managed by `cf apply`. It may be assembled from several YAML files.
+type SpaceManifest struct {
+	Applications     []Application          `yaml:"applications,omitempty"`
+	Routes           []SpaceRoute           `yaml:"routes,omitempty"`
+	ServiceInstances []SpaceServiceInstance `yaml:"service_instances,omitempty"`
+	ServiceBindings  []SpaceServiceBinding  `yaml:"service_bindings,omitempty"`
+	NetworkPolicies  []SpaceNetworkPolicy   `yaml:"network_policies,omitempty"`
+	Labels           map[string]string      `yaml:"labels,omitempty"`
+}
+
+// SpaceRoute is a route in the space, along with the apps it is mapped to.
+type SpaceRoute struct {
+	Host   string   `yaml:"host,omitempty"`
+	Domain string   `yaml:"domain"`
+	Path   string   `yaml:"path,omitempty"`
+	Port   int      `yaml:"port,omitempty"`
+	Apps   []string `yaml:"apps,omitempty"`
+}
+
+// URL returns the route in the same format as the Cloud Controller's route
+// url field.
+func (route SpaceRoute) URL() string {
+	url := route.Domain
+	if route.Host != "" {
+		url = route.Host + "." + url
+	}
+	if route.Port != 0 {
+		url = fmt.Sprintf("%s:%d", url, route.Port)
+	}
+	return url + route.Path
+}
+
+// SpaceServiceInstance is either a managed or a user-provided service
+// instance.
+type SpaceServiceInstance struct {
+	Name            string                 `yaml:"name"`
+	UserProvided    bool                   `yaml:"user_provided,omitempty"`
+	Offering        string                 `yaml:"offering,omitempty"`
+	Plan            string                 `yaml:"plan,omitempty"`
+	Broker          string                 `yaml:"broker,omitempty"`
+	Tags            []string               `yaml:"tags,omitempty"`
+	Parameters      map[string]interface{} `yaml:"parameters,omitempty"`
+	Credentials     map[string]interface{} `yaml:"credentials,omitempty"`
+	RouteServiceURL string                 `yaml:"route_service_url,omitempty"`
+	SyslogDrainURL  string                 `yaml:"syslog_drain_url,omitempty"`
+}
+
+// SpaceServiceBinding binds a service instance to an app in the space.
+type SpaceServiceBinding struct {
+	App             string                 `yaml:"app"`
+	ServiceInstance string                 `yaml:"service_instance"`
+	BindingName     string                 `yaml:"binding_name,omitempty"`
+	Parameters      map[string]interface{} `yaml:"parameters,omitempty"`
+}
+
+// SpaceNetworkPolicy allows traffic between two apps in the space.
+type SpaceNetworkPolicy struct {
+	Source      string `yaml:"source"`
+	Destination string `yaml:"destination"`
+	Protocol    string `yaml:"protocol,omitempty"`
+	Ports       string `yaml:"ports,omitempty"`
+}
+
+// PortRange returns the start and end ports of the policy, defaulting to
+// 8080 when no ports are given.
+func (policy SpaceNetworkPolicy) PortRange() (int, int, error) {
+	ports := policy.Ports
+	if ports == "" {
+		ports = DefaultNetworkPolicyPorts
+	}
+
+	portParts := strings.Split(ports, "-")
+	if len(portParts) > 2 {
+		return 0, 0, fmt.Errorf("Network policy ports '%s' must match integer[-integer].", ports)
+	}
+
+	startPort, err := strconv.Atoi(portParts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("Network policy ports '%s' must be positive integers.", ports)
+	}
+
+	endPort := startPort
+	if len(portParts) == 2 {
+		endPort, err = strconv.Atoi(portParts[1])
+		if err != nil {
+			return 0, 0, fmt.Errorf("Network policy ports '%s' must be positive integers.", ports)
+		}
+	}
+
+	return startPort, endPort, nil
+}
+
+// ProtocolOrDefault returns the protocol of the policy, defaulting to tcp.
+func (policy SpaceNetworkPolicy) ProtocolOrDefault() string {
+	if policy.Protocol == "" {
+		return DefaultNetworkPolicyProtocol
+	}
+	return policy.Protocol
+}
+
+// Merge adds the resources of other to the manifest. Labels in other take
+// precedence over existing labels with the same key.
+func (m *SpaceManifest) Merge(other SpaceManifest) {
+	m.Applications = append(m.Applications, other.Applications...)
+	m.Routes = append(m.Routes, other.Routes...)
+	m.ServiceInstances = append(m.ServiceInstances, other.ServiceInstances...)
+	m.ServiceBindings = append(m.ServiceBindings, other.ServiceBindings...)
+	m.NetworkPolicies = append(m.NetworkPolicies, other.NetworkPolicies...)
+
+	if len(other.Labels) > 0 && m.Labels == nil {
+		m.Labels = map[string]string{}
+	}
+	for key, value := range other.Labels {
+		m.Labels[key] = value
+	}
+}
+
+// Validate checks that every resource in the manifest is named and that no
+// resource is declared twice.
+func (m SpaceManifest) Validate() error {
+	appNames := map[string]bool{}
+	for _, app := range m.Applications {
+		if app.Name == "" {
+			return fmt.Errorf("Found an application with no name specified.")
+		}
+		if appNames[app.Name] {
+			return fmt.Errorf("Application '%s' is declared more than once.", app.Name)
+		}
+		appNames[app.Name] = true
+	}
+
+	routeURLs := map[string]bool{}
+	for _, route := range m.Routes {
+		if route.Domain == "" {
+			return fmt.Errorf("Found a route with no domain specified.")
+		}
+		if routeURLs[route.URL()] {
+			return fmt.Errorf("Route '%s' is declared more than once.", route.URL())
+		}
+		routeURLs[route.URL()] = true
+	}
+
+	instanceNames := map[string]bool{}
+	for _, instance := range m.ServiceInstances {
+		if instance.Name == "" {
+			return fmt.Errorf("Found a service instance with no name specified.")
+		}
+		if instanceNames[instance.Name] {
+			return fmt.Errorf("Service instance '%s' is declared more than once.", instance.Name)
+		}
+		if !instance.UserProvided && (instance.Offering == "" || instance.Plan == "") {
+			return fmt.Errorf("Service instance '%s' must specify an offering and a plan, or be user provided.", instance.Name)
+		}
+		instanceNames[instance.Name] = true
+	}
+
+	for _, binding := range m.ServiceBindings {
+		if binding.App == "" || binding.ServiceInstance == "" {
+			return fmt.Errorf("Found a service binding with no app or service instance specified.")
+		}
+	}
+
+	for _, policy := range m.NetworkPolicies {
+		if policy.Source == "" || policy.Destination == "" {
+			return fmt.Errorf("Found a network policy with no source or destination specified.")
+		}
+		if _, _, err := policy.PortRange(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// normalizeYAMLValue converts the map[interface{}]interface{} values produced
+// by the YAML decoder into map[string]interface{} so they can be sent to the
+// Cloud Controller as JSON.
+func normalizeYAMLValue(value interface{}) interface{} {
+	switch typedValue := value.(type) {
+	case map[interface{}]interface{}:
+		normalized := map[string]interface{}{}
+		for key, val := range typedValue {
+			normalized[fmt.Sprint(key)] = normalizeYAMLValue(val)
+		}
+		return normalized
+	case map[string]interface{}:
+		normalized := map[string]interface{}{}
+		for key, val := range typedValue {
+			normalized[key] = normalizeYAMLValue(val)
+		}
+		return normalized
+	case []interface{}:
+		normalized := make([]interface{}, len(typedValue))
+		for i, val := range typedValue {
+			normalized[i] = normalizeYAMLValue(val)
+		}
+		return normalized
+	default:
+		return value
+	}
+}
+
+func normalizeYAMLObject(object map[string]interface{}) map[string]interface{} {
+	if object == nil {
+		return nil
+	}
+	return normalizeYAMLValue(object).(map[string]interface{})
+}
@@ -0,0 +1,89 @@
+package manifestparser_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/manifestparser"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SpaceManifest", func() {
+	DescribeTable("SpaceRoute.URL",
+		func(route SpaceRoute, expectedURL string) {
+			Expect(route.URL()).To(Equal(expectedURL))
+		},
+		Entry("domain only", SpaceRoute{Domain: "example.com"}, "example.com"),
+		Entry("host and path", SpaceRoute{Host: "www", Domain: "example.com", Path: "/api"}, "www.example.com/api"),
+		Entry("tcp port", SpaceRoute{Domain: "tcp.example.com", Port: 1024}, "tcp.example.com:1024"),
+	)
+
+	Describe("SpaceNetworkPolicy.PortRange", func() {
+		It("defaults to 8080", func() {
+			startPort, endPort, err := SpaceNetworkPolicy{}.PortRange()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(startPort).To(Equal(8080))
+			Expect(endPort).To(Equal(8080))
+		})
+
+		It("parses a range of ports", func() {
+			startPort, endPort, err := SpaceNetworkPolicy{Ports: "9000-9010"}.PortRange()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(startPort).To(Equal(9000))
+			Expect(endPort).To(Equal(9010))
+		})
+
+		It("returns an error when the ports are not integers", func() {
+			_, _, err := SpaceNetworkPolicy{Ports: "http"}.PortRange()
+			Expect(err).To(MatchError("Network policy ports 'http' must be positive integers."))
+		})
+	})
+
+	Describe("Merge", func() {
+		It("appends the resources and overrides labels", func() {
+			manifest := SpaceManifest{
+				Applications: []Application{{Name: "app-1"}},
+				Labels:       map[string]string{"team": "a", "env": "dev"},
+			}
+			manifest.Merge(SpaceManifest{
+				Applications: []Application{{Name: "app-2"}},
+				Routes:       []SpaceRoute{{Domain: "example.com"}},
+				Labels:       map[string]string{"team": "b"},
+			})
+
+			Expect(manifest.Applications).To(Equal([]Application{{Name: "app-1"}, {Name: "app-2"}}))
+			Expect(manifest.Routes).To(Equal([]SpaceRoute{{Domain: "example.com"}}))
+			Expect(manifest.Labels).To(Equal(map[string]string{"team": "b", "env": "dev"}))
+		})
+	})
+
+	DescribeTable("Validate",
+		func(manifest SpaceManifest, expectedErr string) {
+			err := manifest.Validate()
+			if expectedErr == "" {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(expectedErr))
+			}
+		},
+		Entry("valid", SpaceManifest{
+			Applications:     []Application{{Name: "app"}},
+			ServiceInstances: []SpaceServiceInstance{{Name: "db", Offering: "o", Plan: "p"}, {Name: "ups", UserProvided: true}},
+		}, ""),
+		Entry("duplicate app", SpaceManifest{
+			Applications: []Application{{Name: "app"}, {Name: "app"}},
+		}, "Application 'app' is declared more than once."),
+		Entry("duplicate route", SpaceManifest{
+			Routes: []SpaceRoute{{Host: "www", Domain: "example.com"}, {Host: "www", Domain: "example.com"}},
+		}, "Route 'www.example.com' is declared more than once."),
+		Entry("managed service instance without a plan", SpaceManifest{
+			ServiceInstances: []SpaceServiceInstance{{Name: "db", Offering: "o"}},
+		}, "Service instance 'db' must specify an offering and a plan, or be user provided."),
+		Entry("binding without an app", SpaceManifest{
+			ServiceBindings: []SpaceServiceBinding{{ServiceInstance: "db"}},
+		}, "Found a service binding with no app or service instance specified."),
+		Entry("policy with invalid ports", SpaceManifest{
+			NetworkPolicies: []SpaceNetworkPolicy{{Source: "a", Destination: "b", Ports: "1-2-3"}},
+		}, "Network policy ports '1-2-3' must match integer[-integer]."),
+	)
+})
@@ -0,0 +1,12 @@
+package manifestparser
+
+import "fmt"
+
+type UnknownDependencyError struct {
+	AppName    string
+	Dependency string
+}
+
+func (e UnknownDependencyError) Error() string {
+	return fmt.Sprintf("App '%s' depends on '%s', which is not in the manifest.", e.AppName, e.Dependency)
+}
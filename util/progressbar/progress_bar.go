@@ -22,7 +22,9 @@ func NewProgressBar() *ProgressBar {
 func (p *ProgressBar) Complete() {
 	// Adding sleep to ensure UI has finished drawing
 	time.Sleep(time.Second)
-	p.bar.Finish()
+	if p.bar != nil {
+		p.bar.Finish()
+	}
 }
 
 func (p *ProgressBar) NewProgressBarWrapper(reader io.Reader, sizeOfFile int64) io.Reader {
@@ -43,3 +45,11 @@ func (p *ProgressBar) NewProgressBarWrapper(reader io.Reader, sizeOfFile int64)
 func (p *ProgressBar) Ready() {
 	p.ready <- true
 }
+
+// SilentProgressBar passes uploads through without displaying any progress.
+// It is used when several apps are uploaded at the same time.
+type SilentProgressBar struct{}
+
+func (SilentProgressBar) NewProgressBarWrapper(reader io.Reader, sizeOfFile int64) io.Reader {
+	return reader
+}
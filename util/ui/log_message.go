@@ -1,11 +1,13 @@
 package ui
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/fatih/color"
+	"gopkg.in/yaml.v2"
 )
 
 // LogTimestampFormat is the timestamp formatting for log lines.
@@ -48,3 +50,50 @@ func (ui *UI) DisplayLogMessage(message LogMessage, displayHeader bool) {
 		fmt.Fprintf(ui.Out, "   %s\n", logLine)
 	}
 }
+
+type structuredLogMessage struct {
+	Timestamp      string `json:"timestamp" yaml:"timestamp"`
+	SourceType     string `json:"source_type" yaml:"source_type"`
+	SourceInstance string `json:"source_instance" yaml:"source_instance"`
+	MessageType    string `json:"message_type" yaml:"message_type"`
+	Message        string `json:"message" yaml:"message"`
+}
+
+// DisplayStructuredLogMessage outputs a given log message in the configured
+// output format: a single line of JSON, or a YAML document starting with
+// "---", so that a stream of messages can be parsed one at a time.
+func (ui *UI) DisplayStructuredLogMessage(message LogMessage) error {
+	data := structuredLogMessage{
+		Timestamp:      message.Timestamp().In(ui.TimezoneLocation).Format(time.RFC3339Nano),
+		SourceType:     message.SourceType(),
+		SourceInstance: message.SourceInstance(),
+		MessageType:    message.Type(),
+		Message:        strings.TrimRight(message.Message(), "\r\n"),
+	}
+
+	var (
+		raw []byte
+		err error
+	)
+	if ui.outputFormat == OutputFormatYAML {
+		raw, err = yaml.Marshal(data)
+		raw = append([]byte("---\n"), raw...)
+	} else {
+		raw, err = json.Marshal(data)
+		raw = append(raw, '\n')
+	}
+	if err != nil {
+		return err
+	}
+
+	out := ui.structuredOut
+	if out == nil {
+		out = ui.Out
+	}
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	_, err = out.Write(raw)
+	return err
+}
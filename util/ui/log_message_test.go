@@ -115,4 +115,56 @@ var _ = Describe("Log Message", func() {
 			})
 		})
 	})
+
+	Describe("DisplayStructuredLogMessage", func() {
+		var message *uifakes.FakeLogMessage
+
+		BeforeEach(func() {
+			var err error
+			ui.TimezoneLocation, err = time.LoadLocation("America/Los_Angeles")
+			Expect(err).NotTo(HaveOccurred())
+
+			message = new(uifakes.FakeLogMessage)
+			message.MessageReturns("This is a \"log\" message\nwith two lines\r\n")
+			message.TypeReturns("ERR")
+			message.TimestampReturns(time.Unix(1468969692, 500)) // "2016-07-19T16:08:12-07:00"
+			message.SourceTypeReturns("APP/PROC/WEB")
+			message.SourceInstanceReturns("12")
+		})
+
+		When("JSON output is requested", func() {
+			BeforeEach(func() {
+				ui.SetOutputFormat(OutputFormatJSON)
+			})
+
+			It("prints out the message as a single line of JSON to STDOUT", func() {
+				err := ui.DisplayStructuredLogMessage(message)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(out.Contents())).To(Equal(
+					`{"timestamp":"2016-07-19T16:08:12.0000005-07:00","source_type":"APP/PROC/WEB","source_instance":"12","message_type":"ERR","message":"This is a \"log\" message\nwith two lines"}` + "\n",
+				))
+				Expect(errBuff.Contents()).To(BeEmpty())
+			})
+		})
+
+		When("YAML output is requested", func() {
+			BeforeEach(func() {
+				ui.SetOutputFormat(OutputFormatYAML)
+			})
+
+			It("prints out the message as a YAML document to STDOUT", func() {
+				err := ui.DisplayStructuredLogMessage(message)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(out.Contents())).To(HavePrefix("---\n"))
+				Expect(string(out.Contents())).To(MatchYAML(`
+timestamp: "2016-07-19T16:08:12.0000005-07:00"
+source_type: APP/PROC/WEB
+source_instance: "12"
+message_type: ERR
+message: "This is a \"log\" message\nwith two lines"
+`))
+				Expect(errBuff.Contents()).To(BeEmpty())
+			})
+		})
+	})
 })
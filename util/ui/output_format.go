@@ -0,0 +1,77 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+)
+
+// OutputFormat is the format used to render command results.
+type OutputFormat string
+
+const (
+	// OutputFormatDefault renders results as human readable text.
+	OutputFormatDefault OutputFormat = ""
+	// OutputFormatJSON renders results as indented JSON.
+	OutputFormatJSON OutputFormat = "json"
+	// OutputFormatYAML renders results as YAML.
+	OutputFormatYAML OutputFormat = "yaml"
+)
+
+// SetOutputFormat configures the format used by DisplayStructuredOutput. When
+// a structured format is selected, all human readable output (flavor text,
+// tables, OK messages) is redirected to ui.Err so that ui.Out only contains
+// the encoded data.
+func (ui *UI) SetOutputFormat(format OutputFormat) {
+	if format == OutputFormatDefault || ui.outputFormat != OutputFormatDefault {
+		ui.outputFormat = format
+		return
+	}
+
+	ui.outputFormat = format
+	ui.structuredOut = ui.Out
+	ui.Out = ui.Err
+}
+
+// IsStructuredOutput returns true when the results should be rendered with
+// DisplayStructuredOutput instead of tables.
+func (ui *UI) IsStructuredOutput() bool {
+	return ui.outputFormat != OutputFormatDefault
+}
+
+// DisplayStructuredOutput encodes data in the configured output format and
+// outputs the result to the original ui.Out.
+func (ui *UI) DisplayStructuredOutput(data interface{}) error {
+	var (
+		raw []byte
+		err error
+	)
+
+	switch ui.outputFormat {
+	case OutputFormatYAML:
+		raw, err = yaml.Marshal(data)
+	default:
+		buff := new(bytes.Buffer)
+		encoder := json.NewEncoder(buff)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(data)
+		raw = buff.Bytes()
+	}
+	if err != nil {
+		return err
+	}
+
+	out := ui.structuredOut
+	if out == nil {
+		out = ui.Out
+	}
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	_, err = fmt.Fprint(out, string(raw))
+	return err
+}
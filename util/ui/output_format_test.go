@@ -0,0 +1,94 @@
+package ui_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("structured output", func() {
+	type thing struct {
+		Name  string   `json:"name" yaml:"name"`
+		Tags  []string `json:"tags" yaml:"tags"`
+		Count int      `json:"count" yaml:"count"`
+	}
+
+	var (
+		ui      *UI
+		out     *Buffer
+		errBuff *Buffer
+		data    thing
+	)
+
+	BeforeEach(func() {
+		out = NewBuffer()
+		errBuff = NewBuffer()
+		ui = NewTestUI(nil, out, errBuff)
+		data = thing{Name: "some-name", Tags: []string{"a", "b"}, Count: 2}
+	})
+
+	When("no output format is set", func() {
+		It("is not structured", func() {
+			Expect(ui.IsStructuredOutput()).To(BeFalse())
+		})
+
+		It("displays human readable text on ui.Out", func() {
+			ui.DisplayText("hello")
+			Expect(out).To(Say("hello"))
+			Expect(errBuff.Contents()).To(BeEmpty())
+		})
+
+		It("defaults to JSON", func() {
+			Expect(ui.DisplayStructuredOutput(data)).To(Succeed())
+			Expect(out).To(Say(`"name": "some-name"`))
+		})
+	})
+
+	When("the output format is json", func() {
+		BeforeEach(func() {
+			ui.SetOutputFormat(OutputFormatJSON)
+		})
+
+		It("is structured", func() {
+			Expect(ui.IsStructuredOutput()).To(BeTrue())
+		})
+
+		It("encodes the data as indented JSON on the original out", func() {
+			Expect(ui.DisplayStructuredOutput(data)).To(Succeed())
+			Expect(string(out.Contents())).To(MatchJSON(`{"name":"some-name","tags":["a","b"],"count":2}`))
+			Expect(errBuff.Contents()).To(BeEmpty())
+		})
+
+		It("redirects human readable text to ui.Err", func() {
+			ui.DisplayText("Getting things...")
+			ui.DisplayOK()
+			Expect(errBuff).To(Say("Getting things..."))
+			Expect(errBuff).To(Say("OK"))
+			Expect(out.Contents()).To(BeEmpty())
+		})
+
+		When("the format is set more than once", func() {
+			BeforeEach(func() {
+				ui.SetOutputFormat(OutputFormatYAML)
+			})
+
+			It("keeps writing data to the original out", func() {
+				Expect(ui.DisplayStructuredOutput(data)).To(Succeed())
+				Expect(out).To(Say("name: some-name"))
+				Expect(errBuff.Contents()).To(BeEmpty())
+			})
+		})
+	})
+
+	When("the output format is yaml", func() {
+		BeforeEach(func() {
+			ui.SetOutputFormat(OutputFormatYAML)
+		})
+
+		It("encodes the data as YAML", func() {
+			Expect(ui.DisplayStructuredOutput(data)).To(Succeed())
+			Expect(string(out.Contents())).To(MatchYAML("name: some-name\ntags: [a, b]\ncount: 2\n"))
+		})
+	})
+})
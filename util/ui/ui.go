@@ -96,6 +96,9 @@ type UI struct {
 	TimezoneLocation *time.Location
 
 	deferred []string
+
+	outputFormat  OutputFormat
+	structuredOut io.Writer
 }
 
 // NewUI will return a UI object where Out is set to STDOUT, In is set to
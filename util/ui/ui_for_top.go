@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+)
+
+// ClearScreen moves the cursor to the top left of the terminal and clears
+// it. It does nothing when the output is not a terminal.
+func (ui *UI) ClearScreen() {
+	if !ui.IsTTY {
+		return
+	}
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	fmt.Fprint(ui.Out, "\x1b[H\x1b[2J")
+}
+
+// DisplayInstancesTableForTop displays a table of instance metrics, coloring
+// the cells marked in highlighted (such as usage close to a quota) yellow,
+// and down or crashed values in the "state" column red.
+func (ui *UI) DisplayInstancesTableForTop(table [][]string, highlighted [][]bool) {
+	redColor := color.New(color.FgRed, color.Bold)
+	yellowColor := color.New(color.FgYellow, color.Bold)
+	trDown, trCrashed := ui.TranslateText("down"), ui.TranslateText("crashed")
+
+	stateColumn := -1
+	if len(table) > 0 {
+		for j, header := range table[0] {
+			if header == ui.TranslateText("state") {
+				stateColumn = j
+			}
+		}
+	}
+
+	for i, row := range table {
+		if i == 0 {
+			continue
+		}
+		for j := range row {
+			if i < len(highlighted) && j < len(highlighted[i]) && highlighted[i][j] {
+				table[i][j] = ui.modifyColor(row[j], yellowColor)
+			}
+		}
+		if stateColumn >= 0 && (row[stateColumn] == trDown || row[stateColumn] == trCrashed) {
+			table[i][stateColumn] = ui.modifyColor(row[stateColumn], redColor)
+		}
+	}
+	ui.DisplayTableWithHeader("", table, DefaultTableSpacePadding)
+}
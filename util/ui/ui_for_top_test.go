@@ -0,0 +1,68 @@
+package ui_test
+
+import (
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/util/ui/uifakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("UI for top", func() {
+	var (
+		ui         *UI
+		fakeConfig *uifakes.FakeConfig
+		out        *Buffer
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(uifakes.FakeConfig)
+		fakeConfig.ColorEnabledReturns(configv3.ColorEnabled)
+
+		var err error
+		ui, err = NewUI(fakeConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		out = NewBuffer()
+		ui.Out = out
+		ui.Err = NewBuffer()
+	})
+
+	Describe("ClearScreen", func() {
+		When("the output is a terminal", func() {
+			BeforeEach(func() {
+				ui.IsTTY = true
+			})
+
+			It("clears the screen", func() {
+				ui.ClearScreen()
+				Expect(out.Contents()).To(Equal([]byte("\x1b[H\x1b[2J")))
+			})
+		})
+
+		When("the output is not a terminal", func() {
+			It("writes nothing", func() {
+				ui.ClearScreen()
+				Expect(out.Contents()).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("DisplayInstancesTableForTop", func() {
+		It("colors highlighted cells yellow and down or crashed states red", func() {
+			ui.DisplayInstancesTableForTop([][]string{
+				{"app", "state", "memory"},
+				{"app-1", "running", "950M of 1G (93%)"},
+				{"app-2", "crashed", "10M of 1G (1%)"},
+			}, [][]bool{
+				nil,
+				{false, false, true},
+				nil,
+			})
+
+			Expect(ui.Out).To(Say(`app-1\s+running\s+\x1b\[33;1m950M of 1G \(93%\)\x1b\[0m`))
+			Expect(ui.Out).To(Say(`app-2\s+\x1b\[31;1mcrashed\x1b\[0m\s+10M of 1G \(1%\)`))
+		})
+	})
+})